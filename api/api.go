@@ -0,0 +1,9 @@
+// Package api は OpenAPI 形式の API 仕様書を埋め込む
+package api
+
+import "embed"
+
+// Specs は api/*.yaml。openapi.yaml に他の仕様書をまとめて使う
+//
+//go:embed *.yaml
+var Specs embed.FS
@@ -2,8 +2,8 @@ package middleware
 
 import (
 	config "backend_golang/configs"
+	"backend_golang/internal/logging"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
@@ -15,35 +15,58 @@ func Authentication() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		accessToken, err := c.Cookie("access_token")
 		if err != nil {
-			log.Printf("error getting access token: %v", err)
+			logging.FromContext(c.Request.Context()).Debug("access token not found", "error", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
 
-		parsedToken, err := jwt.Parse(accessToken, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected method: %s", token.Header["alg"])
-			}
-			return []byte(config.JWTConfig.GetSecretKey()), nil
-		})
-		if err != nil {
+		userID, ok := parseAccessToken(accessToken)
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
+		setUserID(c, userID)
+		c.Next()
+	}
+}
 
-		var userID string
-		if claims, ok := parsedToken.Claims.(jwt.MapClaims); ok && parsedToken.Valid {
-			// トークンの有効期限を超えている場合はエラーを返す
-			if time.Now().Unix() > int64(claims["exp"].(float64)) {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-				return
+// OptionalAuthentication は有効なトークンがあれば userID をセットし、なくてもリクエストを続行する
+func OptionalAuthentication() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if accessToken, err := c.Cookie("access_token"); err == nil {
+			if userID, ok := parseAccessToken(accessToken); ok {
+				setUserID(c, userID)
 			}
-			userID = claims["sub"].(string)
-		} else {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-			return
 		}
-		c.Set("userID", userID)
 		c.Next()
 	}
 }
+
+// setUserID は認証したメンバーの ID を gin の context とリクエストのロガーに設定する
+func setUserID(c *gin.Context, userID string) {
+	c.Set("userID", userID)
+	c.Request = c.Request.WithContext(logging.With(c.Request.Context(), "user_id", userID))
+}
+
+func parseAccessToken(accessToken string) (string, bool) {
+	parsedToken, err := jwt.Parse(accessToken, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected method: %s", token.Header["alg"])
+		}
+		return []byte(config.JWTConfig.GetSecretKey()), nil
+	})
+	if err != nil {
+		return "", false
+	}
+
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok || !parsedToken.Valid {
+		return "", false
+	}
+	// トークンの有効期限を超えている場合はエラーを返す
+	if time.Now().Unix() > int64(claims["exp"].(float64)) {
+		return "", false
+	}
+	userID, ok := claims["sub"].(string)
+	return userID, ok
+}
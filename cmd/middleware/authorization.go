@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	config "backend_golang/configs"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdmin は管理者以外のリクエストを拒否する。Authentication の後に使う
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !config.AdminConfig.IsAdmin(c.GetString("userID")) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"backend_golang/internal/metrics"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute はどのルートにも一致しなかったリクエストのラベル。パスをそのまま使うと系列が増え続けるため
+const unmatchedRoute = "unmatched"
+
+// Metrics はルートごとの処理時間を記録する
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.HTTPRequestDuration.Observe(time.Since(start).Seconds(), c.Request.Method, route, strconv.Itoa(c.Writer.Status()))
+	}
+}
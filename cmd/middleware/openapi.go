@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"backend_golang/internal/openapi"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenAPIOptions は仕様書による検証の設定
+type OpenAPIOptions struct {
+	// OnResponseError を指定するとレスポンスも検証し、仕様書と異なる場合に呼び出す
+	OnResponseError func(c *gin.Context, err error)
+}
+
+// OpenAPI はパス・クエリのパラメーターとリクエストボディを仕様書で検証し、違反があれば 400 を返す
+// 仕様書にないルートは検証しない。認証が必須の操作でトークンがない場合は認証のミドルウェアに 401 を返させる
+func OpenAPI(spec *openapi.Spec, opts OpenAPIOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := spec.Operation(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+		if _, err := c.Cookie("access_token"); err != nil && op.RequiresAuth() {
+			c.Next()
+			return
+		}
+
+		errs, err := validateRequest(c, op)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Request body too large"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if len(errs) > 0 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": errs})
+			return
+		}
+
+		if opts.OnResponseError == nil {
+			c.Next()
+			return
+		}
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		if err := validateResponse(op, writer); err != nil {
+			opts.OnResponseError(c, err)
+		}
+	}
+}
+
+// validateRequest はパラメーターとボディの違反を返す。ボディを読み込めない場合や JSON でない場合はエラーを返す
+func validateRequest(c *gin.Context, op *openapi.Operation) ([]openapi.FieldError, error) {
+	var errs []openapi.FieldError
+	query := c.Request.URL.Query()
+	for _, param := range op.Parameters {
+		switch param.In {
+		case "path":
+			errs = append(errs, param.Schema.ValidateParameter(param.Name, c.Param(param.Name))...)
+		case "query":
+			if !query.Has(param.Name) {
+				if param.Required {
+					errs = append(errs, openapi.FieldError{Field: param.Name, Message: "This field is required"})
+				}
+				continue
+			}
+			errs = append(errs, param.Schema.ValidateParameter(param.Name, query.Get(param.Name))...)
+		}
+	}
+
+	if op.RequestBody == nil || op.RequestBody.Content["application/json"] == nil || c.Request.Body == nil {
+		return errs, nil
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if len(bytes.TrimSpace(body)) == 0 {
+		if op.RequestBody.Required {
+			errs = append(errs, openapi.FieldError{Message: "request body is required"})
+		}
+		return errs, nil
+	}
+
+	value, err := decodeJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	return append(errs, op.RequestBody.Content["application/json"].Schema.Validate(value)...), nil
+}
+
+// validateResponse はステータスが仕様書に書かれているか、JSON のボディがスキーマに従っているかを検証する
+// 想定外のエラー（5xx）は検証しない
+func validateResponse(op *openapi.Operation, writer *recordingWriter) error {
+	status := writer.Status()
+	if status >= http.StatusInternalServerError {
+		return nil
+	}
+	resp, ok := op.Response(status)
+	if !ok {
+		return fmt.Errorf("%s %s: status %d is not documented", op.Method, op.Path, status)
+	}
+	media := resp.Content["application/json"]
+	if media == nil || writer.body.Len() == 0 {
+		return nil
+	}
+
+	value, err := decodeJSON(writer.body.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", op.Method, op.Path, err)
+	}
+	if errs := media.Schema.Validate(value); len(errs) > 0 {
+		return fmt.Errorf("%s %s: status %d: %w", op.Method, op.Path, status, errors.Join(fieldErrors(errs)...))
+	}
+	return nil
+}
+
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func fieldErrors(errs []openapi.FieldError) []error {
+	converted := make([]error, len(errs))
+	for i, err := range errs {
+		converted[i] = err
+	}
+	return converted
+}
+
+// recordingWriter は JSON のレスポンスボディを書き込みながら記録する
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		w.body.WriteString(s)
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"backend_golang/internal/logging"
+	"backend_golang/internal/metrics"
+	"backend_golang/internal/ratelimit"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit は policies に書かれたルート（例: "POST /v1/teams/:teamID/join"）のリクエストをクライアントごとに制限する
+// クライアントは有効なトークンがあればメンバー ID、なければ IP アドレスで区別する。
+// 制限したルートには RateLimit-* ヘッダーを付け、トークンがなければ Retry-After を付けて 429 を返す。
+// ストアが使えない場合はリクエストを止めずに通す
+func RateLimit(store ratelimit.Store, policies map[string]ratelimit.Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.Request.Method + " " + c.FullPath()
+		policy, ok := policies[route]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		result, err := store.Take(c.Request.Context(), route+" "+clientKey(c), policy, time.Now())
+		if err != nil {
+			logging.FromContext(c.Request.Context()).Warn("rate limit store unavailable", "error", err)
+			c.Next()
+			return
+		}
+
+		header := c.Writer.Header()
+		header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+		header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		header.Set("RateLimit-Reset", seconds(result.Reset))
+		header.Set("RateLimit-Policy", strconv.Itoa(policy.Burst)+";w="+seconds(policy.Period))
+		if !result.Allowed {
+			metrics.RateLimitedRequests.Inc(route)
+			header.Set("Retry-After", seconds(result.RetryAfter))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// clientKey はバケットを分けるクライアントの識別子
+func clientKey(c *gin.Context) string {
+	if accessToken, err := c.Cookie("access_token"); err == nil {
+		if userID, ok := parseAccessToken(accessToken); ok {
+			return "member:" + userID
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// seconds はヘッダーに書く秒数。切り上げて 0 秒前に再試行させないようにする
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -1,67 +1,136 @@
 package main
 
 import (
-	"backend_golang/cmd/middleware"
+	config "backend_golang/configs"
 	"backend_golang/ent"
-	"backend_golang/internal/controller"
-	"backend_golang/internal/repository"
-	"backend_golang/internal/service"
+	"backend_golang/internal/app"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/mail"
+	"backend_golang/internal/migration"
+	"backend_golang/internal/search"
 	"context"
-	"log"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/go-sql-driver/mysql"
 )
 
 func main() {
-	client, err := ent.Open("mysql", "team:team@tcp(localhost:3306)/team?parseTime=true")
+	// 標準の log パッケージの出力も JSON にする
+	slog.SetDefault(logging.New(os.Stdout, logging.ParseLevel(config.LogConfig.Level())))
+
+	db, err := sql.Open("mysql", "team:team@tcp(localhost:3306)/team?parseTime=true")
 	if err != nil {
-		log.Fatalf("failed opening connection to sqlite: %v", err)
+		fatal("failed opening connection to mysql", err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.MySQL, db)))
+	// 役割のカラムを Enum に変更する前に既存の値を揃える
+	if err := migration.NormalizeRoles(context.Background(), db, dialect.MySQL); err != nil {
+		fatal("failed normalizing roles", err)
 	}
-	defer client.Close()
 	// Run the auto migration tool.
 	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+		fatal("failed creating schema resources", err)
 	}
 
-	app := gin.Default()
+	// 全文検索インデックス（ngram パーサー）はマイグレーション後に作成する
+	searcher := search.NewMySQLSearcher(db)
+	if err := searcher.EnsureIndexes(context.Background()); err != nil {
+		fatal("failed creating fulltext indexes", err)
+	}
 
-	// Middleware
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:3000"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Content-Length", "Accept", "X-CSRF-Token", "Authorization"},
-		AllowCredentials: true,
-	}))
+	mailer, err := newMailer()
+	if err != nil {
+		fatal("failed creating mailer", err)
+	}
 
-	// Team
-	teamRepository := repository.NewTeamRepository(client)
-	authRepository := repository.NewAuthRepository(client)
+	application, err := app.New(app.Config{
+		Searcher:                searcher,
+		Mailer:                  mailer,
+		BaseURL:                 config.MailConfig.BaseURL(),
+		AllowOrigins:            []string{"http://localhost:3000"},
+		MaxBodyBytes:            config.ServerConfig.MaxBodyBytes(),
+		LeaderCanSeeMemberEmail: config.PrivacyConfig.LeaderCanSeeMemberEmail(),
+		RateLimits:              config.RateLimitConfig.Policies(),
+		TrustedProxies:          config.ServerConfig.TrustedProxies(),
+	}, client)
+	if err != nil {
+		fatal("failed building application", err)
+	}
+	application.Start()
 
-	teamService := service.NewTeamService(teamRepository, authRepository)
-	teamController := controller.NewTeamController(teamService)
-	app.POST("/v1/teams", middleware.Authentication(), teamController.MakeTeam)
-	app.DELETE("/v1/teams/:teamID", middleware.Authentication(), teamController.DeleteTeam)
-	app.GET("/v1/teams/:teamID", teamController.GetTeam)
-	app.POST("/v1/teams/:teamID/join", middleware.Authentication(), teamController.JoinTeam)
+	server := &http.Server{
+		Addr:              config.ServerConfig.Addr(),
+		Handler:           application.Engine,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		ReadTimeout:       config.ServerConfig.ReadTimeout(),
+		WriteTimeout:      config.ServerConfig.WriteTimeout(),
+		IdleTimeout:       config.ServerConfig.IdleTimeout(),
+		MaxHeaderBytes:    config.MaxHeaderBytes,
+	}
+	// 通知のストリームは終わらないので、停止時に購読を閉じて接続を終わらせる
+	server.RegisterOnShutdown(application.CloseStreams)
 
-	// Announcement
-	announcementRepository := repository.NewAnnouncementRepository(client)
-	announcementService := service.NewAnnouncementService(announcementRepository, teamRepository)
-	announcementController := controller.NewAnnouncementController(announcementService)
-	app.POST("/v1/announcements", middleware.Authentication(), announcementController.Announce)
-	app.GET("/v1/announcements/:announcementID", announcementController.GetAnnouncement)
-	app.GET("/v1/announcements", announcementController.GetAnnouncements)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	serverErr := make(chan error, 1)
+	go func() {
+		slog.Info("server started", "addr", server.Addr)
+		serverErr <- server.ListenAndServe()
+	}()
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			fatal("server failed", err)
+		}
+	case <-ctx.Done():
+	}
+	stop()
 
-	// Auth
-	authService := service.NewAuthService(authRepository)
-	authController := controller.NewAuthController(authService)
+	slog.Info("shutting down", "timeout", config.ServerConfig.ShutdownTimeout().String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ServerConfig.ShutdownTimeout())
+	defer cancel()
+
+	// 新しいリクエストの受け付けをやめ、処理中のリクエストが終わるのを待つ
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed draining requests", "error", err)
+	}
+	// ワーカーを止め、送信待ちのメールを送り切る
+	if err := application.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed stopping workers", "error", err)
+	}
+	// データベースは最後に閉じる
+	if err := client.Close(); err != nil {
+		slog.Error("failed closing database", "error", err)
+	}
+	slog.Info("server stopped")
+}
+
+// newMailer は設定に応じてメールの送り先を作る
+func newMailer() (mail.Mailer, error) {
+	switch config.MailConfig.Sink() {
+	case config.MailSinkSMTP:
+		return mail.NewSMTPMailer(config.MailConfig.SMTPAddr(), config.MailConfig.From(), config.MailConfig.SMTPUsername(), config.MailConfig.SMTPPassword()), nil
+	case config.MailSinkFile:
+		file, err := os.OpenFile(config.MailConfig.FilePath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return mail.NewFileMailer(file, config.MailConfig.From()), nil
+	default:
+		return mail.NewFileMailer(os.Stdout, config.MailConfig.From()), nil
+	}
+}
 
-	app.GET("/v1/auth/login", authController.Login)
-	app.GET("/v1/auth/logout", middleware.Authentication(), authController.Logout)
-	app.GET("/login/oauth2/code/google", authController.GoogleCallback)
-	app.POST("/v1/auth/signup", middleware.Authentication(), authController.Signup)
-	app.GET("/v1/me", middleware.Authentication(), authController.GetMember)
-	app.Run(":8080")
+// fatal はエラーを出力して終了する
+func fatal(message string, err error) {
+	slog.Error(message, "error", err)
+	os.Exit(1)
 }
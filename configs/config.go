@@ -2,11 +2,17 @@ package config
 
 import (
 	"backend_golang/internal/domain"
+	"backend_golang/internal/ratelimit"
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"golang.org/x/oauth2"
@@ -15,6 +21,12 @@ import (
 
 var OAuthConfig *OAuth
 var JWTConfig *JWT
+var PrivacyConfig *Privacy
+var AdminConfig *Admin
+var MailConfig *Mail
+var LogConfig *Log
+var ServerConfig *Server
+var RateLimitConfig *RateLimit
 
 type OAuth struct {
 	config oauth2.Config
@@ -24,6 +36,42 @@ type JWT struct {
 	secret string
 }
 
+type Privacy struct {
+	leaderCanSeeMemberEmail bool
+}
+
+type Admin struct {
+	memberIDs map[string]bool
+}
+
+type Mail struct {
+	sink         string
+	from         string
+	smtpAddr     string
+	smtpUsername string
+	smtpPassword string
+	filePath     string
+	baseURL      string
+}
+
+type Log struct {
+	level string
+}
+
+type Server struct {
+	addr            string
+	readTimeout     time.Duration
+	writeTimeout    time.Duration
+	idleTimeout     time.Duration
+	shutdownTimeout time.Duration
+	maxBodyBytes    int64
+	trustedProxies  []string
+}
+
+type RateLimit struct {
+	policies map[string]ratelimit.Policy
+}
+
 func NewOAuth() *OAuth {
 	scopes := strings.Split(os.Getenv("OAUTH_SCOPES"), ",")
 	return &OAuth{
@@ -43,16 +91,139 @@ func NewJWT() *JWT {
 	}
 }
 
-func init() {
-	err := godotenv.Load()
+func NewPrivacy() *Privacy {
+	leaderCanSeeMemberEmail, _ := strconv.ParseBool(os.Getenv("LEADER_CAN_SEE_MEMBER_EMAIL"))
+	return &Privacy{
+		leaderCanSeeMemberEmail: leaderCanSeeMemberEmail,
+	}
+}
+
+func NewAdmin() *Admin {
+	memberIDs := make(map[string]bool)
+	for _, id := range strings.Split(os.Getenv("ADMIN_MEMBER_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			memberIDs[id] = true
+		}
+	}
+	return &Admin{
+		memberIDs: memberIDs,
+	}
+}
+
+func NewMail() *Mail {
+	return &Mail{
+		sink:         getenvDefault("MAIL_SINK", MailSinkStdout),
+		from:         getenvDefault("MAIL_FROM", "no-reply@localhost"),
+		smtpAddr:     getenvDefault("SMTP_ADDR", "localhost:25"),
+		smtpUsername: os.Getenv("SMTP_USERNAME"),
+		smtpPassword: os.Getenv("SMTP_PASSWORD"),
+		filePath:     getenvDefault("MAIL_FILE", "mail.log"),
+		baseURL:      strings.TrimSuffix(getenvDefault("APP_BASE_URL", "http://localhost:8080"), "/"),
+	}
+}
+
+func NewLog() *Log {
+	return &Log{
+		level: getenvDefault("LOG_LEVEL", "info"),
+	}
+}
+
+func NewServer() *Server {
+	return &Server{
+		addr:            getenvDefault("SERVER_ADDR", ":8080"),
+		readTimeout:     getenvDuration("SERVER_READ_TIMEOUT", 15*time.Second),
+		writeTimeout:    getenvDuration("SERVER_WRITE_TIMEOUT", 30*time.Second),
+		idleTimeout:     getenvDuration("SERVER_IDLE_TIMEOUT", 60*time.Second),
+		shutdownTimeout: getenvDuration("SERVER_SHUTDOWN_TIMEOUT", 20*time.Second),
+		maxBodyBytes:    getenvInt64("SERVER_MAX_BODY_BYTES", 1<<20),
+		trustedProxies:  getenvList("SERVER_TRUSTED_PROXIES"),
+	}
+}
+
+// DefaultRateLimits は RATE_LIMITS が未設定の場合のルートごとのレート制限
+const DefaultRateLimits = "POST /v1/auth/signup=10/1h," +
+	"POST /v1/teams/:teamID/join=10/1m," +
+	"POST /v1/invitations/:token/redeem=10/1m," +
+	"GET /v1/search=60/1m"
+
+// NewRateLimit は RATE_LIMITS を読む。書き間違えた制限が効かないまま起動しないよう、読めない場合はエラーを返す
+func NewRateLimit() (*RateLimit, error) {
+	value := getenvDefault("RATE_LIMITS", DefaultRateLimits)
+	policies, err := ratelimit.ParsePolicies(value)
 	if err != nil {
-		log.Fatal("Error loading .env file", err)
+		return nil, fmt.Errorf("RATE_LIMITS: %w", err)
+	}
+	return &RateLimit{
+		policies: policies,
+	}, nil
+}
+
+// getenvDefault は環境変数が未設定または空の場合に fallback を返す
+func getenvDefault(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getenvDuration は環境変数を 30s のような時間として読む。未設定または不正な場合は fallback を返す
+func getenvDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		slog.Warn("invalid duration, using default", "key", key, "value", value, "default", fallback.String())
+		return fallback
+	}
+	return duration
+}
+
+// getenvInt64 は環境変数を正の整数として読む。未設定または不正な場合は fallback を返す
+func getenvInt64(key string, fallback int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || parsed <= 0 {
+		slog.Warn("invalid integer, using default", "key", key, "value", value, "default", fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// getenvList は環境変数をカンマ区切りのリストとして読む。空の要素は除く
+func getenvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func init() {
+	// .env がない場合は環境変数だけを使う
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.Error("failed loading .env file", "error", err)
+		os.Exit(1)
 	}
 	OAuthConfig = NewOAuth()
 	JWTConfig = NewJWT()
-
-	log.Println("OAuthConfig", OAuthConfig)
-	log.Println("JWTConfig", JWTConfig)
+	PrivacyConfig = NewPrivacy()
+	AdminConfig = NewAdmin()
+	MailConfig = NewMail()
+	LogConfig = NewLog()
+	ServerConfig = NewServer()
+	rateLimit, err := NewRateLimit()
+	if err != nil {
+		slog.Error("invalid rate limits", "error", err)
+		os.Exit(1)
+	}
+	RateLimitConfig = rateLimit
 }
 
 func (o *OAuth) GetAccessToken(c context.Context, code string) (*oauth2.Token, error) {
@@ -68,19 +239,16 @@ func (o *OAuth) GetMember(c context.Context, token *oauth2.Token) (*domain.Membe
 	// TODO : 環境変数に定義する
 	resp, err := client.Get("https://www.googleapis.com/oauth2/v3/userinfo")
 	if err != nil {
-		log.Println("Failed to get user info from google", err)
-		return nil, err
+		return nil, fmt.Errorf("getting user info from google: %w", err)
 	}
 	defer resp.Body.Close()
 
 	var userInfo map[string]any
 	err = json.NewDecoder(resp.Body).Decode(&userInfo)
 	if err != nil {
-		log.Println("Failed to decode user info from google", err)
-		return nil, err
+		return nil, fmt.Errorf("decoding user info from google: %w", err)
 	}
 
-	log.Println("userInfo", userInfo)
 	return &domain.Member{
 		ID:       userInfo["sub"].(string),
 		Email:    userInfo["email"].(string),
@@ -92,3 +260,102 @@ func (o *OAuth) GetMember(c context.Context, token *oauth2.Token) (*domain.Membe
 func (j *JWT) GetSecretKey() []byte {
 	return []byte(j.secret)
 }
+
+// LeaderCanSeeMemberEmail はチームリーダーが自チームメンバーのメールアドレスを閲覧できるかどうか
+func (p *Privacy) LeaderCanSeeMemberEmail() bool {
+	return p.leaderCanSeeMemberEmail
+}
+
+// IsAdmin は管理者として登録されたメンバーかどうか
+func (a *Admin) IsAdmin(memberID string) bool {
+	return a.memberIDs[memberID]
+}
+
+// メールの送り先。開発環境では実際には送らずに書き出す
+const (
+	MailSinkSMTP   = "smtp"
+	MailSinkFile   = "file"
+	MailSinkStdout = "stdout"
+)
+
+// Sink はメールの送り先。MailSinkSMTP, MailSinkFile, MailSinkStdout のいずれか
+func (m *Mail) Sink() string {
+	return m.sink
+}
+
+func (m *Mail) From() string {
+	return m.from
+}
+
+// SMTPAddr は host:port 形式の SMTP サーバーのアドレス
+func (m *Mail) SMTPAddr() string {
+	return m.smtpAddr
+}
+
+func (m *Mail) SMTPUsername() string {
+	return m.smtpUsername
+}
+
+func (m *Mail) SMTPPassword() string {
+	return m.smtpPassword
+}
+
+// FilePath は Sink が MailSinkFile の場合にメールを書き出すファイル
+func (m *Mail) FilePath() string {
+	return m.filePath
+}
+
+// BaseURL はメールに載せるリンクの先頭部分
+func (m *Mail) BaseURL() string {
+	return m.baseURL
+}
+
+// Level はログレベル。debug, info, warn, error のいずれか
+func (l *Log) Level() string {
+	return l.level
+}
+
+// ヘッダーの読み込みにかける時間とサイズの上限。遅いクライアントに接続を占有されないようにする
+const (
+	ReadHeaderTimeout = 5 * time.Second
+	MaxHeaderBytes    = 1 << 20
+)
+
+func (s *Server) Addr() string {
+	return s.addr
+}
+
+// ReadTimeout はリクエスト全体の読み込みにかける時間の上限
+func (s *Server) ReadTimeout() time.Duration {
+	return s.readTimeout
+}
+
+// WriteTimeout はレスポンスの書き込みにかける時間の上限。Server-Sent Events の接続には適用しない
+func (s *Server) WriteTimeout() time.Duration {
+	return s.writeTimeout
+}
+
+// IdleTimeout は keep-alive の接続が次のリクエストを待つ時間の上限
+func (s *Server) IdleTimeout() time.Duration {
+	return s.idleTimeout
+}
+
+// ShutdownTimeout は停止時に処理中のリクエストとワーカーの終了を待つ時間の上限
+func (s *Server) ShutdownTimeout() time.Duration {
+	return s.shutdownTimeout
+}
+
+// MaxBodyBytes はリクエストボディのサイズの上限
+func (s *Server) MaxBodyBytes() int64 {
+	return s.maxBodyBytes
+}
+
+// TrustedProxies は X-Forwarded-For を信用するプロキシ。空の場合は接続元のアドレスをクライアントとみなす
+func (s *Server) TrustedProxies() []string {
+	return s.trustedProxies
+}
+
+// Policies は gin のルート（例: "POST /v1/teams/:teamID/join"）ごとのレート制限
+func (r *RateLimit) Policies() map[string]ratelimit.Policy {
+	return r.policies
+}
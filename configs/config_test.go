@@ -0,0 +1,31 @@
+package config
+
+import (
+	"backend_golang/internal/ratelimit"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimit(t *testing.T) {
+	t.Setenv("RATE_LIMITS", "")
+	rateLimit, err := NewRateLimit()
+	require.NoError(t, err)
+	defaults, err := ratelimit.ParsePolicies(DefaultRateLimits)
+	require.NoError(t, err)
+	assert.Equal(t, defaults, rateLimit.Policies())
+
+	t.Setenv("RATE_LIMITS", "GET /v1/search=5/10s")
+	rateLimit, err = NewRateLimit()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]ratelimit.Policy{"GET /v1/search": {Burst: 5, Period: 10 * time.Second}}, rateLimit.Policies())
+
+	// 読めない場合は既定値に戻さずにエラーにする
+	for _, value := range []string{"GET /v1/search=10/1min", "GET /v1/search", "/v1/search=10/1m"} {
+		t.Setenv("RATE_LIMITS", value)
+		_, err := NewRateLimit()
+		assert.ErrorIs(t, err, ratelimit.ErrInvalidPolicy, value)
+	}
+}
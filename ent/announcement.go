@@ -37,9 +37,11 @@ type Announcement struct {
 type AnnouncementEdges struct {
 	// Team holds the value of the team edge.
 	Team *Team `json:"team,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*AnnouncementComment `json:"comments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TeamOrErr returns the Team value or an error if the edge
@@ -53,6 +55,15 @@ func (e AnnouncementEdges) TeamOrErr() (*Team, error) {
 	return nil, &NotLoadedError{edge: "team"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e AnnouncementEdges) CommentsOrErr() ([]*AnnouncementComment, error) {
+	if e.loadedTypes[1] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Announcement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -136,6 +147,11 @@ func (a *Announcement) QueryTeam() *TeamQuery {
 	return NewAnnouncementClient(a.config).QueryTeam(a)
 }
 
+// QueryComments queries the "comments" edge of the Announcement entity.
+func (a *Announcement) QueryComments() *AnnouncementCommentQuery {
+	return NewAnnouncementClient(a.config).QueryComments(a)
+}
+
 // Update returns a builder for updating this Announcement.
 // Note that you need to call Announcement.Unwrap() before calling this method if this Announcement
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
 	"backend_golang/ent/team"
 	"context"
 	"errors"
@@ -80,6 +81,21 @@ func (ac *AnnouncementCreate) SetTeam(t *Team) *AnnouncementCreate {
 	return ac.SetTeamID(t.ID)
 }
 
+// AddCommentIDs adds the "comments" edge to the AnnouncementComment entity by IDs.
+func (ac *AnnouncementCreate) AddCommentIDs(ids ...int) *AnnouncementCreate {
+	ac.mutation.AddCommentIDs(ids...)
+	return ac
+}
+
+// AddComments adds the "comments" edges to the AnnouncementComment entity.
+func (ac *AnnouncementCreate) AddComments(a ...*AnnouncementComment) *AnnouncementCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddCommentIDs(ids...)
+}
+
 // Mutation returns the AnnouncementMutation object of the builder.
 func (ac *AnnouncementCreate) Mutation() *AnnouncementMutation {
 	return ac.mutation
@@ -208,6 +224,22 @@ func (ac *AnnouncementCreate) createSpec() (*Announcement, *sqlgraph.CreateSpec)
 		_node.team_announcements = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,9 +4,11 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/team"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -20,13 +22,14 @@ import (
 // AnnouncementQuery is the builder for querying Announcement entities.
 type AnnouncementQuery struct {
 	config
-	ctx        *QueryContext
-	order      []announcement.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Announcement
-	withTeam   *TeamQuery
-	withFKs    bool
-	modifiers  []func(*sql.Selector)
+	ctx          *QueryContext
+	order        []announcement.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Announcement
+	withTeam     *TeamQuery
+	withComments *AnnouncementCommentQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +88,28 @@ func (aq *AnnouncementQuery) QueryTeam() *TeamQuery {
 	return query
 }
 
+// QueryComments chains the current query on the "comments" edge.
+func (aq *AnnouncementQuery) QueryComments() *AnnouncementCommentQuery {
+	query := (&AnnouncementCommentClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(announcement.Table, announcement.FieldID, selector),
+			sqlgraph.To(announcementcomment.Table, announcementcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, announcement.CommentsTable, announcement.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Announcement entity from the query.
 // Returns a *NotFoundError when no Announcement was found.
 func (aq *AnnouncementQuery) First(ctx context.Context) (*Announcement, error) {
@@ -272,12 +297,13 @@ func (aq *AnnouncementQuery) Clone() *AnnouncementQuery {
 		return nil
 	}
 	return &AnnouncementQuery{
-		config:     aq.config,
-		ctx:        aq.ctx.Clone(),
-		order:      append([]announcement.OrderOption{}, aq.order...),
-		inters:     append([]Interceptor{}, aq.inters...),
-		predicates: append([]predicate.Announcement{}, aq.predicates...),
-		withTeam:   aq.withTeam.Clone(),
+		config:       aq.config,
+		ctx:          aq.ctx.Clone(),
+		order:        append([]announcement.OrderOption{}, aq.order...),
+		inters:       append([]Interceptor{}, aq.inters...),
+		predicates:   append([]predicate.Announcement{}, aq.predicates...),
+		withTeam:     aq.withTeam.Clone(),
+		withComments: aq.withComments.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -295,6 +321,17 @@ func (aq *AnnouncementQuery) WithTeam(opts ...func(*TeamQuery)) *AnnouncementQue
 	return aq
 }
 
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AnnouncementQuery) WithComments(opts ...func(*AnnouncementCommentQuery)) *AnnouncementQuery {
+	query := (&AnnouncementCommentClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withComments = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -374,8 +411,9 @@ func (aq *AnnouncementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes       = []*Announcement{}
 		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			aq.withTeam != nil,
+			aq.withComments != nil,
 		}
 	)
 	if aq.withTeam != nil {
@@ -411,6 +449,13 @@ func (aq *AnnouncementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := aq.withComments; query != nil {
+		if err := aq.loadComments(ctx, query, nodes,
+			func(n *Announcement) { n.Edges.Comments = []*AnnouncementComment{} },
+			func(n *Announcement, e *AnnouncementComment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -446,6 +491,37 @@ func (aq *AnnouncementQuery) loadTeam(ctx context.Context, query *TeamQuery, nod
 	}
 	return nil
 }
+func (aq *AnnouncementQuery) loadComments(ctx context.Context, query *AnnouncementCommentQuery, nodes []*Announcement, init func(*Announcement), assign func(*Announcement, *AnnouncementComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Announcement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(announcementcomment.FieldAnnouncementID)
+	}
+	query.Where(predicate.AnnouncementComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(announcement.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AnnouncementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "announcement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *AnnouncementQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/team"
 	"context"
@@ -82,6 +83,21 @@ func (au *AnnouncementUpdate) SetTeam(t *Team) *AnnouncementUpdate {
 	return au.SetTeamID(t.ID)
 }
 
+// AddCommentIDs adds the "comments" edge to the AnnouncementComment entity by IDs.
+func (au *AnnouncementUpdate) AddCommentIDs(ids ...int) *AnnouncementUpdate {
+	au.mutation.AddCommentIDs(ids...)
+	return au
+}
+
+// AddComments adds the "comments" edges to the AnnouncementComment entity.
+func (au *AnnouncementUpdate) AddComments(a ...*AnnouncementComment) *AnnouncementUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddCommentIDs(ids...)
+}
+
 // Mutation returns the AnnouncementMutation object of the builder.
 func (au *AnnouncementUpdate) Mutation() *AnnouncementMutation {
 	return au.mutation
@@ -93,6 +109,27 @@ func (au *AnnouncementUpdate) ClearTeam() *AnnouncementUpdate {
 	return au
 }
 
+// ClearComments clears all "comments" edges to the AnnouncementComment entity.
+func (au *AnnouncementUpdate) ClearComments() *AnnouncementUpdate {
+	au.mutation.ClearComments()
+	return au
+}
+
+// RemoveCommentIDs removes the "comments" edge to AnnouncementComment entities by IDs.
+func (au *AnnouncementUpdate) RemoveCommentIDs(ids ...int) *AnnouncementUpdate {
+	au.mutation.RemoveCommentIDs(ids...)
+	return au
+}
+
+// RemoveComments removes "comments" edges to AnnouncementComment entities.
+func (au *AnnouncementUpdate) RemoveComments(a ...*AnnouncementComment) *AnnouncementUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveCommentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AnnouncementUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -194,6 +231,51 @@ func (au *AnnouncementUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !au.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{announcement.Label}
@@ -267,6 +349,21 @@ func (auo *AnnouncementUpdateOne) SetTeam(t *Team) *AnnouncementUpdateOne {
 	return auo.SetTeamID(t.ID)
 }
 
+// AddCommentIDs adds the "comments" edge to the AnnouncementComment entity by IDs.
+func (auo *AnnouncementUpdateOne) AddCommentIDs(ids ...int) *AnnouncementUpdateOne {
+	auo.mutation.AddCommentIDs(ids...)
+	return auo
+}
+
+// AddComments adds the "comments" edges to the AnnouncementComment entity.
+func (auo *AnnouncementUpdateOne) AddComments(a ...*AnnouncementComment) *AnnouncementUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddCommentIDs(ids...)
+}
+
 // Mutation returns the AnnouncementMutation object of the builder.
 func (auo *AnnouncementUpdateOne) Mutation() *AnnouncementMutation {
 	return auo.mutation
@@ -278,6 +375,27 @@ func (auo *AnnouncementUpdateOne) ClearTeam() *AnnouncementUpdateOne {
 	return auo
 }
 
+// ClearComments clears all "comments" edges to the AnnouncementComment entity.
+func (auo *AnnouncementUpdateOne) ClearComments() *AnnouncementUpdateOne {
+	auo.mutation.ClearComments()
+	return auo
+}
+
+// RemoveCommentIDs removes the "comments" edge to AnnouncementComment entities by IDs.
+func (auo *AnnouncementUpdateOne) RemoveCommentIDs(ids ...int) *AnnouncementUpdateOne {
+	auo.mutation.RemoveCommentIDs(ids...)
+	return auo
+}
+
+// RemoveComments removes "comments" edges to AnnouncementComment entities.
+func (auo *AnnouncementUpdateOne) RemoveComments(a ...*AnnouncementComment) *AnnouncementUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveCommentIDs(ids...)
+}
+
 // Where appends a list predicates to the AnnouncementUpdate builder.
 func (auo *AnnouncementUpdateOne) Where(ps ...predicate.Announcement) *AnnouncementUpdateOne {
 	auo.mutation.Where(ps...)
@@ -409,6 +527,51 @@ func (auo *AnnouncementUpdateOne) sqlSave(ctx context.Context) (_node *Announcem
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !auo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcement.CommentsTable,
+			Columns: []string{announcement.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Announcement{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
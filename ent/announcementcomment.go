@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/member"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AnnouncementComment is the model entity for the AnnouncementComment schema.
+type AnnouncementComment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AnnouncementID holds the value of the "announcement_id" field.
+	AnnouncementID int `json:"announcement_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *int `json:"parent_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// LeaderAnswer holds the value of the "leader_answer" field.
+	LeaderAnswer bool `json:"leader_answer,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnnouncementCommentQuery when eager-loading is set.
+	Edges                        AnnouncementCommentEdges `json:"edges"`
+	member_announcement_comments *int
+	selectValues                 sql.SelectValues
+}
+
+// AnnouncementCommentEdges holds the relations/edges for other nodes in the graph.
+type AnnouncementCommentEdges struct {
+	// Announcement holds the value of the announcement edge.
+	Announcement *Announcement `json:"announcement,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *AnnouncementComment `json:"parent,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*AnnouncementComment `json:"replies,omitempty"`
+	// Author holds the value of the author edge.
+	Author *Member `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// AnnouncementOrErr returns the Announcement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnnouncementCommentEdges) AnnouncementOrErr() (*Announcement, error) {
+	if e.Announcement != nil {
+		return e.Announcement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: announcement.Label}
+	}
+	return nil, &NotLoadedError{edge: "announcement"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnnouncementCommentEdges) ParentOrErr() (*AnnouncementComment, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: announcementcomment.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e AnnouncementCommentEdges) RepliesOrErr() ([]*AnnouncementComment, error) {
+	if e.loadedTypes[2] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnnouncementCommentEdges) AuthorOrErr() (*Member, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnnouncementComment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case announcementcomment.FieldLeaderAnswer:
+			values[i] = new(sql.NullBool)
+		case announcementcomment.FieldID, announcementcomment.FieldAnnouncementID, announcementcomment.FieldParentID:
+			values[i] = new(sql.NullInt64)
+		case announcementcomment.FieldBody:
+			values[i] = new(sql.NullString)
+		case announcementcomment.FieldDeletedAt, announcementcomment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case announcementcomment.ForeignKeys[0]: // member_announcement_comments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnnouncementComment fields.
+func (ac *AnnouncementComment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case announcementcomment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ac.ID = int(value.Int64)
+		case announcementcomment.FieldAnnouncementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field announcement_id", values[i])
+			} else if value.Valid {
+				ac.AnnouncementID = int(value.Int64)
+			}
+		case announcementcomment.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				ac.ParentID = new(int)
+				*ac.ParentID = int(value.Int64)
+			}
+		case announcementcomment.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				ac.Body = value.String
+			}
+		case announcementcomment.FieldLeaderAnswer:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field leader_answer", values[i])
+			} else if value.Valid {
+				ac.LeaderAnswer = value.Bool
+			}
+		case announcementcomment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ac.DeletedAt = new(time.Time)
+				*ac.DeletedAt = value.Time
+			}
+		case announcementcomment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ac.CreatedAt = value.Time
+			}
+		case announcementcomment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field member_announcement_comments", value)
+			} else if value.Valid {
+				ac.member_announcement_comments = new(int)
+				*ac.member_announcement_comments = int(value.Int64)
+			}
+		default:
+			ac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnnouncementComment.
+// This includes values selected through modifiers, order, etc.
+func (ac *AnnouncementComment) Value(name string) (ent.Value, error) {
+	return ac.selectValues.Get(name)
+}
+
+// QueryAnnouncement queries the "announcement" edge of the AnnouncementComment entity.
+func (ac *AnnouncementComment) QueryAnnouncement() *AnnouncementQuery {
+	return NewAnnouncementCommentClient(ac.config).QueryAnnouncement(ac)
+}
+
+// QueryParent queries the "parent" edge of the AnnouncementComment entity.
+func (ac *AnnouncementComment) QueryParent() *AnnouncementCommentQuery {
+	return NewAnnouncementCommentClient(ac.config).QueryParent(ac)
+}
+
+// QueryReplies queries the "replies" edge of the AnnouncementComment entity.
+func (ac *AnnouncementComment) QueryReplies() *AnnouncementCommentQuery {
+	return NewAnnouncementCommentClient(ac.config).QueryReplies(ac)
+}
+
+// QueryAuthor queries the "author" edge of the AnnouncementComment entity.
+func (ac *AnnouncementComment) QueryAuthor() *MemberQuery {
+	return NewAnnouncementCommentClient(ac.config).QueryAuthor(ac)
+}
+
+// Update returns a builder for updating this AnnouncementComment.
+// Note that you need to call AnnouncementComment.Unwrap() before calling this method if this AnnouncementComment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ac *AnnouncementComment) Update() *AnnouncementCommentUpdateOne {
+	return NewAnnouncementCommentClient(ac.config).UpdateOne(ac)
+}
+
+// Unwrap unwraps the AnnouncementComment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ac *AnnouncementComment) Unwrap() *AnnouncementComment {
+	_tx, ok := ac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnnouncementComment is not a transactional entity")
+	}
+	ac.config.driver = _tx.drv
+	return ac
+}
+
+// String implements the fmt.Stringer.
+func (ac *AnnouncementComment) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnnouncementComment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ac.ID))
+	builder.WriteString("announcement_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.AnnouncementID))
+	builder.WriteString(", ")
+	if v := ac.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(ac.Body)
+	builder.WriteString(", ")
+	builder.WriteString("leader_answer=")
+	builder.WriteString(fmt.Sprintf("%v", ac.LeaderAnswer))
+	builder.WriteString(", ")
+	if v := ac.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ac.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnnouncementComments is a parsable slice of AnnouncementComment.
+type AnnouncementComments []*AnnouncementComment
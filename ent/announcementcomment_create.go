@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/member"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnnouncementCommentCreate is the builder for creating a AnnouncementComment entity.
+type AnnouncementCommentCreate struct {
+	config
+	mutation *AnnouncementCommentMutation
+	hooks    []Hook
+}
+
+// SetAnnouncementID sets the "announcement_id" field.
+func (acc *AnnouncementCommentCreate) SetAnnouncementID(i int) *AnnouncementCommentCreate {
+	acc.mutation.SetAnnouncementID(i)
+	return acc
+}
+
+// SetParentID sets the "parent_id" field.
+func (acc *AnnouncementCommentCreate) SetParentID(i int) *AnnouncementCommentCreate {
+	acc.mutation.SetParentID(i)
+	return acc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (acc *AnnouncementCommentCreate) SetNillableParentID(i *int) *AnnouncementCommentCreate {
+	if i != nil {
+		acc.SetParentID(*i)
+	}
+	return acc
+}
+
+// SetBody sets the "body" field.
+func (acc *AnnouncementCommentCreate) SetBody(s string) *AnnouncementCommentCreate {
+	acc.mutation.SetBody(s)
+	return acc
+}
+
+// SetLeaderAnswer sets the "leader_answer" field.
+func (acc *AnnouncementCommentCreate) SetLeaderAnswer(b bool) *AnnouncementCommentCreate {
+	acc.mutation.SetLeaderAnswer(b)
+	return acc
+}
+
+// SetNillableLeaderAnswer sets the "leader_answer" field if the given value is not nil.
+func (acc *AnnouncementCommentCreate) SetNillableLeaderAnswer(b *bool) *AnnouncementCommentCreate {
+	if b != nil {
+		acc.SetLeaderAnswer(*b)
+	}
+	return acc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (acc *AnnouncementCommentCreate) SetDeletedAt(t time.Time) *AnnouncementCommentCreate {
+	acc.mutation.SetDeletedAt(t)
+	return acc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (acc *AnnouncementCommentCreate) SetNillableDeletedAt(t *time.Time) *AnnouncementCommentCreate {
+	if t != nil {
+		acc.SetDeletedAt(*t)
+	}
+	return acc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acc *AnnouncementCommentCreate) SetCreatedAt(t time.Time) *AnnouncementCommentCreate {
+	acc.mutation.SetCreatedAt(t)
+	return acc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acc *AnnouncementCommentCreate) SetNillableCreatedAt(t *time.Time) *AnnouncementCommentCreate {
+	if t != nil {
+		acc.SetCreatedAt(*t)
+	}
+	return acc
+}
+
+// SetAnnouncement sets the "announcement" edge to the Announcement entity.
+func (acc *AnnouncementCommentCreate) SetAnnouncement(a *Announcement) *AnnouncementCommentCreate {
+	return acc.SetAnnouncementID(a.ID)
+}
+
+// SetParent sets the "parent" edge to the AnnouncementComment entity.
+func (acc *AnnouncementCommentCreate) SetParent(a *AnnouncementComment) *AnnouncementCommentCreate {
+	return acc.SetParentID(a.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the AnnouncementComment entity by IDs.
+func (acc *AnnouncementCommentCreate) AddReplyIDs(ids ...int) *AnnouncementCommentCreate {
+	acc.mutation.AddReplyIDs(ids...)
+	return acc
+}
+
+// AddReplies adds the "replies" edges to the AnnouncementComment entity.
+func (acc *AnnouncementCommentCreate) AddReplies(a ...*AnnouncementComment) *AnnouncementCommentCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acc.AddReplyIDs(ids...)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (acc *AnnouncementCommentCreate) SetAuthorID(id int) *AnnouncementCommentCreate {
+	acc.mutation.SetAuthorID(id)
+	return acc
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (acc *AnnouncementCommentCreate) SetAuthor(m *Member) *AnnouncementCommentCreate {
+	return acc.SetAuthorID(m.ID)
+}
+
+// Mutation returns the AnnouncementCommentMutation object of the builder.
+func (acc *AnnouncementCommentCreate) Mutation() *AnnouncementCommentMutation {
+	return acc.mutation
+}
+
+// Save creates the AnnouncementComment in the database.
+func (acc *AnnouncementCommentCreate) Save(ctx context.Context) (*AnnouncementComment, error) {
+	acc.defaults()
+	return withHooks(ctx, acc.sqlSave, acc.mutation, acc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *AnnouncementCommentCreate) SaveX(ctx context.Context) *AnnouncementComment {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acc *AnnouncementCommentCreate) Exec(ctx context.Context) error {
+	_, err := acc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acc *AnnouncementCommentCreate) ExecX(ctx context.Context) {
+	if err := acc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acc *AnnouncementCommentCreate) defaults() {
+	if _, ok := acc.mutation.LeaderAnswer(); !ok {
+		v := announcementcomment.DefaultLeaderAnswer
+		acc.mutation.SetLeaderAnswer(v)
+	}
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		v := announcementcomment.DefaultCreatedAt()
+		acc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *AnnouncementCommentCreate) check() error {
+	if _, ok := acc.mutation.AnnouncementID(); !ok {
+		return &ValidationError{Name: "announcement_id", err: errors.New(`ent: missing required field "AnnouncementComment.announcement_id"`)}
+	}
+	if _, ok := acc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "AnnouncementComment.body"`)}
+	}
+	if v, ok := acc.mutation.Body(); ok {
+		if err := announcementcomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "AnnouncementComment.body": %w`, err)}
+		}
+	}
+	if _, ok := acc.mutation.LeaderAnswer(); !ok {
+		return &ValidationError{Name: "leader_answer", err: errors.New(`ent: missing required field "AnnouncementComment.leader_answer"`)}
+	}
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnnouncementComment.created_at"`)}
+	}
+	if len(acc.mutation.AnnouncementIDs()) == 0 {
+		return &ValidationError{Name: "announcement", err: errors.New(`ent: missing required edge "AnnouncementComment.announcement"`)}
+	}
+	if len(acc.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "AnnouncementComment.author"`)}
+	}
+	return nil
+}
+
+func (acc *AnnouncementCommentCreate) sqlSave(ctx context.Context) (*AnnouncementComment, error) {
+	if err := acc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	acc.mutation.id = &_node.ID
+	acc.mutation.done = true
+	return _node, nil
+}
+
+func (acc *AnnouncementCommentCreate) createSpec() (*AnnouncementComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnnouncementComment{config: acc.config}
+		_spec = sqlgraph.NewCreateSpec(announcementcomment.Table, sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt))
+	)
+	if value, ok := acc.mutation.Body(); ok {
+		_spec.SetField(announcementcomment.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := acc.mutation.LeaderAnswer(); ok {
+		_spec.SetField(announcementcomment.FieldLeaderAnswer, field.TypeBool, value)
+		_node.LeaderAnswer = value
+	}
+	if value, ok := acc.mutation.DeletedAt(); ok {
+		_spec.SetField(announcementcomment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := acc.mutation.CreatedAt(); ok {
+		_spec.SetField(announcementcomment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := acc.mutation.AnnouncementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AnnouncementTable,
+			Columns: []string{announcementcomment.AnnouncementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AnnouncementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := acc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.ParentTable,
+			Columns: []string{announcementcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := acc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := acc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AuthorTable,
+			Columns: []string{announcementcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.member_announcement_comments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AnnouncementCommentCreateBulk is the builder for creating many AnnouncementComment entities in bulk.
+type AnnouncementCommentCreateBulk struct {
+	config
+	err      error
+	builders []*AnnouncementCommentCreate
+}
+
+// Save creates the AnnouncementComment entities in the database.
+func (accb *AnnouncementCommentCreateBulk) Save(ctx context.Context) ([]*AnnouncementComment, error) {
+	if accb.err != nil {
+		return nil, accb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*AnnouncementComment, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnnouncementCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *AnnouncementCommentCreateBulk) SaveX(ctx context.Context) []*AnnouncementComment {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (accb *AnnouncementCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := accb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (accb *AnnouncementCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := accb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
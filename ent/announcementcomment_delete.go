@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnnouncementCommentDelete is the builder for deleting a AnnouncementComment entity.
+type AnnouncementCommentDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnnouncementCommentMutation
+}
+
+// Where appends a list predicates to the AnnouncementCommentDelete builder.
+func (acd *AnnouncementCommentDelete) Where(ps ...predicate.AnnouncementComment) *AnnouncementCommentDelete {
+	acd.mutation.Where(ps...)
+	return acd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (acd *AnnouncementCommentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, acd.sqlExec, acd.mutation, acd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acd *AnnouncementCommentDelete) ExecX(ctx context.Context) int {
+	n, err := acd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (acd *AnnouncementCommentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(announcementcomment.Table, sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt))
+	if ps := acd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, acd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	acd.mutation.done = true
+	return affected, err
+}
+
+// AnnouncementCommentDeleteOne is the builder for deleting a single AnnouncementComment entity.
+type AnnouncementCommentDeleteOne struct {
+	acd *AnnouncementCommentDelete
+}
+
+// Where appends a list predicates to the AnnouncementCommentDelete builder.
+func (acdo *AnnouncementCommentDeleteOne) Where(ps ...predicate.AnnouncementComment) *AnnouncementCommentDeleteOne {
+	acdo.acd.mutation.Where(ps...)
+	return acdo
+}
+
+// Exec executes the deletion query.
+func (acdo *AnnouncementCommentDeleteOne) Exec(ctx context.Context) error {
+	n, err := acdo.acd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{announcementcomment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acdo *AnnouncementCommentDeleteOne) ExecX(ctx context.Context) {
+	if err := acdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,881 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnnouncementCommentQuery is the builder for querying AnnouncementComment entities.
+type AnnouncementCommentQuery struct {
+	config
+	ctx              *QueryContext
+	order            []announcementcomment.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.AnnouncementComment
+	withAnnouncement *AnnouncementQuery
+	withParent       *AnnouncementCommentQuery
+	withReplies      *AnnouncementCommentQuery
+	withAuthor       *MemberQuery
+	withFKs          bool
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AnnouncementCommentQuery builder.
+func (acq *AnnouncementCommentQuery) Where(ps ...predicate.AnnouncementComment) *AnnouncementCommentQuery {
+	acq.predicates = append(acq.predicates, ps...)
+	return acq
+}
+
+// Limit the number of records to be returned by this query.
+func (acq *AnnouncementCommentQuery) Limit(limit int) *AnnouncementCommentQuery {
+	acq.ctx.Limit = &limit
+	return acq
+}
+
+// Offset to start from.
+func (acq *AnnouncementCommentQuery) Offset(offset int) *AnnouncementCommentQuery {
+	acq.ctx.Offset = &offset
+	return acq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (acq *AnnouncementCommentQuery) Unique(unique bool) *AnnouncementCommentQuery {
+	acq.ctx.Unique = &unique
+	return acq
+}
+
+// Order specifies how the records should be ordered.
+func (acq *AnnouncementCommentQuery) Order(o ...announcementcomment.OrderOption) *AnnouncementCommentQuery {
+	acq.order = append(acq.order, o...)
+	return acq
+}
+
+// QueryAnnouncement chains the current query on the "announcement" edge.
+func (acq *AnnouncementCommentQuery) QueryAnnouncement() *AnnouncementQuery {
+	query := (&AnnouncementClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(announcementcomment.Table, announcementcomment.FieldID, selector),
+			sqlgraph.To(announcement.Table, announcement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, announcementcomment.AnnouncementTable, announcementcomment.AnnouncementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (acq *AnnouncementCommentQuery) QueryParent() *AnnouncementCommentQuery {
+	query := (&AnnouncementCommentClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(announcementcomment.Table, announcementcomment.FieldID, selector),
+			sqlgraph.To(announcementcomment.Table, announcementcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, announcementcomment.ParentTable, announcementcomment.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplies chains the current query on the "replies" edge.
+func (acq *AnnouncementCommentQuery) QueryReplies() *AnnouncementCommentQuery {
+	query := (&AnnouncementCommentClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(announcementcomment.Table, announcementcomment.FieldID, selector),
+			sqlgraph.To(announcementcomment.Table, announcementcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, announcementcomment.RepliesTable, announcementcomment.RepliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthor chains the current query on the "author" edge.
+func (acq *AnnouncementCommentQuery) QueryAuthor() *MemberQuery {
+	query := (&MemberClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(announcementcomment.Table, announcementcomment.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, announcementcomment.AuthorTable, announcementcomment.AuthorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AnnouncementComment entity from the query.
+// Returns a *NotFoundError when no AnnouncementComment was found.
+func (acq *AnnouncementCommentQuery) First(ctx context.Context) (*AnnouncementComment, error) {
+	nodes, err := acq.Limit(1).All(setContextOp(ctx, acq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{announcementcomment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) FirstX(ctx context.Context) *AnnouncementComment {
+	node, err := acq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AnnouncementComment ID from the query.
+// Returns a *NotFoundError when no AnnouncementComment ID was found.
+func (acq *AnnouncementCommentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(1).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{announcementcomment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) FirstIDX(ctx context.Context) int {
+	id, err := acq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AnnouncementComment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AnnouncementComment entity is found.
+// Returns a *NotFoundError when no AnnouncementComment entities are found.
+func (acq *AnnouncementCommentQuery) Only(ctx context.Context) (*AnnouncementComment, error) {
+	nodes, err := acq.Limit(2).All(setContextOp(ctx, acq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{announcementcomment.Label}
+	default:
+		return nil, &NotSingularError{announcementcomment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) OnlyX(ctx context.Context) *AnnouncementComment {
+	node, err := acq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AnnouncementComment ID in the query.
+// Returns a *NotSingularError when more than one AnnouncementComment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (acq *AnnouncementCommentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(2).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{announcementcomment.Label}
+	default:
+		err = &NotSingularError{announcementcomment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := acq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AnnouncementComments.
+func (acq *AnnouncementCommentQuery) All(ctx context.Context) ([]*AnnouncementComment, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryAll)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AnnouncementComment, *AnnouncementCommentQuery]()
+	return withInterceptors[[]*AnnouncementComment](ctx, acq, qr, acq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) AllX(ctx context.Context) []*AnnouncementComment {
+	nodes, err := acq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AnnouncementComment IDs.
+func (acq *AnnouncementCommentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if acq.ctx.Unique == nil && acq.path != nil {
+		acq.Unique(true)
+	}
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryIDs)
+	if err = acq.Select(announcementcomment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) IDsX(ctx context.Context) []int {
+	ids, err := acq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (acq *AnnouncementCommentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryCount)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, acq, querierCount[*AnnouncementCommentQuery](), acq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) CountX(ctx context.Context) int {
+	count, err := acq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (acq *AnnouncementCommentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryExist)
+	switch _, err := acq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (acq *AnnouncementCommentQuery) ExistX(ctx context.Context) bool {
+	exist, err := acq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AnnouncementCommentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (acq *AnnouncementCommentQuery) Clone() *AnnouncementCommentQuery {
+	if acq == nil {
+		return nil
+	}
+	return &AnnouncementCommentQuery{
+		config:           acq.config,
+		ctx:              acq.ctx.Clone(),
+		order:            append([]announcementcomment.OrderOption{}, acq.order...),
+		inters:           append([]Interceptor{}, acq.inters...),
+		predicates:       append([]predicate.AnnouncementComment{}, acq.predicates...),
+		withAnnouncement: acq.withAnnouncement.Clone(),
+		withParent:       acq.withParent.Clone(),
+		withReplies:      acq.withReplies.Clone(),
+		withAuthor:       acq.withAuthor.Clone(),
+		// clone intermediate query.
+		sql:  acq.sql.Clone(),
+		path: acq.path,
+	}
+}
+
+// WithAnnouncement tells the query-builder to eager-load the nodes that are connected to
+// the "announcement" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AnnouncementCommentQuery) WithAnnouncement(opts ...func(*AnnouncementQuery)) *AnnouncementCommentQuery {
+	query := (&AnnouncementClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withAnnouncement = query
+	return acq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AnnouncementCommentQuery) WithParent(opts ...func(*AnnouncementCommentQuery)) *AnnouncementCommentQuery {
+	query := (&AnnouncementCommentClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withParent = query
+	return acq
+}
+
+// WithReplies tells the query-builder to eager-load the nodes that are connected to
+// the "replies" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AnnouncementCommentQuery) WithReplies(opts ...func(*AnnouncementCommentQuery)) *AnnouncementCommentQuery {
+	query := (&AnnouncementCommentClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withReplies = query
+	return acq
+}
+
+// WithAuthor tells the query-builder to eager-load the nodes that are connected to
+// the "author" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AnnouncementCommentQuery) WithAuthor(opts ...func(*MemberQuery)) *AnnouncementCommentQuery {
+	query := (&MemberClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withAuthor = query
+	return acq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AnnouncementID int `json:"announcement_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AnnouncementComment.Query().
+//		GroupBy(announcementcomment.FieldAnnouncementID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (acq *AnnouncementCommentQuery) GroupBy(field string, fields ...string) *AnnouncementCommentGroupBy {
+	acq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AnnouncementCommentGroupBy{build: acq}
+	grbuild.flds = &acq.ctx.Fields
+	grbuild.label = announcementcomment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AnnouncementID int `json:"announcement_id,omitempty"`
+//	}
+//
+//	client.AnnouncementComment.Query().
+//		Select(announcementcomment.FieldAnnouncementID).
+//		Scan(ctx, &v)
+func (acq *AnnouncementCommentQuery) Select(fields ...string) *AnnouncementCommentSelect {
+	acq.ctx.Fields = append(acq.ctx.Fields, fields...)
+	sbuild := &AnnouncementCommentSelect{AnnouncementCommentQuery: acq}
+	sbuild.label = announcementcomment.Label
+	sbuild.flds, sbuild.scan = &acq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AnnouncementCommentSelect configured with the given aggregations.
+func (acq *AnnouncementCommentQuery) Aggregate(fns ...AggregateFunc) *AnnouncementCommentSelect {
+	return acq.Select().Aggregate(fns...)
+}
+
+func (acq *AnnouncementCommentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range acq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, acq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range acq.ctx.Fields {
+		if !announcementcomment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if acq.path != nil {
+		prev, err := acq.path(ctx)
+		if err != nil {
+			return err
+		}
+		acq.sql = prev
+	}
+	return nil
+}
+
+func (acq *AnnouncementCommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AnnouncementComment, error) {
+	var (
+		nodes       = []*AnnouncementComment{}
+		withFKs     = acq.withFKs
+		_spec       = acq.querySpec()
+		loadedTypes = [4]bool{
+			acq.withAnnouncement != nil,
+			acq.withParent != nil,
+			acq.withReplies != nil,
+			acq.withAuthor != nil,
+		}
+	)
+	if acq.withAuthor != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, announcementcomment.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AnnouncementComment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AnnouncementComment{config: acq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(acq.modifiers) > 0 {
+		_spec.Modifiers = acq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, acq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := acq.withAnnouncement; query != nil {
+		if err := acq.loadAnnouncement(ctx, query, nodes, nil,
+			func(n *AnnouncementComment, e *Announcement) { n.Edges.Announcement = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := acq.withParent; query != nil {
+		if err := acq.loadParent(ctx, query, nodes, nil,
+			func(n *AnnouncementComment, e *AnnouncementComment) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := acq.withReplies; query != nil {
+		if err := acq.loadReplies(ctx, query, nodes,
+			func(n *AnnouncementComment) { n.Edges.Replies = []*AnnouncementComment{} },
+			func(n *AnnouncementComment, e *AnnouncementComment) { n.Edges.Replies = append(n.Edges.Replies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := acq.withAuthor; query != nil {
+		if err := acq.loadAuthor(ctx, query, nodes, nil,
+			func(n *AnnouncementComment, e *Member) { n.Edges.Author = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (acq *AnnouncementCommentQuery) loadAnnouncement(ctx context.Context, query *AnnouncementQuery, nodes []*AnnouncementComment, init func(*AnnouncementComment), assign func(*AnnouncementComment, *Announcement)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AnnouncementComment)
+	for i := range nodes {
+		fk := nodes[i].AnnouncementID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(announcement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "announcement_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (acq *AnnouncementCommentQuery) loadParent(ctx context.Context, query *AnnouncementCommentQuery, nodes []*AnnouncementComment, init func(*AnnouncementComment), assign func(*AnnouncementComment, *AnnouncementComment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AnnouncementComment)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(announcementcomment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (acq *AnnouncementCommentQuery) loadReplies(ctx context.Context, query *AnnouncementCommentQuery, nodes []*AnnouncementComment, init func(*AnnouncementComment), assign func(*AnnouncementComment, *AnnouncementComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AnnouncementComment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(announcementcomment.FieldParentID)
+	}
+	query.Where(predicate.AnnouncementComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(announcementcomment.RepliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (acq *AnnouncementCommentQuery) loadAuthor(ctx context.Context, query *MemberQuery, nodes []*AnnouncementComment, init func(*AnnouncementComment), assign func(*AnnouncementComment, *Member)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AnnouncementComment)
+	for i := range nodes {
+		if nodes[i].member_announcement_comments == nil {
+			continue
+		}
+		fk := *nodes[i].member_announcement_comments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(member.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "member_announcement_comments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (acq *AnnouncementCommentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := acq.querySpec()
+	if len(acq.modifiers) > 0 {
+		_spec.Modifiers = acq.modifiers
+	}
+	_spec.Node.Columns = acq.ctx.Fields
+	if len(acq.ctx.Fields) > 0 {
+		_spec.Unique = acq.ctx.Unique != nil && *acq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, acq.driver, _spec)
+}
+
+func (acq *AnnouncementCommentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(announcementcomment.Table, announcementcomment.Columns, sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt))
+	_spec.From = acq.sql
+	if unique := acq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if acq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := acq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, announcementcomment.FieldID)
+		for i := range fields {
+			if fields[i] != announcementcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if acq.withAnnouncement != nil {
+			_spec.Node.AddColumnOnce(announcementcomment.FieldAnnouncementID)
+		}
+		if acq.withParent != nil {
+			_spec.Node.AddColumnOnce(announcementcomment.FieldParentID)
+		}
+	}
+	if ps := acq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := acq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (acq *AnnouncementCommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(acq.driver.Dialect())
+	t1 := builder.Table(announcementcomment.Table)
+	columns := acq.ctx.Fields
+	if len(columns) == 0 {
+		columns = announcementcomment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if acq.sql != nil {
+		selector = acq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if acq.ctx.Unique != nil && *acq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range acq.modifiers {
+		m(selector)
+	}
+	for _, p := range acq.predicates {
+		p(selector)
+	}
+	for _, p := range acq.order {
+		p(selector)
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (acq *AnnouncementCommentQuery) ForUpdate(opts ...sql.LockOption) *AnnouncementCommentQuery {
+	if acq.driver.Dialect() == dialect.Postgres {
+		acq.Unique(false)
+	}
+	acq.modifiers = append(acq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return acq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (acq *AnnouncementCommentQuery) ForShare(opts ...sql.LockOption) *AnnouncementCommentQuery {
+	if acq.driver.Dialect() == dialect.Postgres {
+		acq.Unique(false)
+	}
+	acq.modifiers = append(acq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return acq
+}
+
+// AnnouncementCommentGroupBy is the group-by builder for AnnouncementComment entities.
+type AnnouncementCommentGroupBy struct {
+	selector
+	build *AnnouncementCommentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (acgb *AnnouncementCommentGroupBy) Aggregate(fns ...AggregateFunc) *AnnouncementCommentGroupBy {
+	acgb.fns = append(acgb.fns, fns...)
+	return acgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acgb *AnnouncementCommentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acgb.build.ctx, ent.OpQueryGroupBy)
+	if err := acgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AnnouncementCommentQuery, *AnnouncementCommentGroupBy](ctx, acgb.build, acgb, acgb.build.inters, v)
+}
+
+func (acgb *AnnouncementCommentGroupBy) sqlScan(ctx context.Context, root *AnnouncementCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(acgb.fns))
+	for _, fn := range acgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*acgb.flds)+len(acgb.fns))
+		for _, f := range *acgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*acgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AnnouncementCommentSelect is the builder for selecting fields of AnnouncementComment entities.
+type AnnouncementCommentSelect struct {
+	*AnnouncementCommentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (acs *AnnouncementCommentSelect) Aggregate(fns ...AggregateFunc) *AnnouncementCommentSelect {
+	acs.fns = append(acs.fns, fns...)
+	return acs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acs *AnnouncementCommentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acs.ctx, ent.OpQuerySelect)
+	if err := acs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AnnouncementCommentQuery, *AnnouncementCommentSelect](ctx, acs.AnnouncementCommentQuery, acs, acs.inters, v)
+}
+
+func (acs *AnnouncementCommentSelect) sqlScan(ctx context.Context, root *AnnouncementCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(acs.fns))
+	for _, fn := range acs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*acs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
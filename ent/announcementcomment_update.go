@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnnouncementCommentUpdate is the builder for updating AnnouncementComment entities.
+type AnnouncementCommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnnouncementCommentMutation
+}
+
+// Where appends a list predicates to the AnnouncementCommentUpdate builder.
+func (acu *AnnouncementCommentUpdate) Where(ps ...predicate.AnnouncementComment) *AnnouncementCommentUpdate {
+	acu.mutation.Where(ps...)
+	return acu
+}
+
+// SetAnnouncementID sets the "announcement_id" field.
+func (acu *AnnouncementCommentUpdate) SetAnnouncementID(i int) *AnnouncementCommentUpdate {
+	acu.mutation.SetAnnouncementID(i)
+	return acu
+}
+
+// SetNillableAnnouncementID sets the "announcement_id" field if the given value is not nil.
+func (acu *AnnouncementCommentUpdate) SetNillableAnnouncementID(i *int) *AnnouncementCommentUpdate {
+	if i != nil {
+		acu.SetAnnouncementID(*i)
+	}
+	return acu
+}
+
+// SetParentID sets the "parent_id" field.
+func (acu *AnnouncementCommentUpdate) SetParentID(i int) *AnnouncementCommentUpdate {
+	acu.mutation.SetParentID(i)
+	return acu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (acu *AnnouncementCommentUpdate) SetNillableParentID(i *int) *AnnouncementCommentUpdate {
+	if i != nil {
+		acu.SetParentID(*i)
+	}
+	return acu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (acu *AnnouncementCommentUpdate) ClearParentID() *AnnouncementCommentUpdate {
+	acu.mutation.ClearParentID()
+	return acu
+}
+
+// SetBody sets the "body" field.
+func (acu *AnnouncementCommentUpdate) SetBody(s string) *AnnouncementCommentUpdate {
+	acu.mutation.SetBody(s)
+	return acu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (acu *AnnouncementCommentUpdate) SetNillableBody(s *string) *AnnouncementCommentUpdate {
+	if s != nil {
+		acu.SetBody(*s)
+	}
+	return acu
+}
+
+// SetLeaderAnswer sets the "leader_answer" field.
+func (acu *AnnouncementCommentUpdate) SetLeaderAnswer(b bool) *AnnouncementCommentUpdate {
+	acu.mutation.SetLeaderAnswer(b)
+	return acu
+}
+
+// SetNillableLeaderAnswer sets the "leader_answer" field if the given value is not nil.
+func (acu *AnnouncementCommentUpdate) SetNillableLeaderAnswer(b *bool) *AnnouncementCommentUpdate {
+	if b != nil {
+		acu.SetLeaderAnswer(*b)
+	}
+	return acu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (acu *AnnouncementCommentUpdate) SetDeletedAt(t time.Time) *AnnouncementCommentUpdate {
+	acu.mutation.SetDeletedAt(t)
+	return acu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (acu *AnnouncementCommentUpdate) SetNillableDeletedAt(t *time.Time) *AnnouncementCommentUpdate {
+	if t != nil {
+		acu.SetDeletedAt(*t)
+	}
+	return acu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (acu *AnnouncementCommentUpdate) ClearDeletedAt() *AnnouncementCommentUpdate {
+	acu.mutation.ClearDeletedAt()
+	return acu
+}
+
+// SetAnnouncement sets the "announcement" edge to the Announcement entity.
+func (acu *AnnouncementCommentUpdate) SetAnnouncement(a *Announcement) *AnnouncementCommentUpdate {
+	return acu.SetAnnouncementID(a.ID)
+}
+
+// SetParent sets the "parent" edge to the AnnouncementComment entity.
+func (acu *AnnouncementCommentUpdate) SetParent(a *AnnouncementComment) *AnnouncementCommentUpdate {
+	return acu.SetParentID(a.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the AnnouncementComment entity by IDs.
+func (acu *AnnouncementCommentUpdate) AddReplyIDs(ids ...int) *AnnouncementCommentUpdate {
+	acu.mutation.AddReplyIDs(ids...)
+	return acu
+}
+
+// AddReplies adds the "replies" edges to the AnnouncementComment entity.
+func (acu *AnnouncementCommentUpdate) AddReplies(a ...*AnnouncementComment) *AnnouncementCommentUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acu.AddReplyIDs(ids...)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (acu *AnnouncementCommentUpdate) SetAuthorID(id int) *AnnouncementCommentUpdate {
+	acu.mutation.SetAuthorID(id)
+	return acu
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (acu *AnnouncementCommentUpdate) SetAuthor(m *Member) *AnnouncementCommentUpdate {
+	return acu.SetAuthorID(m.ID)
+}
+
+// Mutation returns the AnnouncementCommentMutation object of the builder.
+func (acu *AnnouncementCommentUpdate) Mutation() *AnnouncementCommentMutation {
+	return acu.mutation
+}
+
+// ClearAnnouncement clears the "announcement" edge to the Announcement entity.
+func (acu *AnnouncementCommentUpdate) ClearAnnouncement() *AnnouncementCommentUpdate {
+	acu.mutation.ClearAnnouncement()
+	return acu
+}
+
+// ClearParent clears the "parent" edge to the AnnouncementComment entity.
+func (acu *AnnouncementCommentUpdate) ClearParent() *AnnouncementCommentUpdate {
+	acu.mutation.ClearParent()
+	return acu
+}
+
+// ClearReplies clears all "replies" edges to the AnnouncementComment entity.
+func (acu *AnnouncementCommentUpdate) ClearReplies() *AnnouncementCommentUpdate {
+	acu.mutation.ClearReplies()
+	return acu
+}
+
+// RemoveReplyIDs removes the "replies" edge to AnnouncementComment entities by IDs.
+func (acu *AnnouncementCommentUpdate) RemoveReplyIDs(ids ...int) *AnnouncementCommentUpdate {
+	acu.mutation.RemoveReplyIDs(ids...)
+	return acu
+}
+
+// RemoveReplies removes "replies" edges to AnnouncementComment entities.
+func (acu *AnnouncementCommentUpdate) RemoveReplies(a ...*AnnouncementComment) *AnnouncementCommentUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acu.RemoveReplyIDs(ids...)
+}
+
+// ClearAuthor clears the "author" edge to the Member entity.
+func (acu *AnnouncementCommentUpdate) ClearAuthor() *AnnouncementCommentUpdate {
+	acu.mutation.ClearAuthor()
+	return acu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *AnnouncementCommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, acu.sqlSave, acu.mutation, acu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *AnnouncementCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *AnnouncementCommentUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *AnnouncementCommentUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acu *AnnouncementCommentUpdate) check() error {
+	if v, ok := acu.mutation.Body(); ok {
+		if err := announcementcomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "AnnouncementComment.body": %w`, err)}
+		}
+	}
+	if acu.mutation.AnnouncementCleared() && len(acu.mutation.AnnouncementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnnouncementComment.announcement"`)
+	}
+	if acu.mutation.AuthorCleared() && len(acu.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnnouncementComment.author"`)
+	}
+	return nil
+}
+
+func (acu *AnnouncementCommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := acu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(announcementcomment.Table, announcementcomment.Columns, sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt))
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acu.mutation.Body(); ok {
+		_spec.SetField(announcementcomment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.LeaderAnswer(); ok {
+		_spec.SetField(announcementcomment.FieldLeaderAnswer, field.TypeBool, value)
+	}
+	if value, ok := acu.mutation.DeletedAt(); ok {
+		_spec.SetField(announcementcomment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if acu.mutation.DeletedAtCleared() {
+		_spec.ClearField(announcementcomment.FieldDeletedAt, field.TypeTime)
+	}
+	if acu.mutation.AnnouncementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AnnouncementTable,
+			Columns: []string{announcementcomment.AnnouncementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.AnnouncementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AnnouncementTable,
+			Columns: []string{announcementcomment.AnnouncementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.ParentTable,
+			Columns: []string{announcementcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.ParentTable,
+			Columns: []string{announcementcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !acu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AuthorTable,
+			Columns: []string{announcementcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AuthorTable,
+			Columns: []string{announcementcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{announcementcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	acu.mutation.done = true
+	return n, nil
+}
+
+// AnnouncementCommentUpdateOne is the builder for updating a single AnnouncementComment entity.
+type AnnouncementCommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnnouncementCommentMutation
+}
+
+// SetAnnouncementID sets the "announcement_id" field.
+func (acuo *AnnouncementCommentUpdateOne) SetAnnouncementID(i int) *AnnouncementCommentUpdateOne {
+	acuo.mutation.SetAnnouncementID(i)
+	return acuo
+}
+
+// SetNillableAnnouncementID sets the "announcement_id" field if the given value is not nil.
+func (acuo *AnnouncementCommentUpdateOne) SetNillableAnnouncementID(i *int) *AnnouncementCommentUpdateOne {
+	if i != nil {
+		acuo.SetAnnouncementID(*i)
+	}
+	return acuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (acuo *AnnouncementCommentUpdateOne) SetParentID(i int) *AnnouncementCommentUpdateOne {
+	acuo.mutation.SetParentID(i)
+	return acuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (acuo *AnnouncementCommentUpdateOne) SetNillableParentID(i *int) *AnnouncementCommentUpdateOne {
+	if i != nil {
+		acuo.SetParentID(*i)
+	}
+	return acuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (acuo *AnnouncementCommentUpdateOne) ClearParentID() *AnnouncementCommentUpdateOne {
+	acuo.mutation.ClearParentID()
+	return acuo
+}
+
+// SetBody sets the "body" field.
+func (acuo *AnnouncementCommentUpdateOne) SetBody(s string) *AnnouncementCommentUpdateOne {
+	acuo.mutation.SetBody(s)
+	return acuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (acuo *AnnouncementCommentUpdateOne) SetNillableBody(s *string) *AnnouncementCommentUpdateOne {
+	if s != nil {
+		acuo.SetBody(*s)
+	}
+	return acuo
+}
+
+// SetLeaderAnswer sets the "leader_answer" field.
+func (acuo *AnnouncementCommentUpdateOne) SetLeaderAnswer(b bool) *AnnouncementCommentUpdateOne {
+	acuo.mutation.SetLeaderAnswer(b)
+	return acuo
+}
+
+// SetNillableLeaderAnswer sets the "leader_answer" field if the given value is not nil.
+func (acuo *AnnouncementCommentUpdateOne) SetNillableLeaderAnswer(b *bool) *AnnouncementCommentUpdateOne {
+	if b != nil {
+		acuo.SetLeaderAnswer(*b)
+	}
+	return acuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (acuo *AnnouncementCommentUpdateOne) SetDeletedAt(t time.Time) *AnnouncementCommentUpdateOne {
+	acuo.mutation.SetDeletedAt(t)
+	return acuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (acuo *AnnouncementCommentUpdateOne) SetNillableDeletedAt(t *time.Time) *AnnouncementCommentUpdateOne {
+	if t != nil {
+		acuo.SetDeletedAt(*t)
+	}
+	return acuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (acuo *AnnouncementCommentUpdateOne) ClearDeletedAt() *AnnouncementCommentUpdateOne {
+	acuo.mutation.ClearDeletedAt()
+	return acuo
+}
+
+// SetAnnouncement sets the "announcement" edge to the Announcement entity.
+func (acuo *AnnouncementCommentUpdateOne) SetAnnouncement(a *Announcement) *AnnouncementCommentUpdateOne {
+	return acuo.SetAnnouncementID(a.ID)
+}
+
+// SetParent sets the "parent" edge to the AnnouncementComment entity.
+func (acuo *AnnouncementCommentUpdateOne) SetParent(a *AnnouncementComment) *AnnouncementCommentUpdateOne {
+	return acuo.SetParentID(a.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the AnnouncementComment entity by IDs.
+func (acuo *AnnouncementCommentUpdateOne) AddReplyIDs(ids ...int) *AnnouncementCommentUpdateOne {
+	acuo.mutation.AddReplyIDs(ids...)
+	return acuo
+}
+
+// AddReplies adds the "replies" edges to the AnnouncementComment entity.
+func (acuo *AnnouncementCommentUpdateOne) AddReplies(a ...*AnnouncementComment) *AnnouncementCommentUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acuo.AddReplyIDs(ids...)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (acuo *AnnouncementCommentUpdateOne) SetAuthorID(id int) *AnnouncementCommentUpdateOne {
+	acuo.mutation.SetAuthorID(id)
+	return acuo
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (acuo *AnnouncementCommentUpdateOne) SetAuthor(m *Member) *AnnouncementCommentUpdateOne {
+	return acuo.SetAuthorID(m.ID)
+}
+
+// Mutation returns the AnnouncementCommentMutation object of the builder.
+func (acuo *AnnouncementCommentUpdateOne) Mutation() *AnnouncementCommentMutation {
+	return acuo.mutation
+}
+
+// ClearAnnouncement clears the "announcement" edge to the Announcement entity.
+func (acuo *AnnouncementCommentUpdateOne) ClearAnnouncement() *AnnouncementCommentUpdateOne {
+	acuo.mutation.ClearAnnouncement()
+	return acuo
+}
+
+// ClearParent clears the "parent" edge to the AnnouncementComment entity.
+func (acuo *AnnouncementCommentUpdateOne) ClearParent() *AnnouncementCommentUpdateOne {
+	acuo.mutation.ClearParent()
+	return acuo
+}
+
+// ClearReplies clears all "replies" edges to the AnnouncementComment entity.
+func (acuo *AnnouncementCommentUpdateOne) ClearReplies() *AnnouncementCommentUpdateOne {
+	acuo.mutation.ClearReplies()
+	return acuo
+}
+
+// RemoveReplyIDs removes the "replies" edge to AnnouncementComment entities by IDs.
+func (acuo *AnnouncementCommentUpdateOne) RemoveReplyIDs(ids ...int) *AnnouncementCommentUpdateOne {
+	acuo.mutation.RemoveReplyIDs(ids...)
+	return acuo
+}
+
+// RemoveReplies removes "replies" edges to AnnouncementComment entities.
+func (acuo *AnnouncementCommentUpdateOne) RemoveReplies(a ...*AnnouncementComment) *AnnouncementCommentUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acuo.RemoveReplyIDs(ids...)
+}
+
+// ClearAuthor clears the "author" edge to the Member entity.
+func (acuo *AnnouncementCommentUpdateOne) ClearAuthor() *AnnouncementCommentUpdateOne {
+	acuo.mutation.ClearAuthor()
+	return acuo
+}
+
+// Where appends a list predicates to the AnnouncementCommentUpdate builder.
+func (acuo *AnnouncementCommentUpdateOne) Where(ps ...predicate.AnnouncementComment) *AnnouncementCommentUpdateOne {
+	acuo.mutation.Where(ps...)
+	return acuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acuo *AnnouncementCommentUpdateOne) Select(field string, fields ...string) *AnnouncementCommentUpdateOne {
+	acuo.fields = append([]string{field}, fields...)
+	return acuo
+}
+
+// Save executes the query and returns the updated AnnouncementComment entity.
+func (acuo *AnnouncementCommentUpdateOne) Save(ctx context.Context) (*AnnouncementComment, error) {
+	return withHooks(ctx, acuo.sqlSave, acuo.mutation, acuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *AnnouncementCommentUpdateOne) SaveX(ctx context.Context) *AnnouncementComment {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *AnnouncementCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *AnnouncementCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acuo *AnnouncementCommentUpdateOne) check() error {
+	if v, ok := acuo.mutation.Body(); ok {
+		if err := announcementcomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "AnnouncementComment.body": %w`, err)}
+		}
+	}
+	if acuo.mutation.AnnouncementCleared() && len(acuo.mutation.AnnouncementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnnouncementComment.announcement"`)
+	}
+	if acuo.mutation.AuthorCleared() && len(acuo.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnnouncementComment.author"`)
+	}
+	return nil
+}
+
+func (acuo *AnnouncementCommentUpdateOne) sqlSave(ctx context.Context) (_node *AnnouncementComment, err error) {
+	if err := acuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(announcementcomment.Table, announcementcomment.Columns, sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt))
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnnouncementComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, announcementcomment.FieldID)
+		for _, f := range fields {
+			if !announcementcomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != announcementcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acuo.mutation.Body(); ok {
+		_spec.SetField(announcementcomment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.LeaderAnswer(); ok {
+		_spec.SetField(announcementcomment.FieldLeaderAnswer, field.TypeBool, value)
+	}
+	if value, ok := acuo.mutation.DeletedAt(); ok {
+		_spec.SetField(announcementcomment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if acuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(announcementcomment.FieldDeletedAt, field.TypeTime)
+	}
+	if acuo.mutation.AnnouncementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AnnouncementTable,
+			Columns: []string{announcementcomment.AnnouncementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.AnnouncementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AnnouncementTable,
+			Columns: []string{announcementcomment.AnnouncementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.ParentTable,
+			Columns: []string{announcementcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.ParentTable,
+			Columns: []string{announcementcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !acuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   announcementcomment.RepliesTable,
+			Columns: []string{announcementcomment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AuthorTable,
+			Columns: []string{announcementcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   announcementcomment.AuthorTable,
+			Columns: []string{announcementcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AnnouncementComment{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{announcementcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acuo.mutation.done = true
+	return _node, nil
+}
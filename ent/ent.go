@@ -4,11 +4,24 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/ent/outboxmessage"
 	"backend_golang/ent/position"
+	"backend_golang/ent/rsvp"
 	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
 	"backend_golang/ent/transientmember"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/ent/webhook"
+	"backend_golang/ent/webhookdelivery"
 	"context"
 	"errors"
 	"fmt"
@@ -78,12 +91,25 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			announcement.Table:    announcement.ValidColumn,
-			member.Table:          member.ValidColumn,
-			position.Table:        position.ValidColumn,
-			skill.Table:           skill.ValidColumn,
-			team.Table:            team.ValidColumn,
-			transientmember.Table: transientmember.ValidColumn,
+			announcement.Table:        announcement.ValidColumn,
+			announcementcomment.Table: announcementcomment.ValidColumn,
+			event.Table:               event.ValidColumn,
+			eventoverride.Table:       eventoverride.ValidColumn,
+			invitation.Table:          invitation.ValidColumn,
+			member.Table:              member.ValidColumn,
+			notification.Table:        notification.ValidColumn,
+			outboxmessage.Table:       outboxmessage.ValidColumn,
+			position.Table:            position.ValidColumn,
+			rsvp.Table:                rsvp.ValidColumn,
+			skill.Table:               skill.ValidColumn,
+			skillalias.Table:          skillalias.ValidColumn,
+			team.Table:                team.ValidColumn,
+			thread.Table:              thread.ValidColumn,
+			threadcomment.Table:       threadcomment.ValidColumn,
+			transientmember.Table:     transientmember.ValidColumn,
+			waitlistentry.Table:       waitlistentry.ValidColumn,
+			webhook.Table:             webhook.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/team"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Event is the model entity for the Event schema.
+type Event struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int `json:"team_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Agenda holds the value of the "agenda" field.
+	Agenda string `json:"agenda,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// OnlineURL holds the value of the "online_url" field.
+	OnlineURL string `json:"online_url,omitempty"`
+	// Capacity holds the value of the "capacity" field.
+	Capacity int `json:"capacity,omitempty"`
+	// GoingCount holds the value of the "going_count" field.
+	GoingCount int `json:"going_count,omitempty"`
+	// Recurrence holds the value of the "recurrence" field.
+	Recurrence string `json:"recurrence,omitempty"`
+	// RecurrenceEndsAt holds the value of the "recurrence_ends_at" field.
+	RecurrenceEndsAt *time.Time `json:"recurrence_ends_at,omitempty"`
+	// Sequence holds the value of the "sequence" field.
+	Sequence int `json:"sequence,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EventQuery when eager-loading is set.
+	Edges        EventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EventEdges holds the relations/edges for other nodes in the graph.
+type EventEdges struct {
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// Rsvps holds the value of the rsvps edge.
+	Rsvps []*RSVP `json:"rsvps,omitempty"`
+	// Overrides holds the value of the overrides edge.
+	Overrides []*EventOverride `json:"overrides,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// RsvpsOrErr returns the Rsvps value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) RsvpsOrErr() ([]*RSVP, error) {
+	if e.loadedTypes[1] {
+		return e.Rsvps, nil
+	}
+	return nil, &NotLoadedError{edge: "rsvps"}
+}
+
+// OverridesOrErr returns the Overrides value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) OverridesOrErr() ([]*EventOverride, error) {
+	if e.loadedTypes[2] {
+		return e.Overrides, nil
+	}
+	return nil, &NotLoadedError{edge: "overrides"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Event) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case event.FieldID, event.FieldTeamID, event.FieldCapacity, event.FieldGoingCount, event.FieldSequence:
+			values[i] = new(sql.NullInt64)
+		case event.FieldTitle, event.FieldAgenda, event.FieldLocation, event.FieldOnlineURL, event.FieldRecurrence, event.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case event.FieldStartsAt, event.FieldEndsAt, event.FieldRecurrenceEndsAt, event.FieldCancelledAt, event.FieldCreatedAt, event.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Event fields.
+func (e *Event) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case event.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			e.ID = int(value.Int64)
+		case event.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				e.TeamID = int(value.Int64)
+			}
+		case event.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				e.Title = value.String
+			}
+		case event.FieldAgenda:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agenda", values[i])
+			} else if value.Valid {
+				e.Agenda = value.String
+			}
+		case event.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				e.StartsAt = value.Time
+			}
+		case event.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				e.EndsAt = value.Time
+			}
+		case event.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				e.Location = value.String
+			}
+		case event.FieldOnlineURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field online_url", values[i])
+			} else if value.Valid {
+				e.OnlineURL = value.String
+			}
+		case event.FieldCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity", values[i])
+			} else if value.Valid {
+				e.Capacity = int(value.Int64)
+			}
+		case event.FieldGoingCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field going_count", values[i])
+			} else if value.Valid {
+				e.GoingCount = int(value.Int64)
+			}
+		case event.FieldRecurrence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence", values[i])
+			} else if value.Valid {
+				e.Recurrence = value.String
+			}
+		case event.FieldRecurrenceEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_ends_at", values[i])
+			} else if value.Valid {
+				e.RecurrenceEndsAt = new(time.Time)
+				*e.RecurrenceEndsAt = value.Time
+			}
+		case event.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				e.Sequence = int(value.Int64)
+			}
+		case event.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				e.CancelledAt = new(time.Time)
+				*e.CancelledAt = value.Time
+			}
+		case event.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				e.CreatedBy = value.String
+			}
+		case event.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Time
+			}
+		case event.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				e.UpdatedAt = value.Time
+			}
+		default:
+			e.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Event.
+// This includes values selected through modifiers, order, etc.
+func (e *Event) Value(name string) (ent.Value, error) {
+	return e.selectValues.Get(name)
+}
+
+// QueryTeam queries the "team" edge of the Event entity.
+func (e *Event) QueryTeam() *TeamQuery {
+	return NewEventClient(e.config).QueryTeam(e)
+}
+
+// QueryRsvps queries the "rsvps" edge of the Event entity.
+func (e *Event) QueryRsvps() *RSVPQuery {
+	return NewEventClient(e.config).QueryRsvps(e)
+}
+
+// QueryOverrides queries the "overrides" edge of the Event entity.
+func (e *Event) QueryOverrides() *EventOverrideQuery {
+	return NewEventClient(e.config).QueryOverrides(e)
+}
+
+// Update returns a builder for updating this Event.
+// Note that you need to call Event.Unwrap() before calling this method if this Event
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Event) Update() *EventUpdateOne {
+	return NewEventClient(e.config).UpdateOne(e)
+}
+
+// Unwrap unwraps the Event entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (e *Event) Unwrap() *Event {
+	_tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Event is not a transactional entity")
+	}
+	e.config.driver = _tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Event) String() string {
+	var builder strings.Builder
+	builder.WriteString("Event(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", e.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(e.Title)
+	builder.WriteString(", ")
+	builder.WriteString("agenda=")
+	builder.WriteString(e.Agenda)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(e.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(e.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(e.Location)
+	builder.WriteString(", ")
+	builder.WriteString("online_url=")
+	builder.WriteString(e.OnlineURL)
+	builder.WriteString(", ")
+	builder.WriteString("capacity=")
+	builder.WriteString(fmt.Sprintf("%v", e.Capacity))
+	builder.WriteString(", ")
+	builder.WriteString("going_count=")
+	builder.WriteString(fmt.Sprintf("%v", e.GoingCount))
+	builder.WriteString(", ")
+	builder.WriteString("recurrence=")
+	builder.WriteString(e.Recurrence)
+	builder.WriteString(", ")
+	if v := e.RecurrenceEndsAt; v != nil {
+		builder.WriteString("recurrence_ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", e.Sequence))
+	builder.WriteString(", ")
+	if v := e.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(e.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(e.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(e.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Events is a parsable slice of Event.
+type Events []*Event
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package event
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the event type in the database.
+	Label = "event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldAgenda holds the string denoting the agenda field in the database.
+	FieldAgenda = "agenda"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldOnlineURL holds the string denoting the online_url field in the database.
+	FieldOnlineURL = "online_url"
+	// FieldCapacity holds the string denoting the capacity field in the database.
+	FieldCapacity = "capacity"
+	// FieldGoingCount holds the string denoting the going_count field in the database.
+	FieldGoingCount = "going_count"
+	// FieldRecurrence holds the string denoting the recurrence field in the database.
+	FieldRecurrence = "recurrence"
+	// FieldRecurrenceEndsAt holds the string denoting the recurrence_ends_at field in the database.
+	FieldRecurrenceEndsAt = "recurrence_ends_at"
+	// FieldSequence holds the string denoting the sequence field in the database.
+	FieldSequence = "sequence"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// EdgeRsvps holds the string denoting the rsvps edge name in mutations.
+	EdgeRsvps = "rsvps"
+	// EdgeOverrides holds the string denoting the overrides edge name in mutations.
+	EdgeOverrides = "overrides"
+	// Table holds the table name of the event in the database.
+	Table = "events"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "events"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
+	// RsvpsTable is the table that holds the rsvps relation/edge.
+	RsvpsTable = "rsv_ps"
+	// RsvpsInverseTable is the table name for the RSVP entity.
+	// It exists in this package in order to avoid circular dependency with the "rsvp" package.
+	RsvpsInverseTable = "rsv_ps"
+	// RsvpsColumn is the table column denoting the rsvps relation/edge.
+	RsvpsColumn = "event_id"
+	// OverridesTable is the table that holds the overrides relation/edge.
+	OverridesTable = "event_overrides"
+	// OverridesInverseTable is the table name for the EventOverride entity.
+	// It exists in this package in order to avoid circular dependency with the "eventoverride" package.
+	OverridesInverseTable = "event_overrides"
+	// OverridesColumn is the table column denoting the overrides relation/edge.
+	OverridesColumn = "event_id"
+)
+
+// Columns holds all SQL columns for event fields.
+var Columns = []string{
+	FieldID,
+	FieldTeamID,
+	FieldTitle,
+	FieldAgenda,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldLocation,
+	FieldOnlineURL,
+	FieldCapacity,
+	FieldGoingCount,
+	FieldRecurrence,
+	FieldRecurrenceEndsAt,
+	FieldSequence,
+	FieldCancelledAt,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DefaultAgenda holds the default value on creation for the "agenda" field.
+	DefaultAgenda string
+	// DefaultLocation holds the default value on creation for the "location" field.
+	DefaultLocation string
+	// DefaultOnlineURL holds the default value on creation for the "online_url" field.
+	DefaultOnlineURL string
+	// DefaultCapacity holds the default value on creation for the "capacity" field.
+	DefaultCapacity int
+	// DefaultGoingCount holds the default value on creation for the "going_count" field.
+	DefaultGoingCount int
+	// DefaultRecurrence holds the default value on creation for the "recurrence" field.
+	DefaultRecurrence string
+	// DefaultSequence holds the default value on creation for the "sequence" field.
+	DefaultSequence int
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Event queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByAgenda orders the results by the agenda field.
+func ByAgenda(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgenda, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
+// ByOnlineURL orders the results by the online_url field.
+func ByOnlineURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnlineURL, opts...).ToFunc()
+}
+
+// ByCapacity orders the results by the capacity field.
+func ByCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapacity, opts...).ToFunc()
+}
+
+// ByGoingCount orders the results by the going_count field.
+func ByGoingCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGoingCount, opts...).ToFunc()
+}
+
+// ByRecurrence orders the results by the recurrence field.
+func ByRecurrence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrence, opts...).ToFunc()
+}
+
+// ByRecurrenceEndsAt orders the results by the recurrence_ends_at field.
+func ByRecurrenceEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrenceEndsAt, opts...).ToFunc()
+}
+
+// BySequence orders the results by the sequence field.
+func BySequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequence, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRsvpsCount orders the results by rsvps count.
+func ByRsvpsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRsvpsStep(), opts...)
+	}
+}
+
+// ByRsvps orders the results by rsvps terms.
+func ByRsvps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRsvpsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOverridesCount orders the results by overrides count.
+func ByOverridesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOverridesStep(), opts...)
+	}
+}
+
+// ByOverrides orders the results by overrides terms.
+func ByOverrides(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOverridesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+	)
+}
+func newRsvpsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RsvpsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RsvpsTable, RsvpsColumn),
+	)
+}
+func newOverridesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OverridesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OverridesTable, OverridesColumn),
+	)
+}
@@ -0,0 +1,1010 @@
+// Code generated by ent, DO NOT EDIT.
+
+package event
+
+import (
+	"backend_golang/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldID, id))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTitle, v))
+}
+
+// Agenda applies equality check predicate on the "agenda" field. It's identical to AgendaEQ.
+func Agenda(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAgenda, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldLocation, v))
+}
+
+// OnlineURL applies equality check predicate on the "online_url" field. It's identical to OnlineURLEQ.
+func OnlineURL(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldOnlineURL, v))
+}
+
+// Capacity applies equality check predicate on the "capacity" field. It's identical to CapacityEQ.
+func Capacity(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCapacity, v))
+}
+
+// GoingCount applies equality check predicate on the "going_count" field. It's identical to GoingCountEQ.
+func GoingCount(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldGoingCount, v))
+}
+
+// Recurrence applies equality check predicate on the "recurrence" field. It's identical to RecurrenceEQ.
+func Recurrence(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// RecurrenceEndsAt applies equality check predicate on the "recurrence_ends_at" field. It's identical to RecurrenceEndsAtEQ.
+func RecurrenceEndsAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRecurrenceEndsAt, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSequence, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AgendaEQ applies the EQ predicate on the "agenda" field.
+func AgendaEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAgenda, v))
+}
+
+// AgendaNEQ applies the NEQ predicate on the "agenda" field.
+func AgendaNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAgenda, v))
+}
+
+// AgendaIn applies the In predicate on the "agenda" field.
+func AgendaIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAgenda, vs...))
+}
+
+// AgendaNotIn applies the NotIn predicate on the "agenda" field.
+func AgendaNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAgenda, vs...))
+}
+
+// AgendaGT applies the GT predicate on the "agenda" field.
+func AgendaGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldAgenda, v))
+}
+
+// AgendaGTE applies the GTE predicate on the "agenda" field.
+func AgendaGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldAgenda, v))
+}
+
+// AgendaLT applies the LT predicate on the "agenda" field.
+func AgendaLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldAgenda, v))
+}
+
+// AgendaLTE applies the LTE predicate on the "agenda" field.
+func AgendaLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldAgenda, v))
+}
+
+// AgendaContains applies the Contains predicate on the "agenda" field.
+func AgendaContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldAgenda, v))
+}
+
+// AgendaHasPrefix applies the HasPrefix predicate on the "agenda" field.
+func AgendaHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldAgenda, v))
+}
+
+// AgendaHasSuffix applies the HasSuffix predicate on the "agenda" field.
+func AgendaHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldAgenda, v))
+}
+
+// AgendaEqualFold applies the EqualFold predicate on the "agenda" field.
+func AgendaEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldAgenda, v))
+}
+
+// AgendaContainsFold applies the ContainsFold predicate on the "agenda" field.
+func AgendaContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldAgenda, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// OnlineURLEQ applies the EQ predicate on the "online_url" field.
+func OnlineURLEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldOnlineURL, v))
+}
+
+// OnlineURLNEQ applies the NEQ predicate on the "online_url" field.
+func OnlineURLNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldOnlineURL, v))
+}
+
+// OnlineURLIn applies the In predicate on the "online_url" field.
+func OnlineURLIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldOnlineURL, vs...))
+}
+
+// OnlineURLNotIn applies the NotIn predicate on the "online_url" field.
+func OnlineURLNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldOnlineURL, vs...))
+}
+
+// OnlineURLGT applies the GT predicate on the "online_url" field.
+func OnlineURLGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldOnlineURL, v))
+}
+
+// OnlineURLGTE applies the GTE predicate on the "online_url" field.
+func OnlineURLGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldOnlineURL, v))
+}
+
+// OnlineURLLT applies the LT predicate on the "online_url" field.
+func OnlineURLLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldOnlineURL, v))
+}
+
+// OnlineURLLTE applies the LTE predicate on the "online_url" field.
+func OnlineURLLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldOnlineURL, v))
+}
+
+// OnlineURLContains applies the Contains predicate on the "online_url" field.
+func OnlineURLContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldOnlineURL, v))
+}
+
+// OnlineURLHasPrefix applies the HasPrefix predicate on the "online_url" field.
+func OnlineURLHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldOnlineURL, v))
+}
+
+// OnlineURLHasSuffix applies the HasSuffix predicate on the "online_url" field.
+func OnlineURLHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldOnlineURL, v))
+}
+
+// OnlineURLEqualFold applies the EqualFold predicate on the "online_url" field.
+func OnlineURLEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldOnlineURL, v))
+}
+
+// OnlineURLContainsFold applies the ContainsFold predicate on the "online_url" field.
+func OnlineURLContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldOnlineURL, v))
+}
+
+// CapacityEQ applies the EQ predicate on the "capacity" field.
+func CapacityEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCapacity, v))
+}
+
+// CapacityNEQ applies the NEQ predicate on the "capacity" field.
+func CapacityNEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCapacity, v))
+}
+
+// CapacityIn applies the In predicate on the "capacity" field.
+func CapacityIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCapacity, vs...))
+}
+
+// CapacityNotIn applies the NotIn predicate on the "capacity" field.
+func CapacityNotIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCapacity, vs...))
+}
+
+// CapacityGT applies the GT predicate on the "capacity" field.
+func CapacityGT(v int) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCapacity, v))
+}
+
+// CapacityGTE applies the GTE predicate on the "capacity" field.
+func CapacityGTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCapacity, v))
+}
+
+// CapacityLT applies the LT predicate on the "capacity" field.
+func CapacityLT(v int) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCapacity, v))
+}
+
+// CapacityLTE applies the LTE predicate on the "capacity" field.
+func CapacityLTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCapacity, v))
+}
+
+// GoingCountEQ applies the EQ predicate on the "going_count" field.
+func GoingCountEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldGoingCount, v))
+}
+
+// GoingCountNEQ applies the NEQ predicate on the "going_count" field.
+func GoingCountNEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldGoingCount, v))
+}
+
+// GoingCountIn applies the In predicate on the "going_count" field.
+func GoingCountIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldGoingCount, vs...))
+}
+
+// GoingCountNotIn applies the NotIn predicate on the "going_count" field.
+func GoingCountNotIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldGoingCount, vs...))
+}
+
+// GoingCountGT applies the GT predicate on the "going_count" field.
+func GoingCountGT(v int) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldGoingCount, v))
+}
+
+// GoingCountGTE applies the GTE predicate on the "going_count" field.
+func GoingCountGTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldGoingCount, v))
+}
+
+// GoingCountLT applies the LT predicate on the "going_count" field.
+func GoingCountLT(v int) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldGoingCount, v))
+}
+
+// GoingCountLTE applies the LTE predicate on the "going_count" field.
+func GoingCountLTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldGoingCount, v))
+}
+
+// RecurrenceEQ applies the EQ predicate on the "recurrence" field.
+func RecurrenceEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// RecurrenceNEQ applies the NEQ predicate on the "recurrence" field.
+func RecurrenceNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldRecurrence, v))
+}
+
+// RecurrenceIn applies the In predicate on the "recurrence" field.
+func RecurrenceIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceNotIn applies the NotIn predicate on the "recurrence" field.
+func RecurrenceNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceGT applies the GT predicate on the "recurrence" field.
+func RecurrenceGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldRecurrence, v))
+}
+
+// RecurrenceGTE applies the GTE predicate on the "recurrence" field.
+func RecurrenceGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldRecurrence, v))
+}
+
+// RecurrenceLT applies the LT predicate on the "recurrence" field.
+func RecurrenceLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldRecurrence, v))
+}
+
+// RecurrenceLTE applies the LTE predicate on the "recurrence" field.
+func RecurrenceLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldRecurrence, v))
+}
+
+// RecurrenceContains applies the Contains predicate on the "recurrence" field.
+func RecurrenceContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldRecurrence, v))
+}
+
+// RecurrenceHasPrefix applies the HasPrefix predicate on the "recurrence" field.
+func RecurrenceHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldRecurrence, v))
+}
+
+// RecurrenceHasSuffix applies the HasSuffix predicate on the "recurrence" field.
+func RecurrenceHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldRecurrence, v))
+}
+
+// RecurrenceEqualFold applies the EqualFold predicate on the "recurrence" field.
+func RecurrenceEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldRecurrence, v))
+}
+
+// RecurrenceContainsFold applies the ContainsFold predicate on the "recurrence" field.
+func RecurrenceContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldRecurrence, v))
+}
+
+// RecurrenceEndsAtEQ applies the EQ predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRecurrenceEndsAt, v))
+}
+
+// RecurrenceEndsAtNEQ applies the NEQ predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldRecurrenceEndsAt, v))
+}
+
+// RecurrenceEndsAtIn applies the In predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldRecurrenceEndsAt, vs...))
+}
+
+// RecurrenceEndsAtNotIn applies the NotIn predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldRecurrenceEndsAt, vs...))
+}
+
+// RecurrenceEndsAtGT applies the GT predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldRecurrenceEndsAt, v))
+}
+
+// RecurrenceEndsAtGTE applies the GTE predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldRecurrenceEndsAt, v))
+}
+
+// RecurrenceEndsAtLT applies the LT predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldRecurrenceEndsAt, v))
+}
+
+// RecurrenceEndsAtLTE applies the LTE predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldRecurrenceEndsAt, v))
+}
+
+// RecurrenceEndsAtIsNil applies the IsNil predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldRecurrenceEndsAt))
+}
+
+// RecurrenceEndsAtNotNil applies the NotNil predicate on the "recurrence_ends_at" field.
+func RecurrenceEndsAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldRecurrenceEndsAt))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSequence, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRsvps applies the HasEdge predicate on the "rsvps" edge.
+func HasRsvps() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RsvpsTable, RsvpsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRsvpsWith applies the HasEdge predicate on the "rsvps" edge with a given conditions (other predicates).
+func HasRsvpsWith(preds ...predicate.RSVP) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newRsvpsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOverrides applies the HasEdge predicate on the "overrides" edge.
+func HasOverrides() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OverridesTable, OverridesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOverridesWith applies the HasEdge predicate on the "overrides" edge with a given conditions (other predicates).
+func HasOverridesWith(preds ...predicate.EventOverride) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newOverridesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Event) predicate.Event {
+	return predicate.Event(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Event) predicate.Event {
+	return predicate.Event(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Event) predicate.Event {
+	return predicate.Event(sql.NotPredicates(p))
+}
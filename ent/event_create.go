@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/rsvp"
+	"backend_golang/ent/team"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCreate is the builder for creating a Event entity.
+type EventCreate struct {
+	config
+	mutation *EventMutation
+	hooks    []Hook
+}
+
+// SetTeamID sets the "team_id" field.
+func (ec *EventCreate) SetTeamID(i int) *EventCreate {
+	ec.mutation.SetTeamID(i)
+	return ec
+}
+
+// SetTitle sets the "title" field.
+func (ec *EventCreate) SetTitle(s string) *EventCreate {
+	ec.mutation.SetTitle(s)
+	return ec
+}
+
+// SetAgenda sets the "agenda" field.
+func (ec *EventCreate) SetAgenda(s string) *EventCreate {
+	ec.mutation.SetAgenda(s)
+	return ec
+}
+
+// SetNillableAgenda sets the "agenda" field if the given value is not nil.
+func (ec *EventCreate) SetNillableAgenda(s *string) *EventCreate {
+	if s != nil {
+		ec.SetAgenda(*s)
+	}
+	return ec
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (ec *EventCreate) SetStartsAt(t time.Time) *EventCreate {
+	ec.mutation.SetStartsAt(t)
+	return ec
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (ec *EventCreate) SetEndsAt(t time.Time) *EventCreate {
+	ec.mutation.SetEndsAt(t)
+	return ec
+}
+
+// SetLocation sets the "location" field.
+func (ec *EventCreate) SetLocation(s string) *EventCreate {
+	ec.mutation.SetLocation(s)
+	return ec
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (ec *EventCreate) SetNillableLocation(s *string) *EventCreate {
+	if s != nil {
+		ec.SetLocation(*s)
+	}
+	return ec
+}
+
+// SetOnlineURL sets the "online_url" field.
+func (ec *EventCreate) SetOnlineURL(s string) *EventCreate {
+	ec.mutation.SetOnlineURL(s)
+	return ec
+}
+
+// SetNillableOnlineURL sets the "online_url" field if the given value is not nil.
+func (ec *EventCreate) SetNillableOnlineURL(s *string) *EventCreate {
+	if s != nil {
+		ec.SetOnlineURL(*s)
+	}
+	return ec
+}
+
+// SetCapacity sets the "capacity" field.
+func (ec *EventCreate) SetCapacity(i int) *EventCreate {
+	ec.mutation.SetCapacity(i)
+	return ec
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (ec *EventCreate) SetNillableCapacity(i *int) *EventCreate {
+	if i != nil {
+		ec.SetCapacity(*i)
+	}
+	return ec
+}
+
+// SetGoingCount sets the "going_count" field.
+func (ec *EventCreate) SetGoingCount(i int) *EventCreate {
+	ec.mutation.SetGoingCount(i)
+	return ec
+}
+
+// SetNillableGoingCount sets the "going_count" field if the given value is not nil.
+func (ec *EventCreate) SetNillableGoingCount(i *int) *EventCreate {
+	if i != nil {
+		ec.SetGoingCount(*i)
+	}
+	return ec
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (ec *EventCreate) SetRecurrence(s string) *EventCreate {
+	ec.mutation.SetRecurrence(s)
+	return ec
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (ec *EventCreate) SetNillableRecurrence(s *string) *EventCreate {
+	if s != nil {
+		ec.SetRecurrence(*s)
+	}
+	return ec
+}
+
+// SetRecurrenceEndsAt sets the "recurrence_ends_at" field.
+func (ec *EventCreate) SetRecurrenceEndsAt(t time.Time) *EventCreate {
+	ec.mutation.SetRecurrenceEndsAt(t)
+	return ec
+}
+
+// SetNillableRecurrenceEndsAt sets the "recurrence_ends_at" field if the given value is not nil.
+func (ec *EventCreate) SetNillableRecurrenceEndsAt(t *time.Time) *EventCreate {
+	if t != nil {
+		ec.SetRecurrenceEndsAt(*t)
+	}
+	return ec
+}
+
+// SetSequence sets the "sequence" field.
+func (ec *EventCreate) SetSequence(i int) *EventCreate {
+	ec.mutation.SetSequence(i)
+	return ec
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (ec *EventCreate) SetNillableSequence(i *int) *EventCreate {
+	if i != nil {
+		ec.SetSequence(*i)
+	}
+	return ec
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ec *EventCreate) SetCancelledAt(t time.Time) *EventCreate {
+	ec.mutation.SetCancelledAt(t)
+	return ec
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ec *EventCreate) SetNillableCancelledAt(t *time.Time) *EventCreate {
+	if t != nil {
+		ec.SetCancelledAt(*t)
+	}
+	return ec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ec *EventCreate) SetCreatedBy(s string) *EventCreate {
+	ec.mutation.SetCreatedBy(s)
+	return ec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ec *EventCreate) SetCreatedAt(t time.Time) *EventCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *EventCreate) SetNillableCreatedAt(t *time.Time) *EventCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ec *EventCreate) SetUpdatedAt(t time.Time) *EventCreate {
+	ec.mutation.SetUpdatedAt(t)
+	return ec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ec *EventCreate) SetNillableUpdatedAt(t *time.Time) *EventCreate {
+	if t != nil {
+		ec.SetUpdatedAt(*t)
+	}
+	return ec
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (ec *EventCreate) SetTeam(t *Team) *EventCreate {
+	return ec.SetTeamID(t.ID)
+}
+
+// AddRsvpIDs adds the "rsvps" edge to the RSVP entity by IDs.
+func (ec *EventCreate) AddRsvpIDs(ids ...int) *EventCreate {
+	ec.mutation.AddRsvpIDs(ids...)
+	return ec
+}
+
+// AddRsvps adds the "rsvps" edges to the RSVP entity.
+func (ec *EventCreate) AddRsvps(r ...*RSVP) *EventCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddRsvpIDs(ids...)
+}
+
+// AddOverrideIDs adds the "overrides" edge to the EventOverride entity by IDs.
+func (ec *EventCreate) AddOverrideIDs(ids ...int) *EventCreate {
+	ec.mutation.AddOverrideIDs(ids...)
+	return ec
+}
+
+// AddOverrides adds the "overrides" edges to the EventOverride entity.
+func (ec *EventCreate) AddOverrides(e ...*EventOverride) *EventCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ec.AddOverrideIDs(ids...)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (ec *EventCreate) Mutation() *EventMutation {
+	return ec.mutation
+}
+
+// Save creates the Event in the database.
+func (ec *EventCreate) Save(ctx context.Context) (*Event, error) {
+	ec.defaults()
+	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *EventCreate) SaveX(ctx context.Context) *Event {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *EventCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *EventCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ec *EventCreate) defaults() {
+	if _, ok := ec.mutation.Agenda(); !ok {
+		v := event.DefaultAgenda
+		ec.mutation.SetAgenda(v)
+	}
+	if _, ok := ec.mutation.Location(); !ok {
+		v := event.DefaultLocation
+		ec.mutation.SetLocation(v)
+	}
+	if _, ok := ec.mutation.OnlineURL(); !ok {
+		v := event.DefaultOnlineURL
+		ec.mutation.SetOnlineURL(v)
+	}
+	if _, ok := ec.mutation.Capacity(); !ok {
+		v := event.DefaultCapacity
+		ec.mutation.SetCapacity(v)
+	}
+	if _, ok := ec.mutation.GoingCount(); !ok {
+		v := event.DefaultGoingCount
+		ec.mutation.SetGoingCount(v)
+	}
+	if _, ok := ec.mutation.Recurrence(); !ok {
+		v := event.DefaultRecurrence
+		ec.mutation.SetRecurrence(v)
+	}
+	if _, ok := ec.mutation.Sequence(); !ok {
+		v := event.DefaultSequence
+		ec.mutation.SetSequence(v)
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := event.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		v := event.DefaultUpdatedAt()
+		ec.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *EventCreate) check() error {
+	if _, ok := ec.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "Event.team_id"`)}
+	}
+	if _, ok := ec.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Event.title"`)}
+	}
+	if v, ok := ec.mutation.Title(); ok {
+		if err := event.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Agenda(); !ok {
+		return &ValidationError{Name: "agenda", err: errors.New(`ent: missing required field "Event.agenda"`)}
+	}
+	if _, ok := ec.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Event.starts_at"`)}
+	}
+	if _, ok := ec.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "Event.ends_at"`)}
+	}
+	if _, ok := ec.mutation.Location(); !ok {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required field "Event.location"`)}
+	}
+	if _, ok := ec.mutation.OnlineURL(); !ok {
+		return &ValidationError{Name: "online_url", err: errors.New(`ent: missing required field "Event.online_url"`)}
+	}
+	if _, ok := ec.mutation.Capacity(); !ok {
+		return &ValidationError{Name: "capacity", err: errors.New(`ent: missing required field "Event.capacity"`)}
+	}
+	if _, ok := ec.mutation.GoingCount(); !ok {
+		return &ValidationError{Name: "going_count", err: errors.New(`ent: missing required field "Event.going_count"`)}
+	}
+	if _, ok := ec.mutation.Recurrence(); !ok {
+		return &ValidationError{Name: "recurrence", err: errors.New(`ent: missing required field "Event.recurrence"`)}
+	}
+	if _, ok := ec.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "Event.sequence"`)}
+	}
+	if _, ok := ec.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Event.created_by"`)}
+	}
+	if v, ok := ec.mutation.CreatedBy(); ok {
+		if err := event.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Event.created_by": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Event.created_at"`)}
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Event.updated_at"`)}
+	}
+	if len(ec.mutation.TeamIDs()) == 0 {
+		return &ValidationError{Name: "team", err: errors.New(`ent: missing required edge "Event.team"`)}
+	}
+	return nil
+}
+
+func (ec *EventCreate) sqlSave(ctx context.Context) (*Event, error) {
+	if err := ec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ec.mutation.id = &_node.ID
+	ec.mutation.done = true
+	return _node, nil
+}
+
+func (ec *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Event{config: ec.config}
+		_spec = sqlgraph.NewCreateSpec(event.Table, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
+	)
+	if value, ok := ec.mutation.Title(); ok {
+		_spec.SetField(event.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ec.mutation.Agenda(); ok {
+		_spec.SetField(event.FieldAgenda, field.TypeString, value)
+		_node.Agenda = value
+	}
+	if value, ok := ec.mutation.StartsAt(); ok {
+		_spec.SetField(event.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := ec.mutation.EndsAt(); ok {
+		_spec.SetField(event.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := ec.mutation.Location(); ok {
+		_spec.SetField(event.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := ec.mutation.OnlineURL(); ok {
+		_spec.SetField(event.FieldOnlineURL, field.TypeString, value)
+		_node.OnlineURL = value
+	}
+	if value, ok := ec.mutation.Capacity(); ok {
+		_spec.SetField(event.FieldCapacity, field.TypeInt, value)
+		_node.Capacity = value
+	}
+	if value, ok := ec.mutation.GoingCount(); ok {
+		_spec.SetField(event.FieldGoingCount, field.TypeInt, value)
+		_node.GoingCount = value
+	}
+	if value, ok := ec.mutation.Recurrence(); ok {
+		_spec.SetField(event.FieldRecurrence, field.TypeString, value)
+		_node.Recurrence = value
+	}
+	if value, ok := ec.mutation.RecurrenceEndsAt(); ok {
+		_spec.SetField(event.FieldRecurrenceEndsAt, field.TypeTime, value)
+		_node.RecurrenceEndsAt = &value
+	}
+	if value, ok := ec.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := ec.mutation.CancelledAt(); ok {
+		_spec.SetField(event.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := ec.mutation.CreatedBy(); ok {
+		_spec.SetField(event.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(event.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ec.mutation.UpdatedAt(); ok {
+		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ec.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.TeamTable,
+			Columns: []string{event.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.RsvpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.OverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventCreateBulk is the builder for creating many Event entities in bulk.
+type EventCreateBulk struct {
+	config
+	err      error
+	builders []*EventCreate
+}
+
+// Save creates the Event entities in the database.
+func (ecb *EventCreateBulk) Save(ctx context.Context) ([]*Event, error) {
+	if ecb.err != nil {
+		return nil, ecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Event, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *EventCreateBulk) SaveX(ctx context.Context) []*Event {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *EventCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *EventCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/rsvp"
+	"backend_golang/ent/team"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetTeamID sets the "team_id" field.
+func (eu *EventUpdate) SetTeamID(i int) *EventUpdate {
+	eu.mutation.SetTeamID(i)
+	return eu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableTeamID(i *int) *EventUpdate {
+	if i != nil {
+		eu.SetTeamID(*i)
+	}
+	return eu
+}
+
+// SetTitle sets the "title" field.
+func (eu *EventUpdate) SetTitle(s string) *EventUpdate {
+	eu.mutation.SetTitle(s)
+	return eu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableTitle(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetTitle(*s)
+	}
+	return eu
+}
+
+// SetAgenda sets the "agenda" field.
+func (eu *EventUpdate) SetAgenda(s string) *EventUpdate {
+	eu.mutation.SetAgenda(s)
+	return eu
+}
+
+// SetNillableAgenda sets the "agenda" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableAgenda(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetAgenda(*s)
+	}
+	return eu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (eu *EventUpdate) SetStartsAt(t time.Time) *EventUpdate {
+	eu.mutation.SetStartsAt(t)
+	return eu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableStartsAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetStartsAt(*t)
+	}
+	return eu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (eu *EventUpdate) SetEndsAt(t time.Time) *EventUpdate {
+	eu.mutation.SetEndsAt(t)
+	return eu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableEndsAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetEndsAt(*t)
+	}
+	return eu
+}
+
+// SetLocation sets the "location" field.
+func (eu *EventUpdate) SetLocation(s string) *EventUpdate {
+	eu.mutation.SetLocation(s)
+	return eu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableLocation(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetLocation(*s)
+	}
+	return eu
+}
+
+// SetOnlineURL sets the "online_url" field.
+func (eu *EventUpdate) SetOnlineURL(s string) *EventUpdate {
+	eu.mutation.SetOnlineURL(s)
+	return eu
+}
+
+// SetNillableOnlineURL sets the "online_url" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableOnlineURL(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetOnlineURL(*s)
+	}
+	return eu
+}
+
+// SetCapacity sets the "capacity" field.
+func (eu *EventUpdate) SetCapacity(i int) *EventUpdate {
+	eu.mutation.ResetCapacity()
+	eu.mutation.SetCapacity(i)
+	return eu
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableCapacity(i *int) *EventUpdate {
+	if i != nil {
+		eu.SetCapacity(*i)
+	}
+	return eu
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (eu *EventUpdate) AddCapacity(i int) *EventUpdate {
+	eu.mutation.AddCapacity(i)
+	return eu
+}
+
+// SetGoingCount sets the "going_count" field.
+func (eu *EventUpdate) SetGoingCount(i int) *EventUpdate {
+	eu.mutation.ResetGoingCount()
+	eu.mutation.SetGoingCount(i)
+	return eu
+}
+
+// SetNillableGoingCount sets the "going_count" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableGoingCount(i *int) *EventUpdate {
+	if i != nil {
+		eu.SetGoingCount(*i)
+	}
+	return eu
+}
+
+// AddGoingCount adds i to the "going_count" field.
+func (eu *EventUpdate) AddGoingCount(i int) *EventUpdate {
+	eu.mutation.AddGoingCount(i)
+	return eu
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (eu *EventUpdate) SetRecurrence(s string) *EventUpdate {
+	eu.mutation.SetRecurrence(s)
+	return eu
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableRecurrence(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetRecurrence(*s)
+	}
+	return eu
+}
+
+// SetRecurrenceEndsAt sets the "recurrence_ends_at" field.
+func (eu *EventUpdate) SetRecurrenceEndsAt(t time.Time) *EventUpdate {
+	eu.mutation.SetRecurrenceEndsAt(t)
+	return eu
+}
+
+// SetNillableRecurrenceEndsAt sets the "recurrence_ends_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableRecurrenceEndsAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetRecurrenceEndsAt(*t)
+	}
+	return eu
+}
+
+// ClearRecurrenceEndsAt clears the value of the "recurrence_ends_at" field.
+func (eu *EventUpdate) ClearRecurrenceEndsAt() *EventUpdate {
+	eu.mutation.ClearRecurrenceEndsAt()
+	return eu
+}
+
+// SetSequence sets the "sequence" field.
+func (eu *EventUpdate) SetSequence(i int) *EventUpdate {
+	eu.mutation.ResetSequence()
+	eu.mutation.SetSequence(i)
+	return eu
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableSequence(i *int) *EventUpdate {
+	if i != nil {
+		eu.SetSequence(*i)
+	}
+	return eu
+}
+
+// AddSequence adds i to the "sequence" field.
+func (eu *EventUpdate) AddSequence(i int) *EventUpdate {
+	eu.mutation.AddSequence(i)
+	return eu
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (eu *EventUpdate) SetCancelledAt(t time.Time) *EventUpdate {
+	eu.mutation.SetCancelledAt(t)
+	return eu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableCancelledAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetCancelledAt(*t)
+	}
+	return eu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (eu *EventUpdate) ClearCancelledAt() *EventUpdate {
+	eu.mutation.ClearCancelledAt()
+	return eu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (eu *EventUpdate) SetCreatedBy(s string) *EventUpdate {
+	eu.mutation.SetCreatedBy(s)
+	return eu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableCreatedBy(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetCreatedBy(*s)
+	}
+	return eu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eu *EventUpdate) SetUpdatedAt(t time.Time) *EventUpdate {
+	eu.mutation.SetUpdatedAt(t)
+	return eu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (eu *EventUpdate) SetTeam(t *Team) *EventUpdate {
+	return eu.SetTeamID(t.ID)
+}
+
+// AddRsvpIDs adds the "rsvps" edge to the RSVP entity by IDs.
+func (eu *EventUpdate) AddRsvpIDs(ids ...int) *EventUpdate {
+	eu.mutation.AddRsvpIDs(ids...)
+	return eu
+}
+
+// AddRsvps adds the "rsvps" edges to the RSVP entity.
+func (eu *EventUpdate) AddRsvps(r ...*RSVP) *EventUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddRsvpIDs(ids...)
+}
+
+// AddOverrideIDs adds the "overrides" edge to the EventOverride entity by IDs.
+func (eu *EventUpdate) AddOverrideIDs(ids ...int) *EventUpdate {
+	eu.mutation.AddOverrideIDs(ids...)
+	return eu
+}
+
+// AddOverrides adds the "overrides" edges to the EventOverride entity.
+func (eu *EventUpdate) AddOverrides(e ...*EventOverride) *EventUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.AddOverrideIDs(ids...)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (eu *EventUpdate) Mutation() *EventMutation {
+	return eu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (eu *EventUpdate) ClearTeam() *EventUpdate {
+	eu.mutation.ClearTeam()
+	return eu
+}
+
+// ClearRsvps clears all "rsvps" edges to the RSVP entity.
+func (eu *EventUpdate) ClearRsvps() *EventUpdate {
+	eu.mutation.ClearRsvps()
+	return eu
+}
+
+// RemoveRsvpIDs removes the "rsvps" edge to RSVP entities by IDs.
+func (eu *EventUpdate) RemoveRsvpIDs(ids ...int) *EventUpdate {
+	eu.mutation.RemoveRsvpIDs(ids...)
+	return eu
+}
+
+// RemoveRsvps removes "rsvps" edges to RSVP entities.
+func (eu *EventUpdate) RemoveRsvps(r ...*RSVP) *EventUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveRsvpIDs(ids...)
+}
+
+// ClearOverrides clears all "overrides" edges to the EventOverride entity.
+func (eu *EventUpdate) ClearOverrides() *EventUpdate {
+	eu.mutation.ClearOverrides()
+	return eu
+}
+
+// RemoveOverrideIDs removes the "overrides" edge to EventOverride entities by IDs.
+func (eu *EventUpdate) RemoveOverrideIDs(ids ...int) *EventUpdate {
+	eu.mutation.RemoveOverrideIDs(ids...)
+	return eu
+}
+
+// RemoveOverrides removes "overrides" edges to EventOverride entities.
+func (eu *EventUpdate) RemoveOverrides(e ...*EventOverride) *EventUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.RemoveOverrideIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
+	eu.defaults()
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EventUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EventUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eu *EventUpdate) defaults() {
+	if _, ok := eu.mutation.UpdatedAt(); !ok {
+		v := event.UpdateDefaultUpdatedAt()
+		eu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *EventUpdate) check() error {
+	if v, ok := eu.mutation.Title(); ok {
+		if err := event.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.CreatedBy(); ok {
+		if err := event.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Event.created_by": %w`, err)}
+		}
+	}
+	if eu.mutation.TeamCleared() && len(eu.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Event.team"`)
+	}
+	return nil
+}
+
+func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(event.Table, event.Columns, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Title(); ok {
+		_spec.SetField(event.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.Agenda(); ok {
+		_spec.SetField(event.FieldAgenda, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.StartsAt(); ok {
+		_spec.SetField(event.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := eu.mutation.EndsAt(); ok {
+		_spec.SetField(event.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := eu.mutation.Location(); ok {
+		_spec.SetField(event.FieldLocation, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.OnlineURL(); ok {
+		_spec.SetField(event.FieldOnlineURL, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.Capacity(); ok {
+		_spec.SetField(event.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedCapacity(); ok {
+		_spec.AddField(event.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.GoingCount(); ok {
+		_spec.SetField(event.FieldGoingCount, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedGoingCount(); ok {
+		_spec.AddField(event.FieldGoingCount, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.Recurrence(); ok {
+		_spec.SetField(event.FieldRecurrence, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.RecurrenceEndsAt(); ok {
+		_spec.SetField(event.FieldRecurrenceEndsAt, field.TypeTime, value)
+	}
+	if eu.mutation.RecurrenceEndsAtCleared() {
+		_spec.ClearField(event.FieldRecurrenceEndsAt, field.TypeTime)
+	}
+	if value, ok := eu.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedSequence(); ok {
+		_spec.AddField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.CancelledAt(); ok {
+		_spec.SetField(event.FieldCancelledAt, field.TypeTime, value)
+	}
+	if eu.mutation.CancelledAtCleared() {
+		_spec.ClearField(event.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := eu.mutation.CreatedBy(); ok {
+		_spec.SetField(event.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.UpdatedAt(); ok {
+		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.TeamTable,
+			Columns: []string{event.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.TeamTable,
+			Columns: []string{event.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedRsvpsIDs(); len(nodes) > 0 && !eu.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RsvpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.OverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedOverridesIDs(); len(nodes) > 0 && !eu.mutation.OverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.OverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (euo *EventUpdateOne) SetTeamID(i int) *EventUpdateOne {
+	euo.mutation.SetTeamID(i)
+	return euo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableTeamID(i *int) *EventUpdateOne {
+	if i != nil {
+		euo.SetTeamID(*i)
+	}
+	return euo
+}
+
+// SetTitle sets the "title" field.
+func (euo *EventUpdateOne) SetTitle(s string) *EventUpdateOne {
+	euo.mutation.SetTitle(s)
+	return euo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableTitle(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetTitle(*s)
+	}
+	return euo
+}
+
+// SetAgenda sets the "agenda" field.
+func (euo *EventUpdateOne) SetAgenda(s string) *EventUpdateOne {
+	euo.mutation.SetAgenda(s)
+	return euo
+}
+
+// SetNillableAgenda sets the "agenda" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableAgenda(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetAgenda(*s)
+	}
+	return euo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (euo *EventUpdateOne) SetStartsAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetStartsAt(t)
+	return euo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableStartsAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetStartsAt(*t)
+	}
+	return euo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (euo *EventUpdateOne) SetEndsAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetEndsAt(t)
+	return euo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableEndsAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetEndsAt(*t)
+	}
+	return euo
+}
+
+// SetLocation sets the "location" field.
+func (euo *EventUpdateOne) SetLocation(s string) *EventUpdateOne {
+	euo.mutation.SetLocation(s)
+	return euo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableLocation(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetLocation(*s)
+	}
+	return euo
+}
+
+// SetOnlineURL sets the "online_url" field.
+func (euo *EventUpdateOne) SetOnlineURL(s string) *EventUpdateOne {
+	euo.mutation.SetOnlineURL(s)
+	return euo
+}
+
+// SetNillableOnlineURL sets the "online_url" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableOnlineURL(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetOnlineURL(*s)
+	}
+	return euo
+}
+
+// SetCapacity sets the "capacity" field.
+func (euo *EventUpdateOne) SetCapacity(i int) *EventUpdateOne {
+	euo.mutation.ResetCapacity()
+	euo.mutation.SetCapacity(i)
+	return euo
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableCapacity(i *int) *EventUpdateOne {
+	if i != nil {
+		euo.SetCapacity(*i)
+	}
+	return euo
+}
+
+// AddCapacity adds i to the "capacity" field.
+func (euo *EventUpdateOne) AddCapacity(i int) *EventUpdateOne {
+	euo.mutation.AddCapacity(i)
+	return euo
+}
+
+// SetGoingCount sets the "going_count" field.
+func (euo *EventUpdateOne) SetGoingCount(i int) *EventUpdateOne {
+	euo.mutation.ResetGoingCount()
+	euo.mutation.SetGoingCount(i)
+	return euo
+}
+
+// SetNillableGoingCount sets the "going_count" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableGoingCount(i *int) *EventUpdateOne {
+	if i != nil {
+		euo.SetGoingCount(*i)
+	}
+	return euo
+}
+
+// AddGoingCount adds i to the "going_count" field.
+func (euo *EventUpdateOne) AddGoingCount(i int) *EventUpdateOne {
+	euo.mutation.AddGoingCount(i)
+	return euo
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (euo *EventUpdateOne) SetRecurrence(s string) *EventUpdateOne {
+	euo.mutation.SetRecurrence(s)
+	return euo
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableRecurrence(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetRecurrence(*s)
+	}
+	return euo
+}
+
+// SetRecurrenceEndsAt sets the "recurrence_ends_at" field.
+func (euo *EventUpdateOne) SetRecurrenceEndsAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetRecurrenceEndsAt(t)
+	return euo
+}
+
+// SetNillableRecurrenceEndsAt sets the "recurrence_ends_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableRecurrenceEndsAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetRecurrenceEndsAt(*t)
+	}
+	return euo
+}
+
+// ClearRecurrenceEndsAt clears the value of the "recurrence_ends_at" field.
+func (euo *EventUpdateOne) ClearRecurrenceEndsAt() *EventUpdateOne {
+	euo.mutation.ClearRecurrenceEndsAt()
+	return euo
+}
+
+// SetSequence sets the "sequence" field.
+func (euo *EventUpdateOne) SetSequence(i int) *EventUpdateOne {
+	euo.mutation.ResetSequence()
+	euo.mutation.SetSequence(i)
+	return euo
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableSequence(i *int) *EventUpdateOne {
+	if i != nil {
+		euo.SetSequence(*i)
+	}
+	return euo
+}
+
+// AddSequence adds i to the "sequence" field.
+func (euo *EventUpdateOne) AddSequence(i int) *EventUpdateOne {
+	euo.mutation.AddSequence(i)
+	return euo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (euo *EventUpdateOne) SetCancelledAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetCancelledAt(t)
+	return euo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableCancelledAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetCancelledAt(*t)
+	}
+	return euo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (euo *EventUpdateOne) ClearCancelledAt() *EventUpdateOne {
+	euo.mutation.ClearCancelledAt()
+	return euo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (euo *EventUpdateOne) SetCreatedBy(s string) *EventUpdateOne {
+	euo.mutation.SetCreatedBy(s)
+	return euo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableCreatedBy(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetCreatedBy(*s)
+	}
+	return euo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (euo *EventUpdateOne) SetUpdatedAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetUpdatedAt(t)
+	return euo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (euo *EventUpdateOne) SetTeam(t *Team) *EventUpdateOne {
+	return euo.SetTeamID(t.ID)
+}
+
+// AddRsvpIDs adds the "rsvps" edge to the RSVP entity by IDs.
+func (euo *EventUpdateOne) AddRsvpIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.AddRsvpIDs(ids...)
+	return euo
+}
+
+// AddRsvps adds the "rsvps" edges to the RSVP entity.
+func (euo *EventUpdateOne) AddRsvps(r ...*RSVP) *EventUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddRsvpIDs(ids...)
+}
+
+// AddOverrideIDs adds the "overrides" edge to the EventOverride entity by IDs.
+func (euo *EventUpdateOne) AddOverrideIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.AddOverrideIDs(ids...)
+	return euo
+}
+
+// AddOverrides adds the "overrides" edges to the EventOverride entity.
+func (euo *EventUpdateOne) AddOverrides(e ...*EventOverride) *EventUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.AddOverrideIDs(ids...)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (euo *EventUpdateOne) Mutation() *EventMutation {
+	return euo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (euo *EventUpdateOne) ClearTeam() *EventUpdateOne {
+	euo.mutation.ClearTeam()
+	return euo
+}
+
+// ClearRsvps clears all "rsvps" edges to the RSVP entity.
+func (euo *EventUpdateOne) ClearRsvps() *EventUpdateOne {
+	euo.mutation.ClearRsvps()
+	return euo
+}
+
+// RemoveRsvpIDs removes the "rsvps" edge to RSVP entities by IDs.
+func (euo *EventUpdateOne) RemoveRsvpIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.RemoveRsvpIDs(ids...)
+	return euo
+}
+
+// RemoveRsvps removes "rsvps" edges to RSVP entities.
+func (euo *EventUpdateOne) RemoveRsvps(r ...*RSVP) *EventUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveRsvpIDs(ids...)
+}
+
+// ClearOverrides clears all "overrides" edges to the EventOverride entity.
+func (euo *EventUpdateOne) ClearOverrides() *EventUpdateOne {
+	euo.mutation.ClearOverrides()
+	return euo
+}
+
+// RemoveOverrideIDs removes the "overrides" edge to EventOverride entities by IDs.
+func (euo *EventUpdateOne) RemoveOverrideIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.RemoveOverrideIDs(ids...)
+	return euo
+}
+
+// RemoveOverrides removes "overrides" edges to EventOverride entities.
+func (euo *EventUpdateOne) RemoveOverrides(e ...*EventOverride) *EventUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.RemoveOverrideIDs(ids...)
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (euo *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Event entity.
+func (euo *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	euo.defaults()
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (euo *EventUpdateOne) defaults() {
+	if _, ok := euo.mutation.UpdatedAt(); !ok {
+		v := event.UpdateDefaultUpdatedAt()
+		euo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *EventUpdateOne) check() error {
+	if v, ok := euo.mutation.Title(); ok {
+		if err := event.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.CreatedBy(); ok {
+		if err := event.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Event.created_by": %w`, err)}
+		}
+	}
+	if euo.mutation.TeamCleared() && len(euo.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Event.team"`)
+	}
+	return nil
+}
+
+func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(event.Table, event.Columns, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Event.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for _, f := range fields {
+			if !event.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Title(); ok {
+		_spec.SetField(event.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Agenda(); ok {
+		_spec.SetField(event.FieldAgenda, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.StartsAt(); ok {
+		_spec.SetField(event.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := euo.mutation.EndsAt(); ok {
+		_spec.SetField(event.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := euo.mutation.Location(); ok {
+		_spec.SetField(event.FieldLocation, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.OnlineURL(); ok {
+		_spec.SetField(event.FieldOnlineURL, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Capacity(); ok {
+		_spec.SetField(event.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedCapacity(); ok {
+		_spec.AddField(event.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.GoingCount(); ok {
+		_spec.SetField(event.FieldGoingCount, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedGoingCount(); ok {
+		_spec.AddField(event.FieldGoingCount, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.Recurrence(); ok {
+		_spec.SetField(event.FieldRecurrence, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.RecurrenceEndsAt(); ok {
+		_spec.SetField(event.FieldRecurrenceEndsAt, field.TypeTime, value)
+	}
+	if euo.mutation.RecurrenceEndsAtCleared() {
+		_spec.ClearField(event.FieldRecurrenceEndsAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedSequence(); ok {
+		_spec.AddField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.CancelledAt(); ok {
+		_spec.SetField(event.FieldCancelledAt, field.TypeTime, value)
+	}
+	if euo.mutation.CancelledAtCleared() {
+		_spec.ClearField(event.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.CreatedBy(); ok {
+		_spec.SetField(event.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.UpdatedAt(); ok {
+		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if euo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.TeamTable,
+			Columns: []string{event.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.TeamTable,
+			Columns: []string{event.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedRsvpsIDs(); len(nodes) > 0 && !euo.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RsvpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RsvpsTable,
+			Columns: []string{event.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.OverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedOverridesIDs(); len(nodes) > 0 && !euo.mutation.OverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.OverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.OverridesTable,
+			Columns: []string{event.OverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Event{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
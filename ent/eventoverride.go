@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EventOverride is the model entity for the EventOverride schema.
+type EventOverride struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID int `json:"event_id,omitempty"`
+	// OriginalStartsAt holds the value of the "original_starts_at" field.
+	OriginalStartsAt time.Time `json:"original_starts_at,omitempty"`
+	// Cancelled holds the value of the "cancelled" field.
+	Cancelled bool `json:"cancelled,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
+	// Agenda holds the value of the "agenda" field.
+	Agenda *string `json:"agenda,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// Location holds the value of the "location" field.
+	Location *string `json:"location,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EventOverrideQuery when eager-loading is set.
+	Edges        EventOverrideEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EventOverrideEdges holds the relations/edges for other nodes in the graph.
+type EventOverrideEdges struct {
+	// Event holds the value of the event edge.
+	Event *Event `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventOverrideEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventOverride) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventoverride.FieldCancelled:
+			values[i] = new(sql.NullBool)
+		case eventoverride.FieldID, eventoverride.FieldEventID:
+			values[i] = new(sql.NullInt64)
+		case eventoverride.FieldTitle, eventoverride.FieldAgenda, eventoverride.FieldLocation:
+			values[i] = new(sql.NullString)
+		case eventoverride.FieldOriginalStartsAt, eventoverride.FieldStartsAt, eventoverride.FieldEndsAt, eventoverride.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventOverride fields.
+func (eo *EventOverride) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventoverride.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			eo.ID = int(value.Int64)
+		case eventoverride.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				eo.EventID = int(value.Int64)
+			}
+		case eventoverride.FieldOriginalStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field original_starts_at", values[i])
+			} else if value.Valid {
+				eo.OriginalStartsAt = value.Time
+			}
+		case eventoverride.FieldCancelled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled", values[i])
+			} else if value.Valid {
+				eo.Cancelled = value.Bool
+			}
+		case eventoverride.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				eo.Title = new(string)
+				*eo.Title = value.String
+			}
+		case eventoverride.FieldAgenda:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agenda", values[i])
+			} else if value.Valid {
+				eo.Agenda = new(string)
+				*eo.Agenda = value.String
+			}
+		case eventoverride.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				eo.StartsAt = new(time.Time)
+				*eo.StartsAt = value.Time
+			}
+		case eventoverride.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				eo.EndsAt = new(time.Time)
+				*eo.EndsAt = value.Time
+			}
+		case eventoverride.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				eo.Location = new(string)
+				*eo.Location = value.String
+			}
+		case eventoverride.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				eo.UpdatedAt = value.Time
+			}
+		default:
+			eo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventOverride.
+// This includes values selected through modifiers, order, etc.
+func (eo *EventOverride) Value(name string) (ent.Value, error) {
+	return eo.selectValues.Get(name)
+}
+
+// QueryEvent queries the "event" edge of the EventOverride entity.
+func (eo *EventOverride) QueryEvent() *EventQuery {
+	return NewEventOverrideClient(eo.config).QueryEvent(eo)
+}
+
+// Update returns a builder for updating this EventOverride.
+// Note that you need to call EventOverride.Unwrap() before calling this method if this EventOverride
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (eo *EventOverride) Update() *EventOverrideUpdateOne {
+	return NewEventOverrideClient(eo.config).UpdateOne(eo)
+}
+
+// Unwrap unwraps the EventOverride entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (eo *EventOverride) Unwrap() *EventOverride {
+	_tx, ok := eo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventOverride is not a transactional entity")
+	}
+	eo.config.driver = _tx.drv
+	return eo
+}
+
+// String implements the fmt.Stringer.
+func (eo *EventOverride) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventOverride(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", eo.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", eo.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("original_starts_at=")
+	builder.WriteString(eo.OriginalStartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cancelled=")
+	builder.WriteString(fmt.Sprintf("%v", eo.Cancelled))
+	builder.WriteString(", ")
+	if v := eo.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := eo.Agenda; v != nil {
+		builder.WriteString("agenda=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := eo.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := eo.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := eo.Location; v != nil {
+		builder.WriteString("location=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(eo.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventOverrides is a parsable slice of EventOverride.
+type EventOverrides []*EventOverride
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventoverride
+
+import (
+	"backend_golang/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldEventID, v))
+}
+
+// OriginalStartsAt applies equality check predicate on the "original_starts_at" field. It's identical to OriginalStartsAtEQ.
+func OriginalStartsAt(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldOriginalStartsAt, v))
+}
+
+// Cancelled applies equality check predicate on the "cancelled" field. It's identical to CancelledEQ.
+func Cancelled(v bool) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldCancelled, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldTitle, v))
+}
+
+// Agenda applies equality check predicate on the "agenda" field. It's identical to AgendaEQ.
+func Agenda(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldAgenda, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldLocation, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...int) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// OriginalStartsAtEQ applies the EQ predicate on the "original_starts_at" field.
+func OriginalStartsAtEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldOriginalStartsAt, v))
+}
+
+// OriginalStartsAtNEQ applies the NEQ predicate on the "original_starts_at" field.
+func OriginalStartsAtNEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldOriginalStartsAt, v))
+}
+
+// OriginalStartsAtIn applies the In predicate on the "original_starts_at" field.
+func OriginalStartsAtIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldOriginalStartsAt, vs...))
+}
+
+// OriginalStartsAtNotIn applies the NotIn predicate on the "original_starts_at" field.
+func OriginalStartsAtNotIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldOriginalStartsAt, vs...))
+}
+
+// OriginalStartsAtGT applies the GT predicate on the "original_starts_at" field.
+func OriginalStartsAtGT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldOriginalStartsAt, v))
+}
+
+// OriginalStartsAtGTE applies the GTE predicate on the "original_starts_at" field.
+func OriginalStartsAtGTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldOriginalStartsAt, v))
+}
+
+// OriginalStartsAtLT applies the LT predicate on the "original_starts_at" field.
+func OriginalStartsAtLT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldOriginalStartsAt, v))
+}
+
+// OriginalStartsAtLTE applies the LTE predicate on the "original_starts_at" field.
+func OriginalStartsAtLTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldOriginalStartsAt, v))
+}
+
+// CancelledEQ applies the EQ predicate on the "cancelled" field.
+func CancelledEQ(v bool) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldCancelled, v))
+}
+
+// CancelledNEQ applies the NEQ predicate on the "cancelled" field.
+func CancelledNEQ(v bool) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldCancelled, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AgendaEQ applies the EQ predicate on the "agenda" field.
+func AgendaEQ(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldAgenda, v))
+}
+
+// AgendaNEQ applies the NEQ predicate on the "agenda" field.
+func AgendaNEQ(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldAgenda, v))
+}
+
+// AgendaIn applies the In predicate on the "agenda" field.
+func AgendaIn(vs ...string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldAgenda, vs...))
+}
+
+// AgendaNotIn applies the NotIn predicate on the "agenda" field.
+func AgendaNotIn(vs ...string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldAgenda, vs...))
+}
+
+// AgendaGT applies the GT predicate on the "agenda" field.
+func AgendaGT(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldAgenda, v))
+}
+
+// AgendaGTE applies the GTE predicate on the "agenda" field.
+func AgendaGTE(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldAgenda, v))
+}
+
+// AgendaLT applies the LT predicate on the "agenda" field.
+func AgendaLT(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldAgenda, v))
+}
+
+// AgendaLTE applies the LTE predicate on the "agenda" field.
+func AgendaLTE(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldAgenda, v))
+}
+
+// AgendaContains applies the Contains predicate on the "agenda" field.
+func AgendaContains(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldContains(FieldAgenda, v))
+}
+
+// AgendaHasPrefix applies the HasPrefix predicate on the "agenda" field.
+func AgendaHasPrefix(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldHasPrefix(FieldAgenda, v))
+}
+
+// AgendaHasSuffix applies the HasSuffix predicate on the "agenda" field.
+func AgendaHasSuffix(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldHasSuffix(FieldAgenda, v))
+}
+
+// AgendaIsNil applies the IsNil predicate on the "agenda" field.
+func AgendaIsNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIsNull(FieldAgenda))
+}
+
+// AgendaNotNil applies the NotNil predicate on the "agenda" field.
+func AgendaNotNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotNull(FieldAgenda))
+}
+
+// AgendaEqualFold applies the EqualFold predicate on the "agenda" field.
+func AgendaEqualFold(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEqualFold(FieldAgenda, v))
+}
+
+// AgendaContainsFold applies the ContainsFold predicate on the "agenda" field.
+func AgendaContainsFold(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldContainsFold(FieldAgenda, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotNull(FieldStartsAt))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotNull(FieldEndsAt))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EventOverride {
+	return predicate.EventOverride(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasEvent applies the HasEdge predicate on the "event" edge.
+func HasEvent() predicate.EventOverride {
+	return predicate.EventOverride(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EventTable, EventColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventWith applies the HasEdge predicate on the "event" edge with a given conditions (other predicates).
+func HasEventWith(preds ...predicate.Event) predicate.EventOverride {
+	return predicate.EventOverride(func(s *sql.Selector) {
+		step := newEventStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventOverride) predicate.EventOverride {
+	return predicate.EventOverride(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventOverride) predicate.EventOverride {
+	return predicate.EventOverride(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventOverride) predicate.EventOverride {
+	return predicate.EventOverride(sql.NotPredicates(p))
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventOverrideCreate is the builder for creating a EventOverride entity.
+type EventOverrideCreate struct {
+	config
+	mutation *EventOverrideMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (eoc *EventOverrideCreate) SetEventID(i int) *EventOverrideCreate {
+	eoc.mutation.SetEventID(i)
+	return eoc
+}
+
+// SetOriginalStartsAt sets the "original_starts_at" field.
+func (eoc *EventOverrideCreate) SetOriginalStartsAt(t time.Time) *EventOverrideCreate {
+	eoc.mutation.SetOriginalStartsAt(t)
+	return eoc
+}
+
+// SetCancelled sets the "cancelled" field.
+func (eoc *EventOverrideCreate) SetCancelled(b bool) *EventOverrideCreate {
+	eoc.mutation.SetCancelled(b)
+	return eoc
+}
+
+// SetNillableCancelled sets the "cancelled" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableCancelled(b *bool) *EventOverrideCreate {
+	if b != nil {
+		eoc.SetCancelled(*b)
+	}
+	return eoc
+}
+
+// SetTitle sets the "title" field.
+func (eoc *EventOverrideCreate) SetTitle(s string) *EventOverrideCreate {
+	eoc.mutation.SetTitle(s)
+	return eoc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableTitle(s *string) *EventOverrideCreate {
+	if s != nil {
+		eoc.SetTitle(*s)
+	}
+	return eoc
+}
+
+// SetAgenda sets the "agenda" field.
+func (eoc *EventOverrideCreate) SetAgenda(s string) *EventOverrideCreate {
+	eoc.mutation.SetAgenda(s)
+	return eoc
+}
+
+// SetNillableAgenda sets the "agenda" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableAgenda(s *string) *EventOverrideCreate {
+	if s != nil {
+		eoc.SetAgenda(*s)
+	}
+	return eoc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (eoc *EventOverrideCreate) SetStartsAt(t time.Time) *EventOverrideCreate {
+	eoc.mutation.SetStartsAt(t)
+	return eoc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableStartsAt(t *time.Time) *EventOverrideCreate {
+	if t != nil {
+		eoc.SetStartsAt(*t)
+	}
+	return eoc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (eoc *EventOverrideCreate) SetEndsAt(t time.Time) *EventOverrideCreate {
+	eoc.mutation.SetEndsAt(t)
+	return eoc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableEndsAt(t *time.Time) *EventOverrideCreate {
+	if t != nil {
+		eoc.SetEndsAt(*t)
+	}
+	return eoc
+}
+
+// SetLocation sets the "location" field.
+func (eoc *EventOverrideCreate) SetLocation(s string) *EventOverrideCreate {
+	eoc.mutation.SetLocation(s)
+	return eoc
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableLocation(s *string) *EventOverrideCreate {
+	if s != nil {
+		eoc.SetLocation(*s)
+	}
+	return eoc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eoc *EventOverrideCreate) SetUpdatedAt(t time.Time) *EventOverrideCreate {
+	eoc.mutation.SetUpdatedAt(t)
+	return eoc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (eoc *EventOverrideCreate) SetNillableUpdatedAt(t *time.Time) *EventOverrideCreate {
+	if t != nil {
+		eoc.SetUpdatedAt(*t)
+	}
+	return eoc
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (eoc *EventOverrideCreate) SetEvent(e *Event) *EventOverrideCreate {
+	return eoc.SetEventID(e.ID)
+}
+
+// Mutation returns the EventOverrideMutation object of the builder.
+func (eoc *EventOverrideCreate) Mutation() *EventOverrideMutation {
+	return eoc.mutation
+}
+
+// Save creates the EventOverride in the database.
+func (eoc *EventOverrideCreate) Save(ctx context.Context) (*EventOverride, error) {
+	eoc.defaults()
+	return withHooks(ctx, eoc.sqlSave, eoc.mutation, eoc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eoc *EventOverrideCreate) SaveX(ctx context.Context) *EventOverride {
+	v, err := eoc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eoc *EventOverrideCreate) Exec(ctx context.Context) error {
+	_, err := eoc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eoc *EventOverrideCreate) ExecX(ctx context.Context) {
+	if err := eoc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eoc *EventOverrideCreate) defaults() {
+	if _, ok := eoc.mutation.Cancelled(); !ok {
+		v := eventoverride.DefaultCancelled
+		eoc.mutation.SetCancelled(v)
+	}
+	if _, ok := eoc.mutation.UpdatedAt(); !ok {
+		v := eventoverride.DefaultUpdatedAt()
+		eoc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eoc *EventOverrideCreate) check() error {
+	if _, ok := eoc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventOverride.event_id"`)}
+	}
+	if _, ok := eoc.mutation.OriginalStartsAt(); !ok {
+		return &ValidationError{Name: "original_starts_at", err: errors.New(`ent: missing required field "EventOverride.original_starts_at"`)}
+	}
+	if _, ok := eoc.mutation.Cancelled(); !ok {
+		return &ValidationError{Name: "cancelled", err: errors.New(`ent: missing required field "EventOverride.cancelled"`)}
+	}
+	if _, ok := eoc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventOverride.updated_at"`)}
+	}
+	if len(eoc.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "EventOverride.event"`)}
+	}
+	return nil
+}
+
+func (eoc *EventOverrideCreate) sqlSave(ctx context.Context) (*EventOverride, error) {
+	if err := eoc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eoc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eoc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	eoc.mutation.id = &_node.ID
+	eoc.mutation.done = true
+	return _node, nil
+}
+
+func (eoc *EventOverrideCreate) createSpec() (*EventOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventOverride{config: eoc.config}
+		_spec = sqlgraph.NewCreateSpec(eventoverride.Table, sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt))
+	)
+	if value, ok := eoc.mutation.OriginalStartsAt(); ok {
+		_spec.SetField(eventoverride.FieldOriginalStartsAt, field.TypeTime, value)
+		_node.OriginalStartsAt = value
+	}
+	if value, ok := eoc.mutation.Cancelled(); ok {
+		_spec.SetField(eventoverride.FieldCancelled, field.TypeBool, value)
+		_node.Cancelled = value
+	}
+	if value, ok := eoc.mutation.Title(); ok {
+		_spec.SetField(eventoverride.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := eoc.mutation.Agenda(); ok {
+		_spec.SetField(eventoverride.FieldAgenda, field.TypeString, value)
+		_node.Agenda = &value
+	}
+	if value, ok := eoc.mutation.StartsAt(); ok {
+		_spec.SetField(eventoverride.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := eoc.mutation.EndsAt(); ok {
+		_spec.SetField(eventoverride.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if value, ok := eoc.mutation.Location(); ok {
+		_spec.SetField(eventoverride.FieldLocation, field.TypeString, value)
+		_node.Location = &value
+	}
+	if value, ok := eoc.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventoverride.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := eoc.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoverride.EventTable,
+			Columns: []string{eventoverride.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EventID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventOverrideCreateBulk is the builder for creating many EventOverride entities in bulk.
+type EventOverrideCreateBulk struct {
+	config
+	err      error
+	builders []*EventOverrideCreate
+}
+
+// Save creates the EventOverride entities in the database.
+func (eocb *EventOverrideCreateBulk) Save(ctx context.Context) ([]*EventOverride, error) {
+	if eocb.err != nil {
+		return nil, eocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eocb.builders))
+	nodes := make([]*EventOverride, len(eocb.builders))
+	mutators := make([]Mutator, len(eocb.builders))
+	for i := range eocb.builders {
+		func(i int, root context.Context) {
+			builder := eocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eocb *EventOverrideCreateBulk) SaveX(ctx context.Context) []*EventOverride {
+	v, err := eocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eocb *EventOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := eocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eocb *EventOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := eocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventOverrideDelete is the builder for deleting a EventOverride entity.
+type EventOverrideDelete struct {
+	config
+	hooks    []Hook
+	mutation *EventOverrideMutation
+}
+
+// Where appends a list predicates to the EventOverrideDelete builder.
+func (eod *EventOverrideDelete) Where(ps ...predicate.EventOverride) *EventOverrideDelete {
+	eod.mutation.Where(ps...)
+	return eod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eod *EventOverrideDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eod.sqlExec, eod.mutation, eod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eod *EventOverrideDelete) ExecX(ctx context.Context) int {
+	n, err := eod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eod *EventOverrideDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(eventoverride.Table, sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt))
+	if ps := eod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eod.mutation.done = true
+	return affected, err
+}
+
+// EventOverrideDeleteOne is the builder for deleting a single EventOverride entity.
+type EventOverrideDeleteOne struct {
+	eod *EventOverrideDelete
+}
+
+// Where appends a list predicates to the EventOverrideDelete builder.
+func (eodo *EventOverrideDeleteOne) Where(ps ...predicate.EventOverride) *EventOverrideDeleteOne {
+	eodo.eod.mutation.Where(ps...)
+	return eodo
+}
+
+// Exec executes the deletion query.
+func (eodo *EventOverrideDeleteOne) Exec(ctx context.Context) error {
+	n, err := eodo.eod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{eventoverride.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eodo *EventOverrideDeleteOne) ExecX(ctx context.Context) {
+	if err := eodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventOverrideQuery is the builder for querying EventOverride entities.
+type EventOverrideQuery struct {
+	config
+	ctx        *QueryContext
+	order      []eventoverride.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EventOverride
+	withEvent  *EventQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EventOverrideQuery builder.
+func (eoq *EventOverrideQuery) Where(ps ...predicate.EventOverride) *EventOverrideQuery {
+	eoq.predicates = append(eoq.predicates, ps...)
+	return eoq
+}
+
+// Limit the number of records to be returned by this query.
+func (eoq *EventOverrideQuery) Limit(limit int) *EventOverrideQuery {
+	eoq.ctx.Limit = &limit
+	return eoq
+}
+
+// Offset to start from.
+func (eoq *EventOverrideQuery) Offset(offset int) *EventOverrideQuery {
+	eoq.ctx.Offset = &offset
+	return eoq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eoq *EventOverrideQuery) Unique(unique bool) *EventOverrideQuery {
+	eoq.ctx.Unique = &unique
+	return eoq
+}
+
+// Order specifies how the records should be ordered.
+func (eoq *EventOverrideQuery) Order(o ...eventoverride.OrderOption) *EventOverrideQuery {
+	eoq.order = append(eoq.order, o...)
+	return eoq
+}
+
+// QueryEvent chains the current query on the "event" edge.
+func (eoq *EventOverrideQuery) QueryEvent() *EventQuery {
+	query := (&EventClient{config: eoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(eventoverride.Table, eventoverride.FieldID, selector),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, eventoverride.EventTable, eventoverride.EventColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EventOverride entity from the query.
+// Returns a *NotFoundError when no EventOverride was found.
+func (eoq *EventOverrideQuery) First(ctx context.Context) (*EventOverride, error) {
+	nodes, err := eoq.Limit(1).All(setContextOp(ctx, eoq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{eventoverride.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eoq *EventOverrideQuery) FirstX(ctx context.Context) *EventOverride {
+	node, err := eoq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EventOverride ID from the query.
+// Returns a *NotFoundError when no EventOverride ID was found.
+func (eoq *EventOverrideQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eoq.Limit(1).IDs(setContextOp(ctx, eoq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{eventoverride.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eoq *EventOverrideQuery) FirstIDX(ctx context.Context) int {
+	id, err := eoq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EventOverride entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EventOverride entity is found.
+// Returns a *NotFoundError when no EventOverride entities are found.
+func (eoq *EventOverrideQuery) Only(ctx context.Context) (*EventOverride, error) {
+	nodes, err := eoq.Limit(2).All(setContextOp(ctx, eoq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{eventoverride.Label}
+	default:
+		return nil, &NotSingularError{eventoverride.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eoq *EventOverrideQuery) OnlyX(ctx context.Context) *EventOverride {
+	node, err := eoq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EventOverride ID in the query.
+// Returns a *NotSingularError when more than one EventOverride ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eoq *EventOverrideQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eoq.Limit(2).IDs(setContextOp(ctx, eoq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{eventoverride.Label}
+	default:
+		err = &NotSingularError{eventoverride.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eoq *EventOverrideQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eoq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EventOverrides.
+func (eoq *EventOverrideQuery) All(ctx context.Context) ([]*EventOverride, error) {
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryAll)
+	if err := eoq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EventOverride, *EventOverrideQuery]()
+	return withInterceptors[[]*EventOverride](ctx, eoq, qr, eoq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eoq *EventOverrideQuery) AllX(ctx context.Context) []*EventOverride {
+	nodes, err := eoq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EventOverride IDs.
+func (eoq *EventOverrideQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if eoq.ctx.Unique == nil && eoq.path != nil {
+		eoq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryIDs)
+	if err = eoq.Select(eventoverride.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eoq *EventOverrideQuery) IDsX(ctx context.Context) []int {
+	ids, err := eoq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eoq *EventOverrideQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryCount)
+	if err := eoq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eoq, querierCount[*EventOverrideQuery](), eoq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eoq *EventOverrideQuery) CountX(ctx context.Context) int {
+	count, err := eoq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eoq *EventOverrideQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryExist)
+	switch _, err := eoq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eoq *EventOverrideQuery) ExistX(ctx context.Context) bool {
+	exist, err := eoq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EventOverrideQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eoq *EventOverrideQuery) Clone() *EventOverrideQuery {
+	if eoq == nil {
+		return nil
+	}
+	return &EventOverrideQuery{
+		config:     eoq.config,
+		ctx:        eoq.ctx.Clone(),
+		order:      append([]eventoverride.OrderOption{}, eoq.order...),
+		inters:     append([]Interceptor{}, eoq.inters...),
+		predicates: append([]predicate.EventOverride{}, eoq.predicates...),
+		withEvent:  eoq.withEvent.Clone(),
+		// clone intermediate query.
+		sql:  eoq.sql.Clone(),
+		path: eoq.path,
+	}
+}
+
+// WithEvent tells the query-builder to eager-load the nodes that are connected to
+// the "event" edge. The optional arguments are used to configure the query builder of the edge.
+func (eoq *EventOverrideQuery) WithEvent(opts ...func(*EventQuery)) *EventOverrideQuery {
+	query := (&EventClient{config: eoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eoq.withEvent = query
+	return eoq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EventID int `json:"event_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EventOverride.Query().
+//		GroupBy(eventoverride.FieldEventID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eoq *EventOverrideQuery) GroupBy(field string, fields ...string) *EventOverrideGroupBy {
+	eoq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EventOverrideGroupBy{build: eoq}
+	grbuild.flds = &eoq.ctx.Fields
+	grbuild.label = eventoverride.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EventID int `json:"event_id,omitempty"`
+//	}
+//
+//	client.EventOverride.Query().
+//		Select(eventoverride.FieldEventID).
+//		Scan(ctx, &v)
+func (eoq *EventOverrideQuery) Select(fields ...string) *EventOverrideSelect {
+	eoq.ctx.Fields = append(eoq.ctx.Fields, fields...)
+	sbuild := &EventOverrideSelect{EventOverrideQuery: eoq}
+	sbuild.label = eventoverride.Label
+	sbuild.flds, sbuild.scan = &eoq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EventOverrideSelect configured with the given aggregations.
+func (eoq *EventOverrideQuery) Aggregate(fns ...AggregateFunc) *EventOverrideSelect {
+	return eoq.Select().Aggregate(fns...)
+}
+
+func (eoq *EventOverrideQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eoq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eoq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eoq.ctx.Fields {
+		if !eventoverride.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eoq.path != nil {
+		prev, err := eoq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eoq.sql = prev
+	}
+	return nil
+}
+
+func (eoq *EventOverrideQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EventOverride, error) {
+	var (
+		nodes       = []*EventOverride{}
+		_spec       = eoq.querySpec()
+		loadedTypes = [1]bool{
+			eoq.withEvent != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EventOverride).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EventOverride{config: eoq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(eoq.modifiers) > 0 {
+		_spec.Modifiers = eoq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eoq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eoq.withEvent; query != nil {
+		if err := eoq.loadEvent(ctx, query, nodes, nil,
+			func(n *EventOverride, e *Event) { n.Edges.Event = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eoq *EventOverrideQuery) loadEvent(ctx context.Context, query *EventQuery, nodes []*EventOverride, init func(*EventOverride), assign func(*EventOverride, *Event)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EventOverride)
+	for i := range nodes {
+		fk := nodes[i].EventID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(event.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "event_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (eoq *EventOverrideQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eoq.querySpec()
+	if len(eoq.modifiers) > 0 {
+		_spec.Modifiers = eoq.modifiers
+	}
+	_spec.Node.Columns = eoq.ctx.Fields
+	if len(eoq.ctx.Fields) > 0 {
+		_spec.Unique = eoq.ctx.Unique != nil && *eoq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eoq.driver, _spec)
+}
+
+func (eoq *EventOverrideQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(eventoverride.Table, eventoverride.Columns, sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt))
+	_spec.From = eoq.sql
+	if unique := eoq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eoq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eoq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventoverride.FieldID)
+		for i := range fields {
+			if fields[i] != eventoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if eoq.withEvent != nil {
+			_spec.Node.AddColumnOnce(eventoverride.FieldEventID)
+		}
+	}
+	if ps := eoq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eoq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eoq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eoq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eoq *EventOverrideQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eoq.driver.Dialect())
+	t1 := builder.Table(eventoverride.Table)
+	columns := eoq.ctx.Fields
+	if len(columns) == 0 {
+		columns = eventoverride.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eoq.sql != nil {
+		selector = eoq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eoq.ctx.Unique != nil && *eoq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range eoq.modifiers {
+		m(selector)
+	}
+	for _, p := range eoq.predicates {
+		p(selector)
+	}
+	for _, p := range eoq.order {
+		p(selector)
+	}
+	if offset := eoq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eoq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (eoq *EventOverrideQuery) ForUpdate(opts ...sql.LockOption) *EventOverrideQuery {
+	if eoq.driver.Dialect() == dialect.Postgres {
+		eoq.Unique(false)
+	}
+	eoq.modifiers = append(eoq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return eoq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (eoq *EventOverrideQuery) ForShare(opts ...sql.LockOption) *EventOverrideQuery {
+	if eoq.driver.Dialect() == dialect.Postgres {
+		eoq.Unique(false)
+	}
+	eoq.modifiers = append(eoq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return eoq
+}
+
+// EventOverrideGroupBy is the group-by builder for EventOverride entities.
+type EventOverrideGroupBy struct {
+	selector
+	build *EventOverrideQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eogb *EventOverrideGroupBy) Aggregate(fns ...AggregateFunc) *EventOverrideGroupBy {
+	eogb.fns = append(eogb.fns, fns...)
+	return eogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eogb *EventOverrideGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eogb.build.ctx, ent.OpQueryGroupBy)
+	if err := eogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EventOverrideQuery, *EventOverrideGroupBy](ctx, eogb.build, eogb, eogb.build.inters, v)
+}
+
+func (eogb *EventOverrideGroupBy) sqlScan(ctx context.Context, root *EventOverrideQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eogb.fns))
+	for _, fn := range eogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eogb.flds)+len(eogb.fns))
+		for _, f := range *eogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EventOverrideSelect is the builder for selecting fields of EventOverride entities.
+type EventOverrideSelect struct {
+	*EventOverrideQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eos *EventOverrideSelect) Aggregate(fns ...AggregateFunc) *EventOverrideSelect {
+	eos.fns = append(eos.fns, fns...)
+	return eos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eos *EventOverrideSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eos.ctx, ent.OpQuerySelect)
+	if err := eos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EventOverrideQuery, *EventOverrideSelect](ctx, eos.EventOverrideQuery, eos, eos.inters, v)
+}
+
+func (eos *EventOverrideSelect) sqlScan(ctx context.Context, root *EventOverrideQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eos.fns))
+	for _, fn := range eos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
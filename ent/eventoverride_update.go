@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventOverrideUpdate is the builder for updating EventOverride entities.
+type EventOverrideUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventOverrideMutation
+}
+
+// Where appends a list predicates to the EventOverrideUpdate builder.
+func (eou *EventOverrideUpdate) Where(ps ...predicate.EventOverride) *EventOverrideUpdate {
+	eou.mutation.Where(ps...)
+	return eou
+}
+
+// SetEventID sets the "event_id" field.
+func (eou *EventOverrideUpdate) SetEventID(i int) *EventOverrideUpdate {
+	eou.mutation.SetEventID(i)
+	return eou
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableEventID(i *int) *EventOverrideUpdate {
+	if i != nil {
+		eou.SetEventID(*i)
+	}
+	return eou
+}
+
+// SetOriginalStartsAt sets the "original_starts_at" field.
+func (eou *EventOverrideUpdate) SetOriginalStartsAt(t time.Time) *EventOverrideUpdate {
+	eou.mutation.SetOriginalStartsAt(t)
+	return eou
+}
+
+// SetNillableOriginalStartsAt sets the "original_starts_at" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableOriginalStartsAt(t *time.Time) *EventOverrideUpdate {
+	if t != nil {
+		eou.SetOriginalStartsAt(*t)
+	}
+	return eou
+}
+
+// SetCancelled sets the "cancelled" field.
+func (eou *EventOverrideUpdate) SetCancelled(b bool) *EventOverrideUpdate {
+	eou.mutation.SetCancelled(b)
+	return eou
+}
+
+// SetNillableCancelled sets the "cancelled" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableCancelled(b *bool) *EventOverrideUpdate {
+	if b != nil {
+		eou.SetCancelled(*b)
+	}
+	return eou
+}
+
+// SetTitle sets the "title" field.
+func (eou *EventOverrideUpdate) SetTitle(s string) *EventOverrideUpdate {
+	eou.mutation.SetTitle(s)
+	return eou
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableTitle(s *string) *EventOverrideUpdate {
+	if s != nil {
+		eou.SetTitle(*s)
+	}
+	return eou
+}
+
+// ClearTitle clears the value of the "title" field.
+func (eou *EventOverrideUpdate) ClearTitle() *EventOverrideUpdate {
+	eou.mutation.ClearTitle()
+	return eou
+}
+
+// SetAgenda sets the "agenda" field.
+func (eou *EventOverrideUpdate) SetAgenda(s string) *EventOverrideUpdate {
+	eou.mutation.SetAgenda(s)
+	return eou
+}
+
+// SetNillableAgenda sets the "agenda" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableAgenda(s *string) *EventOverrideUpdate {
+	if s != nil {
+		eou.SetAgenda(*s)
+	}
+	return eou
+}
+
+// ClearAgenda clears the value of the "agenda" field.
+func (eou *EventOverrideUpdate) ClearAgenda() *EventOverrideUpdate {
+	eou.mutation.ClearAgenda()
+	return eou
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (eou *EventOverrideUpdate) SetStartsAt(t time.Time) *EventOverrideUpdate {
+	eou.mutation.SetStartsAt(t)
+	return eou
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableStartsAt(t *time.Time) *EventOverrideUpdate {
+	if t != nil {
+		eou.SetStartsAt(*t)
+	}
+	return eou
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (eou *EventOverrideUpdate) ClearStartsAt() *EventOverrideUpdate {
+	eou.mutation.ClearStartsAt()
+	return eou
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (eou *EventOverrideUpdate) SetEndsAt(t time.Time) *EventOverrideUpdate {
+	eou.mutation.SetEndsAt(t)
+	return eou
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableEndsAt(t *time.Time) *EventOverrideUpdate {
+	if t != nil {
+		eou.SetEndsAt(*t)
+	}
+	return eou
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (eou *EventOverrideUpdate) ClearEndsAt() *EventOverrideUpdate {
+	eou.mutation.ClearEndsAt()
+	return eou
+}
+
+// SetLocation sets the "location" field.
+func (eou *EventOverrideUpdate) SetLocation(s string) *EventOverrideUpdate {
+	eou.mutation.SetLocation(s)
+	return eou
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (eou *EventOverrideUpdate) SetNillableLocation(s *string) *EventOverrideUpdate {
+	if s != nil {
+		eou.SetLocation(*s)
+	}
+	return eou
+}
+
+// ClearLocation clears the value of the "location" field.
+func (eou *EventOverrideUpdate) ClearLocation() *EventOverrideUpdate {
+	eou.mutation.ClearLocation()
+	return eou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eou *EventOverrideUpdate) SetUpdatedAt(t time.Time) *EventOverrideUpdate {
+	eou.mutation.SetUpdatedAt(t)
+	return eou
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (eou *EventOverrideUpdate) SetEvent(e *Event) *EventOverrideUpdate {
+	return eou.SetEventID(e.ID)
+}
+
+// Mutation returns the EventOverrideMutation object of the builder.
+func (eou *EventOverrideUpdate) Mutation() *EventOverrideMutation {
+	return eou.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (eou *EventOverrideUpdate) ClearEvent() *EventOverrideUpdate {
+	eou.mutation.ClearEvent()
+	return eou
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eou *EventOverrideUpdate) Save(ctx context.Context) (int, error) {
+	eou.defaults()
+	return withHooks(ctx, eou.sqlSave, eou.mutation, eou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eou *EventOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := eou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eou *EventOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := eou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eou *EventOverrideUpdate) ExecX(ctx context.Context) {
+	if err := eou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eou *EventOverrideUpdate) defaults() {
+	if _, ok := eou.mutation.UpdatedAt(); !ok {
+		v := eventoverride.UpdateDefaultUpdatedAt()
+		eou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eou *EventOverrideUpdate) check() error {
+	if eou.mutation.EventCleared() && len(eou.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EventOverride.event"`)
+	}
+	return nil
+}
+
+func (eou *EventOverrideUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventoverride.Table, eventoverride.Columns, sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt))
+	if ps := eou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eou.mutation.OriginalStartsAt(); ok {
+		_spec.SetField(eventoverride.FieldOriginalStartsAt, field.TypeTime, value)
+	}
+	if value, ok := eou.mutation.Cancelled(); ok {
+		_spec.SetField(eventoverride.FieldCancelled, field.TypeBool, value)
+	}
+	if value, ok := eou.mutation.Title(); ok {
+		_spec.SetField(eventoverride.FieldTitle, field.TypeString, value)
+	}
+	if eou.mutation.TitleCleared() {
+		_spec.ClearField(eventoverride.FieldTitle, field.TypeString)
+	}
+	if value, ok := eou.mutation.Agenda(); ok {
+		_spec.SetField(eventoverride.FieldAgenda, field.TypeString, value)
+	}
+	if eou.mutation.AgendaCleared() {
+		_spec.ClearField(eventoverride.FieldAgenda, field.TypeString)
+	}
+	if value, ok := eou.mutation.StartsAt(); ok {
+		_spec.SetField(eventoverride.FieldStartsAt, field.TypeTime, value)
+	}
+	if eou.mutation.StartsAtCleared() {
+		_spec.ClearField(eventoverride.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := eou.mutation.EndsAt(); ok {
+		_spec.SetField(eventoverride.FieldEndsAt, field.TypeTime, value)
+	}
+	if eou.mutation.EndsAtCleared() {
+		_spec.ClearField(eventoverride.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := eou.mutation.Location(); ok {
+		_spec.SetField(eventoverride.FieldLocation, field.TypeString, value)
+	}
+	if eou.mutation.LocationCleared() {
+		_spec.ClearField(eventoverride.FieldLocation, field.TypeString)
+	}
+	if value, ok := eou.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventoverride.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eou.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoverride.EventTable,
+			Columns: []string{eventoverride.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eou.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoverride.EventTable,
+			Columns: []string{eventoverride.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eou.mutation.done = true
+	return n, nil
+}
+
+// EventOverrideUpdateOne is the builder for updating a single EventOverride entity.
+type EventOverrideUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventOverrideMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (eouo *EventOverrideUpdateOne) SetEventID(i int) *EventOverrideUpdateOne {
+	eouo.mutation.SetEventID(i)
+	return eouo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableEventID(i *int) *EventOverrideUpdateOne {
+	if i != nil {
+		eouo.SetEventID(*i)
+	}
+	return eouo
+}
+
+// SetOriginalStartsAt sets the "original_starts_at" field.
+func (eouo *EventOverrideUpdateOne) SetOriginalStartsAt(t time.Time) *EventOverrideUpdateOne {
+	eouo.mutation.SetOriginalStartsAt(t)
+	return eouo
+}
+
+// SetNillableOriginalStartsAt sets the "original_starts_at" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableOriginalStartsAt(t *time.Time) *EventOverrideUpdateOne {
+	if t != nil {
+		eouo.SetOriginalStartsAt(*t)
+	}
+	return eouo
+}
+
+// SetCancelled sets the "cancelled" field.
+func (eouo *EventOverrideUpdateOne) SetCancelled(b bool) *EventOverrideUpdateOne {
+	eouo.mutation.SetCancelled(b)
+	return eouo
+}
+
+// SetNillableCancelled sets the "cancelled" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableCancelled(b *bool) *EventOverrideUpdateOne {
+	if b != nil {
+		eouo.SetCancelled(*b)
+	}
+	return eouo
+}
+
+// SetTitle sets the "title" field.
+func (eouo *EventOverrideUpdateOne) SetTitle(s string) *EventOverrideUpdateOne {
+	eouo.mutation.SetTitle(s)
+	return eouo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableTitle(s *string) *EventOverrideUpdateOne {
+	if s != nil {
+		eouo.SetTitle(*s)
+	}
+	return eouo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (eouo *EventOverrideUpdateOne) ClearTitle() *EventOverrideUpdateOne {
+	eouo.mutation.ClearTitle()
+	return eouo
+}
+
+// SetAgenda sets the "agenda" field.
+func (eouo *EventOverrideUpdateOne) SetAgenda(s string) *EventOverrideUpdateOne {
+	eouo.mutation.SetAgenda(s)
+	return eouo
+}
+
+// SetNillableAgenda sets the "agenda" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableAgenda(s *string) *EventOverrideUpdateOne {
+	if s != nil {
+		eouo.SetAgenda(*s)
+	}
+	return eouo
+}
+
+// ClearAgenda clears the value of the "agenda" field.
+func (eouo *EventOverrideUpdateOne) ClearAgenda() *EventOverrideUpdateOne {
+	eouo.mutation.ClearAgenda()
+	return eouo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (eouo *EventOverrideUpdateOne) SetStartsAt(t time.Time) *EventOverrideUpdateOne {
+	eouo.mutation.SetStartsAt(t)
+	return eouo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableStartsAt(t *time.Time) *EventOverrideUpdateOne {
+	if t != nil {
+		eouo.SetStartsAt(*t)
+	}
+	return eouo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (eouo *EventOverrideUpdateOne) ClearStartsAt() *EventOverrideUpdateOne {
+	eouo.mutation.ClearStartsAt()
+	return eouo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (eouo *EventOverrideUpdateOne) SetEndsAt(t time.Time) *EventOverrideUpdateOne {
+	eouo.mutation.SetEndsAt(t)
+	return eouo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableEndsAt(t *time.Time) *EventOverrideUpdateOne {
+	if t != nil {
+		eouo.SetEndsAt(*t)
+	}
+	return eouo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (eouo *EventOverrideUpdateOne) ClearEndsAt() *EventOverrideUpdateOne {
+	eouo.mutation.ClearEndsAt()
+	return eouo
+}
+
+// SetLocation sets the "location" field.
+func (eouo *EventOverrideUpdateOne) SetLocation(s string) *EventOverrideUpdateOne {
+	eouo.mutation.SetLocation(s)
+	return eouo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (eouo *EventOverrideUpdateOne) SetNillableLocation(s *string) *EventOverrideUpdateOne {
+	if s != nil {
+		eouo.SetLocation(*s)
+	}
+	return eouo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (eouo *EventOverrideUpdateOne) ClearLocation() *EventOverrideUpdateOne {
+	eouo.mutation.ClearLocation()
+	return eouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eouo *EventOverrideUpdateOne) SetUpdatedAt(t time.Time) *EventOverrideUpdateOne {
+	eouo.mutation.SetUpdatedAt(t)
+	return eouo
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (eouo *EventOverrideUpdateOne) SetEvent(e *Event) *EventOverrideUpdateOne {
+	return eouo.SetEventID(e.ID)
+}
+
+// Mutation returns the EventOverrideMutation object of the builder.
+func (eouo *EventOverrideUpdateOne) Mutation() *EventOverrideMutation {
+	return eouo.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (eouo *EventOverrideUpdateOne) ClearEvent() *EventOverrideUpdateOne {
+	eouo.mutation.ClearEvent()
+	return eouo
+}
+
+// Where appends a list predicates to the EventOverrideUpdate builder.
+func (eouo *EventOverrideUpdateOne) Where(ps ...predicate.EventOverride) *EventOverrideUpdateOne {
+	eouo.mutation.Where(ps...)
+	return eouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eouo *EventOverrideUpdateOne) Select(field string, fields ...string) *EventOverrideUpdateOne {
+	eouo.fields = append([]string{field}, fields...)
+	return eouo
+}
+
+// Save executes the query and returns the updated EventOverride entity.
+func (eouo *EventOverrideUpdateOne) Save(ctx context.Context) (*EventOverride, error) {
+	eouo.defaults()
+	return withHooks(ctx, eouo.sqlSave, eouo.mutation, eouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eouo *EventOverrideUpdateOne) SaveX(ctx context.Context) *EventOverride {
+	node, err := eouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eouo *EventOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := eouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eouo *EventOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := eouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eouo *EventOverrideUpdateOne) defaults() {
+	if _, ok := eouo.mutation.UpdatedAt(); !ok {
+		v := eventoverride.UpdateDefaultUpdatedAt()
+		eouo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eouo *EventOverrideUpdateOne) check() error {
+	if eouo.mutation.EventCleared() && len(eouo.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EventOverride.event"`)
+	}
+	return nil
+}
+
+func (eouo *EventOverrideUpdateOne) sqlSave(ctx context.Context) (_node *EventOverride, err error) {
+	if err := eouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventoverride.Table, eventoverride.Columns, sqlgraph.NewFieldSpec(eventoverride.FieldID, field.TypeInt))
+	id, ok := eouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventoverride.FieldID)
+		for _, f := range fields {
+			if !eventoverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eouo.mutation.OriginalStartsAt(); ok {
+		_spec.SetField(eventoverride.FieldOriginalStartsAt, field.TypeTime, value)
+	}
+	if value, ok := eouo.mutation.Cancelled(); ok {
+		_spec.SetField(eventoverride.FieldCancelled, field.TypeBool, value)
+	}
+	if value, ok := eouo.mutation.Title(); ok {
+		_spec.SetField(eventoverride.FieldTitle, field.TypeString, value)
+	}
+	if eouo.mutation.TitleCleared() {
+		_spec.ClearField(eventoverride.FieldTitle, field.TypeString)
+	}
+	if value, ok := eouo.mutation.Agenda(); ok {
+		_spec.SetField(eventoverride.FieldAgenda, field.TypeString, value)
+	}
+	if eouo.mutation.AgendaCleared() {
+		_spec.ClearField(eventoverride.FieldAgenda, field.TypeString)
+	}
+	if value, ok := eouo.mutation.StartsAt(); ok {
+		_spec.SetField(eventoverride.FieldStartsAt, field.TypeTime, value)
+	}
+	if eouo.mutation.StartsAtCleared() {
+		_spec.ClearField(eventoverride.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := eouo.mutation.EndsAt(); ok {
+		_spec.SetField(eventoverride.FieldEndsAt, field.TypeTime, value)
+	}
+	if eouo.mutation.EndsAtCleared() {
+		_spec.ClearField(eventoverride.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := eouo.mutation.Location(); ok {
+		_spec.SetField(eventoverride.FieldLocation, field.TypeString, value)
+	}
+	if eouo.mutation.LocationCleared() {
+		_spec.ClearField(eventoverride.FieldLocation, field.TypeString)
+	}
+	if value, ok := eouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventoverride.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eouo.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoverride.EventTable,
+			Columns: []string{eventoverride.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eouo.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventoverride.EventTable,
+			Columns: []string{eventoverride.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EventOverride{config: eouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eouo.mutation.done = true
+	return _node, nil
+}
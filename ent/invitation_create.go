@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/invitation"
+	"backend_golang/ent/member"
+	"backend_golang/ent/team"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvitationCreate is the builder for creating a Invitation entity.
+type InvitationCreate struct {
+	config
+	mutation *InvitationMutation
+	hooks    []Hook
+}
+
+// SetTeamID sets the "team_id" field.
+func (ic *InvitationCreate) SetTeamID(i int) *InvitationCreate {
+	ic.mutation.SetTeamID(i)
+	return ic
+}
+
+// SetKind sets the "kind" field.
+func (ic *InvitationCreate) SetKind(mk models.InvitationKind) *InvitationCreate {
+	ic.mutation.SetKind(mk)
+	return ic
+}
+
+// SetRole sets the "role" field.
+func (ic *InvitationCreate) SetRole(m models.Role) *InvitationCreate {
+	ic.mutation.SetRole(m)
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvitationCreate) SetStatus(ms models.InvitationStatus) *InvitationCreate {
+	ic.mutation.SetStatus(ms)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableStatus(ms *models.InvitationStatus) *InvitationCreate {
+	if ms != nil {
+		ic.SetStatus(*ms)
+	}
+	return ic
+}
+
+// SetToken sets the "token" field.
+func (ic *InvitationCreate) SetToken(s string) *InvitationCreate {
+	ic.mutation.SetToken(s)
+	return ic
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableToken(s *string) *InvitationCreate {
+	if s != nil {
+		ic.SetToken(*s)
+	}
+	return ic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ic *InvitationCreate) SetMaxUses(i int) *InvitationCreate {
+	ic.mutation.SetMaxUses(i)
+	return ic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableMaxUses(i *int) *InvitationCreate {
+	if i != nil {
+		ic.SetMaxUses(*i)
+	}
+	return ic
+}
+
+// SetUses sets the "uses" field.
+func (ic *InvitationCreate) SetUses(i int) *InvitationCreate {
+	ic.mutation.SetUses(i)
+	return ic
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableUses(i *int) *InvitationCreate {
+	if i != nil {
+		ic.SetUses(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InvitationCreate) SetExpiresAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableExpiresAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetExpiresAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ic *InvitationCreate) SetCreatedBy(s string) *InvitationCreate {
+	ic.mutation.SetCreatedBy(s)
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InvitationCreate) SetCreatedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableCreatedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (ic *InvitationCreate) SetTeam(t *Team) *InvitationCreate {
+	return ic.SetTeamID(t.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the Member entity by ID.
+func (ic *InvitationCreate) SetInviteeID(id int) *InvitationCreate {
+	ic.mutation.SetInviteeID(id)
+	return ic
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the Member entity by ID if the given value is not nil.
+func (ic *InvitationCreate) SetNillableInviteeID(id *int) *InvitationCreate {
+	if id != nil {
+		ic = ic.SetInviteeID(*id)
+	}
+	return ic
+}
+
+// SetInvitee sets the "invitee" edge to the Member entity.
+func (ic *InvitationCreate) SetInvitee(m *Member) *InvitationCreate {
+	return ic.SetInviteeID(m.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (ic *InvitationCreate) Mutation() *InvitationMutation {
+	return ic.mutation
+}
+
+// Save creates the Invitation in the database.
+func (ic *InvitationCreate) Save(ctx context.Context) (*Invitation, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvitationCreate) SaveX(ctx context.Context) *Invitation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvitationCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvitationCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvitationCreate) defaults() {
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invitation.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		v := invitation.DefaultMaxUses
+		ic.mutation.SetMaxUses(v)
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		v := invitation.DefaultUses
+		ic.mutation.SetUses(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invitation.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvitationCreate) check() error {
+	if _, ok := ic.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "Invitation.team_id"`)}
+	}
+	if _, ok := ic.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Invitation.kind"`)}
+	}
+	if v, ok := ic.mutation.Kind(); ok {
+		if err := invitation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invitation.kind": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Invitation.role"`)}
+	}
+	if v, ok := ic.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invitation.role": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invitation.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invitation.status": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "Invitation.max_uses"`)}
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "Invitation.uses"`)}
+	}
+	if _, ok := ic.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Invitation.created_by"`)}
+	}
+	if v, ok := ic.mutation.CreatedBy(); ok {
+		if err := invitation.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Invitation.created_by": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invitation.created_at"`)}
+	}
+	if len(ic.mutation.TeamIDs()) == 0 {
+		return &ValidationError{Name: "team", err: errors.New(`ent: missing required edge "Invitation.team"`)}
+	}
+	return nil
+}
+
+func (ic *InvitationCreate) sqlSave(ctx context.Context) (*Invitation, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invitation{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invitation.Table, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.Kind(); ok {
+		_spec.SetField(invitation.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := ic.mutation.Role(); ok {
+		_spec.SetField(invitation.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(invitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.Token(); ok {
+		_spec.SetField(invitation.FieldToken, field.TypeString, value)
+		_node.Token = &value
+	}
+	if value, ok := ic.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := ic.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := ic.mutation.CreatedBy(); ok {
+		_spec.SetField(invitation.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.TeamTable,
+			Columns: []string{invitation.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.member_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InvitationCreateBulk is the builder for creating many Invitation entities in bulk.
+type InvitationCreateBulk struct {
+	config
+	err      error
+	builders []*InvitationCreate
+}
+
+// Save creates the Invitation entities in the database.
+func (icb *InvitationCreateBulk) Save(ctx context.Context) ([]*Invitation, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invitation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvitationCreateBulk) SaveX(ctx context.Context) []*Invitation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
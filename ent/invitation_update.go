@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/invitation"
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/team"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetTeamID sets the "team_id" field.
+func (iu *InvitationUpdate) SetTeamID(i int) *InvitationUpdate {
+	iu.mutation.SetTeamID(i)
+	return iu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableTeamID(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetTeamID(*i)
+	}
+	return iu
+}
+
+// SetKind sets the "kind" field.
+func (iu *InvitationUpdate) SetKind(mk models.InvitationKind) *InvitationUpdate {
+	iu.mutation.SetKind(mk)
+	return iu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableKind(mk *models.InvitationKind) *InvitationUpdate {
+	if mk != nil {
+		iu.SetKind(*mk)
+	}
+	return iu
+}
+
+// SetRole sets the "role" field.
+func (iu *InvitationUpdate) SetRole(m models.Role) *InvitationUpdate {
+	iu.mutation.SetRole(m)
+	return iu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRole(m *models.Role) *InvitationUpdate {
+	if m != nil {
+		iu.SetRole(*m)
+	}
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvitationUpdate) SetStatus(ms models.InvitationStatus) *InvitationUpdate {
+	iu.mutation.SetStatus(ms)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableStatus(ms *models.InvitationStatus) *InvitationUpdate {
+	if ms != nil {
+		iu.SetStatus(*ms)
+	}
+	return iu
+}
+
+// SetToken sets the "token" field.
+func (iu *InvitationUpdate) SetToken(s string) *InvitationUpdate {
+	iu.mutation.SetToken(s)
+	return iu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableToken(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetToken(*s)
+	}
+	return iu
+}
+
+// ClearToken clears the value of the "token" field.
+func (iu *InvitationUpdate) ClearToken() *InvitationUpdate {
+	iu.mutation.ClearToken()
+	return iu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iu *InvitationUpdate) SetMaxUses(i int) *InvitationUpdate {
+	iu.mutation.ResetMaxUses()
+	iu.mutation.SetMaxUses(i)
+	return iu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableMaxUses(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetMaxUses(*i)
+	}
+	return iu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iu *InvitationUpdate) AddMaxUses(i int) *InvitationUpdate {
+	iu.mutation.AddMaxUses(i)
+	return iu
+}
+
+// SetUses sets the "uses" field.
+func (iu *InvitationUpdate) SetUses(i int) *InvitationUpdate {
+	iu.mutation.ResetUses()
+	iu.mutation.SetUses(i)
+	return iu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUses(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetUses(*i)
+	}
+	return iu
+}
+
+// AddUses adds i to the "uses" field.
+func (iu *InvitationUpdate) AddUses(i int) *InvitationUpdate {
+	iu.mutation.AddUses(i)
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableExpiresAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iu *InvitationUpdate) ClearExpiresAt() *InvitationUpdate {
+	iu.mutation.ClearExpiresAt()
+	return iu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iu *InvitationUpdate) SetCreatedBy(s string) *InvitationUpdate {
+	iu.mutation.SetCreatedBy(s)
+	return iu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCreatedBy(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetCreatedBy(*s)
+	}
+	return iu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (iu *InvitationUpdate) SetTeam(t *Team) *InvitationUpdate {
+	return iu.SetTeamID(t.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the Member entity by ID.
+func (iu *InvitationUpdate) SetInviteeID(id int) *InvitationUpdate {
+	iu.mutation.SetInviteeID(id)
+	return iu
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the Member entity by ID if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableInviteeID(id *int) *InvitationUpdate {
+	if id != nil {
+		iu = iu.SetInviteeID(*id)
+	}
+	return iu
+}
+
+// SetInvitee sets the "invitee" edge to the Member entity.
+func (iu *InvitationUpdate) SetInvitee(m *Member) *InvitationUpdate {
+	return iu.SetInviteeID(m.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (iu *InvitationUpdate) ClearTeam() *InvitationUpdate {
+	iu.mutation.ClearTeam()
+	return iu
+}
+
+// ClearInvitee clears the "invitee" edge to the Member entity.
+func (iu *InvitationUpdate) ClearInvitee() *InvitationUpdate {
+	iu.mutation.ClearInvitee()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationUpdate) check() error {
+	if v, ok := iu.mutation.Kind(); ok {
+		if err := invitation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invitation.kind": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invitation.role": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invitation.status": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.CreatedBy(); ok {
+		if err := invitation.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Invitation.created_by": %w`, err)}
+		}
+	}
+	if iu.mutation.TeamCleared() && len(iu.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invitation.team"`)
+	}
+	return nil
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Kind(); ok {
+		_spec.SetField(invitation.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.Role(); ok {
+		_spec.SetField(invitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.Token(); ok {
+		_spec.SetField(invitation.FieldToken, field.TypeString, value)
+	}
+	if iu.mutation.TokenCleared() {
+		_spec.ClearField(invitation.FieldToken, field.TypeString)
+	}
+	if value, ok := iu.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.CreatedBy(); ok {
+		_spec.SetField(invitation.FieldCreatedBy, field.TypeString, value)
+	}
+	if iu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.TeamTable,
+			Columns: []string{invitation.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.TeamTable,
+			Columns: []string{invitation.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (iuo *InvitationUpdateOne) SetTeamID(i int) *InvitationUpdateOne {
+	iuo.mutation.SetTeamID(i)
+	return iuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableTeamID(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetTeamID(*i)
+	}
+	return iuo
+}
+
+// SetKind sets the "kind" field.
+func (iuo *InvitationUpdateOne) SetKind(mk models.InvitationKind) *InvitationUpdateOne {
+	iuo.mutation.SetKind(mk)
+	return iuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableKind(mk *models.InvitationKind) *InvitationUpdateOne {
+	if mk != nil {
+		iuo.SetKind(*mk)
+	}
+	return iuo
+}
+
+// SetRole sets the "role" field.
+func (iuo *InvitationUpdateOne) SetRole(m models.Role) *InvitationUpdateOne {
+	iuo.mutation.SetRole(m)
+	return iuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRole(m *models.Role) *InvitationUpdateOne {
+	if m != nil {
+		iuo.SetRole(*m)
+	}
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvitationUpdateOne) SetStatus(ms models.InvitationStatus) *InvitationUpdateOne {
+	iuo.mutation.SetStatus(ms)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableStatus(ms *models.InvitationStatus) *InvitationUpdateOne {
+	if ms != nil {
+		iuo.SetStatus(*ms)
+	}
+	return iuo
+}
+
+// SetToken sets the "token" field.
+func (iuo *InvitationUpdateOne) SetToken(s string) *InvitationUpdateOne {
+	iuo.mutation.SetToken(s)
+	return iuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableToken(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetToken(*s)
+	}
+	return iuo
+}
+
+// ClearToken clears the value of the "token" field.
+func (iuo *InvitationUpdateOne) ClearToken() *InvitationUpdateOne {
+	iuo.mutation.ClearToken()
+	return iuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iuo *InvitationUpdateOne) SetMaxUses(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetMaxUses()
+	iuo.mutation.SetMaxUses(i)
+	return iuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableMaxUses(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetMaxUses(*i)
+	}
+	return iuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iuo *InvitationUpdateOne) AddMaxUses(i int) *InvitationUpdateOne {
+	iuo.mutation.AddMaxUses(i)
+	return iuo
+}
+
+// SetUses sets the "uses" field.
+func (iuo *InvitationUpdateOne) SetUses(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetUses()
+	iuo.mutation.SetUses(i)
+	return iuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUses(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetUses(*i)
+	}
+	return iuo
+}
+
+// AddUses adds i to the "uses" field.
+func (iuo *InvitationUpdateOne) AddUses(i int) *InvitationUpdateOne {
+	iuo.mutation.AddUses(i)
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iuo *InvitationUpdateOne) ClearExpiresAt() *InvitationUpdateOne {
+	iuo.mutation.ClearExpiresAt()
+	return iuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iuo *InvitationUpdateOne) SetCreatedBy(s string) *InvitationUpdateOne {
+	iuo.mutation.SetCreatedBy(s)
+	return iuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCreatedBy(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetCreatedBy(*s)
+	}
+	return iuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (iuo *InvitationUpdateOne) SetTeam(t *Team) *InvitationUpdateOne {
+	return iuo.SetTeamID(t.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the Member entity by ID.
+func (iuo *InvitationUpdateOne) SetInviteeID(id int) *InvitationUpdateOne {
+	iuo.mutation.SetInviteeID(id)
+	return iuo
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the Member entity by ID if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableInviteeID(id *int) *InvitationUpdateOne {
+	if id != nil {
+		iuo = iuo.SetInviteeID(*id)
+	}
+	return iuo
+}
+
+// SetInvitee sets the "invitee" edge to the Member entity.
+func (iuo *InvitationUpdateOne) SetInvitee(m *Member) *InvitationUpdateOne {
+	return iuo.SetInviteeID(m.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (iuo *InvitationUpdateOne) ClearTeam() *InvitationUpdateOne {
+	iuo.mutation.ClearTeam()
+	return iuo
+}
+
+// ClearInvitee clears the "invitee" edge to the Member entity.
+func (iuo *InvitationUpdateOne) ClearInvitee() *InvitationUpdateOne {
+	iuo.mutation.ClearInvitee()
+	return iuo
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationUpdateOne) Select(field string, fields ...string) *InvitationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationUpdateOne) check() error {
+	if v, ok := iuo.mutation.Kind(); ok {
+		if err := invitation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invitation.kind": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invitation.role": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invitation.status": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.CreatedBy(); ok {
+		if err := invitation.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Invitation.created_by": %w`, err)}
+		}
+	}
+	if iuo.mutation.TeamCleared() && len(iuo.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invitation.team"`)
+	}
+	return nil
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for _, f := range fields {
+			if !invitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Kind(); ok {
+		_spec.SetField(invitation.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.Role(); ok {
+		_spec.SetField(invitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.Token(); ok {
+		_spec.SetField(invitation.FieldToken, field.TypeString, value)
+	}
+	if iuo.mutation.TokenCleared() {
+		_spec.ClearField(invitation.FieldToken, field.TypeString)
+	}
+	if value, ok := iuo.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.CreatedBy(); ok {
+		_spec.SetField(invitation.FieldCreatedBy, field.TypeString, value)
+	}
+	if iuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.TeamTable,
+			Columns: []string{invitation.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.TeamTable,
+			Columns: []string{invitation.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -4,7 +4,10 @@ package ent
 
 import (
 	"backend_golang/ent/member"
+	"backend_golang/ent/position"
 	"backend_golang/ent/team"
+	"backend_golang/internal/models"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -28,12 +31,21 @@ type Member struct {
 	// Bio holds the value of the "bio" field.
 	Bio string `json:"bio,omitempty"`
 	// PreferredRole holds the value of the "preferred_role" field.
-	PreferredRole string `json:"preferred_role,omitempty"`
+	PreferredRole models.Role `json:"preferred_role,omitempty"`
+	// Roles holds the value of the "roles" field.
+	Roles []models.Role `json:"roles,omitempty"`
+	// CalendarToken holds the value of the "calendar_token" field.
+	CalendarToken *string `json:"-"`
+	// Locale holds the value of the "locale" field.
+	Locale models.Locale `json:"locale,omitempty"`
+	// EmailMuted holds the value of the "email_muted" field.
+	EmailMuted []models.NotificationKind `json:"email_muted,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MemberQuery when eager-loading is set.
-	Edges        MemberEdges `json:"edges"`
-	team_members *int
-	selectValues sql.SelectValues
+	Edges            MemberEdges `json:"edges"`
+	position_members *int
+	team_members     *int
+	selectValues     sql.SelectValues
 }
 
 // MemberEdges holds the relations/edges for other nodes in the graph.
@@ -42,9 +54,25 @@ type MemberEdges struct {
 	Skills []*Skill `json:"skills,omitempty"`
 	// Teams holds the value of the teams edge.
 	Teams *Team `json:"teams,omitempty"`
+	// Position holds the value of the position edge.
+	Position *Position `json:"position,omitempty"`
+	// Waitlist holds the value of the waitlist edge.
+	Waitlist []*WaitlistEntry `json:"waitlist,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Rsvps holds the value of the rsvps edge.
+	Rsvps []*RSVP `json:"rsvps,omitempty"`
+	// Threads holds the value of the threads edge.
+	Threads []*Thread `json:"threads,omitempty"`
+	// ThreadComments holds the value of the thread_comments edge.
+	ThreadComments []*ThreadComment `json:"thread_comments,omitempty"`
+	// AnnouncementComments holds the value of the announcement_comments edge.
+	AnnouncementComments []*AnnouncementComment `json:"announcement_comments,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [10]bool
 }
 
 // SkillsOrErr returns the Skills value or an error if the edge
@@ -67,16 +95,94 @@ func (e MemberEdges) TeamsOrErr() (*Team, error) {
 	return nil, &NotLoadedError{edge: "teams"}
 }
 
+// PositionOrErr returns the Position value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberEdges) PositionOrErr() (*Position, error) {
+	if e.Position != nil {
+		return e.Position, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: position.Label}
+	}
+	return nil, &NotLoadedError{edge: "position"}
+}
+
+// WaitlistOrErr returns the Waitlist value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) WaitlistOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[3] {
+		return e.Waitlist, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[4] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// RsvpsOrErr returns the Rsvps value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) RsvpsOrErr() ([]*RSVP, error) {
+	if e.loadedTypes[5] {
+		return e.Rsvps, nil
+	}
+	return nil, &NotLoadedError{edge: "rsvps"}
+}
+
+// ThreadsOrErr returns the Threads value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) ThreadsOrErr() ([]*Thread, error) {
+	if e.loadedTypes[6] {
+		return e.Threads, nil
+	}
+	return nil, &NotLoadedError{edge: "threads"}
+}
+
+// ThreadCommentsOrErr returns the ThreadComments value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) ThreadCommentsOrErr() ([]*ThreadComment, error) {
+	if e.loadedTypes[7] {
+		return e.ThreadComments, nil
+	}
+	return nil, &NotLoadedError{edge: "thread_comments"}
+}
+
+// AnnouncementCommentsOrErr returns the AnnouncementComments value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) AnnouncementCommentsOrErr() ([]*AnnouncementComment, error) {
+	if e.loadedTypes[8] {
+		return e.AnnouncementComments, nil
+	}
+	return nil, &NotLoadedError{edge: "announcement_comments"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[9] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Member) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case member.FieldRoles, member.FieldEmailMuted:
+			values[i] = new([]byte)
 		case member.FieldID:
 			values[i] = new(sql.NullInt64)
-		case member.FieldMemberID, member.FieldEmail, member.FieldPicture, member.FieldNickname, member.FieldBio, member.FieldPreferredRole:
+		case member.FieldMemberID, member.FieldEmail, member.FieldPicture, member.FieldNickname, member.FieldBio, member.FieldPreferredRole, member.FieldCalendarToken, member.FieldLocale:
 			values[i] = new(sql.NullString)
-		case member.ForeignKeys[0]: // team_members
+		case member.ForeignKeys[0]: // position_members
+			values[i] = new(sql.NullInt64)
+		case member.ForeignKeys[1]: // team_members
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -133,9 +239,45 @@ func (m *Member) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field preferred_role", values[i])
 			} else if value.Valid {
-				m.PreferredRole = value.String
+				m.PreferredRole = models.Role(value.String)
+			}
+		case member.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		case member.FieldCalendarToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_token", values[i])
+			} else if value.Valid {
+				m.CalendarToken = new(string)
+				*m.CalendarToken = value.String
+			}
+		case member.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				m.Locale = models.Locale(value.String)
+			}
+		case member.FieldEmailMuted:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field email_muted", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.EmailMuted); err != nil {
+					return fmt.Errorf("unmarshal field email_muted: %w", err)
+				}
 			}
 		case member.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field position_members", value)
+			} else if value.Valid {
+				m.position_members = new(int)
+				*m.position_members = int(value.Int64)
+			}
+		case member.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field team_members", value)
 			} else if value.Valid {
@@ -165,6 +307,46 @@ func (m *Member) QueryTeams() *TeamQuery {
 	return NewMemberClient(m.config).QueryTeams(m)
 }
 
+// QueryPosition queries the "position" edge of the Member entity.
+func (m *Member) QueryPosition() *PositionQuery {
+	return NewMemberClient(m.config).QueryPosition(m)
+}
+
+// QueryWaitlist queries the "waitlist" edge of the Member entity.
+func (m *Member) QueryWaitlist() *WaitlistEntryQuery {
+	return NewMemberClient(m.config).QueryWaitlist(m)
+}
+
+// QueryInvitations queries the "invitations" edge of the Member entity.
+func (m *Member) QueryInvitations() *InvitationQuery {
+	return NewMemberClient(m.config).QueryInvitations(m)
+}
+
+// QueryRsvps queries the "rsvps" edge of the Member entity.
+func (m *Member) QueryRsvps() *RSVPQuery {
+	return NewMemberClient(m.config).QueryRsvps(m)
+}
+
+// QueryThreads queries the "threads" edge of the Member entity.
+func (m *Member) QueryThreads() *ThreadQuery {
+	return NewMemberClient(m.config).QueryThreads(m)
+}
+
+// QueryThreadComments queries the "thread_comments" edge of the Member entity.
+func (m *Member) QueryThreadComments() *ThreadCommentQuery {
+	return NewMemberClient(m.config).QueryThreadComments(m)
+}
+
+// QueryAnnouncementComments queries the "announcement_comments" edge of the Member entity.
+func (m *Member) QueryAnnouncementComments() *AnnouncementCommentQuery {
+	return NewMemberClient(m.config).QueryAnnouncementComments(m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Member entity.
+func (m *Member) QueryNotifications() *NotificationQuery {
+	return NewMemberClient(m.config).QueryNotifications(m)
+}
+
 // Update returns a builder for updating this Member.
 // Note that you need to call Member.Unwrap() before calling this method if this Member
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -204,7 +386,18 @@ func (m *Member) String() string {
 	builder.WriteString(m.Bio)
 	builder.WriteString(", ")
 	builder.WriteString("preferred_role=")
-	builder.WriteString(m.PreferredRole)
+	builder.WriteString(fmt.Sprintf("%v", m.PreferredRole))
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", m.Roles))
+	builder.WriteString(", ")
+	builder.WriteString("calendar_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(fmt.Sprintf("%v", m.Locale))
+	builder.WriteString(", ")
+	builder.WriteString("email_muted=")
+	builder.WriteString(fmt.Sprintf("%v", m.EmailMuted))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,9 @@
 package member
 
 import (
+	"backend_golang/internal/models"
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -24,10 +27,34 @@ const (
 	FieldBio = "bio"
 	// FieldPreferredRole holds the string denoting the preferred_role field in the database.
 	FieldPreferredRole = "preferred_role"
+	// FieldRoles holds the string denoting the roles field in the database.
+	FieldRoles = "roles"
+	// FieldCalendarToken holds the string denoting the calendar_token field in the database.
+	FieldCalendarToken = "calendar_token"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldEmailMuted holds the string denoting the email_muted field in the database.
+	FieldEmailMuted = "email_muted"
 	// EdgeSkills holds the string denoting the skills edge name in mutations.
 	EdgeSkills = "skills"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
+	// EdgePosition holds the string denoting the position edge name in mutations.
+	EdgePosition = "position"
+	// EdgeWaitlist holds the string denoting the waitlist edge name in mutations.
+	EdgeWaitlist = "waitlist"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeRsvps holds the string denoting the rsvps edge name in mutations.
+	EdgeRsvps = "rsvps"
+	// EdgeThreads holds the string denoting the threads edge name in mutations.
+	EdgeThreads = "threads"
+	// EdgeThreadComments holds the string denoting the thread_comments edge name in mutations.
+	EdgeThreadComments = "thread_comments"
+	// EdgeAnnouncementComments holds the string denoting the announcement_comments edge name in mutations.
+	EdgeAnnouncementComments = "announcement_comments"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
 	// Table holds the table name of the member in the database.
 	Table = "members"
 	// SkillsTable is the table that holds the skills relation/edge. The primary key declared below.
@@ -42,6 +69,62 @@ const (
 	TeamsInverseTable = "teams"
 	// TeamsColumn is the table column denoting the teams relation/edge.
 	TeamsColumn = "team_members"
+	// PositionTable is the table that holds the position relation/edge.
+	PositionTable = "members"
+	// PositionInverseTable is the table name for the Position entity.
+	// It exists in this package in order to avoid circular dependency with the "position" package.
+	PositionInverseTable = "positions"
+	// PositionColumn is the table column denoting the position relation/edge.
+	PositionColumn = "position_members"
+	// WaitlistTable is the table that holds the waitlist relation/edge.
+	WaitlistTable = "waitlist_entries"
+	// WaitlistInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistInverseTable = "waitlist_entries"
+	// WaitlistColumn is the table column denoting the waitlist relation/edge.
+	WaitlistColumn = "member_waitlist"
+	// InvitationsTable is the table that holds the invitations relation/edge.
+	InvitationsTable = "invitations"
+	// InvitationsInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	InvitationsInverseTable = "invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "member_invitations"
+	// RsvpsTable is the table that holds the rsvps relation/edge.
+	RsvpsTable = "rsv_ps"
+	// RsvpsInverseTable is the table name for the RSVP entity.
+	// It exists in this package in order to avoid circular dependency with the "rsvp" package.
+	RsvpsInverseTable = "rsv_ps"
+	// RsvpsColumn is the table column denoting the rsvps relation/edge.
+	RsvpsColumn = "member_rsvps"
+	// ThreadsTable is the table that holds the threads relation/edge.
+	ThreadsTable = "threads"
+	// ThreadsInverseTable is the table name for the Thread entity.
+	// It exists in this package in order to avoid circular dependency with the "thread" package.
+	ThreadsInverseTable = "threads"
+	// ThreadsColumn is the table column denoting the threads relation/edge.
+	ThreadsColumn = "member_threads"
+	// ThreadCommentsTable is the table that holds the thread_comments relation/edge.
+	ThreadCommentsTable = "thread_comments"
+	// ThreadCommentsInverseTable is the table name for the ThreadComment entity.
+	// It exists in this package in order to avoid circular dependency with the "threadcomment" package.
+	ThreadCommentsInverseTable = "thread_comments"
+	// ThreadCommentsColumn is the table column denoting the thread_comments relation/edge.
+	ThreadCommentsColumn = "member_thread_comments"
+	// AnnouncementCommentsTable is the table that holds the announcement_comments relation/edge.
+	AnnouncementCommentsTable = "announcement_comments"
+	// AnnouncementCommentsInverseTable is the table name for the AnnouncementComment entity.
+	// It exists in this package in order to avoid circular dependency with the "announcementcomment" package.
+	AnnouncementCommentsInverseTable = "announcement_comments"
+	// AnnouncementCommentsColumn is the table column denoting the announcement_comments relation/edge.
+	AnnouncementCommentsColumn = "member_announcement_comments"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "member_notifications"
 )
 
 // Columns holds all SQL columns for member fields.
@@ -53,11 +136,16 @@ var Columns = []string{
 	FieldNickname,
 	FieldBio,
 	FieldPreferredRole,
+	FieldRoles,
+	FieldCalendarToken,
+	FieldLocale,
+	FieldEmailMuted,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "members"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"position_members",
 	"team_members",
 }
 
@@ -82,6 +170,28 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// PreferredRoleValidator is a validator for the "preferred_role" field enum values. It is called by the builders before save.
+func PreferredRoleValidator(pr models.Role) error {
+	switch pr {
+	case "FRONTEND", "BACKEND", "INFRA", "DESIGNER", "MANAGER", "FULLSTACK", "MOBILE":
+		return nil
+	default:
+		return fmt.Errorf("member: invalid enum value for preferred_role field: %q", pr)
+	}
+}
+
+const DefaultLocale models.Locale = "ja"
+
+// LocaleValidator is a validator for the "locale" field enum values. It is called by the builders before save.
+func LocaleValidator(l models.Locale) error {
+	switch l {
+	case "ja", "ko", "en":
+		return nil
+	default:
+		return fmt.Errorf("member: invalid enum value for locale field: %q", l)
+	}
+}
+
 // OrderOption defines the ordering options for the Member queries.
 type OrderOption func(*sql.Selector)
 
@@ -120,6 +230,16 @@ func ByPreferredRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPreferredRole, opts...).ToFunc()
 }
 
+// ByCalendarToken orders the results by the calendar_token field.
+func ByCalendarToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarToken, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
 // BySkillsCount orders the results by skills count.
 func BySkillsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -140,6 +260,111 @@ func ByTeamsField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTeamsStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPositionField orders the results by position field.
+func ByPositionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPositionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWaitlistCount orders the results by waitlist count.
+func ByWaitlistCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistStep(), opts...)
+	}
+}
+
+// ByWaitlist orders the results by waitlist terms.
+func ByWaitlist(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitationsCount orders the results by invitations count.
+func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitationsStep(), opts...)
+	}
+}
+
+// ByInvitations orders the results by invitations terms.
+func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRsvpsCount orders the results by rsvps count.
+func ByRsvpsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRsvpsStep(), opts...)
+	}
+}
+
+// ByRsvps orders the results by rsvps terms.
+func ByRsvps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRsvpsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByThreadsCount orders the results by threads count.
+func ByThreadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newThreadsStep(), opts...)
+	}
+}
+
+// ByThreads orders the results by threads terms.
+func ByThreads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newThreadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByThreadCommentsCount orders the results by thread_comments count.
+func ByThreadCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newThreadCommentsStep(), opts...)
+	}
+}
+
+// ByThreadComments orders the results by thread_comments terms.
+func ByThreadComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newThreadCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnnouncementCommentsCount orders the results by announcement_comments count.
+func ByAnnouncementCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnnouncementCommentsStep(), opts...)
+	}
+}
+
+// ByAnnouncementComments orders the results by announcement_comments terms.
+func ByAnnouncementComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnnouncementCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSkillsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -154,3 +379,59 @@ func newTeamsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TeamsTable, TeamsColumn),
 	)
 }
+func newPositionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PositionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PositionTable, PositionColumn),
+	)
+}
+func newWaitlistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistTable, WaitlistColumn),
+	)
+}
+func newInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+	)
+}
+func newRsvpsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RsvpsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RsvpsTable, RsvpsColumn),
+	)
+}
+func newThreadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ThreadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ThreadsTable, ThreadsColumn),
+	)
+}
+func newThreadCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ThreadCommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ThreadCommentsTable, ThreadCommentsColumn),
+	)
+}
+func newAnnouncementCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnnouncementCommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnnouncementCommentsTable, AnnouncementCommentsColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
@@ -4,6 +4,7 @@ package member
 
 import (
 	"backend_golang/ent/predicate"
+	"backend_golang/internal/models"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -79,9 +80,9 @@ func Bio(v string) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldBio, v))
 }
 
-// PreferredRole applies equality check predicate on the "preferred_role" field. It's identical to PreferredRoleEQ.
-func PreferredRole(v string) predicate.Member {
-	return predicate.Member(sql.FieldEQ(FieldPreferredRole, v))
+// CalendarToken applies equality check predicate on the "calendar_token" field. It's identical to CalendarTokenEQ.
+func CalendarToken(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldCalendarToken, v))
 }
 
 // MemberIDEQ applies the EQ predicate on the "member_id" field.
@@ -410,68 +411,158 @@ func BioContainsFold(v string) predicate.Member {
 }
 
 // PreferredRoleEQ applies the EQ predicate on the "preferred_role" field.
-func PreferredRoleEQ(v string) predicate.Member {
-	return predicate.Member(sql.FieldEQ(FieldPreferredRole, v))
+func PreferredRoleEQ(v models.Role) predicate.Member {
+	vc := v
+	return predicate.Member(sql.FieldEQ(FieldPreferredRole, vc))
 }
 
 // PreferredRoleNEQ applies the NEQ predicate on the "preferred_role" field.
-func PreferredRoleNEQ(v string) predicate.Member {
-	return predicate.Member(sql.FieldNEQ(FieldPreferredRole, v))
+func PreferredRoleNEQ(v models.Role) predicate.Member {
+	vc := v
+	return predicate.Member(sql.FieldNEQ(FieldPreferredRole, vc))
 }
 
 // PreferredRoleIn applies the In predicate on the "preferred_role" field.
-func PreferredRoleIn(vs ...string) predicate.Member {
-	return predicate.Member(sql.FieldIn(FieldPreferredRole, vs...))
+func PreferredRoleIn(vs ...models.Role) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Member(sql.FieldIn(FieldPreferredRole, v...))
 }
 
 // PreferredRoleNotIn applies the NotIn predicate on the "preferred_role" field.
-func PreferredRoleNotIn(vs ...string) predicate.Member {
-	return predicate.Member(sql.FieldNotIn(FieldPreferredRole, vs...))
+func PreferredRoleNotIn(vs ...models.Role) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Member(sql.FieldNotIn(FieldPreferredRole, v...))
 }
 
-// PreferredRoleGT applies the GT predicate on the "preferred_role" field.
-func PreferredRoleGT(v string) predicate.Member {
-	return predicate.Member(sql.FieldGT(FieldPreferredRole, v))
+// RolesIsNil applies the IsNil predicate on the "roles" field.
+func RolesIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldRoles))
 }
 
-// PreferredRoleGTE applies the GTE predicate on the "preferred_role" field.
-func PreferredRoleGTE(v string) predicate.Member {
-	return predicate.Member(sql.FieldGTE(FieldPreferredRole, v))
+// RolesNotNil applies the NotNil predicate on the "roles" field.
+func RolesNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldRoles))
 }
 
-// PreferredRoleLT applies the LT predicate on the "preferred_role" field.
-func PreferredRoleLT(v string) predicate.Member {
-	return predicate.Member(sql.FieldLT(FieldPreferredRole, v))
+// CalendarTokenEQ applies the EQ predicate on the "calendar_token" field.
+func CalendarTokenEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldCalendarToken, v))
 }
 
-// PreferredRoleLTE applies the LTE predicate on the "preferred_role" field.
-func PreferredRoleLTE(v string) predicate.Member {
-	return predicate.Member(sql.FieldLTE(FieldPreferredRole, v))
+// CalendarTokenNEQ applies the NEQ predicate on the "calendar_token" field.
+func CalendarTokenNEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldCalendarToken, v))
 }
 
-// PreferredRoleContains applies the Contains predicate on the "preferred_role" field.
-func PreferredRoleContains(v string) predicate.Member {
-	return predicate.Member(sql.FieldContains(FieldPreferredRole, v))
+// CalendarTokenIn applies the In predicate on the "calendar_token" field.
+func CalendarTokenIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldCalendarToken, vs...))
 }
 
-// PreferredRoleHasPrefix applies the HasPrefix predicate on the "preferred_role" field.
-func PreferredRoleHasPrefix(v string) predicate.Member {
-	return predicate.Member(sql.FieldHasPrefix(FieldPreferredRole, v))
+// CalendarTokenNotIn applies the NotIn predicate on the "calendar_token" field.
+func CalendarTokenNotIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldCalendarToken, vs...))
 }
 
-// PreferredRoleHasSuffix applies the HasSuffix predicate on the "preferred_role" field.
-func PreferredRoleHasSuffix(v string) predicate.Member {
-	return predicate.Member(sql.FieldHasSuffix(FieldPreferredRole, v))
+// CalendarTokenGT applies the GT predicate on the "calendar_token" field.
+func CalendarTokenGT(v string) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldCalendarToken, v))
 }
 
-// PreferredRoleEqualFold applies the EqualFold predicate on the "preferred_role" field.
-func PreferredRoleEqualFold(v string) predicate.Member {
-	return predicate.Member(sql.FieldEqualFold(FieldPreferredRole, v))
+// CalendarTokenGTE applies the GTE predicate on the "calendar_token" field.
+func CalendarTokenGTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldCalendarToken, v))
 }
 
-// PreferredRoleContainsFold applies the ContainsFold predicate on the "preferred_role" field.
-func PreferredRoleContainsFold(v string) predicate.Member {
-	return predicate.Member(sql.FieldContainsFold(FieldPreferredRole, v))
+// CalendarTokenLT applies the LT predicate on the "calendar_token" field.
+func CalendarTokenLT(v string) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldCalendarToken, v))
+}
+
+// CalendarTokenLTE applies the LTE predicate on the "calendar_token" field.
+func CalendarTokenLTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldCalendarToken, v))
+}
+
+// CalendarTokenContains applies the Contains predicate on the "calendar_token" field.
+func CalendarTokenContains(v string) predicate.Member {
+	return predicate.Member(sql.FieldContains(FieldCalendarToken, v))
+}
+
+// CalendarTokenHasPrefix applies the HasPrefix predicate on the "calendar_token" field.
+func CalendarTokenHasPrefix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasPrefix(FieldCalendarToken, v))
+}
+
+// CalendarTokenHasSuffix applies the HasSuffix predicate on the "calendar_token" field.
+func CalendarTokenHasSuffix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasSuffix(FieldCalendarToken, v))
+}
+
+// CalendarTokenIsNil applies the IsNil predicate on the "calendar_token" field.
+func CalendarTokenIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldCalendarToken))
+}
+
+// CalendarTokenNotNil applies the NotNil predicate on the "calendar_token" field.
+func CalendarTokenNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldCalendarToken))
+}
+
+// CalendarTokenEqualFold applies the EqualFold predicate on the "calendar_token" field.
+func CalendarTokenEqualFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldEqualFold(FieldCalendarToken, v))
+}
+
+// CalendarTokenContainsFold applies the ContainsFold predicate on the "calendar_token" field.
+func CalendarTokenContainsFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldContainsFold(FieldCalendarToken, v))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v models.Locale) predicate.Member {
+	vc := v
+	return predicate.Member(sql.FieldEQ(FieldLocale, vc))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v models.Locale) predicate.Member {
+	vc := v
+	return predicate.Member(sql.FieldNEQ(FieldLocale, vc))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...models.Locale) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Member(sql.FieldIn(FieldLocale, v...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...models.Locale) predicate.Member {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Member(sql.FieldNotIn(FieldLocale, v...))
+}
+
+// EmailMutedIsNil applies the IsNil predicate on the "email_muted" field.
+func EmailMutedIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldEmailMuted))
+}
+
+// EmailMutedNotNil applies the NotNil predicate on the "email_muted" field.
+func EmailMutedNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldEmailMuted))
 }
 
 // HasSkills applies the HasEdge predicate on the "skills" edge.
@@ -520,6 +611,190 @@ func HasTeamsWith(preds ...predicate.Team) predicate.Member {
 	})
 }
 
+// HasPosition applies the HasEdge predicate on the "position" edge.
+func HasPosition() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PositionTable, PositionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPositionWith applies the HasEdge predicate on the "position" edge with a given conditions (other predicates).
+func HasPositionWith(preds ...predicate.Position) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newPositionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlist applies the HasEdge predicate on the "waitlist" edge.
+func HasWaitlist() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistTable, WaitlistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistWith applies the HasEdge predicate on the "waitlist" edge with a given conditions (other predicates).
+func HasWaitlistWith(preds ...predicate.WaitlistEntry) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newWaitlistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.Invitation) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRsvps applies the HasEdge predicate on the "rsvps" edge.
+func HasRsvps() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RsvpsTable, RsvpsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRsvpsWith applies the HasEdge predicate on the "rsvps" edge with a given conditions (other predicates).
+func HasRsvpsWith(preds ...predicate.RSVP) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newRsvpsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasThreads applies the HasEdge predicate on the "threads" edge.
+func HasThreads() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ThreadsTable, ThreadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasThreadsWith applies the HasEdge predicate on the "threads" edge with a given conditions (other predicates).
+func HasThreadsWith(preds ...predicate.Thread) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newThreadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasThreadComments applies the HasEdge predicate on the "thread_comments" edge.
+func HasThreadComments() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ThreadCommentsTable, ThreadCommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasThreadCommentsWith applies the HasEdge predicate on the "thread_comments" edge with a given conditions (other predicates).
+func HasThreadCommentsWith(preds ...predicate.ThreadComment) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newThreadCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnnouncementComments applies the HasEdge predicate on the "announcement_comments" edge.
+func HasAnnouncementComments() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnnouncementCommentsTable, AnnouncementCommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnnouncementCommentsWith applies the HasEdge predicate on the "announcement_comments" edge with a given conditions (other predicates).
+func HasAnnouncementCommentsWith(preds ...predicate.AnnouncementComment) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newAnnouncementCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Member) predicate.Member {
 	return predicate.Member(sql.AndPredicates(predicates...))
@@ -3,9 +3,18 @@
 package ent
 
 import (
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/ent/position"
+	"backend_golang/ent/rsvp"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/models"
 	"context"
 	"errors"
 	"fmt"
@@ -52,8 +61,48 @@ func (mc *MemberCreate) SetBio(s string) *MemberCreate {
 }
 
 // SetPreferredRole sets the "preferred_role" field.
-func (mc *MemberCreate) SetPreferredRole(s string) *MemberCreate {
-	mc.mutation.SetPreferredRole(s)
+func (mc *MemberCreate) SetPreferredRole(m models.Role) *MemberCreate {
+	mc.mutation.SetPreferredRole(m)
+	return mc
+}
+
+// SetRoles sets the "roles" field.
+func (mc *MemberCreate) SetRoles(m []models.Role) *MemberCreate {
+	mc.mutation.SetRoles(m)
+	return mc
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (mc *MemberCreate) SetCalendarToken(s string) *MemberCreate {
+	mc.mutation.SetCalendarToken(s)
+	return mc
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableCalendarToken(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetCalendarToken(*s)
+	}
+	return mc
+}
+
+// SetLocale sets the "locale" field.
+func (mc *MemberCreate) SetLocale(m models.Locale) *MemberCreate {
+	mc.mutation.SetLocale(m)
+	return mc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableLocale(m *models.Locale) *MemberCreate {
+	if m != nil {
+		mc.SetLocale(*m)
+	}
+	return mc
+}
+
+// SetEmailMuted sets the "email_muted" field.
+func (mc *MemberCreate) SetEmailMuted(mk []models.NotificationKind) *MemberCreate {
+	mc.mutation.SetEmailMuted(mk)
 	return mc
 }
 
@@ -91,6 +140,130 @@ func (mc *MemberCreate) SetTeams(t *Team) *MemberCreate {
 	return mc.SetTeamsID(t.ID)
 }
 
+// SetPositionID sets the "position" edge to the Position entity by ID.
+func (mc *MemberCreate) SetPositionID(id int) *MemberCreate {
+	mc.mutation.SetPositionID(id)
+	return mc
+}
+
+// SetNillablePositionID sets the "position" edge to the Position entity by ID if the given value is not nil.
+func (mc *MemberCreate) SetNillablePositionID(id *int) *MemberCreate {
+	if id != nil {
+		mc = mc.SetPositionID(*id)
+	}
+	return mc
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (mc *MemberCreate) SetPosition(p *Position) *MemberCreate {
+	return mc.SetPositionID(p.ID)
+}
+
+// AddWaitlistIDs adds the "waitlist" edge to the WaitlistEntry entity by IDs.
+func (mc *MemberCreate) AddWaitlistIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddWaitlistIDs(ids...)
+	return mc
+}
+
+// AddWaitlist adds the "waitlist" edges to the WaitlistEntry entity.
+func (mc *MemberCreate) AddWaitlist(w ...*WaitlistEntry) *MemberCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return mc.AddWaitlistIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (mc *MemberCreate) AddInvitationIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddInvitationIDs(ids...)
+	return mc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (mc *MemberCreate) AddInvitations(i ...*Invitation) *MemberCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return mc.AddInvitationIDs(ids...)
+}
+
+// AddRsvpIDs adds the "rsvps" edge to the RSVP entity by IDs.
+func (mc *MemberCreate) AddRsvpIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddRsvpIDs(ids...)
+	return mc
+}
+
+// AddRsvps adds the "rsvps" edges to the RSVP entity.
+func (mc *MemberCreate) AddRsvps(r ...*RSVP) *MemberCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mc.AddRsvpIDs(ids...)
+}
+
+// AddThreadIDs adds the "threads" edge to the Thread entity by IDs.
+func (mc *MemberCreate) AddThreadIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddThreadIDs(ids...)
+	return mc
+}
+
+// AddThreads adds the "threads" edges to the Thread entity.
+func (mc *MemberCreate) AddThreads(t ...*Thread) *MemberCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddThreadIDs(ids...)
+}
+
+// AddThreadCommentIDs adds the "thread_comments" edge to the ThreadComment entity by IDs.
+func (mc *MemberCreate) AddThreadCommentIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddThreadCommentIDs(ids...)
+	return mc
+}
+
+// AddThreadComments adds the "thread_comments" edges to the ThreadComment entity.
+func (mc *MemberCreate) AddThreadComments(t ...*ThreadComment) *MemberCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddThreadCommentIDs(ids...)
+}
+
+// AddAnnouncementCommentIDs adds the "announcement_comments" edge to the AnnouncementComment entity by IDs.
+func (mc *MemberCreate) AddAnnouncementCommentIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddAnnouncementCommentIDs(ids...)
+	return mc
+}
+
+// AddAnnouncementComments adds the "announcement_comments" edges to the AnnouncementComment entity.
+func (mc *MemberCreate) AddAnnouncementComments(a ...*AnnouncementComment) *MemberCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mc.AddAnnouncementCommentIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (mc *MemberCreate) AddNotificationIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddNotificationIDs(ids...)
+	return mc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (mc *MemberCreate) AddNotifications(n ...*Notification) *MemberCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mc.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the MemberMutation object of the builder.
 func (mc *MemberCreate) Mutation() *MemberMutation {
 	return mc.mutation
@@ -98,6 +271,7 @@ func (mc *MemberCreate) Mutation() *MemberMutation {
 
 // Save creates the Member in the database.
 func (mc *MemberCreate) Save(ctx context.Context) (*Member, error) {
+	mc.defaults()
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -123,6 +297,14 @@ func (mc *MemberCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (mc *MemberCreate) defaults() {
+	if _, ok := mc.mutation.Locale(); !ok {
+		v := member.DefaultLocale
+		mc.mutation.SetLocale(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (mc *MemberCreate) check() error {
 	if _, ok := mc.mutation.MemberID(); !ok {
@@ -143,6 +325,19 @@ func (mc *MemberCreate) check() error {
 	if _, ok := mc.mutation.PreferredRole(); !ok {
 		return &ValidationError{Name: "preferred_role", err: errors.New(`ent: missing required field "Member.preferred_role"`)}
 	}
+	if v, ok := mc.mutation.PreferredRole(); ok {
+		if err := member.PreferredRoleValidator(v); err != nil {
+			return &ValidationError{Name: "preferred_role", err: fmt.Errorf(`ent: validator failed for field "Member.preferred_role": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "Member.locale"`)}
+	}
+	if v, ok := mc.mutation.Locale(); ok {
+		if err := member.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "Member.locale": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -190,9 +385,25 @@ func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 		_node.Bio = value
 	}
 	if value, ok := mc.mutation.PreferredRole(); ok {
-		_spec.SetField(member.FieldPreferredRole, field.TypeString, value)
+		_spec.SetField(member.FieldPreferredRole, field.TypeEnum, value)
 		_node.PreferredRole = value
 	}
+	if value, ok := mc.mutation.Roles(); ok {
+		_spec.SetField(member.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := mc.mutation.CalendarToken(); ok {
+		_spec.SetField(member.FieldCalendarToken, field.TypeString, value)
+		_node.CalendarToken = &value
+	}
+	if value, ok := mc.mutation.Locale(); ok {
+		_spec.SetField(member.FieldLocale, field.TypeEnum, value)
+		_node.Locale = value
+	}
+	if value, ok := mc.mutation.EmailMuted(); ok {
+		_spec.SetField(member.FieldEmailMuted, field.TypeJSON, value)
+		_node.EmailMuted = value
+	}
 	if nodes := mc.mutation.SkillsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -226,6 +437,135 @@ func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 		_node.team_members = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.PositionTable,
+			Columns: []string{member.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.position_members = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.WaitlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RsvpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ThreadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ThreadCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.AnnouncementCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -247,6 +587,7 @@ func (mcb *MemberCreateBulk) Save(ctx context.Context) ([]*Member, error) {
 	for i := range mcb.builders {
 		func(i int, root context.Context) {
 			builder := mcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*MemberMutation)
 				if !ok {
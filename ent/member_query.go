@@ -3,10 +3,18 @@
 package ent
 
 import (
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
+	"backend_golang/ent/rsvp"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"backend_golang/ent/waitlistentry"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -22,14 +30,22 @@ import (
 // MemberQuery is the builder for querying Member entities.
 type MemberQuery struct {
 	config
-	ctx        *QueryContext
-	order      []member.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Member
-	withSkills *SkillQuery
-	withTeams  *TeamQuery
-	withFKs    bool
-	modifiers  []func(*sql.Selector)
+	ctx                      *QueryContext
+	order                    []member.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Member
+	withSkills               *SkillQuery
+	withTeams                *TeamQuery
+	withPosition             *PositionQuery
+	withWaitlist             *WaitlistEntryQuery
+	withInvitations          *InvitationQuery
+	withRsvps                *RSVPQuery
+	withThreads              *ThreadQuery
+	withThreadComments       *ThreadCommentQuery
+	withAnnouncementComments *AnnouncementCommentQuery
+	withNotifications        *NotificationQuery
+	withFKs                  bool
+	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +126,182 @@ func (mq *MemberQuery) QueryTeams() *TeamQuery {
 	return query
 }
 
+// QueryPosition chains the current query on the "position" edge.
+func (mq *MemberQuery) QueryPosition() *PositionQuery {
+	query := (&PositionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, member.PositionTable, member.PositionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlist chains the current query on the "waitlist" edge.
+func (mq *MemberQuery) QueryWaitlist() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.WaitlistTable, member.WaitlistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitations chains the current query on the "invitations" edge.
+func (mq *MemberQuery) QueryInvitations() *InvitationQuery {
+	query := (&InvitationClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.InvitationsTable, member.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRsvps chains the current query on the "rsvps" edge.
+func (mq *MemberQuery) QueryRsvps() *RSVPQuery {
+	query := (&RSVPClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(rsvp.Table, rsvp.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.RsvpsTable, member.RsvpsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThreads chains the current query on the "threads" edge.
+func (mq *MemberQuery) QueryThreads() *ThreadQuery {
+	query := (&ThreadClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(thread.Table, thread.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.ThreadsTable, member.ThreadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThreadComments chains the current query on the "thread_comments" edge.
+func (mq *MemberQuery) QueryThreadComments() *ThreadCommentQuery {
+	query := (&ThreadCommentClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(threadcomment.Table, threadcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.ThreadCommentsTable, member.ThreadCommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnnouncementComments chains the current query on the "announcement_comments" edge.
+func (mq *MemberQuery) QueryAnnouncementComments() *AnnouncementCommentQuery {
+	query := (&AnnouncementCommentClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(announcementcomment.Table, announcementcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.AnnouncementCommentsTable, member.AnnouncementCommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (mq *MemberQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.NotificationsTable, member.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Member entity from the query.
 // Returns a *NotFoundError when no Member was found.
 func (mq *MemberQuery) First(ctx context.Context) (*Member, error) {
@@ -297,13 +489,21 @@ func (mq *MemberQuery) Clone() *MemberQuery {
 		return nil
 	}
 	return &MemberQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]member.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Member{}, mq.predicates...),
-		withSkills: mq.withSkills.Clone(),
-		withTeams:  mq.withTeams.Clone(),
+		config:                   mq.config,
+		ctx:                      mq.ctx.Clone(),
+		order:                    append([]member.OrderOption{}, mq.order...),
+		inters:                   append([]Interceptor{}, mq.inters...),
+		predicates:               append([]predicate.Member{}, mq.predicates...),
+		withSkills:               mq.withSkills.Clone(),
+		withTeams:                mq.withTeams.Clone(),
+		withPosition:             mq.withPosition.Clone(),
+		withWaitlist:             mq.withWaitlist.Clone(),
+		withInvitations:          mq.withInvitations.Clone(),
+		withRsvps:                mq.withRsvps.Clone(),
+		withThreads:              mq.withThreads.Clone(),
+		withThreadComments:       mq.withThreadComments.Clone(),
+		withAnnouncementComments: mq.withAnnouncementComments.Clone(),
+		withNotifications:        mq.withNotifications.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
@@ -332,6 +532,94 @@ func (mq *MemberQuery) WithTeams(opts ...func(*TeamQuery)) *MemberQuery {
 	return mq
 }
 
+// WithPosition tells the query-builder to eager-load the nodes that are connected to
+// the "position" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithPosition(opts ...func(*PositionQuery)) *MemberQuery {
+	query := (&PositionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withPosition = query
+	return mq
+}
+
+// WithWaitlist tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithWaitlist(opts ...func(*WaitlistEntryQuery)) *MemberQuery {
+	query := (&WaitlistEntryClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withWaitlist = query
+	return mq
+}
+
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithInvitations(opts ...func(*InvitationQuery)) *MemberQuery {
+	query := (&InvitationClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withInvitations = query
+	return mq
+}
+
+// WithRsvps tells the query-builder to eager-load the nodes that are connected to
+// the "rsvps" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithRsvps(opts ...func(*RSVPQuery)) *MemberQuery {
+	query := (&RSVPClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withRsvps = query
+	return mq
+}
+
+// WithThreads tells the query-builder to eager-load the nodes that are connected to
+// the "threads" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithThreads(opts ...func(*ThreadQuery)) *MemberQuery {
+	query := (&ThreadClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withThreads = query
+	return mq
+}
+
+// WithThreadComments tells the query-builder to eager-load the nodes that are connected to
+// the "thread_comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithThreadComments(opts ...func(*ThreadCommentQuery)) *MemberQuery {
+	query := (&ThreadCommentClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withThreadComments = query
+	return mq
+}
+
+// WithAnnouncementComments tells the query-builder to eager-load the nodes that are connected to
+// the "announcement_comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithAnnouncementComments(opts ...func(*AnnouncementCommentQuery)) *MemberQuery {
+	query := (&AnnouncementCommentClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withAnnouncementComments = query
+	return mq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithNotifications(opts ...func(*NotificationQuery)) *MemberQuery {
+	query := (&NotificationClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withNotifications = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -411,12 +699,20 @@ func (mq *MemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Membe
 		nodes       = []*Member{}
 		withFKs     = mq.withFKs
 		_spec       = mq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [10]bool{
 			mq.withSkills != nil,
 			mq.withTeams != nil,
+			mq.withPosition != nil,
+			mq.withWaitlist != nil,
+			mq.withInvitations != nil,
+			mq.withRsvps != nil,
+			mq.withThreads != nil,
+			mq.withThreadComments != nil,
+			mq.withAnnouncementComments != nil,
+			mq.withNotifications != nil,
 		}
 	)
-	if mq.withTeams != nil {
+	if mq.withTeams != nil || mq.withPosition != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -456,6 +752,63 @@ func (mq *MemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Membe
 			return nil, err
 		}
 	}
+	if query := mq.withPosition; query != nil {
+		if err := mq.loadPosition(ctx, query, nodes, nil,
+			func(n *Member, e *Position) { n.Edges.Position = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withWaitlist; query != nil {
+		if err := mq.loadWaitlist(ctx, query, nodes,
+			func(n *Member) { n.Edges.Waitlist = []*WaitlistEntry{} },
+			func(n *Member, e *WaitlistEntry) { n.Edges.Waitlist = append(n.Edges.Waitlist, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withInvitations; query != nil {
+		if err := mq.loadInvitations(ctx, query, nodes,
+			func(n *Member) { n.Edges.Invitations = []*Invitation{} },
+			func(n *Member, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withRsvps; query != nil {
+		if err := mq.loadRsvps(ctx, query, nodes,
+			func(n *Member) { n.Edges.Rsvps = []*RSVP{} },
+			func(n *Member, e *RSVP) { n.Edges.Rsvps = append(n.Edges.Rsvps, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withThreads; query != nil {
+		if err := mq.loadThreads(ctx, query, nodes,
+			func(n *Member) { n.Edges.Threads = []*Thread{} },
+			func(n *Member, e *Thread) { n.Edges.Threads = append(n.Edges.Threads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withThreadComments; query != nil {
+		if err := mq.loadThreadComments(ctx, query, nodes,
+			func(n *Member) { n.Edges.ThreadComments = []*ThreadComment{} },
+			func(n *Member, e *ThreadComment) { n.Edges.ThreadComments = append(n.Edges.ThreadComments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withAnnouncementComments; query != nil {
+		if err := mq.loadAnnouncementComments(ctx, query, nodes,
+			func(n *Member) { n.Edges.AnnouncementComments = []*AnnouncementComment{} },
+			func(n *Member, e *AnnouncementComment) {
+				n.Edges.AnnouncementComments = append(n.Edges.AnnouncementComments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withNotifications; query != nil {
+		if err := mq.loadNotifications(ctx, query, nodes,
+			func(n *Member) { n.Edges.Notifications = []*Notification{} },
+			func(n *Member, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -552,6 +905,255 @@ func (mq *MemberQuery) loadTeams(ctx context.Context, query *TeamQuery, nodes []
 	}
 	return nil
 }
+func (mq *MemberQuery) loadPosition(ctx context.Context, query *PositionQuery, nodes []*Member, init func(*Member), assign func(*Member, *Position)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Member)
+	for i := range nodes {
+		if nodes[i].position_members == nil {
+			continue
+		}
+		fk := *nodes[i].position_members
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(position.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "position_members" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadWaitlist(ctx context.Context, query *WaitlistEntryQuery, nodes []*Member, init func(*Member), assign func(*Member, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.WaitlistColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_waitlist
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_waitlist" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_waitlist" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*Member, init func(*Member), assign func(*Member, *Invitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_invitations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_invitations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_invitations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadRsvps(ctx context.Context, query *RSVPQuery, nodes []*Member, init func(*Member), assign func(*Member, *RSVP)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RSVP(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.RsvpsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_rsvps
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_rsvps" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_rsvps" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadThreads(ctx context.Context, query *ThreadQuery, nodes []*Member, init func(*Member), assign func(*Member, *Thread)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Thread(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.ThreadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_threads
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_threads" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_threads" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadThreadComments(ctx context.Context, query *ThreadCommentQuery, nodes []*Member, init func(*Member), assign func(*Member, *ThreadComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ThreadComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.ThreadCommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_thread_comments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_thread_comments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_thread_comments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadAnnouncementComments(ctx context.Context, query *AnnouncementCommentQuery, nodes []*Member, init func(*Member), assign func(*Member, *AnnouncementComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AnnouncementComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.AnnouncementCommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_announcement_comments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_announcement_comments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_announcement_comments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*Member, init func(*Member), assign func(*Member, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.member_notifications
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "member_notifications" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_notifications" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (mq *MemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
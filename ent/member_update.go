@@ -3,16 +3,26 @@
 package ent
 
 import (
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
+	"backend_golang/ent/rsvp"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/models"
 	"context"
 	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -100,19 +110,89 @@ func (mu *MemberUpdate) SetNillableBio(s *string) *MemberUpdate {
 }
 
 // SetPreferredRole sets the "preferred_role" field.
-func (mu *MemberUpdate) SetPreferredRole(s string) *MemberUpdate {
-	mu.mutation.SetPreferredRole(s)
+func (mu *MemberUpdate) SetPreferredRole(m models.Role) *MemberUpdate {
+	mu.mutation.SetPreferredRole(m)
 	return mu
 }
 
 // SetNillablePreferredRole sets the "preferred_role" field if the given value is not nil.
-func (mu *MemberUpdate) SetNillablePreferredRole(s *string) *MemberUpdate {
+func (mu *MemberUpdate) SetNillablePreferredRole(m *models.Role) *MemberUpdate {
+	if m != nil {
+		mu.SetPreferredRole(*m)
+	}
+	return mu
+}
+
+// SetRoles sets the "roles" field.
+func (mu *MemberUpdate) SetRoles(m []models.Role) *MemberUpdate {
+	mu.mutation.SetRoles(m)
+	return mu
+}
+
+// AppendRoles appends m to the "roles" field.
+func (mu *MemberUpdate) AppendRoles(m []models.Role) *MemberUpdate {
+	mu.mutation.AppendRoles(m)
+	return mu
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (mu *MemberUpdate) ClearRoles() *MemberUpdate {
+	mu.mutation.ClearRoles()
+	return mu
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (mu *MemberUpdate) SetCalendarToken(s string) *MemberUpdate {
+	mu.mutation.SetCalendarToken(s)
+	return mu
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableCalendarToken(s *string) *MemberUpdate {
 	if s != nil {
-		mu.SetPreferredRole(*s)
+		mu.SetCalendarToken(*s)
 	}
 	return mu
 }
 
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (mu *MemberUpdate) ClearCalendarToken() *MemberUpdate {
+	mu.mutation.ClearCalendarToken()
+	return mu
+}
+
+// SetLocale sets the "locale" field.
+func (mu *MemberUpdate) SetLocale(m models.Locale) *MemberUpdate {
+	mu.mutation.SetLocale(m)
+	return mu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableLocale(m *models.Locale) *MemberUpdate {
+	if m != nil {
+		mu.SetLocale(*m)
+	}
+	return mu
+}
+
+// SetEmailMuted sets the "email_muted" field.
+func (mu *MemberUpdate) SetEmailMuted(mk []models.NotificationKind) *MemberUpdate {
+	mu.mutation.SetEmailMuted(mk)
+	return mu
+}
+
+// AppendEmailMuted appends mk to the "email_muted" field.
+func (mu *MemberUpdate) AppendEmailMuted(mk []models.NotificationKind) *MemberUpdate {
+	mu.mutation.AppendEmailMuted(mk)
+	return mu
+}
+
+// ClearEmailMuted clears the value of the "email_muted" field.
+func (mu *MemberUpdate) ClearEmailMuted() *MemberUpdate {
+	mu.mutation.ClearEmailMuted()
+	return mu
+}
+
 // AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
 func (mu *MemberUpdate) AddSkillIDs(ids ...int) *MemberUpdate {
 	mu.mutation.AddSkillIDs(ids...)
@@ -147,6 +227,130 @@ func (mu *MemberUpdate) SetTeams(t *Team) *MemberUpdate {
 	return mu.SetTeamsID(t.ID)
 }
 
+// SetPositionID sets the "position" edge to the Position entity by ID.
+func (mu *MemberUpdate) SetPositionID(id int) *MemberUpdate {
+	mu.mutation.SetPositionID(id)
+	return mu
+}
+
+// SetNillablePositionID sets the "position" edge to the Position entity by ID if the given value is not nil.
+func (mu *MemberUpdate) SetNillablePositionID(id *int) *MemberUpdate {
+	if id != nil {
+		mu = mu.SetPositionID(*id)
+	}
+	return mu
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (mu *MemberUpdate) SetPosition(p *Position) *MemberUpdate {
+	return mu.SetPositionID(p.ID)
+}
+
+// AddWaitlistIDs adds the "waitlist" edge to the WaitlistEntry entity by IDs.
+func (mu *MemberUpdate) AddWaitlistIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddWaitlistIDs(ids...)
+	return mu
+}
+
+// AddWaitlist adds the "waitlist" edges to the WaitlistEntry entity.
+func (mu *MemberUpdate) AddWaitlist(w ...*WaitlistEntry) *MemberUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return mu.AddWaitlistIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (mu *MemberUpdate) AddInvitationIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddInvitationIDs(ids...)
+	return mu
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (mu *MemberUpdate) AddInvitations(i ...*Invitation) *MemberUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return mu.AddInvitationIDs(ids...)
+}
+
+// AddRsvpIDs adds the "rsvps" edge to the RSVP entity by IDs.
+func (mu *MemberUpdate) AddRsvpIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddRsvpIDs(ids...)
+	return mu
+}
+
+// AddRsvps adds the "rsvps" edges to the RSVP entity.
+func (mu *MemberUpdate) AddRsvps(r ...*RSVP) *MemberUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mu.AddRsvpIDs(ids...)
+}
+
+// AddThreadIDs adds the "threads" edge to the Thread entity by IDs.
+func (mu *MemberUpdate) AddThreadIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddThreadIDs(ids...)
+	return mu
+}
+
+// AddThreads adds the "threads" edges to the Thread entity.
+func (mu *MemberUpdate) AddThreads(t ...*Thread) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddThreadIDs(ids...)
+}
+
+// AddThreadCommentIDs adds the "thread_comments" edge to the ThreadComment entity by IDs.
+func (mu *MemberUpdate) AddThreadCommentIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddThreadCommentIDs(ids...)
+	return mu
+}
+
+// AddThreadComments adds the "thread_comments" edges to the ThreadComment entity.
+func (mu *MemberUpdate) AddThreadComments(t ...*ThreadComment) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddThreadCommentIDs(ids...)
+}
+
+// AddAnnouncementCommentIDs adds the "announcement_comments" edge to the AnnouncementComment entity by IDs.
+func (mu *MemberUpdate) AddAnnouncementCommentIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddAnnouncementCommentIDs(ids...)
+	return mu
+}
+
+// AddAnnouncementComments adds the "announcement_comments" edges to the AnnouncementComment entity.
+func (mu *MemberUpdate) AddAnnouncementComments(a ...*AnnouncementComment) *MemberUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.AddAnnouncementCommentIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (mu *MemberUpdate) AddNotificationIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddNotificationIDs(ids...)
+	return mu
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (mu *MemberUpdate) AddNotifications(n ...*Notification) *MemberUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the MemberMutation object of the builder.
 func (mu *MemberUpdate) Mutation() *MemberMutation {
 	return mu.mutation
@@ -179,6 +383,159 @@ func (mu *MemberUpdate) ClearTeams() *MemberUpdate {
 	return mu
 }
 
+// ClearPosition clears the "position" edge to the Position entity.
+func (mu *MemberUpdate) ClearPosition() *MemberUpdate {
+	mu.mutation.ClearPosition()
+	return mu
+}
+
+// ClearWaitlist clears all "waitlist" edges to the WaitlistEntry entity.
+func (mu *MemberUpdate) ClearWaitlist() *MemberUpdate {
+	mu.mutation.ClearWaitlist()
+	return mu
+}
+
+// RemoveWaitlistIDs removes the "waitlist" edge to WaitlistEntry entities by IDs.
+func (mu *MemberUpdate) RemoveWaitlistIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveWaitlistIDs(ids...)
+	return mu
+}
+
+// RemoveWaitlist removes "waitlist" edges to WaitlistEntry entities.
+func (mu *MemberUpdate) RemoveWaitlist(w ...*WaitlistEntry) *MemberUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return mu.RemoveWaitlistIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (mu *MemberUpdate) ClearInvitations() *MemberUpdate {
+	mu.mutation.ClearInvitations()
+	return mu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (mu *MemberUpdate) RemoveInvitationIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveInvitationIDs(ids...)
+	return mu
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (mu *MemberUpdate) RemoveInvitations(i ...*Invitation) *MemberUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return mu.RemoveInvitationIDs(ids...)
+}
+
+// ClearRsvps clears all "rsvps" edges to the RSVP entity.
+func (mu *MemberUpdate) ClearRsvps() *MemberUpdate {
+	mu.mutation.ClearRsvps()
+	return mu
+}
+
+// RemoveRsvpIDs removes the "rsvps" edge to RSVP entities by IDs.
+func (mu *MemberUpdate) RemoveRsvpIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveRsvpIDs(ids...)
+	return mu
+}
+
+// RemoveRsvps removes "rsvps" edges to RSVP entities.
+func (mu *MemberUpdate) RemoveRsvps(r ...*RSVP) *MemberUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mu.RemoveRsvpIDs(ids...)
+}
+
+// ClearThreads clears all "threads" edges to the Thread entity.
+func (mu *MemberUpdate) ClearThreads() *MemberUpdate {
+	mu.mutation.ClearThreads()
+	return mu
+}
+
+// RemoveThreadIDs removes the "threads" edge to Thread entities by IDs.
+func (mu *MemberUpdate) RemoveThreadIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveThreadIDs(ids...)
+	return mu
+}
+
+// RemoveThreads removes "threads" edges to Thread entities.
+func (mu *MemberUpdate) RemoveThreads(t ...*Thread) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveThreadIDs(ids...)
+}
+
+// ClearThreadComments clears all "thread_comments" edges to the ThreadComment entity.
+func (mu *MemberUpdate) ClearThreadComments() *MemberUpdate {
+	mu.mutation.ClearThreadComments()
+	return mu
+}
+
+// RemoveThreadCommentIDs removes the "thread_comments" edge to ThreadComment entities by IDs.
+func (mu *MemberUpdate) RemoveThreadCommentIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveThreadCommentIDs(ids...)
+	return mu
+}
+
+// RemoveThreadComments removes "thread_comments" edges to ThreadComment entities.
+func (mu *MemberUpdate) RemoveThreadComments(t ...*ThreadComment) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveThreadCommentIDs(ids...)
+}
+
+// ClearAnnouncementComments clears all "announcement_comments" edges to the AnnouncementComment entity.
+func (mu *MemberUpdate) ClearAnnouncementComments() *MemberUpdate {
+	mu.mutation.ClearAnnouncementComments()
+	return mu
+}
+
+// RemoveAnnouncementCommentIDs removes the "announcement_comments" edge to AnnouncementComment entities by IDs.
+func (mu *MemberUpdate) RemoveAnnouncementCommentIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveAnnouncementCommentIDs(ids...)
+	return mu
+}
+
+// RemoveAnnouncementComments removes "announcement_comments" edges to AnnouncementComment entities.
+func (mu *MemberUpdate) RemoveAnnouncementComments(a ...*AnnouncementComment) *MemberUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.RemoveAnnouncementCommentIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (mu *MemberUpdate) ClearNotifications() *MemberUpdate {
+	mu.mutation.ClearNotifications()
+	return mu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (mu *MemberUpdate) RemoveNotificationIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveNotificationIDs(ids...)
+	return mu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (mu *MemberUpdate) RemoveNotifications(n ...*Notification) *MemberUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.RemoveNotificationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MemberUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
@@ -206,7 +563,25 @@ func (mu *MemberUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (mu *MemberUpdate) check() error {
+	if v, ok := mu.mutation.PreferredRole(); ok {
+		if err := member.PreferredRoleValidator(v); err != nil {
+			return &ValidationError{Name: "preferred_role", err: fmt.Errorf(`ent: validator failed for field "Member.preferred_role": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Locale(); ok {
+		if err := member.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "Member.locale": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt))
 	if ps := mu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -231,7 +606,38 @@ func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.SetField(member.FieldBio, field.TypeString, value)
 	}
 	if value, ok := mu.mutation.PreferredRole(); ok {
-		_spec.SetField(member.FieldPreferredRole, field.TypeString, value)
+		_spec.SetField(member.FieldPreferredRole, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Roles(); ok {
+		_spec.SetField(member.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, member.FieldRoles, value)
+		})
+	}
+	if mu.mutation.RolesCleared() {
+		_spec.ClearField(member.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.CalendarToken(); ok {
+		_spec.SetField(member.FieldCalendarToken, field.TypeString, value)
+	}
+	if mu.mutation.CalendarTokenCleared() {
+		_spec.ClearField(member.FieldCalendarToken, field.TypeString)
+	}
+	if value, ok := mu.mutation.Locale(); ok {
+		_spec.SetField(member.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.EmailMuted(); ok {
+		_spec.SetField(member.FieldEmailMuted, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedEmailMuted(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, member.FieldEmailMuted, value)
+		})
+	}
+	if mu.mutation.EmailMutedCleared() {
+		_spec.ClearField(member.FieldEmailMuted, field.TypeJSON)
 	}
 	if mu.mutation.SkillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -307,64 +713,408 @@ func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{member.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if mu.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.PositionTable,
+			Columns: []string{member.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	mu.mutation.done = true
-	return n, nil
-}
-
-// MemberUpdateOne is the builder for updating a single Member entity.
-type MemberUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *MemberMutation
-}
-
-// SetMemberID sets the "member_id" field.
-func (muo *MemberUpdateOne) SetMemberID(s string) *MemberUpdateOne {
-	muo.mutation.SetMemberID(s)
-	return muo
-}
-
-// SetNillableMemberID sets the "member_id" field if the given value is not nil.
-func (muo *MemberUpdateOne) SetNillableMemberID(s *string) *MemberUpdateOne {
-	if s != nil {
-		muo.SetMemberID(*s)
+	if nodes := mu.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.PositionTable,
+			Columns: []string{member.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return muo
-}
-
-// SetEmail sets the "email" field.
-func (muo *MemberUpdateOne) SetEmail(s string) *MemberUpdateOne {
-	muo.mutation.SetEmail(s)
-	return muo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (muo *MemberUpdateOne) SetNillableEmail(s *string) *MemberUpdateOne {
-	if s != nil {
-		muo.SetEmail(*s)
+	if mu.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// SetPicture sets the "picture" field.
-func (muo *MemberUpdateOne) SetPicture(s string) *MemberUpdateOne {
-	muo.mutation.SetPicture(s)
-	return muo
-}
-
-// SetNillablePicture sets the "picture" field if the given value is not nil.
-func (muo *MemberUpdateOne) SetNillablePicture(s *string) *MemberUpdateOne {
-	if s != nil {
-		muo.SetPicture(*s)
+	if nodes := mu.mutation.RemovedWaitlistIDs(); len(nodes) > 0 && !mu.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.WaitlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !mu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRsvpsIDs(); len(nodes) > 0 && !mu.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RsvpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedThreadsIDs(); len(nodes) > 0 && !mu.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ThreadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ThreadCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedThreadCommentsIDs(); len(nodes) > 0 && !mu.mutation.ThreadCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ThreadCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AnnouncementCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedAnnouncementCommentsIDs(); len(nodes) > 0 && !mu.mutation.AnnouncementCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AnnouncementCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !mu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MemberUpdateOne is the builder for updating a single Member entity.
+type MemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MemberMutation
+}
+
+// SetMemberID sets the "member_id" field.
+func (muo *MemberUpdateOne) SetMemberID(s string) *MemberUpdateOne {
+	muo.mutation.SetMemberID(s)
+	return muo
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableMemberID(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetMemberID(*s)
+	}
+	return muo
+}
+
+// SetEmail sets the "email" field.
+func (muo *MemberUpdateOne) SetEmail(s string) *MemberUpdateOne {
+	muo.mutation.SetEmail(s)
+	return muo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableEmail(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetEmail(*s)
+	}
+	return muo
+}
+
+// SetPicture sets the "picture" field.
+func (muo *MemberUpdateOne) SetPicture(s string) *MemberUpdateOne {
+	muo.mutation.SetPicture(s)
+	return muo
+}
+
+// SetNillablePicture sets the "picture" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillablePicture(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetPicture(*s)
 	}
 	return muo
 }
@@ -398,19 +1148,89 @@ func (muo *MemberUpdateOne) SetNillableBio(s *string) *MemberUpdateOne {
 }
 
 // SetPreferredRole sets the "preferred_role" field.
-func (muo *MemberUpdateOne) SetPreferredRole(s string) *MemberUpdateOne {
-	muo.mutation.SetPreferredRole(s)
+func (muo *MemberUpdateOne) SetPreferredRole(m models.Role) *MemberUpdateOne {
+	muo.mutation.SetPreferredRole(m)
 	return muo
 }
 
 // SetNillablePreferredRole sets the "preferred_role" field if the given value is not nil.
-func (muo *MemberUpdateOne) SetNillablePreferredRole(s *string) *MemberUpdateOne {
+func (muo *MemberUpdateOne) SetNillablePreferredRole(m *models.Role) *MemberUpdateOne {
+	if m != nil {
+		muo.SetPreferredRole(*m)
+	}
+	return muo
+}
+
+// SetRoles sets the "roles" field.
+func (muo *MemberUpdateOne) SetRoles(m []models.Role) *MemberUpdateOne {
+	muo.mutation.SetRoles(m)
+	return muo
+}
+
+// AppendRoles appends m to the "roles" field.
+func (muo *MemberUpdateOne) AppendRoles(m []models.Role) *MemberUpdateOne {
+	muo.mutation.AppendRoles(m)
+	return muo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (muo *MemberUpdateOne) ClearRoles() *MemberUpdateOne {
+	muo.mutation.ClearRoles()
+	return muo
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (muo *MemberUpdateOne) SetCalendarToken(s string) *MemberUpdateOne {
+	muo.mutation.SetCalendarToken(s)
+	return muo
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableCalendarToken(s *string) *MemberUpdateOne {
 	if s != nil {
-		muo.SetPreferredRole(*s)
+		muo.SetCalendarToken(*s)
 	}
 	return muo
 }
 
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (muo *MemberUpdateOne) ClearCalendarToken() *MemberUpdateOne {
+	muo.mutation.ClearCalendarToken()
+	return muo
+}
+
+// SetLocale sets the "locale" field.
+func (muo *MemberUpdateOne) SetLocale(m models.Locale) *MemberUpdateOne {
+	muo.mutation.SetLocale(m)
+	return muo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableLocale(m *models.Locale) *MemberUpdateOne {
+	if m != nil {
+		muo.SetLocale(*m)
+	}
+	return muo
+}
+
+// SetEmailMuted sets the "email_muted" field.
+func (muo *MemberUpdateOne) SetEmailMuted(mk []models.NotificationKind) *MemberUpdateOne {
+	muo.mutation.SetEmailMuted(mk)
+	return muo
+}
+
+// AppendEmailMuted appends mk to the "email_muted" field.
+func (muo *MemberUpdateOne) AppendEmailMuted(mk []models.NotificationKind) *MemberUpdateOne {
+	muo.mutation.AppendEmailMuted(mk)
+	return muo
+}
+
+// ClearEmailMuted clears the value of the "email_muted" field.
+func (muo *MemberUpdateOne) ClearEmailMuted() *MemberUpdateOne {
+	muo.mutation.ClearEmailMuted()
+	return muo
+}
+
 // AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
 func (muo *MemberUpdateOne) AddSkillIDs(ids ...int) *MemberUpdateOne {
 	muo.mutation.AddSkillIDs(ids...)
@@ -440,43 +1260,320 @@ func (muo *MemberUpdateOne) SetNillableTeamsID(id *int) *MemberUpdateOne {
 	return muo
 }
 
-// SetTeams sets the "teams" edge to the Team entity.
-func (muo *MemberUpdateOne) SetTeams(t *Team) *MemberUpdateOne {
-	return muo.SetTeamsID(t.ID)
+// SetTeams sets the "teams" edge to the Team entity.
+func (muo *MemberUpdateOne) SetTeams(t *Team) *MemberUpdateOne {
+	return muo.SetTeamsID(t.ID)
+}
+
+// SetPositionID sets the "position" edge to the Position entity by ID.
+func (muo *MemberUpdateOne) SetPositionID(id int) *MemberUpdateOne {
+	muo.mutation.SetPositionID(id)
+	return muo
+}
+
+// SetNillablePositionID sets the "position" edge to the Position entity by ID if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillablePositionID(id *int) *MemberUpdateOne {
+	if id != nil {
+		muo = muo.SetPositionID(*id)
+	}
+	return muo
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (muo *MemberUpdateOne) SetPosition(p *Position) *MemberUpdateOne {
+	return muo.SetPositionID(p.ID)
+}
+
+// AddWaitlistIDs adds the "waitlist" edge to the WaitlistEntry entity by IDs.
+func (muo *MemberUpdateOne) AddWaitlistIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddWaitlistIDs(ids...)
+	return muo
+}
+
+// AddWaitlist adds the "waitlist" edges to the WaitlistEntry entity.
+func (muo *MemberUpdateOne) AddWaitlist(w ...*WaitlistEntry) *MemberUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return muo.AddWaitlistIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (muo *MemberUpdateOne) AddInvitationIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddInvitationIDs(ids...)
+	return muo
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (muo *MemberUpdateOne) AddInvitations(i ...*Invitation) *MemberUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return muo.AddInvitationIDs(ids...)
+}
+
+// AddRsvpIDs adds the "rsvps" edge to the RSVP entity by IDs.
+func (muo *MemberUpdateOne) AddRsvpIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddRsvpIDs(ids...)
+	return muo
+}
+
+// AddRsvps adds the "rsvps" edges to the RSVP entity.
+func (muo *MemberUpdateOne) AddRsvps(r ...*RSVP) *MemberUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return muo.AddRsvpIDs(ids...)
+}
+
+// AddThreadIDs adds the "threads" edge to the Thread entity by IDs.
+func (muo *MemberUpdateOne) AddThreadIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddThreadIDs(ids...)
+	return muo
+}
+
+// AddThreads adds the "threads" edges to the Thread entity.
+func (muo *MemberUpdateOne) AddThreads(t ...*Thread) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddThreadIDs(ids...)
+}
+
+// AddThreadCommentIDs adds the "thread_comments" edge to the ThreadComment entity by IDs.
+func (muo *MemberUpdateOne) AddThreadCommentIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddThreadCommentIDs(ids...)
+	return muo
+}
+
+// AddThreadComments adds the "thread_comments" edges to the ThreadComment entity.
+func (muo *MemberUpdateOne) AddThreadComments(t ...*ThreadComment) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddThreadCommentIDs(ids...)
+}
+
+// AddAnnouncementCommentIDs adds the "announcement_comments" edge to the AnnouncementComment entity by IDs.
+func (muo *MemberUpdateOne) AddAnnouncementCommentIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddAnnouncementCommentIDs(ids...)
+	return muo
+}
+
+// AddAnnouncementComments adds the "announcement_comments" edges to the AnnouncementComment entity.
+func (muo *MemberUpdateOne) AddAnnouncementComments(a ...*AnnouncementComment) *MemberUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return muo.AddAnnouncementCommentIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (muo *MemberUpdateOne) AddNotificationIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddNotificationIDs(ids...)
+	return muo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (muo *MemberUpdateOne) AddNotifications(n ...*Notification) *MemberUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.AddNotificationIDs(ids...)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (muo *MemberUpdateOne) Mutation() *MemberMutation {
+	return muo.mutation
+}
+
+// ClearSkills clears all "skills" edges to the Skill entity.
+func (muo *MemberUpdateOne) ClearSkills() *MemberUpdateOne {
+	muo.mutation.ClearSkills()
+	return muo
+}
+
+// RemoveSkillIDs removes the "skills" edge to Skill entities by IDs.
+func (muo *MemberUpdateOne) RemoveSkillIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveSkillIDs(ids...)
+	return muo
+}
+
+// RemoveSkills removes "skills" edges to Skill entities.
+func (muo *MemberUpdateOne) RemoveSkills(s ...*Skill) *MemberUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.RemoveSkillIDs(ids...)
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (muo *MemberUpdateOne) ClearTeams() *MemberUpdateOne {
+	muo.mutation.ClearTeams()
+	return muo
+}
+
+// ClearPosition clears the "position" edge to the Position entity.
+func (muo *MemberUpdateOne) ClearPosition() *MemberUpdateOne {
+	muo.mutation.ClearPosition()
+	return muo
+}
+
+// ClearWaitlist clears all "waitlist" edges to the WaitlistEntry entity.
+func (muo *MemberUpdateOne) ClearWaitlist() *MemberUpdateOne {
+	muo.mutation.ClearWaitlist()
+	return muo
+}
+
+// RemoveWaitlistIDs removes the "waitlist" edge to WaitlistEntry entities by IDs.
+func (muo *MemberUpdateOne) RemoveWaitlistIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveWaitlistIDs(ids...)
+	return muo
+}
+
+// RemoveWaitlist removes "waitlist" edges to WaitlistEntry entities.
+func (muo *MemberUpdateOne) RemoveWaitlist(w ...*WaitlistEntry) *MemberUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return muo.RemoveWaitlistIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (muo *MemberUpdateOne) ClearInvitations() *MemberUpdateOne {
+	muo.mutation.ClearInvitations()
+	return muo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (muo *MemberUpdateOne) RemoveInvitationIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveInvitationIDs(ids...)
+	return muo
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (muo *MemberUpdateOne) RemoveInvitations(i ...*Invitation) *MemberUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return muo.RemoveInvitationIDs(ids...)
+}
+
+// ClearRsvps clears all "rsvps" edges to the RSVP entity.
+func (muo *MemberUpdateOne) ClearRsvps() *MemberUpdateOne {
+	muo.mutation.ClearRsvps()
+	return muo
+}
+
+// RemoveRsvpIDs removes the "rsvps" edge to RSVP entities by IDs.
+func (muo *MemberUpdateOne) RemoveRsvpIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveRsvpIDs(ids...)
+	return muo
+}
+
+// RemoveRsvps removes "rsvps" edges to RSVP entities.
+func (muo *MemberUpdateOne) RemoveRsvps(r ...*RSVP) *MemberUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return muo.RemoveRsvpIDs(ids...)
+}
+
+// ClearThreads clears all "threads" edges to the Thread entity.
+func (muo *MemberUpdateOne) ClearThreads() *MemberUpdateOne {
+	muo.mutation.ClearThreads()
+	return muo
+}
+
+// RemoveThreadIDs removes the "threads" edge to Thread entities by IDs.
+func (muo *MemberUpdateOne) RemoveThreadIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveThreadIDs(ids...)
+	return muo
+}
+
+// RemoveThreads removes "threads" edges to Thread entities.
+func (muo *MemberUpdateOne) RemoveThreads(t ...*Thread) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveThreadIDs(ids...)
+}
+
+// ClearThreadComments clears all "thread_comments" edges to the ThreadComment entity.
+func (muo *MemberUpdateOne) ClearThreadComments() *MemberUpdateOne {
+	muo.mutation.ClearThreadComments()
+	return muo
+}
+
+// RemoveThreadCommentIDs removes the "thread_comments" edge to ThreadComment entities by IDs.
+func (muo *MemberUpdateOne) RemoveThreadCommentIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveThreadCommentIDs(ids...)
+	return muo
 }
 
-// Mutation returns the MemberMutation object of the builder.
-func (muo *MemberUpdateOne) Mutation() *MemberMutation {
-	return muo.mutation
+// RemoveThreadComments removes "thread_comments" edges to ThreadComment entities.
+func (muo *MemberUpdateOne) RemoveThreadComments(t ...*ThreadComment) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveThreadCommentIDs(ids...)
 }
 
-// ClearSkills clears all "skills" edges to the Skill entity.
-func (muo *MemberUpdateOne) ClearSkills() *MemberUpdateOne {
-	muo.mutation.ClearSkills()
+// ClearAnnouncementComments clears all "announcement_comments" edges to the AnnouncementComment entity.
+func (muo *MemberUpdateOne) ClearAnnouncementComments() *MemberUpdateOne {
+	muo.mutation.ClearAnnouncementComments()
 	return muo
 }
 
-// RemoveSkillIDs removes the "skills" edge to Skill entities by IDs.
-func (muo *MemberUpdateOne) RemoveSkillIDs(ids ...int) *MemberUpdateOne {
-	muo.mutation.RemoveSkillIDs(ids...)
+// RemoveAnnouncementCommentIDs removes the "announcement_comments" edge to AnnouncementComment entities by IDs.
+func (muo *MemberUpdateOne) RemoveAnnouncementCommentIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveAnnouncementCommentIDs(ids...)
 	return muo
 }
 
-// RemoveSkills removes "skills" edges to Skill entities.
-func (muo *MemberUpdateOne) RemoveSkills(s ...*Skill) *MemberUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveAnnouncementComments removes "announcement_comments" edges to AnnouncementComment entities.
+func (muo *MemberUpdateOne) RemoveAnnouncementComments(a ...*AnnouncementComment) *MemberUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return muo.RemoveSkillIDs(ids...)
+	return muo.RemoveAnnouncementCommentIDs(ids...)
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (muo *MemberUpdateOne) ClearTeams() *MemberUpdateOne {
-	muo.mutation.ClearTeams()
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (muo *MemberUpdateOne) ClearNotifications() *MemberUpdateOne {
+	muo.mutation.ClearNotifications()
 	return muo
 }
 
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (muo *MemberUpdateOne) RemoveNotificationIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveNotificationIDs(ids...)
+	return muo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (muo *MemberUpdateOne) RemoveNotifications(n ...*Notification) *MemberUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.RemoveNotificationIDs(ids...)
+}
+
 // Where appends a list predicates to the MemberUpdate builder.
 func (muo *MemberUpdateOne) Where(ps ...predicate.Member) *MemberUpdateOne {
 	muo.mutation.Where(ps...)
@@ -517,7 +1614,25 @@ func (muo *MemberUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (muo *MemberUpdateOne) check() error {
+	if v, ok := muo.mutation.PreferredRole(); ok {
+		if err := member.PreferredRoleValidator(v); err != nil {
+			return &ValidationError{Name: "preferred_role", err: fmt.Errorf(`ent: validator failed for field "Member.preferred_role": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Locale(); ok {
+		if err := member.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "Member.locale": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt))
 	id, ok := muo.mutation.ID()
 	if !ok {
@@ -559,7 +1674,38 @@ func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err err
 		_spec.SetField(member.FieldBio, field.TypeString, value)
 	}
 	if value, ok := muo.mutation.PreferredRole(); ok {
-		_spec.SetField(member.FieldPreferredRole, field.TypeString, value)
+		_spec.SetField(member.FieldPreferredRole, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Roles(); ok {
+		_spec.SetField(member.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, member.FieldRoles, value)
+		})
+	}
+	if muo.mutation.RolesCleared() {
+		_spec.ClearField(member.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.CalendarToken(); ok {
+		_spec.SetField(member.FieldCalendarToken, field.TypeString, value)
+	}
+	if muo.mutation.CalendarTokenCleared() {
+		_spec.ClearField(member.FieldCalendarToken, field.TypeString)
+	}
+	if value, ok := muo.mutation.Locale(); ok {
+		_spec.SetField(member.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.EmailMuted(); ok {
+		_spec.SetField(member.FieldEmailMuted, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedEmailMuted(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, member.FieldEmailMuted, value)
+		})
+	}
+	if muo.mutation.EmailMutedCleared() {
+		_spec.ClearField(member.FieldEmailMuted, field.TypeJSON)
 	}
 	if muo.mutation.SkillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -635,6 +1781,350 @@ func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.PositionTable,
+			Columns: []string{member.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.PositionTable,
+			Columns: []string{member.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedWaitlistIDs(); len(nodes) > 0 && !muo.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.WaitlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WaitlistTable,
+			Columns: []string{member.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !muo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.InvitationsTable,
+			Columns: []string{member.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRsvpsIDs(); len(nodes) > 0 && !muo.mutation.RsvpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RsvpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.RsvpsTable,
+			Columns: []string{member.RsvpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedThreadsIDs(); len(nodes) > 0 && !muo.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ThreadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadsTable,
+			Columns: []string{member.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ThreadCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedThreadCommentsIDs(); len(nodes) > 0 && !muo.mutation.ThreadCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ThreadCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ThreadCommentsTable,
+			Columns: []string{member.ThreadCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.AnnouncementCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedAnnouncementCommentsIDs(); len(nodes) > 0 && !muo.mutation.AnnouncementCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.AnnouncementCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.AnnouncementCommentsTable,
+			Columns: []string{member.AnnouncementCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcementcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !muo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.NotificationsTable,
+			Columns: []string{member.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Member{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
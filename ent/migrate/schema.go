@@ -31,6 +31,165 @@ var (
 			},
 		},
 	}
+	// AnnouncementCommentsColumns holds the columns for the "announcement_comments" table.
+	AnnouncementCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "leader_answer", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "announcement_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "member_announcement_comments", Type: field.TypeInt},
+	}
+	// AnnouncementCommentsTable holds the schema information for the "announcement_comments" table.
+	AnnouncementCommentsTable = &schema.Table{
+		Name:       "announcement_comments",
+		Columns:    AnnouncementCommentsColumns,
+		PrimaryKey: []*schema.Column{AnnouncementCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "announcement_comments_announcements_comments",
+				Columns:    []*schema.Column{AnnouncementCommentsColumns[5]},
+				RefColumns: []*schema.Column{AnnouncementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "announcement_comments_announcement_comments_replies",
+				Columns:    []*schema.Column{AnnouncementCommentsColumns[6]},
+				RefColumns: []*schema.Column{AnnouncementCommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "announcement_comments_members_announcement_comments",
+				Columns:    []*schema.Column{AnnouncementCommentsColumns[7]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "announcementcomment_announcement_id_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnnouncementCommentsColumns[5], AnnouncementCommentsColumns[6]},
+			},
+			{
+				Name:    "announcementcomment_created_at_member_announcement_comments",
+				Unique:  false,
+				Columns: []*schema.Column{AnnouncementCommentsColumns[4], AnnouncementCommentsColumns[7]},
+			},
+		},
+	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "agenda", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "location", Type: field.TypeString, Default: ""},
+		{Name: "online_url", Type: field.TypeString, Default: ""},
+		{Name: "capacity", Type: field.TypeInt, Default: 0},
+		{Name: "going_count", Type: field.TypeInt, Default: 0},
+		{Name: "recurrence", Type: field.TypeString, Default: ""},
+		{Name: "recurrence_ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sequence", Type: field.TypeInt, Default: 0},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "team_id", Type: field.TypeInt},
+	}
+	// EventsTable holds the schema information for the "events" table.
+	EventsTable = &schema.Table{
+		Name:       "events",
+		Columns:    EventsColumns,
+		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "events_teams_events",
+				Columns:    []*schema.Column{EventsColumns[16]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_team_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[16], EventsColumns[3]},
+			},
+		},
+	}
+	// EventOverridesColumns holds the columns for the "event_overrides" table.
+	EventOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "original_starts_at", Type: field.TypeTime},
+		{Name: "cancelled", Type: field.TypeBool, Default: false},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "agenda", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeInt},
+	}
+	// EventOverridesTable holds the schema information for the "event_overrides" table.
+	EventOverridesTable = &schema.Table{
+		Name:       "event_overrides",
+		Columns:    EventOverridesColumns,
+		PrimaryKey: []*schema.Column{EventOverridesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_overrides_events_overrides",
+				Columns:    []*schema.Column{EventOverridesColumns[9]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventoverride_event_id_original_starts_at",
+				Unique:  true,
+				Columns: []*schema.Column{EventOverridesColumns[9], EventOverridesColumns[1]},
+			},
+		},
+	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"DIRECT", "LINK"}},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"FRONTEND", "BACKEND", "INFRA", "DESIGNER", "MANAGER", "FULLSTACK", "MOBILE"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"ACTIVE", "ACCEPTED", "DECLINED", "REVOKED"}, Default: "ACTIVE"},
+		{Name: "token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "member_invitations", Type: field.TypeInt, Nullable: true},
+		{Name: "team_id", Type: field.TypeInt},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_members_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[10]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invitations_teams_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[11]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// MembersColumns holds the columns for the "members" table.
 	MembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -39,7 +198,12 @@ var (
 		{Name: "picture", Type: field.TypeString},
 		{Name: "nickname", Type: field.TypeString},
 		{Name: "bio", Type: field.TypeString, Size: 2147483647},
-		{Name: "preferred_role", Type: field.TypeString},
+		{Name: "preferred_role", Type: field.TypeEnum, Enums: []string{"FRONTEND", "BACKEND", "INFRA", "DESIGNER", "MANAGER", "FULLSTACK", "MOBILE"}},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "calendar_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "locale", Type: field.TypeEnum, Enums: []string{"ja", "ko", "en"}, Default: "ja"},
+		{Name: "email_muted", Type: field.TypeJSON, Nullable: true},
+		{Name: "position_members", Type: field.TypeInt, Nullable: true},
 		{Name: "team_members", Type: field.TypeInt, Nullable: true},
 	}
 	// MembersTable holds the schema information for the "members" table.
@@ -48,18 +212,85 @@ var (
 		Columns:    MembersColumns,
 		PrimaryKey: []*schema.Column{MembersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "members_positions_members",
+				Columns:    []*schema.Column{MembersColumns[11]},
+				RefColumns: []*schema.Column{PositionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "members_teams_members",
-				Columns:    []*schema.Column{MembersColumns[7]},
+				Columns:    []*schema.Column{MembersColumns[12]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"APPLICATION_RECEIVED", "APPLICATION_ACCEPTED", "MEMBER_JOINED", "MEMBER_LEFT", "NEW_COMMENT", "MENTIONED", "EVENT_REMINDER"}},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "subject", Type: field.TypeString, Default: ""},
+		{Name: "link", Type: field.TypeString, Default: ""},
+		{Name: "dedupe_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "member_notifications", Type: field.TypeInt},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_members_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[9]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_read_at_member_notifications",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[7], NotificationsColumns[9]},
+			},
+		},
+	}
+	// OutboxMessagesColumns holds the columns for the "outbox_messages" table.
+	OutboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"TEAM_CREATED", "TEAM_DELETED", "MEMBER_JOINED", "MEMBER_LEFT", "WAITLIST_JOINED", "WAITLIST_OFFERED", "ANNOUNCEMENT_CREATED"}},
+		{Name: "aggregate_id", Type: field.TypeInt},
+		{Name: "team_id", Type: field.TypeInt},
+		{Name: "member_id", Type: field.TypeString, Default: ""},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "dispatched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "abandoned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxMessagesTable holds the schema information for the "outbox_messages" table.
+	OutboxMessagesTable = &schema.Table{
+		Name:       "outbox_messages",
+		Columns:    OutboxMessagesColumns,
+		PrimaryKey: []*schema.Column{OutboxMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxmessage_dispatched_at_abandoned_at_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxMessagesColumns[8], OutboxMessagesColumns[9], OutboxMessagesColumns[7]},
+			},
+		},
+	}
 	// PositionsColumns holds the columns for the "positions" table.
 	PositionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "role", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"FRONTEND", "BACKEND", "INFRA", "DESIGNER", "MANAGER", "FULLSTACK", "MOBILE"}},
 		{Name: "vacancy", Type: field.TypeInt8},
 		{Name: "team_id", Type: field.TypeInt, Nullable: true},
 	}
@@ -77,10 +308,47 @@ var (
 			},
 		},
 	}
+	// RsvPsColumns holds the columns for the "rsv_ps" table.
+	RsvPsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"GOING", "MAYBE", "DECLINED"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeInt},
+		{Name: "member_rsvps", Type: field.TypeInt},
+	}
+	// RsvPsTable holds the schema information for the "rsv_ps" table.
+	RsvPsTable = &schema.Table{
+		Name:       "rsv_ps",
+		Columns:    RsvPsColumns,
+		PrimaryKey: []*schema.Column{RsvPsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rsv_ps_events_rsvps",
+				Columns:    []*schema.Column{RsvPsColumns[4]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "rsv_ps_members_rsvps",
+				Columns:    []*schema.Column{RsvPsColumns[5]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rsvp_event_id_member_rsvps",
+				Unique:  true,
+				Columns: []*schema.Column{RsvPsColumns[4], RsvPsColumns[5]},
+			},
+		},
+	}
 	// SkillsColumns holds the columns for the "skills" table.
 	SkillsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "category", Type: field.TypeString, Default: ""},
 	}
 	// SkillsTable holds the schema information for the "skills" table.
 	SkillsTable = &schema.Table{
@@ -88,6 +356,26 @@ var (
 		Columns:    SkillsColumns,
 		PrimaryKey: []*schema.Column{SkillsColumns[0]},
 	}
+	// SkillAliasColumns holds the columns for the "skill_alias" table.
+	SkillAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "skill_aliases", Type: field.TypeInt},
+	}
+	// SkillAliasTable holds the schema information for the "skill_alias" table.
+	SkillAliasTable = &schema.Table{
+		Name:       "skill_alias",
+		Columns:    SkillAliasColumns,
+		PrimaryKey: []*schema.Column{SkillAliasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "skill_alias_skills_aliases",
+				Columns:    []*schema.Column{SkillAliasColumns[2]},
+				RefColumns: []*schema.Column{SkillsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TeamsColumns holds the columns for the "teams" table.
 	TeamsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -95,6 +383,7 @@ var (
 		{Name: "description", Type: field.TypeString, Size: 2147483647},
 		{Name: "headcount", Type: field.TypeInt8},
 		{Name: "created_by", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"RECRUITING", "FULL", "CLOSED", "ARCHIVED"}, Default: "RECRUITING"},
 	}
 	// TeamsTable holds the schema information for the "teams" table.
 	TeamsTable = &schema.Table{
@@ -102,6 +391,76 @@ var (
 		Columns:    TeamsColumns,
 		PrimaryKey: []*schema.Column{TeamsColumns[0]},
 	}
+	// ThreadsColumns holds the columns for the "threads" table.
+	ThreadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "mentions", Type: field.TypeJSON, Nullable: true},
+		{Name: "comment_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_activity_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "member_threads", Type: field.TypeInt},
+		{Name: "team_id", Type: field.TypeInt},
+	}
+	// ThreadsTable holds the schema information for the "threads" table.
+	ThreadsTable = &schema.Table{
+		Name:       "threads",
+		Columns:    ThreadsColumns,
+		PrimaryKey: []*schema.Column{ThreadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "threads_members_threads",
+				Columns:    []*schema.Column{ThreadsColumns[8]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "threads_teams_threads",
+				Columns:    []*schema.Column{ThreadsColumns[9]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "thread_team_id_last_activity_at",
+				Unique:  false,
+				Columns: []*schema.Column{ThreadsColumns[9], ThreadsColumns[5]},
+			},
+		},
+	}
+	// ThreadCommentsColumns holds the columns for the "thread_comments" table.
+	ThreadCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "mentions", Type: field.TypeJSON, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "member_thread_comments", Type: field.TypeInt},
+		{Name: "thread_id", Type: field.TypeInt},
+	}
+	// ThreadCommentsTable holds the schema information for the "thread_comments" table.
+	ThreadCommentsTable = &schema.Table{
+		Name:       "thread_comments",
+		Columns:    ThreadCommentsColumns,
+		PrimaryKey: []*schema.Column{ThreadCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "thread_comments_members_thread_comments",
+				Columns:    []*schema.Column{ThreadCommentsColumns[5]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "thread_comments_threads_comments",
+				Columns:    []*schema.Column{ThreadCommentsColumns[6]},
+				RefColumns: []*schema.Column{ThreadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TransientMembersColumns holds the columns for the "transient_members" table.
 	TransientMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -116,6 +475,119 @@ var (
 		Columns:    TransientMembersColumns,
 		PrimaryKey: []*schema.Column{TransientMembersColumns[0]},
 	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"WAITING", "OFFERED", "ACCEPTED", "EXPIRED", "CANCELLED"}, Default: "WAITING"},
+		{Name: "offer_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "member_waitlist", Type: field.TypeInt},
+		{Name: "position_id", Type: field.TypeInt},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "waitlist_entries_members_waitlist",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[5]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "waitlist_entries_positions_waitlist",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[6]},
+				RefColumns: []*schema.Column{PositionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_position_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[6], WaitlistEntriesColumns[1]},
+			},
+			{
+				Name:    "waitlistentry_status_offer_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[1], WaitlistEntriesColumns[2]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"GENERIC", "SLACK", "DISCORD"}, Default: "GENERIC"},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "team_id", Type: field.TypeInt},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_teams_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[11]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeInt},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"TEAM_CREATED", "TEAM_DELETED", "MEMBER_JOINED", "MEMBER_LEFT", "WAITLIST_JOINED", "WAITLIST_OFFERED", "ANNOUNCEMENT_CREATED"}},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "response_status", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+		},
+	}
 	// SkillUsersColumns holds the columns for the "skill_users" table.
 	SkillUsersColumns = []*schema.Column{
 		{Name: "skill_id", Type: field.TypeInt},
@@ -169,11 +641,24 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AnnouncementsTable,
+		AnnouncementCommentsTable,
+		EventsTable,
+		EventOverridesTable,
+		InvitationsTable,
 		MembersTable,
+		NotificationsTable,
+		OutboxMessagesTable,
 		PositionsTable,
+		RsvPsTable,
 		SkillsTable,
+		SkillAliasTable,
 		TeamsTable,
+		ThreadsTable,
+		ThreadCommentsTable,
 		TransientMembersTable,
+		WaitlistEntriesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		SkillUsersTable,
 		SkillTeamsTable,
 	}
@@ -181,8 +666,28 @@ var (
 
 func init() {
 	AnnouncementsTable.ForeignKeys[0].RefTable = TeamsTable
-	MembersTable.ForeignKeys[0].RefTable = TeamsTable
+	AnnouncementCommentsTable.ForeignKeys[0].RefTable = AnnouncementsTable
+	AnnouncementCommentsTable.ForeignKeys[1].RefTable = AnnouncementCommentsTable
+	AnnouncementCommentsTable.ForeignKeys[2].RefTable = MembersTable
+	EventsTable.ForeignKeys[0].RefTable = TeamsTable
+	EventOverridesTable.ForeignKeys[0].RefTable = EventsTable
+	InvitationsTable.ForeignKeys[0].RefTable = MembersTable
+	InvitationsTable.ForeignKeys[1].RefTable = TeamsTable
+	MembersTable.ForeignKeys[0].RefTable = PositionsTable
+	MembersTable.ForeignKeys[1].RefTable = TeamsTable
+	NotificationsTable.ForeignKeys[0].RefTable = MembersTable
 	PositionsTable.ForeignKeys[0].RefTable = TeamsTable
+	RsvPsTable.ForeignKeys[0].RefTable = EventsTable
+	RsvPsTable.ForeignKeys[1].RefTable = MembersTable
+	SkillAliasTable.ForeignKeys[0].RefTable = SkillsTable
+	ThreadsTable.ForeignKeys[0].RefTable = MembersTable
+	ThreadsTable.ForeignKeys[1].RefTable = TeamsTable
+	ThreadCommentsTable.ForeignKeys[0].RefTable = MembersTable
+	ThreadCommentsTable.ForeignKeys[1].RefTable = ThreadsTable
+	WaitlistEntriesTable.ForeignKeys[0].RefTable = MembersTable
+	WaitlistEntriesTable.ForeignKeys[1].RefTable = PositionsTable
+	WebhooksTable.ForeignKeys[0].RefTable = TeamsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	SkillUsersTable.ForeignKeys[0].RefTable = SkillsTable
 	SkillUsersTable.ForeignKeys[1].RefTable = MembersTable
 	SkillTeamsTable.ForeignKeys[0].RefTable = SkillsTable
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (nc *NotificationCreate) SetKind(mk models.NotificationKind) *NotificationCreate {
+	nc.mutation.SetKind(mk)
+	return nc
+}
+
+// SetTeamID sets the "team_id" field.
+func (nc *NotificationCreate) SetTeamID(i int) *NotificationCreate {
+	nc.mutation.SetTeamID(i)
+	return nc
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableTeamID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetTeamID(*i)
+	}
+	return nc
+}
+
+// SetActorID sets the "actor_id" field.
+func (nc *NotificationCreate) SetActorID(s string) *NotificationCreate {
+	nc.mutation.SetActorID(s)
+	return nc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableActorID(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetActorID(*s)
+	}
+	return nc
+}
+
+// SetSubject sets the "subject" field.
+func (nc *NotificationCreate) SetSubject(s string) *NotificationCreate {
+	nc.mutation.SetSubject(s)
+	return nc
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableSubject(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetSubject(*s)
+	}
+	return nc
+}
+
+// SetLink sets the "link" field.
+func (nc *NotificationCreate) SetLink(s string) *NotificationCreate {
+	nc.mutation.SetLink(s)
+	return nc
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableLink(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetLink(*s)
+	}
+	return nc
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (nc *NotificationCreate) SetDedupeKey(s string) *NotificationCreate {
+	nc.mutation.SetDedupeKey(s)
+	return nc
+}
+
+// SetNillableDedupeKey sets the "dedupe_key" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableDedupeKey(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetDedupeKey(*s)
+	}
+	return nc
+}
+
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableCreatedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetRecipientID sets the "recipient" edge to the Member entity by ID.
+func (nc *NotificationCreate) SetRecipientID(id int) *NotificationCreate {
+	nc.mutation.SetRecipientID(id)
+	return nc
+}
+
+// SetRecipient sets the "recipient" edge to the Member entity.
+func (nc *NotificationCreate) SetRecipient(m *Member) *NotificationCreate {
+	return nc.SetRecipientID(m.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nc *NotificationCreate) Mutation() *NotificationMutation {
+	return nc.mutation
+}
+
+// Save creates the Notification in the database.
+func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	nc.defaults()
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotificationCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotificationCreate) defaults() {
+	if _, ok := nc.mutation.Subject(); !ok {
+		v := notification.DefaultSubject
+		nc.mutation.SetSubject(v)
+	}
+	if _, ok := nc.mutation.Link(); !ok {
+		v := notification.DefaultLink
+		nc.mutation.SetLink(v)
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := notification.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Notification.kind"`)}
+	}
+	if v, ok := nc.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Notification.subject"`)}
+	}
+	if _, ok := nc.mutation.Link(); !ok {
+		return &ValidationError{Name: "link", err: errors.New(`ent: missing required field "Notification.link"`)}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notification.created_at"`)}
+	}
+	if len(nc.mutation.RecipientIDs()) == 0 {
+		return &ValidationError{Name: "recipient", err: errors.New(`ent: missing required edge "Notification.recipient"`)}
+	}
+	return nil
+}
+
+func (nc *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(notification.Table, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	)
+	if value, ok := nc.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := nc.mutation.TeamID(); ok {
+		_spec.SetField(notification.FieldTeamID, field.TypeInt, value)
+		_node.TeamID = &value
+	}
+	if value, ok := nc.mutation.ActorID(); ok {
+		_spec.SetField(notification.FieldActorID, field.TypeString, value)
+		_node.ActorID = &value
+	}
+	if value, ok := nc.mutation.Subject(); ok {
+		_spec.SetField(notification.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := nc.mutation.Link(); ok {
+		_spec.SetField(notification.FieldLink, field.TypeString, value)
+		_node.Link = value
+	}
+	if value, ok := nc.mutation.DedupeKey(); ok {
+		_spec.SetField(notification.FieldDedupeKey, field.TypeString, value)
+		_node.DedupeKey = &value
+	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := nc.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.member_notifications = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationCreate
+}
+
+// Save creates the Notification entities in the database.
+func (ncb *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notification, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,632 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/ent/predicate"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetKind sets the "kind" field.
+func (nu *NotificationUpdate) SetKind(mk models.NotificationKind) *NotificationUpdate {
+	nu.mutation.SetKind(mk)
+	return nu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableKind(mk *models.NotificationKind) *NotificationUpdate {
+	if mk != nil {
+		nu.SetKind(*mk)
+	}
+	return nu
+}
+
+// SetTeamID sets the "team_id" field.
+func (nu *NotificationUpdate) SetTeamID(i int) *NotificationUpdate {
+	nu.mutation.ResetTeamID()
+	nu.mutation.SetTeamID(i)
+	return nu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableTeamID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetTeamID(*i)
+	}
+	return nu
+}
+
+// AddTeamID adds i to the "team_id" field.
+func (nu *NotificationUpdate) AddTeamID(i int) *NotificationUpdate {
+	nu.mutation.AddTeamID(i)
+	return nu
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (nu *NotificationUpdate) ClearTeamID() *NotificationUpdate {
+	nu.mutation.ClearTeamID()
+	return nu
+}
+
+// SetActorID sets the "actor_id" field.
+func (nu *NotificationUpdate) SetActorID(s string) *NotificationUpdate {
+	nu.mutation.SetActorID(s)
+	return nu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableActorID(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetActorID(*s)
+	}
+	return nu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (nu *NotificationUpdate) ClearActorID() *NotificationUpdate {
+	nu.mutation.ClearActorID()
+	return nu
+}
+
+// SetSubject sets the "subject" field.
+func (nu *NotificationUpdate) SetSubject(s string) *NotificationUpdate {
+	nu.mutation.SetSubject(s)
+	return nu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableSubject(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetSubject(*s)
+	}
+	return nu
+}
+
+// SetLink sets the "link" field.
+func (nu *NotificationUpdate) SetLink(s string) *NotificationUpdate {
+	nu.mutation.SetLink(s)
+	return nu
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableLink(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetLink(*s)
+	}
+	return nu
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (nu *NotificationUpdate) SetDedupeKey(s string) *NotificationUpdate {
+	nu.mutation.SetDedupeKey(s)
+	return nu
+}
+
+// SetNillableDedupeKey sets the "dedupe_key" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableDedupeKey(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetDedupeKey(*s)
+	}
+	return nu
+}
+
+// ClearDedupeKey clears the value of the "dedupe_key" field.
+func (nu *NotificationUpdate) ClearDedupeKey() *NotificationUpdate {
+	nu.mutation.ClearDedupeKey()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// SetRecipientID sets the "recipient" edge to the Member entity by ID.
+func (nu *NotificationUpdate) SetRecipientID(id int) *NotificationUpdate {
+	nu.mutation.SetRecipientID(id)
+	return nu
+}
+
+// SetRecipient sets the "recipient" edge to the Member entity.
+func (nu *NotificationUpdate) SetRecipient(m *Member) *NotificationUpdate {
+	return nu.SetRecipientID(m.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// ClearRecipient clears the "recipient" edge to the Member entity.
+func (nu *NotificationUpdate) ClearRecipient() *NotificationUpdate {
+	nu.mutation.ClearRecipient()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if nu.mutation.RecipientCleared() && len(nu.mutation.RecipientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.recipient"`)
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.TeamID(); ok {
+		_spec.SetField(notification.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedTeamID(); ok {
+		_spec.AddField(notification.FieldTeamID, field.TypeInt, value)
+	}
+	if nu.mutation.TeamIDCleared() {
+		_spec.ClearField(notification.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := nu.mutation.ActorID(); ok {
+		_spec.SetField(notification.FieldActorID, field.TypeString, value)
+	}
+	if nu.mutation.ActorIDCleared() {
+		_spec.ClearField(notification.FieldActorID, field.TypeString)
+	}
+	if value, ok := nu.mutation.Subject(); ok {
+		_spec.SetField(notification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Link(); ok {
+		_spec.SetField(notification.FieldLink, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.DedupeKey(); ok {
+		_spec.SetField(notification.FieldDedupeKey, field.TypeString, value)
+	}
+	if nu.mutation.DedupeKeyCleared() {
+		_spec.ClearField(notification.FieldDedupeKey, field.TypeString)
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nu.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetKind sets the "kind" field.
+func (nuo *NotificationUpdateOne) SetKind(mk models.NotificationKind) *NotificationUpdateOne {
+	nuo.mutation.SetKind(mk)
+	return nuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableKind(mk *models.NotificationKind) *NotificationUpdateOne {
+	if mk != nil {
+		nuo.SetKind(*mk)
+	}
+	return nuo
+}
+
+// SetTeamID sets the "team_id" field.
+func (nuo *NotificationUpdateOne) SetTeamID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetTeamID()
+	nuo.mutation.SetTeamID(i)
+	return nuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableTeamID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetTeamID(*i)
+	}
+	return nuo
+}
+
+// AddTeamID adds i to the "team_id" field.
+func (nuo *NotificationUpdateOne) AddTeamID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddTeamID(i)
+	return nuo
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (nuo *NotificationUpdateOne) ClearTeamID() *NotificationUpdateOne {
+	nuo.mutation.ClearTeamID()
+	return nuo
+}
+
+// SetActorID sets the "actor_id" field.
+func (nuo *NotificationUpdateOne) SetActorID(s string) *NotificationUpdateOne {
+	nuo.mutation.SetActorID(s)
+	return nuo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableActorID(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetActorID(*s)
+	}
+	return nuo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (nuo *NotificationUpdateOne) ClearActorID() *NotificationUpdateOne {
+	nuo.mutation.ClearActorID()
+	return nuo
+}
+
+// SetSubject sets the "subject" field.
+func (nuo *NotificationUpdateOne) SetSubject(s string) *NotificationUpdateOne {
+	nuo.mutation.SetSubject(s)
+	return nuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableSubject(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetSubject(*s)
+	}
+	return nuo
+}
+
+// SetLink sets the "link" field.
+func (nuo *NotificationUpdateOne) SetLink(s string) *NotificationUpdateOne {
+	nuo.mutation.SetLink(s)
+	return nuo
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableLink(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetLink(*s)
+	}
+	return nuo
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (nuo *NotificationUpdateOne) SetDedupeKey(s string) *NotificationUpdateOne {
+	nuo.mutation.SetDedupeKey(s)
+	return nuo
+}
+
+// SetNillableDedupeKey sets the "dedupe_key" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableDedupeKey(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetDedupeKey(*s)
+	}
+	return nuo
+}
+
+// ClearDedupeKey clears the value of the "dedupe_key" field.
+func (nuo *NotificationUpdateOne) ClearDedupeKey() *NotificationUpdateOne {
+	nuo.mutation.ClearDedupeKey()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// SetRecipientID sets the "recipient" edge to the Member entity by ID.
+func (nuo *NotificationUpdateOne) SetRecipientID(id int) *NotificationUpdateOne {
+	nuo.mutation.SetRecipientID(id)
+	return nuo
+}
+
+// SetRecipient sets the "recipient" edge to the Member entity.
+func (nuo *NotificationUpdateOne) SetRecipient(m *Member) *NotificationUpdateOne {
+	return nuo.SetRecipientID(m.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// ClearRecipient clears the "recipient" edge to the Member entity.
+func (nuo *NotificationUpdateOne) ClearRecipient() *NotificationUpdateOne {
+	nuo.mutation.ClearRecipient()
+	return nuo
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if nuo.mutation.RecipientCleared() && len(nuo.mutation.RecipientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.recipient"`)
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.TeamID(); ok {
+		_spec.SetField(notification.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedTeamID(); ok {
+		_spec.AddField(notification.FieldTeamID, field.TypeInt, value)
+	}
+	if nuo.mutation.TeamIDCleared() {
+		_spec.ClearField(notification.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := nuo.mutation.ActorID(); ok {
+		_spec.SetField(notification.FieldActorID, field.TypeString, value)
+	}
+	if nuo.mutation.ActorIDCleared() {
+		_spec.ClearField(notification.FieldActorID, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Subject(); ok {
+		_spec.SetField(notification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Link(); ok {
+		_spec.SetField(notification.FieldLink, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.DedupeKey(); ok {
+		_spec.SetField(notification.FieldDedupeKey, field.TypeString, value)
+	}
+	if nuo.mutation.DedupeKeyCleared() {
+		_spec.ClearField(notification.FieldDedupeKey, field.TypeString)
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nuo.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/outboxmessage"
+	"backend_golang/internal/models"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OutboxMessage is the model entity for the OutboxMessage schema.
+type OutboxMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type models.DomainEventType `json:"type,omitempty"`
+	// AggregateID holds the value of the "aggregate_id" field.
+	AggregateID int `json:"aggregate_id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int `json:"team_id,omitempty"`
+	// MemberID holds the value of the "member_id" field.
+	MemberID string `json:"member_id,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// DispatchedAt holds the value of the "dispatched_at" field.
+	DispatchedAt *time.Time `json:"dispatched_at,omitempty"`
+	// AbandonedAt holds the value of the "abandoned_at" field.
+	AbandonedAt *time.Time `json:"abandoned_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxmessage.FieldID, outboxmessage.FieldAggregateID, outboxmessage.FieldTeamID, outboxmessage.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case outboxmessage.FieldType, outboxmessage.FieldMemberID, outboxmessage.FieldLastError:
+			values[i] = new(sql.NullString)
+		case outboxmessage.FieldNextAttemptAt, outboxmessage.FieldDispatchedAt, outboxmessage.FieldAbandonedAt, outboxmessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxMessage fields.
+func (om *OutboxMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxmessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			om.ID = int(value.Int64)
+		case outboxmessage.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				om.Type = models.DomainEventType(value.String)
+			}
+		case outboxmessage.FieldAggregateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field aggregate_id", values[i])
+			} else if value.Valid {
+				om.AggregateID = int(value.Int64)
+			}
+		case outboxmessage.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				om.TeamID = int(value.Int64)
+			}
+		case outboxmessage.FieldMemberID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value.Valid {
+				om.MemberID = value.String
+			}
+		case outboxmessage.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				om.Attempts = int(value.Int64)
+			}
+		case outboxmessage.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				om.LastError = value.String
+			}
+		case outboxmessage.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				om.NextAttemptAt = value.Time
+			}
+		case outboxmessage.FieldDispatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dispatched_at", values[i])
+			} else if value.Valid {
+				om.DispatchedAt = new(time.Time)
+				*om.DispatchedAt = value.Time
+			}
+		case outboxmessage.FieldAbandonedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field abandoned_at", values[i])
+			} else if value.Valid {
+				om.AbandonedAt = new(time.Time)
+				*om.AbandonedAt = value.Time
+			}
+		case outboxmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				om.CreatedAt = value.Time
+			}
+		default:
+			om.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxMessage.
+// This includes values selected through modifiers, order, etc.
+func (om *OutboxMessage) Value(name string) (ent.Value, error) {
+	return om.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxMessage.
+// Note that you need to call OutboxMessage.Unwrap() before calling this method if this OutboxMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (om *OutboxMessage) Update() *OutboxMessageUpdateOne {
+	return NewOutboxMessageClient(om.config).UpdateOne(om)
+}
+
+// Unwrap unwraps the OutboxMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (om *OutboxMessage) Unwrap() *OutboxMessage {
+	_tx, ok := om.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxMessage is not a transactional entity")
+	}
+	om.config.driver = _tx.drv
+	return om
+}
+
+// String implements the fmt.Stringer.
+func (om *OutboxMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", om.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", om.Type))
+	builder.WriteString(", ")
+	builder.WriteString("aggregate_id=")
+	builder.WriteString(fmt.Sprintf("%v", om.AggregateID))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", om.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(om.MemberID)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", om.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(om.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(om.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := om.DispatchedAt; v != nil {
+		builder.WriteString("dispatched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := om.AbandonedAt; v != nil {
+		builder.WriteString("abandoned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(om.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxMessages is a parsable slice of OutboxMessage.
+type OutboxMessages []*OutboxMessage
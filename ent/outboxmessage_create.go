@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/outboxmessage"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxMessageCreate is the builder for creating a OutboxMessage entity.
+type OutboxMessageCreate struct {
+	config
+	mutation *OutboxMessageMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (omc *OutboxMessageCreate) SetType(met models.DomainEventType) *OutboxMessageCreate {
+	omc.mutation.SetType(met)
+	return omc
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (omc *OutboxMessageCreate) SetAggregateID(i int) *OutboxMessageCreate {
+	omc.mutation.SetAggregateID(i)
+	return omc
+}
+
+// SetTeamID sets the "team_id" field.
+func (omc *OutboxMessageCreate) SetTeamID(i int) *OutboxMessageCreate {
+	omc.mutation.SetTeamID(i)
+	return omc
+}
+
+// SetMemberID sets the "member_id" field.
+func (omc *OutboxMessageCreate) SetMemberID(s string) *OutboxMessageCreate {
+	omc.mutation.SetMemberID(s)
+	return omc
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableMemberID(s *string) *OutboxMessageCreate {
+	if s != nil {
+		omc.SetMemberID(*s)
+	}
+	return omc
+}
+
+// SetAttempts sets the "attempts" field.
+func (omc *OutboxMessageCreate) SetAttempts(i int) *OutboxMessageCreate {
+	omc.mutation.SetAttempts(i)
+	return omc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableAttempts(i *int) *OutboxMessageCreate {
+	if i != nil {
+		omc.SetAttempts(*i)
+	}
+	return omc
+}
+
+// SetLastError sets the "last_error" field.
+func (omc *OutboxMessageCreate) SetLastError(s string) *OutboxMessageCreate {
+	omc.mutation.SetLastError(s)
+	return omc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableLastError(s *string) *OutboxMessageCreate {
+	if s != nil {
+		omc.SetLastError(*s)
+	}
+	return omc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (omc *OutboxMessageCreate) SetNextAttemptAt(t time.Time) *OutboxMessageCreate {
+	omc.mutation.SetNextAttemptAt(t)
+	return omc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableNextAttemptAt(t *time.Time) *OutboxMessageCreate {
+	if t != nil {
+		omc.SetNextAttemptAt(*t)
+	}
+	return omc
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (omc *OutboxMessageCreate) SetDispatchedAt(t time.Time) *OutboxMessageCreate {
+	omc.mutation.SetDispatchedAt(t)
+	return omc
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableDispatchedAt(t *time.Time) *OutboxMessageCreate {
+	if t != nil {
+		omc.SetDispatchedAt(*t)
+	}
+	return omc
+}
+
+// SetAbandonedAt sets the "abandoned_at" field.
+func (omc *OutboxMessageCreate) SetAbandonedAt(t time.Time) *OutboxMessageCreate {
+	omc.mutation.SetAbandonedAt(t)
+	return omc
+}
+
+// SetNillableAbandonedAt sets the "abandoned_at" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableAbandonedAt(t *time.Time) *OutboxMessageCreate {
+	if t != nil {
+		omc.SetAbandonedAt(*t)
+	}
+	return omc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (omc *OutboxMessageCreate) SetCreatedAt(t time.Time) *OutboxMessageCreate {
+	omc.mutation.SetCreatedAt(t)
+	return omc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (omc *OutboxMessageCreate) SetNillableCreatedAt(t *time.Time) *OutboxMessageCreate {
+	if t != nil {
+		omc.SetCreatedAt(*t)
+	}
+	return omc
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omc *OutboxMessageCreate) Mutation() *OutboxMessageMutation {
+	return omc.mutation
+}
+
+// Save creates the OutboxMessage in the database.
+func (omc *OutboxMessageCreate) Save(ctx context.Context) (*OutboxMessage, error) {
+	omc.defaults()
+	return withHooks(ctx, omc.sqlSave, omc.mutation, omc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (omc *OutboxMessageCreate) SaveX(ctx context.Context) *OutboxMessage {
+	v, err := omc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (omc *OutboxMessageCreate) Exec(ctx context.Context) error {
+	_, err := omc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omc *OutboxMessageCreate) ExecX(ctx context.Context) {
+	if err := omc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (omc *OutboxMessageCreate) defaults() {
+	if _, ok := omc.mutation.MemberID(); !ok {
+		v := outboxmessage.DefaultMemberID
+		omc.mutation.SetMemberID(v)
+	}
+	if _, ok := omc.mutation.Attempts(); !ok {
+		v := outboxmessage.DefaultAttempts
+		omc.mutation.SetAttempts(v)
+	}
+	if _, ok := omc.mutation.LastError(); !ok {
+		v := outboxmessage.DefaultLastError
+		omc.mutation.SetLastError(v)
+	}
+	if _, ok := omc.mutation.NextAttemptAt(); !ok {
+		v := outboxmessage.DefaultNextAttemptAt()
+		omc.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := omc.mutation.CreatedAt(); !ok {
+		v := outboxmessage.DefaultCreatedAt()
+		omc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omc *OutboxMessageCreate) check() error {
+	if _, ok := omc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "OutboxMessage.type"`)}
+	}
+	if v, ok := omc.mutation.GetType(); ok {
+		if err := outboxmessage.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OutboxMessage.type": %w`, err)}
+		}
+	}
+	if _, ok := omc.mutation.AggregateID(); !ok {
+		return &ValidationError{Name: "aggregate_id", err: errors.New(`ent: missing required field "OutboxMessage.aggregate_id"`)}
+	}
+	if _, ok := omc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "OutboxMessage.team_id"`)}
+	}
+	if _, ok := omc.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "OutboxMessage.member_id"`)}
+	}
+	if _, ok := omc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxMessage.attempts"`)}
+	}
+	if _, ok := omc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "OutboxMessage.last_error"`)}
+	}
+	if _, ok := omc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "OutboxMessage.next_attempt_at"`)}
+	}
+	if _, ok := omc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxMessage.created_at"`)}
+	}
+	return nil
+}
+
+func (omc *OutboxMessageCreate) sqlSave(ctx context.Context) (*OutboxMessage, error) {
+	if err := omc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := omc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, omc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	omc.mutation.id = &_node.ID
+	omc.mutation.done = true
+	return _node, nil
+}
+
+func (omc *OutboxMessageCreate) createSpec() (*OutboxMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxMessage{config: omc.config}
+		_spec = sqlgraph.NewCreateSpec(outboxmessage.Table, sqlgraph.NewFieldSpec(outboxmessage.FieldID, field.TypeInt))
+	)
+	if value, ok := omc.mutation.GetType(); ok {
+		_spec.SetField(outboxmessage.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := omc.mutation.AggregateID(); ok {
+		_spec.SetField(outboxmessage.FieldAggregateID, field.TypeInt, value)
+		_node.AggregateID = value
+	}
+	if value, ok := omc.mutation.TeamID(); ok {
+		_spec.SetField(outboxmessage.FieldTeamID, field.TypeInt, value)
+		_node.TeamID = value
+	}
+	if value, ok := omc.mutation.MemberID(); ok {
+		_spec.SetField(outboxmessage.FieldMemberID, field.TypeString, value)
+		_node.MemberID = value
+	}
+	if value, ok := omc.mutation.Attempts(); ok {
+		_spec.SetField(outboxmessage.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := omc.mutation.LastError(); ok {
+		_spec.SetField(outboxmessage.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := omc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxmessage.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := omc.mutation.DispatchedAt(); ok {
+		_spec.SetField(outboxmessage.FieldDispatchedAt, field.TypeTime, value)
+		_node.DispatchedAt = &value
+	}
+	if value, ok := omc.mutation.AbandonedAt(); ok {
+		_spec.SetField(outboxmessage.FieldAbandonedAt, field.TypeTime, value)
+		_node.AbandonedAt = &value
+	}
+	if value, ok := omc.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OutboxMessageCreateBulk is the builder for creating many OutboxMessage entities in bulk.
+type OutboxMessageCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxMessageCreate
+}
+
+// Save creates the OutboxMessage entities in the database.
+func (omcb *OutboxMessageCreateBulk) Save(ctx context.Context) ([]*OutboxMessage, error) {
+	if omcb.err != nil {
+		return nil, omcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(omcb.builders))
+	nodes := make([]*OutboxMessage, len(omcb.builders))
+	mutators := make([]Mutator, len(omcb.builders))
+	for i := range omcb.builders {
+		func(i int, root context.Context) {
+			builder := omcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, omcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, omcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, omcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omcb *OutboxMessageCreateBulk) SaveX(ctx context.Context) []*OutboxMessage {
+	v, err := omcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (omcb *OutboxMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := omcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omcb *OutboxMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := omcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/outboxmessage"
+	"backend_golang/ent/predicate"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxMessageUpdate is the builder for updating OutboxMessage entities.
+type OutboxMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxMessageMutation
+}
+
+// Where appends a list predicates to the OutboxMessageUpdate builder.
+func (omu *OutboxMessageUpdate) Where(ps ...predicate.OutboxMessage) *OutboxMessageUpdate {
+	omu.mutation.Where(ps...)
+	return omu
+}
+
+// SetType sets the "type" field.
+func (omu *OutboxMessageUpdate) SetType(met models.DomainEventType) *OutboxMessageUpdate {
+	omu.mutation.SetType(met)
+	return omu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableType(met *models.DomainEventType) *OutboxMessageUpdate {
+	if met != nil {
+		omu.SetType(*met)
+	}
+	return omu
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (omu *OutboxMessageUpdate) SetAggregateID(i int) *OutboxMessageUpdate {
+	omu.mutation.ResetAggregateID()
+	omu.mutation.SetAggregateID(i)
+	return omu
+}
+
+// SetNillableAggregateID sets the "aggregate_id" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableAggregateID(i *int) *OutboxMessageUpdate {
+	if i != nil {
+		omu.SetAggregateID(*i)
+	}
+	return omu
+}
+
+// AddAggregateID adds i to the "aggregate_id" field.
+func (omu *OutboxMessageUpdate) AddAggregateID(i int) *OutboxMessageUpdate {
+	omu.mutation.AddAggregateID(i)
+	return omu
+}
+
+// SetTeamID sets the "team_id" field.
+func (omu *OutboxMessageUpdate) SetTeamID(i int) *OutboxMessageUpdate {
+	omu.mutation.ResetTeamID()
+	omu.mutation.SetTeamID(i)
+	return omu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableTeamID(i *int) *OutboxMessageUpdate {
+	if i != nil {
+		omu.SetTeamID(*i)
+	}
+	return omu
+}
+
+// AddTeamID adds i to the "team_id" field.
+func (omu *OutboxMessageUpdate) AddTeamID(i int) *OutboxMessageUpdate {
+	omu.mutation.AddTeamID(i)
+	return omu
+}
+
+// SetMemberID sets the "member_id" field.
+func (omu *OutboxMessageUpdate) SetMemberID(s string) *OutboxMessageUpdate {
+	omu.mutation.SetMemberID(s)
+	return omu
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableMemberID(s *string) *OutboxMessageUpdate {
+	if s != nil {
+		omu.SetMemberID(*s)
+	}
+	return omu
+}
+
+// SetAttempts sets the "attempts" field.
+func (omu *OutboxMessageUpdate) SetAttempts(i int) *OutboxMessageUpdate {
+	omu.mutation.ResetAttempts()
+	omu.mutation.SetAttempts(i)
+	return omu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableAttempts(i *int) *OutboxMessageUpdate {
+	if i != nil {
+		omu.SetAttempts(*i)
+	}
+	return omu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (omu *OutboxMessageUpdate) AddAttempts(i int) *OutboxMessageUpdate {
+	omu.mutation.AddAttempts(i)
+	return omu
+}
+
+// SetLastError sets the "last_error" field.
+func (omu *OutboxMessageUpdate) SetLastError(s string) *OutboxMessageUpdate {
+	omu.mutation.SetLastError(s)
+	return omu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableLastError(s *string) *OutboxMessageUpdate {
+	if s != nil {
+		omu.SetLastError(*s)
+	}
+	return omu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (omu *OutboxMessageUpdate) SetNextAttemptAt(t time.Time) *OutboxMessageUpdate {
+	omu.mutation.SetNextAttemptAt(t)
+	return omu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableNextAttemptAt(t *time.Time) *OutboxMessageUpdate {
+	if t != nil {
+		omu.SetNextAttemptAt(*t)
+	}
+	return omu
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (omu *OutboxMessageUpdate) SetDispatchedAt(t time.Time) *OutboxMessageUpdate {
+	omu.mutation.SetDispatchedAt(t)
+	return omu
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableDispatchedAt(t *time.Time) *OutboxMessageUpdate {
+	if t != nil {
+		omu.SetDispatchedAt(*t)
+	}
+	return omu
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (omu *OutboxMessageUpdate) ClearDispatchedAt() *OutboxMessageUpdate {
+	omu.mutation.ClearDispatchedAt()
+	return omu
+}
+
+// SetAbandonedAt sets the "abandoned_at" field.
+func (omu *OutboxMessageUpdate) SetAbandonedAt(t time.Time) *OutboxMessageUpdate {
+	omu.mutation.SetAbandonedAt(t)
+	return omu
+}
+
+// SetNillableAbandonedAt sets the "abandoned_at" field if the given value is not nil.
+func (omu *OutboxMessageUpdate) SetNillableAbandonedAt(t *time.Time) *OutboxMessageUpdate {
+	if t != nil {
+		omu.SetAbandonedAt(*t)
+	}
+	return omu
+}
+
+// ClearAbandonedAt clears the value of the "abandoned_at" field.
+func (omu *OutboxMessageUpdate) ClearAbandonedAt() *OutboxMessageUpdate {
+	omu.mutation.ClearAbandonedAt()
+	return omu
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omu *OutboxMessageUpdate) Mutation() *OutboxMessageMutation {
+	return omu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (omu *OutboxMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, omu.sqlSave, omu.mutation, omu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omu *OutboxMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := omu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (omu *OutboxMessageUpdate) Exec(ctx context.Context) error {
+	_, err := omu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omu *OutboxMessageUpdate) ExecX(ctx context.Context) {
+	if err := omu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omu *OutboxMessageUpdate) check() error {
+	if v, ok := omu.mutation.GetType(); ok {
+		if err := outboxmessage.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OutboxMessage.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (omu *OutboxMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := omu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxmessage.Table, outboxmessage.Columns, sqlgraph.NewFieldSpec(outboxmessage.FieldID, field.TypeInt))
+	if ps := omu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := omu.mutation.GetType(); ok {
+		_spec.SetField(outboxmessage.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := omu.mutation.AggregateID(); ok {
+		_spec.SetField(outboxmessage.FieldAggregateID, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedAggregateID(); ok {
+		_spec.AddField(outboxmessage.FieldAggregateID, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.TeamID(); ok {
+		_spec.SetField(outboxmessage.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedTeamID(); ok {
+		_spec.AddField(outboxmessage.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.MemberID(); ok {
+		_spec.SetField(outboxmessage.FieldMemberID, field.TypeString, value)
+	}
+	if value, ok := omu.mutation.Attempts(); ok {
+		_spec.SetField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.LastError(); ok {
+		_spec.SetField(outboxmessage.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := omu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxmessage.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := omu.mutation.DispatchedAt(); ok {
+		_spec.SetField(outboxmessage.FieldDispatchedAt, field.TypeTime, value)
+	}
+	if omu.mutation.DispatchedAtCleared() {
+		_spec.ClearField(outboxmessage.FieldDispatchedAt, field.TypeTime)
+	}
+	if value, ok := omu.mutation.AbandonedAt(); ok {
+		_spec.SetField(outboxmessage.FieldAbandonedAt, field.TypeTime, value)
+	}
+	if omu.mutation.AbandonedAtCleared() {
+		_spec.ClearField(outboxmessage.FieldAbandonedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, omu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	omu.mutation.done = true
+	return n, nil
+}
+
+// OutboxMessageUpdateOne is the builder for updating a single OutboxMessage entity.
+type OutboxMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxMessageMutation
+}
+
+// SetType sets the "type" field.
+func (omuo *OutboxMessageUpdateOne) SetType(met models.DomainEventType) *OutboxMessageUpdateOne {
+	omuo.mutation.SetType(met)
+	return omuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableType(met *models.DomainEventType) *OutboxMessageUpdateOne {
+	if met != nil {
+		omuo.SetType(*met)
+	}
+	return omuo
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (omuo *OutboxMessageUpdateOne) SetAggregateID(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.ResetAggregateID()
+	omuo.mutation.SetAggregateID(i)
+	return omuo
+}
+
+// SetNillableAggregateID sets the "aggregate_id" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableAggregateID(i *int) *OutboxMessageUpdateOne {
+	if i != nil {
+		omuo.SetAggregateID(*i)
+	}
+	return omuo
+}
+
+// AddAggregateID adds i to the "aggregate_id" field.
+func (omuo *OutboxMessageUpdateOne) AddAggregateID(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.AddAggregateID(i)
+	return omuo
+}
+
+// SetTeamID sets the "team_id" field.
+func (omuo *OutboxMessageUpdateOne) SetTeamID(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.ResetTeamID()
+	omuo.mutation.SetTeamID(i)
+	return omuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableTeamID(i *int) *OutboxMessageUpdateOne {
+	if i != nil {
+		omuo.SetTeamID(*i)
+	}
+	return omuo
+}
+
+// AddTeamID adds i to the "team_id" field.
+func (omuo *OutboxMessageUpdateOne) AddTeamID(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.AddTeamID(i)
+	return omuo
+}
+
+// SetMemberID sets the "member_id" field.
+func (omuo *OutboxMessageUpdateOne) SetMemberID(s string) *OutboxMessageUpdateOne {
+	omuo.mutation.SetMemberID(s)
+	return omuo
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableMemberID(s *string) *OutboxMessageUpdateOne {
+	if s != nil {
+		omuo.SetMemberID(*s)
+	}
+	return omuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (omuo *OutboxMessageUpdateOne) SetAttempts(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.ResetAttempts()
+	omuo.mutation.SetAttempts(i)
+	return omuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableAttempts(i *int) *OutboxMessageUpdateOne {
+	if i != nil {
+		omuo.SetAttempts(*i)
+	}
+	return omuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (omuo *OutboxMessageUpdateOne) AddAttempts(i int) *OutboxMessageUpdateOne {
+	omuo.mutation.AddAttempts(i)
+	return omuo
+}
+
+// SetLastError sets the "last_error" field.
+func (omuo *OutboxMessageUpdateOne) SetLastError(s string) *OutboxMessageUpdateOne {
+	omuo.mutation.SetLastError(s)
+	return omuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableLastError(s *string) *OutboxMessageUpdateOne {
+	if s != nil {
+		omuo.SetLastError(*s)
+	}
+	return omuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (omuo *OutboxMessageUpdateOne) SetNextAttemptAt(t time.Time) *OutboxMessageUpdateOne {
+	omuo.mutation.SetNextAttemptAt(t)
+	return omuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableNextAttemptAt(t *time.Time) *OutboxMessageUpdateOne {
+	if t != nil {
+		omuo.SetNextAttemptAt(*t)
+	}
+	return omuo
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (omuo *OutboxMessageUpdateOne) SetDispatchedAt(t time.Time) *OutboxMessageUpdateOne {
+	omuo.mutation.SetDispatchedAt(t)
+	return omuo
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableDispatchedAt(t *time.Time) *OutboxMessageUpdateOne {
+	if t != nil {
+		omuo.SetDispatchedAt(*t)
+	}
+	return omuo
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (omuo *OutboxMessageUpdateOne) ClearDispatchedAt() *OutboxMessageUpdateOne {
+	omuo.mutation.ClearDispatchedAt()
+	return omuo
+}
+
+// SetAbandonedAt sets the "abandoned_at" field.
+func (omuo *OutboxMessageUpdateOne) SetAbandonedAt(t time.Time) *OutboxMessageUpdateOne {
+	omuo.mutation.SetAbandonedAt(t)
+	return omuo
+}
+
+// SetNillableAbandonedAt sets the "abandoned_at" field if the given value is not nil.
+func (omuo *OutboxMessageUpdateOne) SetNillableAbandonedAt(t *time.Time) *OutboxMessageUpdateOne {
+	if t != nil {
+		omuo.SetAbandonedAt(*t)
+	}
+	return omuo
+}
+
+// ClearAbandonedAt clears the value of the "abandoned_at" field.
+func (omuo *OutboxMessageUpdateOne) ClearAbandonedAt() *OutboxMessageUpdateOne {
+	omuo.mutation.ClearAbandonedAt()
+	return omuo
+}
+
+// Mutation returns the OutboxMessageMutation object of the builder.
+func (omuo *OutboxMessageUpdateOne) Mutation() *OutboxMessageMutation {
+	return omuo.mutation
+}
+
+// Where appends a list predicates to the OutboxMessageUpdate builder.
+func (omuo *OutboxMessageUpdateOne) Where(ps ...predicate.OutboxMessage) *OutboxMessageUpdateOne {
+	omuo.mutation.Where(ps...)
+	return omuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (omuo *OutboxMessageUpdateOne) Select(field string, fields ...string) *OutboxMessageUpdateOne {
+	omuo.fields = append([]string{field}, fields...)
+	return omuo
+}
+
+// Save executes the query and returns the updated OutboxMessage entity.
+func (omuo *OutboxMessageUpdateOne) Save(ctx context.Context) (*OutboxMessage, error) {
+	return withHooks(ctx, omuo.sqlSave, omuo.mutation, omuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omuo *OutboxMessageUpdateOne) SaveX(ctx context.Context) *OutboxMessage {
+	node, err := omuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (omuo *OutboxMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := omuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omuo *OutboxMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := omuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omuo *OutboxMessageUpdateOne) check() error {
+	if v, ok := omuo.mutation.GetType(); ok {
+		if err := outboxmessage.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OutboxMessage.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (omuo *OutboxMessageUpdateOne) sqlSave(ctx context.Context) (_node *OutboxMessage, err error) {
+	if err := omuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxmessage.Table, outboxmessage.Columns, sqlgraph.NewFieldSpec(outboxmessage.FieldID, field.TypeInt))
+	id, ok := omuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := omuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxmessage.FieldID)
+		for _, f := range fields {
+			if !outboxmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := omuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := omuo.mutation.GetType(); ok {
+		_spec.SetField(outboxmessage.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := omuo.mutation.AggregateID(); ok {
+		_spec.SetField(outboxmessage.FieldAggregateID, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedAggregateID(); ok {
+		_spec.AddField(outboxmessage.FieldAggregateID, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.TeamID(); ok {
+		_spec.SetField(outboxmessage.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedTeamID(); ok {
+		_spec.AddField(outboxmessage.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.MemberID(); ok {
+		_spec.SetField(outboxmessage.FieldMemberID, field.TypeString, value)
+	}
+	if value, ok := omuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.LastError(); ok {
+		_spec.SetField(outboxmessage.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := omuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxmessage.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := omuo.mutation.DispatchedAt(); ok {
+		_spec.SetField(outboxmessage.FieldDispatchedAt, field.TypeTime, value)
+	}
+	if omuo.mutation.DispatchedAtCleared() {
+		_spec.ClearField(outboxmessage.FieldDispatchedAt, field.TypeTime)
+	}
+	if value, ok := omuo.mutation.AbandonedAt(); ok {
+		_spec.SetField(outboxmessage.FieldAbandonedAt, field.TypeTime, value)
+	}
+	if omuo.mutation.AbandonedAtCleared() {
+		_spec.ClearField(outboxmessage.FieldAbandonedAt, field.TypeTime)
+	}
+	_node = &OutboxMessage{config: omuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, omuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	omuo.mutation.done = true
+	return _node, nil
+}
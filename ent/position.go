@@ -5,6 +5,7 @@ package ent
 import (
 	"backend_golang/ent/position"
 	"backend_golang/ent/team"
+	"backend_golang/internal/models"
 	"fmt"
 	"strings"
 
@@ -20,7 +21,7 @@ type Position struct {
 	// TeamID holds the value of the "team_id" field.
 	TeamID int `json:"team_id,omitempty"`
 	// Role holds the value of the "role" field.
-	Role string `json:"role,omitempty"`
+	Role models.Role `json:"role,omitempty"`
 	// Vacancy holds the value of the "vacancy" field.
 	Vacancy int8 `json:"vacancy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -33,9 +34,13 @@ type Position struct {
 type PositionEdges struct {
 	// Team holds the value of the team edge.
 	Team *Team `json:"team,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*Member `json:"members,omitempty"`
+	// Waitlist holds the value of the waitlist edge.
+	Waitlist []*WaitlistEntry `json:"waitlist,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // TeamOrErr returns the Team value or an error if the edge
@@ -49,6 +54,24 @@ func (e PositionEdges) TeamOrErr() (*Team, error) {
 	return nil, &NotLoadedError{edge: "team"}
 }
 
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e PositionEdges) MembersOrErr() ([]*Member, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// WaitlistOrErr returns the Waitlist value or an error if the edge
+// was not loaded in eager-loading.
+func (e PositionEdges) WaitlistOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[2] {
+		return e.Waitlist, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Position) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -89,7 +112,7 @@ func (po *Position) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field role", values[i])
 			} else if value.Valid {
-				po.Role = value.String
+				po.Role = models.Role(value.String)
 			}
 		case position.FieldVacancy:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -115,6 +138,16 @@ func (po *Position) QueryTeam() *TeamQuery {
 	return NewPositionClient(po.config).QueryTeam(po)
 }
 
+// QueryMembers queries the "members" edge of the Position entity.
+func (po *Position) QueryMembers() *MemberQuery {
+	return NewPositionClient(po.config).QueryMembers(po)
+}
+
+// QueryWaitlist queries the "waitlist" edge of the Position entity.
+func (po *Position) QueryWaitlist() *WaitlistEntryQuery {
+	return NewPositionClient(po.config).QueryWaitlist(po)
+}
+
 // Update returns a builder for updating this Position.
 // Note that you need to call Position.Unwrap() before calling this method if this Position
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -142,7 +175,7 @@ func (po *Position) String() string {
 	builder.WriteString(fmt.Sprintf("%v", po.TeamID))
 	builder.WriteString(", ")
 	builder.WriteString("role=")
-	builder.WriteString(po.Role)
+	builder.WriteString(fmt.Sprintf("%v", po.Role))
 	builder.WriteString(", ")
 	builder.WriteString("vacancy=")
 	builder.WriteString(fmt.Sprintf("%v", po.Vacancy))
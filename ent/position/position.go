@@ -3,6 +3,9 @@
 package position
 
 import (
+	"backend_golang/internal/models"
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,6 +23,10 @@ const (
 	FieldVacancy = "vacancy"
 	// EdgeTeam holds the string denoting the team edge name in mutations.
 	EdgeTeam = "team"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeWaitlist holds the string denoting the waitlist edge name in mutations.
+	EdgeWaitlist = "waitlist"
 	// Table holds the table name of the position in the database.
 	Table = "positions"
 	// TeamTable is the table that holds the team relation/edge.
@@ -29,6 +36,20 @@ const (
 	TeamInverseTable = "teams"
 	// TeamColumn is the table column denoting the team relation/edge.
 	TeamColumn = "team_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "members"
+	// MembersInverseTable is the table name for the Member entity.
+	// It exists in this package in order to avoid circular dependency with the "member" package.
+	MembersInverseTable = "members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "position_members"
+	// WaitlistTable is the table that holds the waitlist relation/edge.
+	WaitlistTable = "waitlist_entries"
+	// WaitlistInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistInverseTable = "waitlist_entries"
+	// WaitlistColumn is the table column denoting the waitlist relation/edge.
+	WaitlistColumn = "position_id"
 )
 
 // Columns holds all SQL columns for position fields.
@@ -49,6 +70,16 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r models.Role) error {
+	switch r {
+	case "FRONTEND", "BACKEND", "INFRA", "DESIGNER", "MANAGER", "FULLSTACK", "MOBILE":
+		return nil
+	default:
+		return fmt.Errorf("position: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the Position queries.
 type OrderOption func(*sql.Selector)
 
@@ -78,6 +109,34 @@ func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWaitlistCount orders the results by waitlist count.
+func ByWaitlistCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistStep(), opts...)
+	}
+}
+
+// ByWaitlist orders the results by waitlist terms.
+func ByWaitlist(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTeamStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -85,3 +144,17 @@ func newTeamStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
 	)
 }
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
+func newWaitlistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistTable, WaitlistColumn),
+	)
+}
@@ -4,6 +4,7 @@ package position
 
 import (
 	"backend_golang/ent/predicate"
+	"backend_golang/internal/models"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -59,11 +60,6 @@ func TeamID(v int) predicate.Position {
 	return predicate.Position(sql.FieldEQ(FieldTeamID, v))
 }
 
-// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
-func Role(v string) predicate.Position {
-	return predicate.Position(sql.FieldEQ(FieldRole, v))
-}
-
 // Vacancy applies equality check predicate on the "vacancy" field. It's identical to VacancyEQ.
 func Vacancy(v int8) predicate.Position {
 	return predicate.Position(sql.FieldEQ(FieldVacancy, v))
@@ -100,68 +96,33 @@ func TeamIDNotNil() predicate.Position {
 }
 
 // RoleEQ applies the EQ predicate on the "role" field.
-func RoleEQ(v string) predicate.Position {
-	return predicate.Position(sql.FieldEQ(FieldRole, v))
+func RoleEQ(v models.Role) predicate.Position {
+	vc := v
+	return predicate.Position(sql.FieldEQ(FieldRole, vc))
 }
 
 // RoleNEQ applies the NEQ predicate on the "role" field.
-func RoleNEQ(v string) predicate.Position {
-	return predicate.Position(sql.FieldNEQ(FieldRole, v))
+func RoleNEQ(v models.Role) predicate.Position {
+	vc := v
+	return predicate.Position(sql.FieldNEQ(FieldRole, vc))
 }
 
 // RoleIn applies the In predicate on the "role" field.
-func RoleIn(vs ...string) predicate.Position {
-	return predicate.Position(sql.FieldIn(FieldRole, vs...))
+func RoleIn(vs ...models.Role) predicate.Position {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Position(sql.FieldIn(FieldRole, v...))
 }
 
 // RoleNotIn applies the NotIn predicate on the "role" field.
-func RoleNotIn(vs ...string) predicate.Position {
-	return predicate.Position(sql.FieldNotIn(FieldRole, vs...))
-}
-
-// RoleGT applies the GT predicate on the "role" field.
-func RoleGT(v string) predicate.Position {
-	return predicate.Position(sql.FieldGT(FieldRole, v))
-}
-
-// RoleGTE applies the GTE predicate on the "role" field.
-func RoleGTE(v string) predicate.Position {
-	return predicate.Position(sql.FieldGTE(FieldRole, v))
-}
-
-// RoleLT applies the LT predicate on the "role" field.
-func RoleLT(v string) predicate.Position {
-	return predicate.Position(sql.FieldLT(FieldRole, v))
-}
-
-// RoleLTE applies the LTE predicate on the "role" field.
-func RoleLTE(v string) predicate.Position {
-	return predicate.Position(sql.FieldLTE(FieldRole, v))
-}
-
-// RoleContains applies the Contains predicate on the "role" field.
-func RoleContains(v string) predicate.Position {
-	return predicate.Position(sql.FieldContains(FieldRole, v))
-}
-
-// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
-func RoleHasPrefix(v string) predicate.Position {
-	return predicate.Position(sql.FieldHasPrefix(FieldRole, v))
-}
-
-// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
-func RoleHasSuffix(v string) predicate.Position {
-	return predicate.Position(sql.FieldHasSuffix(FieldRole, v))
-}
-
-// RoleEqualFold applies the EqualFold predicate on the "role" field.
-func RoleEqualFold(v string) predicate.Position {
-	return predicate.Position(sql.FieldEqualFold(FieldRole, v))
-}
-
-// RoleContainsFold applies the ContainsFold predicate on the "role" field.
-func RoleContainsFold(v string) predicate.Position {
-	return predicate.Position(sql.FieldContainsFold(FieldRole, v))
+func RoleNotIn(vs ...models.Role) predicate.Position {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Position(sql.FieldNotIn(FieldRole, v...))
 }
 
 // VacancyEQ applies the EQ predicate on the "vacancy" field.
@@ -227,6 +188,52 @@ func HasTeamWith(preds ...predicate.Team) predicate.Position {
 	})
 }
 
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.Member) predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlist applies the HasEdge predicate on the "waitlist" edge.
+func HasWaitlist() predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistTable, WaitlistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistWith applies the HasEdge predicate on the "waitlist" edge with a given conditions (other predicates).
+func HasWaitlistWith(preds ...predicate.WaitlistEntry) predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := newWaitlistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Position) predicate.Position {
 	return predicate.Position(sql.AndPredicates(predicates...))
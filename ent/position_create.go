@@ -3,8 +3,11 @@
 package ent
 
 import (
+	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/team"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/models"
 	"context"
 	"errors"
 	"fmt"
@@ -35,8 +38,8 @@ func (pc *PositionCreate) SetNillableTeamID(i *int) *PositionCreate {
 }
 
 // SetRole sets the "role" field.
-func (pc *PositionCreate) SetRole(s string) *PositionCreate {
-	pc.mutation.SetRole(s)
+func (pc *PositionCreate) SetRole(m models.Role) *PositionCreate {
+	pc.mutation.SetRole(m)
 	return pc
 }
 
@@ -51,6 +54,36 @@ func (pc *PositionCreate) SetTeam(t *Team) *PositionCreate {
 	return pc.SetTeamID(t.ID)
 }
 
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (pc *PositionCreate) AddMemberIDs(ids ...int) *PositionCreate {
+	pc.mutation.AddMemberIDs(ids...)
+	return pc
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (pc *PositionCreate) AddMembers(m ...*Member) *PositionCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pc.AddMemberIDs(ids...)
+}
+
+// AddWaitlistIDs adds the "waitlist" edge to the WaitlistEntry entity by IDs.
+func (pc *PositionCreate) AddWaitlistIDs(ids ...int) *PositionCreate {
+	pc.mutation.AddWaitlistIDs(ids...)
+	return pc
+}
+
+// AddWaitlist adds the "waitlist" edges to the WaitlistEntry entity.
+func (pc *PositionCreate) AddWaitlist(w ...*WaitlistEntry) *PositionCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pc.AddWaitlistIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (pc *PositionCreate) Mutation() *PositionMutation {
 	return pc.mutation
@@ -88,6 +121,11 @@ func (pc *PositionCreate) check() error {
 	if _, ok := pc.mutation.Role(); !ok {
 		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Position.role"`)}
 	}
+	if v, ok := pc.mutation.Role(); ok {
+		if err := position.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Position.role": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Vacancy(); !ok {
 		return &ValidationError{Name: "vacancy", err: errors.New(`ent: missing required field "Position.vacancy"`)}
 	}
@@ -118,7 +156,7 @@ func (pc *PositionCreate) createSpec() (*Position, *sqlgraph.CreateSpec) {
 		_spec = sqlgraph.NewCreateSpec(position.Table, sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt))
 	)
 	if value, ok := pc.mutation.Role(); ok {
-		_spec.SetField(position.FieldRole, field.TypeString, value)
+		_spec.SetField(position.FieldRole, field.TypeEnum, value)
 		_node.Role = value
 	}
 	if value, ok := pc.mutation.Vacancy(); ok {
@@ -142,6 +180,38 @@ func (pc *PositionCreate) createSpec() (*Position, *sqlgraph.CreateSpec) {
 		_node.TeamID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.WaitlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
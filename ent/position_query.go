@@ -3,10 +3,13 @@
 package ent
 
 import (
+	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/team"
+	"backend_golang/ent/waitlistentry"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -20,12 +23,14 @@ import (
 // PositionQuery is the builder for querying Position entities.
 type PositionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []position.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Position
-	withTeam   *TeamQuery
-	modifiers  []func(*sql.Selector)
+	ctx          *QueryContext
+	order        []position.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Position
+	withTeam     *TeamQuery
+	withMembers  *MemberQuery
+	withWaitlist *WaitlistEntryQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +89,50 @@ func (pq *PositionQuery) QueryTeam() *TeamQuery {
 	return query
 }
 
+// QueryMembers chains the current query on the "members" edge.
+func (pq *PositionQuery) QueryMembers() *MemberQuery {
+	query := (&MemberClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.MembersTable, position.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlist chains the current query on the "waitlist" edge.
+func (pq *PositionQuery) QueryWaitlist() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.WaitlistTable, position.WaitlistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Position entity from the query.
 // Returns a *NotFoundError when no Position was found.
 func (pq *PositionQuery) First(ctx context.Context) (*Position, error) {
@@ -271,12 +320,14 @@ func (pq *PositionQuery) Clone() *PositionQuery {
 		return nil
 	}
 	return &PositionQuery{
-		config:     pq.config,
-		ctx:        pq.ctx.Clone(),
-		order:      append([]position.OrderOption{}, pq.order...),
-		inters:     append([]Interceptor{}, pq.inters...),
-		predicates: append([]predicate.Position{}, pq.predicates...),
-		withTeam:   pq.withTeam.Clone(),
+		config:       pq.config,
+		ctx:          pq.ctx.Clone(),
+		order:        append([]position.OrderOption{}, pq.order...),
+		inters:       append([]Interceptor{}, pq.inters...),
+		predicates:   append([]predicate.Position{}, pq.predicates...),
+		withTeam:     pq.withTeam.Clone(),
+		withMembers:  pq.withMembers.Clone(),
+		withWaitlist: pq.withWaitlist.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -294,6 +345,28 @@ func (pq *PositionQuery) WithTeam(opts ...func(*TeamQuery)) *PositionQuery {
 	return pq
 }
 
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PositionQuery) WithMembers(opts ...func(*MemberQuery)) *PositionQuery {
+	query := (&MemberClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withMembers = query
+	return pq
+}
+
+// WithWaitlist tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PositionQuery) WithWaitlist(opts ...func(*WaitlistEntryQuery)) *PositionQuery {
+	query := (&WaitlistEntryClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withWaitlist = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +445,10 @@ func (pq *PositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 	var (
 		nodes       = []*Position{}
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			pq.withTeam != nil,
+			pq.withMembers != nil,
+			pq.withWaitlist != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +478,20 @@ func (pq *PositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 			return nil, err
 		}
 	}
+	if query := pq.withMembers; query != nil {
+		if err := pq.loadMembers(ctx, query, nodes,
+			func(n *Position) { n.Edges.Members = []*Member{} },
+			func(n *Position, e *Member) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withWaitlist; query != nil {
+		if err := pq.loadWaitlist(ctx, query, nodes,
+			func(n *Position) { n.Edges.Waitlist = []*WaitlistEntry{} },
+			func(n *Position, e *WaitlistEntry) { n.Edges.Waitlist = append(n.Edges.Waitlist, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +524,68 @@ func (pq *PositionQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes [
 	}
 	return nil
 }
+func (pq *PositionQuery) loadMembers(ctx context.Context, query *MemberQuery, nodes []*Position, init func(*Position), assign func(*Position, *Member)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Position)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Member(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(position.MembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.position_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "position_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "position_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PositionQuery) loadWaitlist(ctx context.Context, query *WaitlistEntryQuery, nodes []*Position, init func(*Position), assign func(*Position, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Position)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(waitlistentry.FieldPositionID)
+	}
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(position.WaitlistColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PositionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "position_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PositionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
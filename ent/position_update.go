@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/team"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/models"
 	"context"
 	"errors"
 	"fmt"
@@ -49,15 +52,15 @@ func (pu *PositionUpdate) ClearTeamID() *PositionUpdate {
 }
 
 // SetRole sets the "role" field.
-func (pu *PositionUpdate) SetRole(s string) *PositionUpdate {
-	pu.mutation.SetRole(s)
+func (pu *PositionUpdate) SetRole(m models.Role) *PositionUpdate {
+	pu.mutation.SetRole(m)
 	return pu
 }
 
 // SetNillableRole sets the "role" field if the given value is not nil.
-func (pu *PositionUpdate) SetNillableRole(s *string) *PositionUpdate {
-	if s != nil {
-		pu.SetRole(*s)
+func (pu *PositionUpdate) SetNillableRole(m *models.Role) *PositionUpdate {
+	if m != nil {
+		pu.SetRole(*m)
 	}
 	return pu
 }
@@ -88,6 +91,36 @@ func (pu *PositionUpdate) SetTeam(t *Team) *PositionUpdate {
 	return pu.SetTeamID(t.ID)
 }
 
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (pu *PositionUpdate) AddMemberIDs(ids ...int) *PositionUpdate {
+	pu.mutation.AddMemberIDs(ids...)
+	return pu
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (pu *PositionUpdate) AddMembers(m ...*Member) *PositionUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.AddMemberIDs(ids...)
+}
+
+// AddWaitlistIDs adds the "waitlist" edge to the WaitlistEntry entity by IDs.
+func (pu *PositionUpdate) AddWaitlistIDs(ids ...int) *PositionUpdate {
+	pu.mutation.AddWaitlistIDs(ids...)
+	return pu
+}
+
+// AddWaitlist adds the "waitlist" edges to the WaitlistEntry entity.
+func (pu *PositionUpdate) AddWaitlist(w ...*WaitlistEntry) *PositionUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.AddWaitlistIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (pu *PositionUpdate) Mutation() *PositionMutation {
 	return pu.mutation
@@ -99,6 +132,48 @@ func (pu *PositionUpdate) ClearTeam() *PositionUpdate {
 	return pu
 }
 
+// ClearMembers clears all "members" edges to the Member entity.
+func (pu *PositionUpdate) ClearMembers() *PositionUpdate {
+	pu.mutation.ClearMembers()
+	return pu
+}
+
+// RemoveMemberIDs removes the "members" edge to Member entities by IDs.
+func (pu *PositionUpdate) RemoveMemberIDs(ids ...int) *PositionUpdate {
+	pu.mutation.RemoveMemberIDs(ids...)
+	return pu
+}
+
+// RemoveMembers removes "members" edges to Member entities.
+func (pu *PositionUpdate) RemoveMembers(m ...*Member) *PositionUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.RemoveMemberIDs(ids...)
+}
+
+// ClearWaitlist clears all "waitlist" edges to the WaitlistEntry entity.
+func (pu *PositionUpdate) ClearWaitlist() *PositionUpdate {
+	pu.mutation.ClearWaitlist()
+	return pu
+}
+
+// RemoveWaitlistIDs removes the "waitlist" edge to WaitlistEntry entities by IDs.
+func (pu *PositionUpdate) RemoveWaitlistIDs(ids ...int) *PositionUpdate {
+	pu.mutation.RemoveWaitlistIDs(ids...)
+	return pu
+}
+
+// RemoveWaitlist removes "waitlist" edges to WaitlistEntry entities.
+func (pu *PositionUpdate) RemoveWaitlist(w ...*WaitlistEntry) *PositionUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.RemoveWaitlistIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PositionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -126,7 +201,20 @@ func (pu *PositionUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PositionUpdate) check() error {
+	if v, ok := pu.mutation.Role(); ok {
+		if err := position.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Position.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (pu *PositionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(position.Table, position.Columns, sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt))
 	if ps := pu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -136,7 +224,7 @@ func (pu *PositionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 	}
 	if value, ok := pu.mutation.Role(); ok {
-		_spec.SetField(position.FieldRole, field.TypeString, value)
+		_spec.SetField(position.FieldRole, field.TypeEnum, value)
 	}
 	if value, ok := pu.mutation.Vacancy(); ok {
 		_spec.SetField(position.FieldVacancy, field.TypeInt8, value)
@@ -173,6 +261,96 @@ func (pu *PositionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !pu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWaitlistIDs(); len(nodes) > 0 && !pu.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WaitlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{position.Label}
@@ -214,15 +392,15 @@ func (puo *PositionUpdateOne) ClearTeamID() *PositionUpdateOne {
 }
 
 // SetRole sets the "role" field.
-func (puo *PositionUpdateOne) SetRole(s string) *PositionUpdateOne {
-	puo.mutation.SetRole(s)
+func (puo *PositionUpdateOne) SetRole(m models.Role) *PositionUpdateOne {
+	puo.mutation.SetRole(m)
 	return puo
 }
 
 // SetNillableRole sets the "role" field if the given value is not nil.
-func (puo *PositionUpdateOne) SetNillableRole(s *string) *PositionUpdateOne {
-	if s != nil {
-		puo.SetRole(*s)
+func (puo *PositionUpdateOne) SetNillableRole(m *models.Role) *PositionUpdateOne {
+	if m != nil {
+		puo.SetRole(*m)
 	}
 	return puo
 }
@@ -253,6 +431,36 @@ func (puo *PositionUpdateOne) SetTeam(t *Team) *PositionUpdateOne {
 	return puo.SetTeamID(t.ID)
 }
 
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (puo *PositionUpdateOne) AddMemberIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.AddMemberIDs(ids...)
+	return puo
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (puo *PositionUpdateOne) AddMembers(m ...*Member) *PositionUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.AddMemberIDs(ids...)
+}
+
+// AddWaitlistIDs adds the "waitlist" edge to the WaitlistEntry entity by IDs.
+func (puo *PositionUpdateOne) AddWaitlistIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.AddWaitlistIDs(ids...)
+	return puo
+}
+
+// AddWaitlist adds the "waitlist" edges to the WaitlistEntry entity.
+func (puo *PositionUpdateOne) AddWaitlist(w ...*WaitlistEntry) *PositionUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.AddWaitlistIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (puo *PositionUpdateOne) Mutation() *PositionMutation {
 	return puo.mutation
@@ -264,6 +472,48 @@ func (puo *PositionUpdateOne) ClearTeam() *PositionUpdateOne {
 	return puo
 }
 
+// ClearMembers clears all "members" edges to the Member entity.
+func (puo *PositionUpdateOne) ClearMembers() *PositionUpdateOne {
+	puo.mutation.ClearMembers()
+	return puo
+}
+
+// RemoveMemberIDs removes the "members" edge to Member entities by IDs.
+func (puo *PositionUpdateOne) RemoveMemberIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.RemoveMemberIDs(ids...)
+	return puo
+}
+
+// RemoveMembers removes "members" edges to Member entities.
+func (puo *PositionUpdateOne) RemoveMembers(m ...*Member) *PositionUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.RemoveMemberIDs(ids...)
+}
+
+// ClearWaitlist clears all "waitlist" edges to the WaitlistEntry entity.
+func (puo *PositionUpdateOne) ClearWaitlist() *PositionUpdateOne {
+	puo.mutation.ClearWaitlist()
+	return puo
+}
+
+// RemoveWaitlistIDs removes the "waitlist" edge to WaitlistEntry entities by IDs.
+func (puo *PositionUpdateOne) RemoveWaitlistIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.RemoveWaitlistIDs(ids...)
+	return puo
+}
+
+// RemoveWaitlist removes "waitlist" edges to WaitlistEntry entities.
+func (puo *PositionUpdateOne) RemoveWaitlist(w ...*WaitlistEntry) *PositionUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.RemoveWaitlistIDs(ids...)
+}
+
 // Where appends a list predicates to the PositionUpdate builder.
 func (puo *PositionUpdateOne) Where(ps ...predicate.Position) *PositionUpdateOne {
 	puo.mutation.Where(ps...)
@@ -304,7 +554,20 @@ func (puo *PositionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PositionUpdateOne) check() error {
+	if v, ok := puo.mutation.Role(); ok {
+		if err := position.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Position.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (puo *PositionUpdateOne) sqlSave(ctx context.Context) (_node *Position, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(position.Table, position.Columns, sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt))
 	id, ok := puo.mutation.ID()
 	if !ok {
@@ -331,7 +594,7 @@ func (puo *PositionUpdateOne) sqlSave(ctx context.Context) (_node *Position, err
 		}
 	}
 	if value, ok := puo.mutation.Role(); ok {
-		_spec.SetField(position.FieldRole, field.TypeString, value)
+		_spec.SetField(position.FieldRole, field.TypeEnum, value)
 	}
 	if value, ok := puo.mutation.Vacancy(); ok {
 		_spec.SetField(position.FieldVacancy, field.TypeInt8, value)
@@ -368,6 +631,96 @@ func (puo *PositionUpdateOne) sqlSave(ctx context.Context) (_node *Position, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !puo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.MembersTable,
+			Columns: []string{position.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedWaitlistIDs(); len(nodes) > 0 && !puo.mutation.WaitlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.WaitlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.WaitlistTable,
+			Columns: []string{position.WaitlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Position{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
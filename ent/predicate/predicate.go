@@ -9,17 +9,56 @@ import (
 // Announcement is the predicate function for announcement builders.
 type Announcement func(*sql.Selector)
 
+// AnnouncementComment is the predicate function for announcementcomment builders.
+type AnnouncementComment func(*sql.Selector)
+
+// Event is the predicate function for event builders.
+type Event func(*sql.Selector)
+
+// EventOverride is the predicate function for eventoverride builders.
+type EventOverride func(*sql.Selector)
+
+// Invitation is the predicate function for invitation builders.
+type Invitation func(*sql.Selector)
+
 // Member is the predicate function for member builders.
 type Member func(*sql.Selector)
 
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// OutboxMessage is the predicate function for outboxmessage builders.
+type OutboxMessage func(*sql.Selector)
+
 // Position is the predicate function for position builders.
 type Position func(*sql.Selector)
 
+// RSVP is the predicate function for rsvp builders.
+type RSVP func(*sql.Selector)
+
 // Skill is the predicate function for skill builders.
 type Skill func(*sql.Selector)
 
+// SkillAlias is the predicate function for skillalias builders.
+type SkillAlias func(*sql.Selector)
+
 // Team is the predicate function for team builders.
 type Team func(*sql.Selector)
 
+// Thread is the predicate function for thread builders.
+type Thread func(*sql.Selector)
+
+// ThreadComment is the predicate function for threadcomment builders.
+type ThreadComment func(*sql.Selector)
+
 // TransientMember is the predicate function for transientmember builders.
 type TransientMember func(*sql.Selector)
+
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
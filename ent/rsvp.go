@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/member"
+	"backend_golang/ent/rsvp"
+	"backend_golang/internal/models"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RSVP is the model entity for the RSVP schema.
+type RSVP struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID int `json:"event_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status models.RSVPStatus `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RSVPQuery when eager-loading is set.
+	Edges        RSVPEdges `json:"edges"`
+	member_rsvps *int
+	selectValues sql.SelectValues
+}
+
+// RSVPEdges holds the relations/edges for other nodes in the graph.
+type RSVPEdges struct {
+	// Event holds the value of the event edge.
+	Event *Event `json:"event,omitempty"`
+	// Member holds the value of the member edge.
+	Member *Member `json:"member,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RSVPEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// MemberOrErr returns the Member value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RSVPEdges) MemberOrErr() (*Member, error) {
+	if e.Member != nil {
+		return e.Member, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "member"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RSVP) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rsvp.FieldID, rsvp.FieldEventID:
+			values[i] = new(sql.NullInt64)
+		case rsvp.FieldStatus:
+			values[i] = new(sql.NullString)
+		case rsvp.FieldCreatedAt, rsvp.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case rsvp.ForeignKeys[0]: // member_rsvps
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RSVP fields.
+func (r *RSVP) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rsvp.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case rsvp.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				r.EventID = int(value.Int64)
+			}
+		case rsvp.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = models.RSVPStatus(value.String)
+			}
+		case rsvp.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case rsvp.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		case rsvp.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field member_rsvps", value)
+			} else if value.Valid {
+				r.member_rsvps = new(int)
+				*r.member_rsvps = int(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RSVP.
+// This includes values selected through modifiers, order, etc.
+func (r *RSVP) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryEvent queries the "event" edge of the RSVP entity.
+func (r *RSVP) QueryEvent() *EventQuery {
+	return NewRSVPClient(r.config).QueryEvent(r)
+}
+
+// QueryMember queries the "member" edge of the RSVP entity.
+func (r *RSVP) QueryMember() *MemberQuery {
+	return NewRSVPClient(r.config).QueryMember(r)
+}
+
+// Update returns a builder for updating this RSVP.
+// Note that you need to call RSVP.Unwrap() before calling this method if this RSVP
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *RSVP) Update() *RSVPUpdateOne {
+	return NewRSVPClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the RSVP entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *RSVP) Unwrap() *RSVP {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RSVP is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *RSVP) String() string {
+	var builder strings.Builder
+	builder.WriteString("RSVP(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RSVPs is a parsable slice of RSVP.
+type RSVPs []*RSVP
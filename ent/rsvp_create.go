@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/member"
+	"backend_golang/ent/rsvp"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RSVPCreate is the builder for creating a RSVP entity.
+type RSVPCreate struct {
+	config
+	mutation *RSVPMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (rc *RSVPCreate) SetEventID(i int) *RSVPCreate {
+	rc.mutation.SetEventID(i)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *RSVPCreate) SetStatus(ms models.RSVPStatus) *RSVPCreate {
+	rc.mutation.SetStatus(ms)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RSVPCreate) SetCreatedAt(t time.Time) *RSVPCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RSVPCreate) SetNillableCreatedAt(t *time.Time) *RSVPCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *RSVPCreate) SetUpdatedAt(t time.Time) *RSVPCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *RSVPCreate) SetNillableUpdatedAt(t *time.Time) *RSVPCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (rc *RSVPCreate) SetEvent(e *Event) *RSVPCreate {
+	return rc.SetEventID(e.ID)
+}
+
+// SetMemberID sets the "member" edge to the Member entity by ID.
+func (rc *RSVPCreate) SetMemberID(id int) *RSVPCreate {
+	rc.mutation.SetMemberID(id)
+	return rc
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (rc *RSVPCreate) SetMember(m *Member) *RSVPCreate {
+	return rc.SetMemberID(m.ID)
+}
+
+// Mutation returns the RSVPMutation object of the builder.
+func (rc *RSVPCreate) Mutation() *RSVPMutation {
+	return rc.mutation
+}
+
+// Save creates the RSVP in the database.
+func (rc *RSVPCreate) Save(ctx context.Context) (*RSVP, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RSVPCreate) SaveX(ctx context.Context) *RSVP {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RSVPCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RSVPCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RSVPCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := rsvp.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := rsvp.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RSVPCreate) check() error {
+	if _, ok := rc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "RSVP.event_id"`)}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RSVP.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := rsvp.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RSVP.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RSVP.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RSVP.updated_at"`)}
+	}
+	if len(rc.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "RSVP.event"`)}
+	}
+	if len(rc.mutation.MemberIDs()) == 0 {
+		return &ValidationError{Name: "member", err: errors.New(`ent: missing required edge "RSVP.member"`)}
+	}
+	return nil
+}
+
+func (rc *RSVPCreate) sqlSave(ctx context.Context) (*RSVP, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RSVPCreate) createSpec() (*RSVP, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RSVP{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(rsvp.Table, sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(rsvp.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(rsvp.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rsvp.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rc.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.EventTable,
+			Columns: []string{rsvp.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EventID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.MemberTable,
+			Columns: []string{rsvp.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.member_rsvps = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RSVPCreateBulk is the builder for creating many RSVP entities in bulk.
+type RSVPCreateBulk struct {
+	config
+	err      error
+	builders []*RSVPCreate
+}
+
+// Save creates the RSVP entities in the database.
+func (rcb *RSVPCreateBulk) Save(ctx context.Context) ([]*RSVP, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*RSVP, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RSVPMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RSVPCreateBulk) SaveX(ctx context.Context) []*RSVP {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RSVPCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RSVPCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
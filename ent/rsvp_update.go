@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/event"
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/rsvp"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RSVPUpdate is the builder for updating RSVP entities.
+type RSVPUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RSVPMutation
+}
+
+// Where appends a list predicates to the RSVPUpdate builder.
+func (ru *RSVPUpdate) Where(ps ...predicate.RSVP) *RSVPUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetEventID sets the "event_id" field.
+func (ru *RSVPUpdate) SetEventID(i int) *RSVPUpdate {
+	ru.mutation.SetEventID(i)
+	return ru
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (ru *RSVPUpdate) SetNillableEventID(i *int) *RSVPUpdate {
+	if i != nil {
+		ru.SetEventID(*i)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *RSVPUpdate) SetStatus(ms models.RSVPStatus) *RSVPUpdate {
+	ru.mutation.SetStatus(ms)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *RSVPUpdate) SetNillableStatus(ms *models.RSVPStatus) *RSVPUpdate {
+	if ms != nil {
+		ru.SetStatus(*ms)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RSVPUpdate) SetUpdatedAt(t time.Time) *RSVPUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (ru *RSVPUpdate) SetEvent(e *Event) *RSVPUpdate {
+	return ru.SetEventID(e.ID)
+}
+
+// SetMemberID sets the "member" edge to the Member entity by ID.
+func (ru *RSVPUpdate) SetMemberID(id int) *RSVPUpdate {
+	ru.mutation.SetMemberID(id)
+	return ru
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (ru *RSVPUpdate) SetMember(m *Member) *RSVPUpdate {
+	return ru.SetMemberID(m.ID)
+}
+
+// Mutation returns the RSVPMutation object of the builder.
+func (ru *RSVPUpdate) Mutation() *RSVPMutation {
+	return ru.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (ru *RSVPUpdate) ClearEvent() *RSVPUpdate {
+	ru.mutation.ClearEvent()
+	return ru
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (ru *RSVPUpdate) ClearMember() *RSVPUpdate {
+	ru.mutation.ClearMember()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RSVPUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RSVPUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RSVPUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RSVPUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RSVPUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := rsvp.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RSVPUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := rsvp.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RSVP.status": %w`, err)}
+		}
+	}
+	if ru.mutation.EventCleared() && len(ru.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RSVP.event"`)
+	}
+	if ru.mutation.MemberCleared() && len(ru.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RSVP.member"`)
+	}
+	return nil
+}
+
+func (ru *RSVPUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rsvp.Table, rsvp.Columns, sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(rsvp.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(rsvp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.EventTable,
+			Columns: []string{rsvp.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.EventTable,
+			Columns: []string{rsvp.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.MemberTable,
+			Columns: []string{rsvp.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.MemberTable,
+			Columns: []string{rsvp.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rsvp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RSVPUpdateOne is the builder for updating a single RSVP entity.
+type RSVPUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RSVPMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (ruo *RSVPUpdateOne) SetEventID(i int) *RSVPUpdateOne {
+	ruo.mutation.SetEventID(i)
+	return ruo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (ruo *RSVPUpdateOne) SetNillableEventID(i *int) *RSVPUpdateOne {
+	if i != nil {
+		ruo.SetEventID(*i)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *RSVPUpdateOne) SetStatus(ms models.RSVPStatus) *RSVPUpdateOne {
+	ruo.mutation.SetStatus(ms)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *RSVPUpdateOne) SetNillableStatus(ms *models.RSVPStatus) *RSVPUpdateOne {
+	if ms != nil {
+		ruo.SetStatus(*ms)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RSVPUpdateOne) SetUpdatedAt(t time.Time) *RSVPUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (ruo *RSVPUpdateOne) SetEvent(e *Event) *RSVPUpdateOne {
+	return ruo.SetEventID(e.ID)
+}
+
+// SetMemberID sets the "member" edge to the Member entity by ID.
+func (ruo *RSVPUpdateOne) SetMemberID(id int) *RSVPUpdateOne {
+	ruo.mutation.SetMemberID(id)
+	return ruo
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (ruo *RSVPUpdateOne) SetMember(m *Member) *RSVPUpdateOne {
+	return ruo.SetMemberID(m.ID)
+}
+
+// Mutation returns the RSVPMutation object of the builder.
+func (ruo *RSVPUpdateOne) Mutation() *RSVPMutation {
+	return ruo.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (ruo *RSVPUpdateOne) ClearEvent() *RSVPUpdateOne {
+	ruo.mutation.ClearEvent()
+	return ruo
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (ruo *RSVPUpdateOne) ClearMember() *RSVPUpdateOne {
+	ruo.mutation.ClearMember()
+	return ruo
+}
+
+// Where appends a list predicates to the RSVPUpdate builder.
+func (ruo *RSVPUpdateOne) Where(ps ...predicate.RSVP) *RSVPUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RSVPUpdateOne) Select(field string, fields ...string) *RSVPUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated RSVP entity.
+func (ruo *RSVPUpdateOne) Save(ctx context.Context) (*RSVP, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RSVPUpdateOne) SaveX(ctx context.Context) *RSVP {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RSVPUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RSVPUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RSVPUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := rsvp.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RSVPUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := rsvp.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RSVP.status": %w`, err)}
+		}
+	}
+	if ruo.mutation.EventCleared() && len(ruo.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RSVP.event"`)
+	}
+	if ruo.mutation.MemberCleared() && len(ruo.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RSVP.member"`)
+	}
+	return nil
+}
+
+func (ruo *RSVPUpdateOne) sqlSave(ctx context.Context) (_node *RSVP, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rsvp.Table, rsvp.Columns, sqlgraph.NewFieldSpec(rsvp.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RSVP.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rsvp.FieldID)
+		for _, f := range fields {
+			if !rsvp.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rsvp.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(rsvp.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rsvp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.EventTable,
+			Columns: []string{rsvp.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.EventTable,
+			Columns: []string{rsvp.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.MemberTable,
+			Columns: []string{rsvp.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsvp.MemberTable,
+			Columns: []string{rsvp.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RSVP{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rsvp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -4,9 +4,22 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/invitation"
+	"backend_golang/ent/notification"
+	"backend_golang/ent/outboxmessage"
+	"backend_golang/ent/rsvp"
 	"backend_golang/ent/schema"
 	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/ent/webhook"
+	"backend_golang/ent/webhookdelivery"
 	"time"
 )
 
@@ -34,16 +47,266 @@ func init() {
 	announcement.DefaultUpdatedAt = announcementDescUpdatedAt.Default.(func() time.Time)
 	// announcement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	announcement.UpdateDefaultUpdatedAt = announcementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	announcementcommentFields := schema.AnnouncementComment{}.Fields()
+	_ = announcementcommentFields
+	// announcementcommentDescBody is the schema descriptor for body field.
+	announcementcommentDescBody := announcementcommentFields[2].Descriptor()
+	// announcementcomment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	announcementcomment.BodyValidator = announcementcommentDescBody.Validators[0].(func(string) error)
+	// announcementcommentDescLeaderAnswer is the schema descriptor for leader_answer field.
+	announcementcommentDescLeaderAnswer := announcementcommentFields[3].Descriptor()
+	// announcementcomment.DefaultLeaderAnswer holds the default value on creation for the leader_answer field.
+	announcementcomment.DefaultLeaderAnswer = announcementcommentDescLeaderAnswer.Default.(bool)
+	// announcementcommentDescCreatedAt is the schema descriptor for created_at field.
+	announcementcommentDescCreatedAt := announcementcommentFields[5].Descriptor()
+	// announcementcomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	announcementcomment.DefaultCreatedAt = announcementcommentDescCreatedAt.Default.(func() time.Time)
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescTitle is the schema descriptor for title field.
+	eventDescTitle := eventFields[1].Descriptor()
+	// event.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	event.TitleValidator = eventDescTitle.Validators[0].(func(string) error)
+	// eventDescAgenda is the schema descriptor for agenda field.
+	eventDescAgenda := eventFields[2].Descriptor()
+	// event.DefaultAgenda holds the default value on creation for the agenda field.
+	event.DefaultAgenda = eventDescAgenda.Default.(string)
+	// eventDescLocation is the schema descriptor for location field.
+	eventDescLocation := eventFields[5].Descriptor()
+	// event.DefaultLocation holds the default value on creation for the location field.
+	event.DefaultLocation = eventDescLocation.Default.(string)
+	// eventDescOnlineURL is the schema descriptor for online_url field.
+	eventDescOnlineURL := eventFields[6].Descriptor()
+	// event.DefaultOnlineURL holds the default value on creation for the online_url field.
+	event.DefaultOnlineURL = eventDescOnlineURL.Default.(string)
+	// eventDescCapacity is the schema descriptor for capacity field.
+	eventDescCapacity := eventFields[7].Descriptor()
+	// event.DefaultCapacity holds the default value on creation for the capacity field.
+	event.DefaultCapacity = eventDescCapacity.Default.(int)
+	// eventDescGoingCount is the schema descriptor for going_count field.
+	eventDescGoingCount := eventFields[8].Descriptor()
+	// event.DefaultGoingCount holds the default value on creation for the going_count field.
+	event.DefaultGoingCount = eventDescGoingCount.Default.(int)
+	// eventDescRecurrence is the schema descriptor for recurrence field.
+	eventDescRecurrence := eventFields[9].Descriptor()
+	// event.DefaultRecurrence holds the default value on creation for the recurrence field.
+	event.DefaultRecurrence = eventDescRecurrence.Default.(string)
+	// eventDescSequence is the schema descriptor for sequence field.
+	eventDescSequence := eventFields[11].Descriptor()
+	// event.DefaultSequence holds the default value on creation for the sequence field.
+	event.DefaultSequence = eventDescSequence.Default.(int)
+	// eventDescCreatedBy is the schema descriptor for created_by field.
+	eventDescCreatedBy := eventFields[13].Descriptor()
+	// event.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	event.CreatedByValidator = eventDescCreatedBy.Validators[0].(func(string) error)
+	// eventDescCreatedAt is the schema descriptor for created_at field.
+	eventDescCreatedAt := eventFields[14].Descriptor()
+	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
+	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
+	// eventDescUpdatedAt is the schema descriptor for updated_at field.
+	eventDescUpdatedAt := eventFields[15].Descriptor()
+	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
+	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	eventoverrideFields := schema.EventOverride{}.Fields()
+	_ = eventoverrideFields
+	// eventoverrideDescCancelled is the schema descriptor for cancelled field.
+	eventoverrideDescCancelled := eventoverrideFields[2].Descriptor()
+	// eventoverride.DefaultCancelled holds the default value on creation for the cancelled field.
+	eventoverride.DefaultCancelled = eventoverrideDescCancelled.Default.(bool)
+	// eventoverrideDescUpdatedAt is the schema descriptor for updated_at field.
+	eventoverrideDescUpdatedAt := eventoverrideFields[8].Descriptor()
+	// eventoverride.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventoverride.DefaultUpdatedAt = eventoverrideDescUpdatedAt.Default.(func() time.Time)
+	// eventoverride.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventoverride.UpdateDefaultUpdatedAt = eventoverrideDescUpdatedAt.UpdateDefault.(func() time.Time)
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescMaxUses is the schema descriptor for max_uses field.
+	invitationDescMaxUses := invitationFields[5].Descriptor()
+	// invitation.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invitation.DefaultMaxUses = invitationDescMaxUses.Default.(int)
+	// invitationDescUses is the schema descriptor for uses field.
+	invitationDescUses := invitationFields[6].Descriptor()
+	// invitation.DefaultUses holds the default value on creation for the uses field.
+	invitation.DefaultUses = invitationDescUses.Default.(int)
+	// invitationDescCreatedBy is the schema descriptor for created_by field.
+	invitationDescCreatedBy := invitationFields[8].Descriptor()
+	// invitation.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	invitation.CreatedByValidator = invitationDescCreatedBy.Validators[0].(func(string) error)
+	// invitationDescCreatedAt is the schema descriptor for created_at field.
+	invitationDescCreatedAt := invitationFields[9].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	memberFields := schema.Member{}.Fields()
+	_ = memberFields
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescSubject is the schema descriptor for subject field.
+	notificationDescSubject := notificationFields[3].Descriptor()
+	// notification.DefaultSubject holds the default value on creation for the subject field.
+	notification.DefaultSubject = notificationDescSubject.Default.(string)
+	// notificationDescLink is the schema descriptor for link field.
+	notificationDescLink := notificationFields[4].Descriptor()
+	// notification.DefaultLink holds the default value on creation for the link field.
+	notification.DefaultLink = notificationDescLink.Default.(string)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[7].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	outboxmessageFields := schema.OutboxMessage{}.Fields()
+	_ = outboxmessageFields
+	// outboxmessageDescMemberID is the schema descriptor for member_id field.
+	outboxmessageDescMemberID := outboxmessageFields[3].Descriptor()
+	// outboxmessage.DefaultMemberID holds the default value on creation for the member_id field.
+	outboxmessage.DefaultMemberID = outboxmessageDescMemberID.Default.(string)
+	// outboxmessageDescAttempts is the schema descriptor for attempts field.
+	outboxmessageDescAttempts := outboxmessageFields[4].Descriptor()
+	// outboxmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxmessage.DefaultAttempts = outboxmessageDescAttempts.Default.(int)
+	// outboxmessageDescLastError is the schema descriptor for last_error field.
+	outboxmessageDescLastError := outboxmessageFields[5].Descriptor()
+	// outboxmessage.DefaultLastError holds the default value on creation for the last_error field.
+	outboxmessage.DefaultLastError = outboxmessageDescLastError.Default.(string)
+	// outboxmessageDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxmessageDescNextAttemptAt := outboxmessageFields[6].Descriptor()
+	// outboxmessage.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxmessage.DefaultNextAttemptAt = outboxmessageDescNextAttemptAt.Default.(func() time.Time)
+	// outboxmessageDescCreatedAt is the schema descriptor for created_at field.
+	outboxmessageDescCreatedAt := outboxmessageFields[9].Descriptor()
+	// outboxmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxmessage.DefaultCreatedAt = outboxmessageDescCreatedAt.Default.(func() time.Time)
+	rsvpFields := schema.RSVP{}.Fields()
+	_ = rsvpFields
+	// rsvpDescCreatedAt is the schema descriptor for created_at field.
+	rsvpDescCreatedAt := rsvpFields[2].Descriptor()
+	// rsvp.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rsvp.DefaultCreatedAt = rsvpDescCreatedAt.Default.(func() time.Time)
+	// rsvpDescUpdatedAt is the schema descriptor for updated_at field.
+	rsvpDescUpdatedAt := rsvpFields[3].Descriptor()
+	// rsvp.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rsvp.DefaultUpdatedAt = rsvpDescUpdatedAt.Default.(func() time.Time)
+	// rsvp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rsvp.UpdateDefaultUpdatedAt = rsvpDescUpdatedAt.UpdateDefault.(func() time.Time)
 	skillFields := schema.Skill{}.Fields()
 	_ = skillFields
 	// skillDescName is the schema descriptor for name field.
 	skillDescName := skillFields[0].Descriptor()
 	// skill.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	skill.NameValidator = skillDescName.Validators[0].(func(string) error)
+	// skillDescCategory is the schema descriptor for category field.
+	skillDescCategory := skillFields[1].Descriptor()
+	// skill.DefaultCategory holds the default value on creation for the category field.
+	skill.DefaultCategory = skillDescCategory.Default.(string)
+	skillaliasFields := schema.SkillAlias{}.Fields()
+	_ = skillaliasFields
+	// skillaliasDescName is the schema descriptor for name field.
+	skillaliasDescName := skillaliasFields[0].Descriptor()
+	// skillalias.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	skillalias.NameValidator = skillaliasDescName.Validators[0].(func(string) error)
 	teamFields := schema.Team{}.Fields()
 	_ = teamFields
 	// teamDescCreatedBy is the schema descriptor for created_by field.
 	teamDescCreatedBy := teamFields[3].Descriptor()
 	// team.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	team.CreatedByValidator = teamDescCreatedBy.Validators[0].(func(string) error)
+	threadFields := schema.Thread{}.Fields()
+	_ = threadFields
+	// threadDescTitle is the schema descriptor for title field.
+	threadDescTitle := threadFields[1].Descriptor()
+	// thread.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	thread.TitleValidator = threadDescTitle.Validators[0].(func(string) error)
+	// threadDescCommentCount is the schema descriptor for comment_count field.
+	threadDescCommentCount := threadFields[4].Descriptor()
+	// thread.DefaultCommentCount holds the default value on creation for the comment_count field.
+	thread.DefaultCommentCount = threadDescCommentCount.Default.(int)
+	// threadDescLastActivityAt is the schema descriptor for last_activity_at field.
+	threadDescLastActivityAt := threadFields[5].Descriptor()
+	// thread.DefaultLastActivityAt holds the default value on creation for the last_activity_at field.
+	thread.DefaultLastActivityAt = threadDescLastActivityAt.Default.(func() time.Time)
+	// threadDescCreatedAt is the schema descriptor for created_at field.
+	threadDescCreatedAt := threadFields[7].Descriptor()
+	// thread.DefaultCreatedAt holds the default value on creation for the created_at field.
+	thread.DefaultCreatedAt = threadDescCreatedAt.Default.(func() time.Time)
+	threadcommentFields := schema.ThreadComment{}.Fields()
+	_ = threadcommentFields
+	// threadcommentDescBody is the schema descriptor for body field.
+	threadcommentDescBody := threadcommentFields[1].Descriptor()
+	// threadcomment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	threadcomment.BodyValidator = threadcommentDescBody.Validators[0].(func(string) error)
+	// threadcommentDescCreatedAt is the schema descriptor for created_at field.
+	threadcommentDescCreatedAt := threadcommentFields[4].Descriptor()
+	// threadcomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	threadcomment.DefaultCreatedAt = threadcommentDescCreatedAt.Default.(func() time.Time)
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryFields[3].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	// waitlistentryDescUpdatedAt is the schema descriptor for updated_at field.
+	waitlistentryDescUpdatedAt := waitlistentryFields[4].Descriptor()
+	// waitlistentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	waitlistentry.DefaultUpdatedAt = waitlistentryDescUpdatedAt.Default.(func() time.Time)
+	// waitlistentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	waitlistentry.UpdateDefaultUpdatedAt = waitlistentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[5].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	webhookDescConsecutiveFailures := webhookFields[6].Descriptor()
+	// webhook.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	webhook.DefaultConsecutiveFailures = webhookDescConsecutiveFailures.Default.(int)
+	// webhookDescCreatedBy is the schema descriptor for created_by field.
+	webhookDescCreatedBy := webhookFields[8].Descriptor()
+	// webhook.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	webhook.CreatedByValidator = webhookDescCreatedBy.Validators[0].(func(string) error)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[9].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[10].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryDescResponseStatus := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultResponseStatus holds the default value on creation for the response_status field.
+	webhookdelivery.DefaultResponseStatus = webhookdeliveryDescResponseStatus.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
+	// webhookdeliveryDescDurationMs is the schema descriptor for duration_ms field.
+	webhookdeliveryDescDurationMs := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	webhookdelivery.DefaultDurationMs = webhookdeliveryDescDurationMs.Default.(int64)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
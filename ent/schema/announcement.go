@@ -33,5 +33,6 @@ func (Announcement) Edges() []ent.Edge {
 		edge.From("team", Team.Type).
 			Ref("announcements").
 			Unique(),
+		edge.To("comments", AnnouncementComment.Type),
 	}
 }
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AnnouncementComment holds the schema definition for the AnnouncementComment entity.
+// 募集記事への公開の質問と回答。返信は1階層まで
+type AnnouncementComment struct {
+	ent.Schema
+}
+
+// Fields of the AnnouncementComment.
+func (AnnouncementComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("announcement_id"),
+		// 返信先のコメント。nil の場合は質問（最上位のコメント）
+		field.Int("parent_id").
+			Optional().
+			Nillable(),
+		field.Text("body").NotEmpty(),
+		// 投稿時にチームリーダーだった場合は回答として強調表示する
+		field.Bool("leader_answer").Default(false),
+		// 返信の並びを保つため、削除しても行は残して本文を隠す
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the AnnouncementComment.
+func (AnnouncementComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("announcement", Announcement.Type).
+			Ref("comments").
+			Field("announcement_id").
+			Unique().
+			Required(),
+		edge.To("replies", AnnouncementComment.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.From("author", Member.Type).
+			Ref("announcement_comments").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the AnnouncementComment.
+func (AnnouncementComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("announcement_id", "parent_id"),
+		// 投稿数の制限で直近のコメント数を数える
+		index.Fields("created_at").
+			Edges("author"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Event holds the schema definition for the Event entity.
+type Event struct {
+	ent.Schema
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("team_id"),
+		field.String("title").NotEmpty(),
+		field.Text("agenda").Default(""),
+		field.Time("starts_at"),
+		field.Time("ends_at"),
+		field.String("location").Default(""),
+		field.String("online_url").Default(""),
+		// 0 の場合は定員なし
+		field.Int("capacity").Default(0),
+		// 参加（GOING）と回答したメンバー数
+		field.Int("going_count").Default(0),
+		// RFC 5545 の RRULE（例: FREQ=WEEKLY;INTERVAL=2）。空の場合は繰り返さない
+		// iCalendar にはここに保存した値をそのまま書き出す
+		field.String("recurrence").Default(""),
+		// 繰り返しの最後の回の終了日時。期限のない繰り返しの場合は nil
+		field.Time("recurrence_ends_at").
+			Optional().
+			Nillable(),
+		// 内容を変更・取り消しするたびに増やす版数。iCalendar の SEQUENCE に使う
+		field.Int("sequence").Default(0),
+		// 取り消したイベントはカレンダーに取り消しを伝えるため削除せずに残す
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.String("created_by").NotEmpty(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Event.
+func (Event) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).
+			Ref("events").
+			Field("team_id").
+			Unique().
+			Required(),
+		edge.To("rsvps", RSVP.Type),
+		edge.To("overrides", EventOverride.Type),
+	}
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "starts_at"),
+	}
+}
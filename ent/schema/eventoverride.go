@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventOverride holds the schema definition for the EventOverride entity.
+// 繰り返しイベントの特定の回だけを変更・取り消しする
+type EventOverride struct {
+	ent.Schema
+}
+
+// Fields of the EventOverride.
+func (EventOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("event_id"),
+		// 変更前の開始日時（UTC）。iCalendar の RECURRENCE-ID に使う
+		field.Time("original_starts_at"),
+		field.Bool("cancelled").Default(false),
+		// 以下は nil の場合は繰り返しイベントの値を使う
+		field.String("title").
+			Optional().
+			Nillable(),
+		field.Text("agenda").
+			Optional().
+			Nillable(),
+		field.Time("starts_at").
+			Optional().
+			Nillable(),
+		field.Time("ends_at").
+			Optional().
+			Nillable(),
+		field.String("location").
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EventOverride.
+func (EventOverride) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("overrides").
+			Field("event_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the EventOverride.
+func (EventOverride) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "original_starts_at").
+			Unique(),
+	}
+}
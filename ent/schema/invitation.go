@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("team_id"),
+		field.Enum("kind").GoType(models.InvitationKind("")),
+		field.Enum("role").GoType(models.Role("")),
+		field.Enum("status").
+			GoType(models.InvitationStatus("")).
+			Default(string(models.InvitationActive)),
+		// 招待リンクのトークン。メンバーへの直接招待では持たない
+		field.String("token").
+			Optional().
+			Nillable().
+			Unique(),
+		// 0 の場合は回数制限なし
+		field.Int("max_uses").Default(0),
+		field.Int("uses").Default(0),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.String("created_by").NotEmpty(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).
+			Ref("invitations").
+			Field("team_id").
+			Unique().
+			Required(),
+		// 直接招待されたメンバー
+		edge.From("invitee", Member.Type).
+			Ref("invitations").
+			Unique(),
+	}
+}
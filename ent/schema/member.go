@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"backend_golang/internal/models"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -19,7 +21,21 @@ func (Member) Fields() []ent.Field {
 		field.String("picture"),
 		field.String("nickname"),
 		field.Text("bio"),
-		field.String("preferred_role"),
+		field.Enum("preferred_role").GoType(models.Role("")),
+		// preferred_role 以外に担当できる役割
+		field.JSON("roles", []models.Role{}).Optional(),
+		// カレンダーアプリは Cookie を送れないため、.ics フィードはこのトークンで認証する
+		field.String("calendar_token").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive(),
+		// メールの言語
+		field.Enum("locale").
+			GoType(models.Locale("")).
+			Default(string(models.DefaultLocale)),
+		// メールを送らない通知の種類
+		field.JSON("email_muted", []models.NotificationKind{}).Optional(),
 	}
 }
 
@@ -31,5 +47,16 @@ func (Member) Edges() []ent.Edge {
 		edge.From("teams", Team.Type).
 			Ref("members").
 			Unique(),
+		// チーム内で担当しているポジション。チームリーダーは持たない
+		edge.From("position", Position.Type).
+			Ref("members").
+			Unique(),
+		edge.To("waitlist", WaitlistEntry.Type),
+		edge.To("invitations", Invitation.Type),
+		edge.To("rsvps", RSVP.Type),
+		edge.To("threads", Thread.Type),
+		edge.To("thread_comments", ThreadComment.Type),
+		edge.To("announcement_comments", AnnouncementComment.Type),
+		edge.To("notifications", Notification.Type),
 	}
 }
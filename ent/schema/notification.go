@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// メンバーへのアプリ内通知
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").GoType(models.NotificationKind("")),
+		// チームが削除されても通知は残すため、エッジではなく ID だけを持つ
+		field.Int("team_id").
+			Optional().
+			Nillable(),
+		// 通知のきっかけになったメンバーの member_id
+		field.String("actor_id").
+			Optional().
+			Nillable(),
+		// 通知時点のチーム名・スレッド名・イベント名など
+		field.String("subject").Default(""),
+		// 対象のリソースの API パス
+		field.String("link").Default(""),
+		// 同じ通知を二度作らないためのキー。リマインダーなど繰り返し実行する処理で使う
+		field.String("dedupe_key").
+			Optional().
+			Nillable().
+			Unique(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("recipient", Member.Type).
+			Ref("notifications").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("read_at").
+			Edges("recipient"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxMessage holds the schema definition for the OutboxMessage entity.
+// 状態変化と同じトランザクションで記録し、リレーが購読者に配信するドメインイベント
+type OutboxMessage struct {
+	ent.Schema
+}
+
+// Fields of the OutboxMessage.
+func (OutboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").GoType(models.DomainEventType("")),
+		// イベントの対象（チーム・募集記事・待機リストのエントリー）の ID
+		field.Int("aggregate_id"),
+		// 対象が削除されても配信できるよう、エッジではなく ID だけを持つ
+		field.Int("team_id"),
+		// イベントのきっかけになった、または対象になったメンバーの member_id
+		field.String("member_id").Default(""),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Default(""),
+		// この日時以降に配信する。失敗すると間隔を空けて後ろにずらす
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Time("dispatched_at").
+			Optional().
+			Nillable(),
+		// 再試行の上限に達して配信を諦めた日時
+		field.Time("abandoned_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Indexes of the OutboxMessage.
+func (OutboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("dispatched_at", "abandoned_at", "next_attempt_at"),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"backend_golang/internal/models"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -16,7 +18,7 @@ func (Position) Fields() []ent.Field {
 	return []ent.Field{
 		// field.Int64("position_id"),
 		field.Int("team_id").Optional(),
-		field.String("role"),
+		field.Enum("role").GoType(models.Role("")),
 		field.Int8("vacancy"),
 	}
 }
@@ -28,5 +30,8 @@ func (Position) Edges() []ent.Edge {
 			Ref("positions").
 			Unique().
 			Field("team_id"),
+		edge.To("members", Member.Type),
+		// 空きを待っているメンバー。ID の昇順が待ち順になる
+		edge.To("waitlist", WaitlistEntry.Type),
 	}
 }
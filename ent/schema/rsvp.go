@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RSVP holds the schema definition for the RSVP entity.
+type RSVP struct {
+	ent.Schema
+}
+
+// Fields of the RSVP.
+func (RSVP) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("event_id"),
+		field.Enum("status").GoType(models.RSVPStatus("")),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RSVP.
+func (RSVP) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("rsvps").
+			Field("event_id").
+			Unique().
+			Required(),
+		edge.From("member", Member.Type).
+			Ref("rsvps").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the RSVP.
+func (RSVP) Indexes() []ent.Index {
+	return []ent.Index{
+		// メンバーはイベントごとに1つだけ回答できる
+		index.Fields("event_id").
+			Edges("member").
+			Unique(),
+	}
+}
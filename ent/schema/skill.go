@@ -15,6 +15,7 @@ type Skill struct {
 func (Skill) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Unique().NotEmpty(),
+		field.String("category").Default(""),
 	}
 }
 
@@ -23,5 +24,6 @@ func (Skill) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("users", Member.Type),
 		edge.To("teams", Team.Type),
+		edge.To("aliases", SkillAlias.Type),
 	}
 }
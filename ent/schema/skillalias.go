@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SkillAlias holds the schema definition for the SkillAlias entity.
+// name は正規化済みの表記で、同じ技術の表記ゆれ（golang, GoLang など）を1つのスキルに紐づける
+type SkillAlias struct {
+	ent.Schema
+}
+
+// Fields of the SkillAlias.
+func (SkillAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty(),
+	}
+}
+
+// Edges of the SkillAlias.
+func (SkillAlias) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("skill", Skill.Type).
+			Ref("aliases").
+			Unique().
+			Required(),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"backend_golang/internal/models"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -18,6 +20,10 @@ func (Team) Fields() []ent.Field {
 		field.Text("description"),
 		field.Int8("headcount"),
 		field.String("created_by").Unique().NotEmpty(),
+		// status は参加・脱退・ポジション変更のたびに再計算される
+		field.Enum("status").
+			GoType(models.TeamStatus("")).
+			Default(string(models.TeamStatusRecruiting)),
 	}
 }
 
@@ -27,6 +33,10 @@ func (Team) Edges() []ent.Edge {
 		edge.To("positions", Position.Type),
 		edge.To("members", Member.Type),
 		edge.To("announcements", Announcement.Type),
+		edge.To("invitations", Invitation.Type),
+		edge.To("events", Event.Type),
+		edge.To("threads", Thread.Type),
+		edge.To("webhooks", Webhook.Type),
 		edge.From("skills", Skill.Type).
 			Ref("teams"),
 	}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Thread holds the schema definition for the Thread entity.
+// チームのメンバーだけが読み書きできる話題
+type Thread struct {
+	ent.Schema
+}
+
+// Fields of the Thread.
+func (Thread) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("team_id"),
+		field.String("title").NotEmpty(),
+		// Markdown の本文
+		field.Text("body"),
+		// 本文でメンションされたメンバーの member_id
+		field.JSON("mentions", []string{}).Optional(),
+		field.Int("comment_count").Default(0),
+		// 作成・コメントのたびに更新し、一覧の並び順に使う
+		field.Time("last_activity_at").Default(time.Now),
+		field.Time("edited_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Thread.
+func (Thread) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).
+			Ref("threads").
+			Field("team_id").
+			Unique().
+			Required(),
+		edge.From("author", Member.Type).
+			Ref("threads").
+			Unique().
+			Required(),
+		edge.To("comments", ThreadComment.Type),
+	}
+}
+
+// Indexes of the Thread.
+func (Thread) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "last_activity_at"),
+	}
+}
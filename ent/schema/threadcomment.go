@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadComment holds the schema definition for the ThreadComment entity.
+type ThreadComment struct {
+	ent.Schema
+}
+
+// Fields of the ThreadComment.
+func (ThreadComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("thread_id"),
+		// Markdown の本文
+		field.Text("body").NotEmpty(),
+		// 本文でメンションされたメンバーの member_id
+		field.JSON("mentions", []string{}).Optional(),
+		field.Time("edited_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the ThreadComment.
+func (ThreadComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("thread", Thread.Type).
+			Ref("comments").
+			Field("thread_id").
+			Unique().
+			Required(),
+		edge.From("author", Member.Type).
+			Ref("thread_comments").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WaitlistEntry holds the schema definition for the WaitlistEntry entity.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+// Fields of the WaitlistEntry.
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("position_id"),
+		field.Enum("status").
+			GoType(models.WaitlistStatus("")).
+			Default(string(models.WaitlistWaiting)),
+		// 空きを提示した場合の承諾期限
+		field.Time("offer_expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WaitlistEntry.
+func (WaitlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("position", Position.Type).
+			Ref("waitlist").
+			Field("position_id").
+			Unique().
+			Required(),
+		edge.From("member", Member.Type).
+			Ref("waitlist").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WaitlistEntry.
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position_id", "status"),
+		index.Fields("status", "offer_expires_at"),
+	}
+}
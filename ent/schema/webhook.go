@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// チームのイベントを外部のサービスに送る送信先
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("team_id"),
+		field.String("url").NotEmpty(),
+		field.Enum("format").
+			GoType(models.WebhookFormat("")).
+			Default(string(models.WebhookGeneric)),
+		// 本文の署名に使う鍵
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		// 送るイベントの種類。空の場合は Webhook で受け取れる全ての種類を送る
+		field.JSON("events", []models.DomainEventType{}).Optional(),
+		field.Bool("active").Default(true),
+		// 連続して送信に失敗した回数。成功すると0に戻す
+		field.Int("consecutive_failures").Default(0),
+		// 連続した失敗で自動的に無効にした日時
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
+		field.String("created_by").NotEmpty(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).
+			Ref("webhooks").
+			Field("team_id").
+			Unique().
+			Required(),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"backend_golang/internal/models"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// ドメインイベント1件の Webhook への送信と、その結果の記録
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id"),
+		// outbox のイベントの ID。同じイベントは Webhook ごとに1回だけ送る
+		field.Int("event_id"),
+		field.Enum("event_type").GoType(models.DomainEventType("")),
+		// 送信する本文。署名は送信のたびに計算する
+		field.Text("body"),
+		field.Enum("status").
+			GoType(models.WebhookDeliveryStatus("")).
+			Default(string(models.WebhookDeliveryPending)),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+		// 最後の送信で受け取った HTTP ステータス。応答がなかった場合は0
+		field.Int("response_status").Default(0),
+		field.String("last_error").Default(""),
+		field.Int64("duration_ms").Default(0),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "event_id").Unique(),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -18,6 +18,8 @@ type Skill struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SkillQuery when eager-loading is set.
 	Edges        SkillEdges `json:"edges"`
@@ -30,9 +32,11 @@ type SkillEdges struct {
 	Users []*Member `json:"users,omitempty"`
 	// Teams holds the value of the teams edge.
 	Teams []*Team `json:"teams,omitempty"`
+	// Aliases holds the value of the aliases edge.
+	Aliases []*SkillAlias `json:"aliases,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -53,6 +57,15 @@ func (e SkillEdges) TeamsOrErr() ([]*Team, error) {
 	return nil, &NotLoadedError{edge: "teams"}
 }
 
+// AliasesOrErr returns the Aliases value or an error if the edge
+// was not loaded in eager-loading.
+func (e SkillEdges) AliasesOrErr() ([]*SkillAlias, error) {
+	if e.loadedTypes[2] {
+		return e.Aliases, nil
+	}
+	return nil, &NotLoadedError{edge: "aliases"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Skill) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -60,7 +73,7 @@ func (*Skill) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case skill.FieldID:
 			values[i] = new(sql.NullInt64)
-		case skill.FieldName:
+		case skill.FieldName, skill.FieldCategory:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -89,6 +102,12 @@ func (s *Skill) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Name = value.String
 			}
+		case skill.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				s.Category = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -112,6 +131,11 @@ func (s *Skill) QueryTeams() *TeamQuery {
 	return NewSkillClient(s.config).QueryTeams(s)
 }
 
+// QueryAliases queries the "aliases" edge of the Skill entity.
+func (s *Skill) QueryAliases() *SkillAliasQuery {
+	return NewSkillClient(s.config).QueryAliases(s)
+}
+
 // Update returns a builder for updating this Skill.
 // Note that you need to call Skill.Unwrap() before calling this method if this Skill
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -137,6 +161,9 @@ func (s *Skill) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
 	builder.WriteString("name=")
 	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(s.Category)
 	builder.WriteByte(')')
 	return builder.String()
 }
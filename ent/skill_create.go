@@ -5,6 +5,7 @@ package ent
 import (
 	"backend_golang/ent/member"
 	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
 	"backend_golang/ent/team"
 	"context"
 	"errors"
@@ -27,6 +28,20 @@ func (sc *SkillCreate) SetName(s string) *SkillCreate {
 	return sc
 }
 
+// SetCategory sets the "category" field.
+func (sc *SkillCreate) SetCategory(s string) *SkillCreate {
+	sc.mutation.SetCategory(s)
+	return sc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (sc *SkillCreate) SetNillableCategory(s *string) *SkillCreate {
+	if s != nil {
+		sc.SetCategory(*s)
+	}
+	return sc
+}
+
 // AddUserIDs adds the "users" edge to the Member entity by IDs.
 func (sc *SkillCreate) AddUserIDs(ids ...int) *SkillCreate {
 	sc.mutation.AddUserIDs(ids...)
@@ -57,6 +72,21 @@ func (sc *SkillCreate) AddTeams(t ...*Team) *SkillCreate {
 	return sc.AddTeamIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the SkillAlias entity by IDs.
+func (sc *SkillCreate) AddAliasIDs(ids ...int) *SkillCreate {
+	sc.mutation.AddAliasIDs(ids...)
+	return sc
+}
+
+// AddAliases adds the "aliases" edges to the SkillAlias entity.
+func (sc *SkillCreate) AddAliases(s ...*SkillAlias) *SkillCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddAliasIDs(ids...)
+}
+
 // Mutation returns the SkillMutation object of the builder.
 func (sc *SkillCreate) Mutation() *SkillMutation {
 	return sc.mutation
@@ -64,6 +94,7 @@ func (sc *SkillCreate) Mutation() *SkillMutation {
 
 // Save creates the Skill in the database.
 func (sc *SkillCreate) Save(ctx context.Context) (*Skill, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -89,6 +120,14 @@ func (sc *SkillCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SkillCreate) defaults() {
+	if _, ok := sc.mutation.Category(); !ok {
+		v := skill.DefaultCategory
+		sc.mutation.SetCategory(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SkillCreate) check() error {
 	if _, ok := sc.mutation.Name(); !ok {
@@ -99,6 +138,9 @@ func (sc *SkillCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Skill.name": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Skill.category"`)}
+	}
 	return nil
 }
 
@@ -129,6 +171,10 @@ func (sc *SkillCreate) createSpec() (*Skill, *sqlgraph.CreateSpec) {
 		_spec.SetField(skill.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := sc.mutation.Category(); ok {
+		_spec.SetField(skill.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
 	if nodes := sc.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -161,6 +207,22 @@ func (sc *SkillCreate) createSpec() (*Skill, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -182,6 +244,7 @@ func (scb *SkillCreateBulk) Save(ctx context.Context) ([]*Skill, error) {
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SkillMutation)
 				if !ok {
@@ -6,6 +6,7 @@ import (
 	"backend_golang/ent/member"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
 	"backend_golang/ent/team"
 	"context"
 	"database/sql/driver"
@@ -22,13 +23,14 @@ import (
 // SkillQuery is the builder for querying Skill entities.
 type SkillQuery struct {
 	config
-	ctx        *QueryContext
-	order      []skill.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Skill
-	withUsers  *MemberQuery
-	withTeams  *TeamQuery
-	modifiers  []func(*sql.Selector)
+	ctx         *QueryContext
+	order       []skill.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Skill
+	withUsers   *MemberQuery
+	withTeams   *TeamQuery
+	withAliases *SkillAliasQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +111,28 @@ func (sq *SkillQuery) QueryTeams() *TeamQuery {
 	return query
 }
 
+// QueryAliases chains the current query on the "aliases" edge.
+func (sq *SkillQuery) QueryAliases() *SkillAliasQuery {
+	query := (&SkillAliasClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(skill.Table, skill.FieldID, selector),
+			sqlgraph.To(skillalias.Table, skillalias.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, skill.AliasesTable, skill.AliasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Skill entity from the query.
 // Returns a *NotFoundError when no Skill was found.
 func (sq *SkillQuery) First(ctx context.Context) (*Skill, error) {
@@ -296,13 +320,14 @@ func (sq *SkillQuery) Clone() *SkillQuery {
 		return nil
 	}
 	return &SkillQuery{
-		config:     sq.config,
-		ctx:        sq.ctx.Clone(),
-		order:      append([]skill.OrderOption{}, sq.order...),
-		inters:     append([]Interceptor{}, sq.inters...),
-		predicates: append([]predicate.Skill{}, sq.predicates...),
-		withUsers:  sq.withUsers.Clone(),
-		withTeams:  sq.withTeams.Clone(),
+		config:      sq.config,
+		ctx:         sq.ctx.Clone(),
+		order:       append([]skill.OrderOption{}, sq.order...),
+		inters:      append([]Interceptor{}, sq.inters...),
+		predicates:  append([]predicate.Skill{}, sq.predicates...),
+		withUsers:   sq.withUsers.Clone(),
+		withTeams:   sq.withTeams.Clone(),
+		withAliases: sq.withAliases.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -331,6 +356,17 @@ func (sq *SkillQuery) WithTeams(opts ...func(*TeamQuery)) *SkillQuery {
 	return sq
 }
 
+// WithAliases tells the query-builder to eager-load the nodes that are connected to
+// the "aliases" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SkillQuery) WithAliases(opts ...func(*SkillAliasQuery)) *SkillQuery {
+	query := (&SkillAliasClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withAliases = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +445,10 @@ func (sq *SkillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Skill,
 	var (
 		nodes       = []*Skill{}
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			sq.withUsers != nil,
 			sq.withTeams != nil,
+			sq.withAliases != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -449,6 +486,13 @@ func (sq *SkillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Skill,
 			return nil, err
 		}
 	}
+	if query := sq.withAliases; query != nil {
+		if err := sq.loadAliases(ctx, query, nodes,
+			func(n *Skill) { n.Edges.Aliases = []*SkillAlias{} },
+			func(n *Skill, e *SkillAlias) { n.Edges.Aliases = append(n.Edges.Aliases, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -574,6 +618,37 @@ func (sq *SkillQuery) loadTeams(ctx context.Context, query *TeamQuery, nodes []*
 	}
 	return nil
 }
+func (sq *SkillQuery) loadAliases(ctx context.Context, query *SkillAliasQuery, nodes []*Skill, init func(*Skill), assign func(*Skill, *SkillAlias)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Skill)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SkillAlias(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(skill.AliasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.skill_aliases
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "skill_aliases" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "skill_aliases" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SkillQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
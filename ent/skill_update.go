@@ -6,6 +6,7 @@ import (
 	"backend_golang/ent/member"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
 	"backend_golang/ent/team"
 	"context"
 	"errors"
@@ -43,6 +44,20 @@ func (su *SkillUpdate) SetNillableName(s *string) *SkillUpdate {
 	return su
 }
 
+// SetCategory sets the "category" field.
+func (su *SkillUpdate) SetCategory(s string) *SkillUpdate {
+	su.mutation.SetCategory(s)
+	return su
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (su *SkillUpdate) SetNillableCategory(s *string) *SkillUpdate {
+	if s != nil {
+		su.SetCategory(*s)
+	}
+	return su
+}
+
 // AddUserIDs adds the "users" edge to the Member entity by IDs.
 func (su *SkillUpdate) AddUserIDs(ids ...int) *SkillUpdate {
 	su.mutation.AddUserIDs(ids...)
@@ -73,6 +88,21 @@ func (su *SkillUpdate) AddTeams(t ...*Team) *SkillUpdate {
 	return su.AddTeamIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the SkillAlias entity by IDs.
+func (su *SkillUpdate) AddAliasIDs(ids ...int) *SkillUpdate {
+	su.mutation.AddAliasIDs(ids...)
+	return su
+}
+
+// AddAliases adds the "aliases" edges to the SkillAlias entity.
+func (su *SkillUpdate) AddAliases(s ...*SkillAlias) *SkillUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddAliasIDs(ids...)
+}
+
 // Mutation returns the SkillMutation object of the builder.
 func (su *SkillUpdate) Mutation() *SkillMutation {
 	return su.mutation
@@ -120,6 +150,27 @@ func (su *SkillUpdate) RemoveTeams(t ...*Team) *SkillUpdate {
 	return su.RemoveTeamIDs(ids...)
 }
 
+// ClearAliases clears all "aliases" edges to the SkillAlias entity.
+func (su *SkillUpdate) ClearAliases() *SkillUpdate {
+	su.mutation.ClearAliases()
+	return su
+}
+
+// RemoveAliasIDs removes the "aliases" edge to SkillAlias entities by IDs.
+func (su *SkillUpdate) RemoveAliasIDs(ids ...int) *SkillUpdate {
+	su.mutation.RemoveAliasIDs(ids...)
+	return su
+}
+
+// RemoveAliases removes "aliases" edges to SkillAlias entities.
+func (su *SkillUpdate) RemoveAliases(s ...*SkillAlias) *SkillUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveAliasIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SkillUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -172,6 +223,9 @@ func (su *SkillUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.Name(); ok {
 		_spec.SetField(skill.FieldName, field.TypeString, value)
 	}
+	if value, ok := su.mutation.Category(); ok {
+		_spec.SetField(skill.FieldCategory, field.TypeString, value)
+	}
 	if su.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -262,6 +316,51 @@ func (su *SkillUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !su.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{skill.Label}
@@ -296,6 +395,20 @@ func (suo *SkillUpdateOne) SetNillableName(s *string) *SkillUpdateOne {
 	return suo
 }
 
+// SetCategory sets the "category" field.
+func (suo *SkillUpdateOne) SetCategory(s string) *SkillUpdateOne {
+	suo.mutation.SetCategory(s)
+	return suo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (suo *SkillUpdateOne) SetNillableCategory(s *string) *SkillUpdateOne {
+	if s != nil {
+		suo.SetCategory(*s)
+	}
+	return suo
+}
+
 // AddUserIDs adds the "users" edge to the Member entity by IDs.
 func (suo *SkillUpdateOne) AddUserIDs(ids ...int) *SkillUpdateOne {
 	suo.mutation.AddUserIDs(ids...)
@@ -326,6 +439,21 @@ func (suo *SkillUpdateOne) AddTeams(t ...*Team) *SkillUpdateOne {
 	return suo.AddTeamIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the SkillAlias entity by IDs.
+func (suo *SkillUpdateOne) AddAliasIDs(ids ...int) *SkillUpdateOne {
+	suo.mutation.AddAliasIDs(ids...)
+	return suo
+}
+
+// AddAliases adds the "aliases" edges to the SkillAlias entity.
+func (suo *SkillUpdateOne) AddAliases(s ...*SkillAlias) *SkillUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddAliasIDs(ids...)
+}
+
 // Mutation returns the SkillMutation object of the builder.
 func (suo *SkillUpdateOne) Mutation() *SkillMutation {
 	return suo.mutation
@@ -373,6 +501,27 @@ func (suo *SkillUpdateOne) RemoveTeams(t ...*Team) *SkillUpdateOne {
 	return suo.RemoveTeamIDs(ids...)
 }
 
+// ClearAliases clears all "aliases" edges to the SkillAlias entity.
+func (suo *SkillUpdateOne) ClearAliases() *SkillUpdateOne {
+	suo.mutation.ClearAliases()
+	return suo
+}
+
+// RemoveAliasIDs removes the "aliases" edge to SkillAlias entities by IDs.
+func (suo *SkillUpdateOne) RemoveAliasIDs(ids ...int) *SkillUpdateOne {
+	suo.mutation.RemoveAliasIDs(ids...)
+	return suo
+}
+
+// RemoveAliases removes "aliases" edges to SkillAlias entities.
+func (suo *SkillUpdateOne) RemoveAliases(s ...*SkillAlias) *SkillUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveAliasIDs(ids...)
+}
+
 // Where appends a list predicates to the SkillUpdate builder.
 func (suo *SkillUpdateOne) Where(ps ...predicate.Skill) *SkillUpdateOne {
 	suo.mutation.Where(ps...)
@@ -455,6 +604,9 @@ func (suo *SkillUpdateOne) sqlSave(ctx context.Context) (_node *Skill, err error
 	if value, ok := suo.mutation.Name(); ok {
 		_spec.SetField(skill.FieldName, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.Category(); ok {
+		_spec.SetField(skill.FieldCategory, field.TypeString, value)
+	}
 	if suo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -545,6 +697,51 @@ func (suo *SkillUpdateOne) sqlSave(ctx context.Context) (_node *Skill, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !suo.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   skill.AliasesTable,
+			Columns: []string{skill.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Skill{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
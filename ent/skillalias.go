@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SkillAlias is the model entity for the SkillAlias schema.
+type SkillAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SkillAliasQuery when eager-loading is set.
+	Edges         SkillAliasEdges `json:"edges"`
+	skill_aliases *int
+	selectValues  sql.SelectValues
+}
+
+// SkillAliasEdges holds the relations/edges for other nodes in the graph.
+type SkillAliasEdges struct {
+	// Skill holds the value of the skill edge.
+	Skill *Skill `json:"skill,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SkillOrErr returns the Skill value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SkillAliasEdges) SkillOrErr() (*Skill, error) {
+	if e.Skill != nil {
+		return e.Skill, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: skill.Label}
+	}
+	return nil, &NotLoadedError{edge: "skill"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SkillAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case skillalias.FieldID:
+			values[i] = new(sql.NullInt64)
+		case skillalias.FieldName:
+			values[i] = new(sql.NullString)
+		case skillalias.ForeignKeys[0]: // skill_aliases
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SkillAlias fields.
+func (sa *SkillAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case skillalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = int(value.Int64)
+		case skillalias.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sa.Name = value.String
+			}
+		case skillalias.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field skill_aliases", value)
+			} else if value.Valid {
+				sa.skill_aliases = new(int)
+				*sa.skill_aliases = int(value.Int64)
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SkillAlias.
+// This includes values selected through modifiers, order, etc.
+func (sa *SkillAlias) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QuerySkill queries the "skill" edge of the SkillAlias entity.
+func (sa *SkillAlias) QuerySkill() *SkillQuery {
+	return NewSkillAliasClient(sa.config).QuerySkill(sa)
+}
+
+// Update returns a builder for updating this SkillAlias.
+// Note that you need to call SkillAlias.Unwrap() before calling this method if this SkillAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SkillAlias) Update() *SkillAliasUpdateOne {
+	return NewSkillAliasClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SkillAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SkillAlias) Unwrap() *SkillAlias {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SkillAlias is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SkillAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("SkillAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sa.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SkillAliasSlice is a parsable slice of SkillAlias.
+type SkillAliasSlice []*SkillAlias
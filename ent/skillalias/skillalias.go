@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package skillalias
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the skillalias type in the database.
+	Label = "skill_alias"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeSkill holds the string denoting the skill edge name in mutations.
+	EdgeSkill = "skill"
+	// Table holds the table name of the skillalias in the database.
+	Table = "skill_alias"
+	// SkillTable is the table that holds the skill relation/edge.
+	SkillTable = "skill_alias"
+	// SkillInverseTable is the table name for the Skill entity.
+	// It exists in this package in order to avoid circular dependency with the "skill" package.
+	SkillInverseTable = "skills"
+	// SkillColumn is the table column denoting the skill relation/edge.
+	SkillColumn = "skill_aliases"
+)
+
+// Columns holds all SQL columns for skillalias fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "skill_alias"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"skill_aliases",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SkillAlias queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySkillField orders the results by skill field.
+func BySkillField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSkillStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSkillStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SkillInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SkillTable, SkillColumn),
+	)
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SkillAliasCreate is the builder for creating a SkillAlias entity.
+type SkillAliasCreate struct {
+	config
+	mutation *SkillAliasMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sac *SkillAliasCreate) SetName(s string) *SkillAliasCreate {
+	sac.mutation.SetName(s)
+	return sac
+}
+
+// SetSkillID sets the "skill" edge to the Skill entity by ID.
+func (sac *SkillAliasCreate) SetSkillID(id int) *SkillAliasCreate {
+	sac.mutation.SetSkillID(id)
+	return sac
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (sac *SkillAliasCreate) SetSkill(s *Skill) *SkillAliasCreate {
+	return sac.SetSkillID(s.ID)
+}
+
+// Mutation returns the SkillAliasMutation object of the builder.
+func (sac *SkillAliasCreate) Mutation() *SkillAliasMutation {
+	return sac.mutation
+}
+
+// Save creates the SkillAlias in the database.
+func (sac *SkillAliasCreate) Save(ctx context.Context) (*SkillAlias, error) {
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SkillAliasCreate) SaveX(ctx context.Context) *SkillAlias {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SkillAliasCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SkillAliasCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SkillAliasCreate) check() error {
+	if _, ok := sac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SkillAlias.name"`)}
+	}
+	if v, ok := sac.mutation.Name(); ok {
+		if err := skillalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SkillAlias.name": %w`, err)}
+		}
+	}
+	if len(sac.mutation.SkillIDs()) == 0 {
+		return &ValidationError{Name: "skill", err: errors.New(`ent: missing required edge "SkillAlias.skill"`)}
+	}
+	return nil
+}
+
+func (sac *SkillAliasCreate) sqlSave(ctx context.Context) (*SkillAlias, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SkillAliasCreate) createSpec() (*SkillAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SkillAlias{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(skillalias.Table, sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt))
+	)
+	if value, ok := sac.mutation.Name(); ok {
+		_spec.SetField(skillalias.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := sac.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   skillalias.SkillTable,
+			Columns: []string{skillalias.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.skill_aliases = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SkillAliasCreateBulk is the builder for creating many SkillAlias entities in bulk.
+type SkillAliasCreateBulk struct {
+	config
+	err      error
+	builders []*SkillAliasCreate
+}
+
+// Save creates the SkillAlias entities in the database.
+func (sacb *SkillAliasCreateBulk) Save(ctx context.Context) ([]*SkillAlias, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SkillAlias, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SkillAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SkillAliasCreateBulk) SaveX(ctx context.Context) []*SkillAlias {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SkillAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SkillAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SkillAliasQuery is the builder for querying SkillAlias entities.
+type SkillAliasQuery struct {
+	config
+	ctx        *QueryContext
+	order      []skillalias.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SkillAlias
+	withSkill  *SkillQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SkillAliasQuery builder.
+func (saq *SkillAliasQuery) Where(ps ...predicate.SkillAlias) *SkillAliasQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit the number of records to be returned by this query.
+func (saq *SkillAliasQuery) Limit(limit int) *SkillAliasQuery {
+	saq.ctx.Limit = &limit
+	return saq
+}
+
+// Offset to start from.
+func (saq *SkillAliasQuery) Offset(offset int) *SkillAliasQuery {
+	saq.ctx.Offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *SkillAliasQuery) Unique(unique bool) *SkillAliasQuery {
+	saq.ctx.Unique = &unique
+	return saq
+}
+
+// Order specifies how the records should be ordered.
+func (saq *SkillAliasQuery) Order(o ...skillalias.OrderOption) *SkillAliasQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// QuerySkill chains the current query on the "skill" edge.
+func (saq *SkillAliasQuery) QuerySkill() *SkillQuery {
+	query := (&SkillClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(skillalias.Table, skillalias.FieldID, selector),
+			sqlgraph.To(skill.Table, skill.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, skillalias.SkillTable, skillalias.SkillColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SkillAlias entity from the query.
+// Returns a *NotFoundError when no SkillAlias was found.
+func (saq *SkillAliasQuery) First(ctx context.Context) (*SkillAlias, error) {
+	nodes, err := saq.Limit(1).All(setContextOp(ctx, saq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{skillalias.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *SkillAliasQuery) FirstX(ctx context.Context) *SkillAlias {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SkillAlias ID from the query.
+// Returns a *NotFoundError when no SkillAlias ID was found.
+func (saq *SkillAliasQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = saq.Limit(1).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{skillalias.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *SkillAliasQuery) FirstIDX(ctx context.Context) int {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SkillAlias entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SkillAlias entity is found.
+// Returns a *NotFoundError when no SkillAlias entities are found.
+func (saq *SkillAliasQuery) Only(ctx context.Context) (*SkillAlias, error) {
+	nodes, err := saq.Limit(2).All(setContextOp(ctx, saq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{skillalias.Label}
+	default:
+		return nil, &NotSingularError{skillalias.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *SkillAliasQuery) OnlyX(ctx context.Context) *SkillAlias {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SkillAlias ID in the query.
+// Returns a *NotSingularError when more than one SkillAlias ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *SkillAliasQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = saq.Limit(2).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{skillalias.Label}
+	default:
+		err = &NotSingularError{skillalias.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *SkillAliasQuery) OnlyIDX(ctx context.Context) int {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SkillAliasSlice.
+func (saq *SkillAliasQuery) All(ctx context.Context) ([]*SkillAlias, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryAll)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SkillAlias, *SkillAliasQuery]()
+	return withInterceptors[[]*SkillAlias](ctx, saq, qr, saq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *SkillAliasQuery) AllX(ctx context.Context) []*SkillAlias {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SkillAlias IDs.
+func (saq *SkillAliasQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if saq.ctx.Unique == nil && saq.path != nil {
+		saq.Unique(true)
+	}
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryIDs)
+	if err = saq.Select(skillalias.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *SkillAliasQuery) IDsX(ctx context.Context) []int {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *SkillAliasQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryCount)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, saq, querierCount[*SkillAliasQuery](), saq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *SkillAliasQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *SkillAliasQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryExist)
+	switch _, err := saq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *SkillAliasQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SkillAliasQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *SkillAliasQuery) Clone() *SkillAliasQuery {
+	if saq == nil {
+		return nil
+	}
+	return &SkillAliasQuery{
+		config:     saq.config,
+		ctx:        saq.ctx.Clone(),
+		order:      append([]skillalias.OrderOption{}, saq.order...),
+		inters:     append([]Interceptor{}, saq.inters...),
+		predicates: append([]predicate.SkillAlias{}, saq.predicates...),
+		withSkill:  saq.withSkill.Clone(),
+		// clone intermediate query.
+		sql:  saq.sql.Clone(),
+		path: saq.path,
+	}
+}
+
+// WithSkill tells the query-builder to eager-load the nodes that are connected to
+// the "skill" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *SkillAliasQuery) WithSkill(opts ...func(*SkillQuery)) *SkillAliasQuery {
+	query := (&SkillClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withSkill = query
+	return saq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SkillAlias.Query().
+//		GroupBy(skillalias.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (saq *SkillAliasQuery) GroupBy(field string, fields ...string) *SkillAliasGroupBy {
+	saq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SkillAliasGroupBy{build: saq}
+	grbuild.flds = &saq.ctx.Fields
+	grbuild.label = skillalias.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SkillAlias.Query().
+//		Select(skillalias.FieldName).
+//		Scan(ctx, &v)
+func (saq *SkillAliasQuery) Select(fields ...string) *SkillAliasSelect {
+	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
+	sbuild := &SkillAliasSelect{SkillAliasQuery: saq}
+	sbuild.label = skillalias.Label
+	sbuild.flds, sbuild.scan = &saq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SkillAliasSelect configured with the given aggregations.
+func (saq *SkillAliasQuery) Aggregate(fns ...AggregateFunc) *SkillAliasSelect {
+	return saq.Select().Aggregate(fns...)
+}
+
+func (saq *SkillAliasQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range saq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, saq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range saq.ctx.Fields {
+		if !skillalias.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *SkillAliasQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SkillAlias, error) {
+	var (
+		nodes       = []*SkillAlias{}
+		withFKs     = saq.withFKs
+		_spec       = saq.querySpec()
+		loadedTypes = [1]bool{
+			saq.withSkill != nil,
+		}
+	)
+	if saq.withSkill != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, skillalias.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SkillAlias).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SkillAlias{config: saq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := saq.withSkill; query != nil {
+		if err := saq.loadSkill(ctx, query, nodes, nil,
+			func(n *SkillAlias, e *Skill) { n.Edges.Skill = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (saq *SkillAliasQuery) loadSkill(ctx context.Context, query *SkillQuery, nodes []*SkillAlias, init func(*SkillAlias), assign func(*SkillAlias, *Skill)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SkillAlias)
+	for i := range nodes {
+		if nodes[i].skill_aliases == nil {
+			continue
+		}
+		fk := *nodes[i].skill_aliases
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(skill.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "skill_aliases" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (saq *SkillAliasQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	_spec.Node.Columns = saq.ctx.Fields
+	if len(saq.ctx.Fields) > 0 {
+		_spec.Unique = saq.ctx.Unique != nil && *saq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *SkillAliasQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(skillalias.Table, skillalias.Columns, sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt))
+	_spec.From = saq.sql
+	if unique := saq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if saq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := saq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, skillalias.FieldID)
+		for i := range fields {
+			if fields[i] != skillalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *SkillAliasQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(skillalias.Table)
+	columns := saq.ctx.Fields
+	if len(columns) == 0 {
+		columns = skillalias.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.ctx.Unique != nil && *saq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range saq.modifiers {
+		m(selector)
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (saq *SkillAliasQuery) ForUpdate(opts ...sql.LockOption) *SkillAliasQuery {
+	if saq.driver.Dialect() == dialect.Postgres {
+		saq.Unique(false)
+	}
+	saq.modifiers = append(saq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return saq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (saq *SkillAliasQuery) ForShare(opts ...sql.LockOption) *SkillAliasQuery {
+	if saq.driver.Dialect() == dialect.Postgres {
+		saq.Unique(false)
+	}
+	saq.modifiers = append(saq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return saq
+}
+
+// SkillAliasGroupBy is the group-by builder for SkillAlias entities.
+type SkillAliasGroupBy struct {
+	selector
+	build *SkillAliasQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *SkillAliasGroupBy) Aggregate(fns ...AggregateFunc) *SkillAliasGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sagb *SkillAliasGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sagb.build.ctx, ent.OpQueryGroupBy)
+	if err := sagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SkillAliasQuery, *SkillAliasGroupBy](ctx, sagb.build, sagb, sagb.build.inters, v)
+}
+
+func (sagb *SkillAliasGroupBy) sqlScan(ctx context.Context, root *SkillAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sagb.flds)+len(sagb.fns))
+		for _, f := range *sagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SkillAliasSelect is the builder for selecting fields of SkillAlias entities.
+type SkillAliasSelect struct {
+	*SkillAliasQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sas *SkillAliasSelect) Aggregate(fns ...AggregateFunc) *SkillAliasSelect {
+	sas.fns = append(sas.fns, fns...)
+	return sas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *SkillAliasSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sas.ctx, ent.OpQuerySelect)
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SkillAliasQuery, *SkillAliasSelect](ctx, sas.SkillAliasQuery, sas, sas.inters, v)
+}
+
+func (sas *SkillAliasSelect) sqlScan(ctx context.Context, root *SkillAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sas.fns))
+	for _, fn := range sas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SkillAliasUpdate is the builder for updating SkillAlias entities.
+type SkillAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SkillAliasMutation
+}
+
+// Where appends a list predicates to the SkillAliasUpdate builder.
+func (sau *SkillAliasUpdate) Where(ps ...predicate.SkillAlias) *SkillAliasUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetName sets the "name" field.
+func (sau *SkillAliasUpdate) SetName(s string) *SkillAliasUpdate {
+	sau.mutation.SetName(s)
+	return sau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sau *SkillAliasUpdate) SetNillableName(s *string) *SkillAliasUpdate {
+	if s != nil {
+		sau.SetName(*s)
+	}
+	return sau
+}
+
+// SetSkillID sets the "skill" edge to the Skill entity by ID.
+func (sau *SkillAliasUpdate) SetSkillID(id int) *SkillAliasUpdate {
+	sau.mutation.SetSkillID(id)
+	return sau
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (sau *SkillAliasUpdate) SetSkill(s *Skill) *SkillAliasUpdate {
+	return sau.SetSkillID(s.ID)
+}
+
+// Mutation returns the SkillAliasMutation object of the builder.
+func (sau *SkillAliasUpdate) Mutation() *SkillAliasMutation {
+	return sau.mutation
+}
+
+// ClearSkill clears the "skill" edge to the Skill entity.
+func (sau *SkillAliasUpdate) ClearSkill() *SkillAliasUpdate {
+	sau.mutation.ClearSkill()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SkillAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SkillAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SkillAliasUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SkillAliasUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *SkillAliasUpdate) check() error {
+	if v, ok := sau.mutation.Name(); ok {
+		if err := skillalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SkillAlias.name": %w`, err)}
+		}
+	}
+	if sau.mutation.SkillCleared() && len(sau.mutation.SkillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SkillAlias.skill"`)
+	}
+	return nil
+}
+
+func (sau *SkillAliasUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(skillalias.Table, skillalias.Columns, sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Name(); ok {
+		_spec.SetField(skillalias.FieldName, field.TypeString, value)
+	}
+	if sau.mutation.SkillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   skillalias.SkillTable,
+			Columns: []string{skillalias.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   skillalias.SkillTable,
+			Columns: []string{skillalias.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{skillalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SkillAliasUpdateOne is the builder for updating a single SkillAlias entity.
+type SkillAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SkillAliasMutation
+}
+
+// SetName sets the "name" field.
+func (sauo *SkillAliasUpdateOne) SetName(s string) *SkillAliasUpdateOne {
+	sauo.mutation.SetName(s)
+	return sauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sauo *SkillAliasUpdateOne) SetNillableName(s *string) *SkillAliasUpdateOne {
+	if s != nil {
+		sauo.SetName(*s)
+	}
+	return sauo
+}
+
+// SetSkillID sets the "skill" edge to the Skill entity by ID.
+func (sauo *SkillAliasUpdateOne) SetSkillID(id int) *SkillAliasUpdateOne {
+	sauo.mutation.SetSkillID(id)
+	return sauo
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (sauo *SkillAliasUpdateOne) SetSkill(s *Skill) *SkillAliasUpdateOne {
+	return sauo.SetSkillID(s.ID)
+}
+
+// Mutation returns the SkillAliasMutation object of the builder.
+func (sauo *SkillAliasUpdateOne) Mutation() *SkillAliasMutation {
+	return sauo.mutation
+}
+
+// ClearSkill clears the "skill" edge to the Skill entity.
+func (sauo *SkillAliasUpdateOne) ClearSkill() *SkillAliasUpdateOne {
+	sauo.mutation.ClearSkill()
+	return sauo
+}
+
+// Where appends a list predicates to the SkillAliasUpdate builder.
+func (sauo *SkillAliasUpdateOne) Where(ps ...predicate.SkillAlias) *SkillAliasUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SkillAliasUpdateOne) Select(field string, fields ...string) *SkillAliasUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SkillAlias entity.
+func (sauo *SkillAliasUpdateOne) Save(ctx context.Context) (*SkillAlias, error) {
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SkillAliasUpdateOne) SaveX(ctx context.Context) *SkillAlias {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SkillAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SkillAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *SkillAliasUpdateOne) check() error {
+	if v, ok := sauo.mutation.Name(); ok {
+		if err := skillalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SkillAlias.name": %w`, err)}
+		}
+	}
+	if sauo.mutation.SkillCleared() && len(sauo.mutation.SkillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SkillAlias.skill"`)
+	}
+	return nil
+}
+
+func (sauo *SkillAliasUpdateOne) sqlSave(ctx context.Context) (_node *SkillAlias, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(skillalias.Table, skillalias.Columns, sqlgraph.NewFieldSpec(skillalias.FieldID, field.TypeInt))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SkillAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, skillalias.FieldID)
+		for _, f := range fields {
+			if !skillalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != skillalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Name(); ok {
+		_spec.SetField(skillalias.FieldName, field.TypeString, value)
+	}
+	if sauo.mutation.SkillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   skillalias.SkillTable,
+			Columns: []string{skillalias.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   skillalias.SkillTable,
+			Columns: []string{skillalias.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SkillAlias{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{skillalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
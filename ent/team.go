@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend_golang/ent/team"
+	"backend_golang/internal/models"
 	"fmt"
 	"strings"
 
@@ -24,6 +25,8 @@ type Team struct {
 	Headcount int8 `json:"headcount,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy string `json:"created_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status models.TeamStatus `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TeamQuery when eager-loading is set.
 	Edges        TeamEdges `json:"edges"`
@@ -38,11 +41,19 @@ type TeamEdges struct {
 	Members []*Member `json:"members,omitempty"`
 	// Announcements holds the value of the announcements edge.
 	Announcements []*Announcement `json:"announcements,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*Event `json:"events,omitempty"`
+	// Threads holds the value of the threads edge.
+	Threads []*Thread `json:"threads,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// Skills holds the value of the skills edge.
 	Skills []*Skill `json:"skills,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [8]bool
 }
 
 // PositionsOrErr returns the Positions value or an error if the edge
@@ -72,10 +83,46 @@ func (e TeamEdges) AnnouncementsOrErr() ([]*Announcement, error) {
 	return nil, &NotLoadedError{edge: "announcements"}
 }
 
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[3] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) EventsOrErr() ([]*Event, error) {
+	if e.loadedTypes[4] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// ThreadsOrErr returns the Threads value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) ThreadsOrErr() ([]*Thread, error) {
+	if e.loadedTypes[5] {
+		return e.Threads, nil
+	}
+	return nil, &NotLoadedError{edge: "threads"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[6] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // SkillsOrErr returns the Skills value or an error if the edge
 // was not loaded in eager-loading.
 func (e TeamEdges) SkillsOrErr() ([]*Skill, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[7] {
 		return e.Skills, nil
 	}
 	return nil, &NotLoadedError{edge: "skills"}
@@ -88,7 +135,7 @@ func (*Team) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case team.FieldID, team.FieldHeadcount:
 			values[i] = new(sql.NullInt64)
-		case team.FieldName, team.FieldDescription, team.FieldCreatedBy:
+		case team.FieldName, team.FieldDescription, team.FieldCreatedBy, team.FieldStatus:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,6 +182,12 @@ func (t *Team) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.CreatedBy = value.String
 			}
+		case team.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				t.Status = models.TeamStatus(value.String)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -163,6 +216,26 @@ func (t *Team) QueryAnnouncements() *AnnouncementQuery {
 	return NewTeamClient(t.config).QueryAnnouncements(t)
 }
 
+// QueryInvitations queries the "invitations" edge of the Team entity.
+func (t *Team) QueryInvitations() *InvitationQuery {
+	return NewTeamClient(t.config).QueryInvitations(t)
+}
+
+// QueryEvents queries the "events" edge of the Team entity.
+func (t *Team) QueryEvents() *EventQuery {
+	return NewTeamClient(t.config).QueryEvents(t)
+}
+
+// QueryThreads queries the "threads" edge of the Team entity.
+func (t *Team) QueryThreads() *ThreadQuery {
+	return NewTeamClient(t.config).QueryThreads(t)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Team entity.
+func (t *Team) QueryWebhooks() *WebhookQuery {
+	return NewTeamClient(t.config).QueryWebhooks(t)
+}
+
 // QuerySkills queries the "skills" edge of the Team entity.
 func (t *Team) QuerySkills() *SkillQuery {
 	return NewTeamClient(t.config).QuerySkills(t)
@@ -202,6 +275,9 @@ func (t *Team) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(t.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", t.Status))
 	builder.WriteByte(')')
 	return builder.String()
 }
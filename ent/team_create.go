@@ -4,10 +4,15 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/event"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/webhook"
+	"backend_golang/internal/models"
 	"context"
 	"errors"
 	"fmt"
@@ -47,6 +52,20 @@ func (tc *TeamCreate) SetCreatedBy(s string) *TeamCreate {
 	return tc
 }
 
+// SetStatus sets the "status" field.
+func (tc *TeamCreate) SetStatus(ms models.TeamStatus) *TeamCreate {
+	tc.mutation.SetStatus(ms)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TeamCreate) SetNillableStatus(ms *models.TeamStatus) *TeamCreate {
+	if ms != nil {
+		tc.SetStatus(*ms)
+	}
+	return tc
+}
+
 // AddPositionIDs adds the "positions" edge to the Position entity by IDs.
 func (tc *TeamCreate) AddPositionIDs(ids ...int) *TeamCreate {
 	tc.mutation.AddPositionIDs(ids...)
@@ -92,6 +111,66 @@ func (tc *TeamCreate) AddAnnouncements(a ...*Announcement) *TeamCreate {
 	return tc.AddAnnouncementIDs(ids...)
 }
 
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (tc *TeamCreate) AddInvitationIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddInvitationIDs(ids...)
+	return tc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (tc *TeamCreate) AddInvitations(i ...*Invitation) *TeamCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return tc.AddInvitationIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (tc *TeamCreate) AddEventIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddEventIDs(ids...)
+	return tc
+}
+
+// AddEvents adds the "events" edges to the Event entity.
+func (tc *TeamCreate) AddEvents(e ...*Event) *TeamCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tc.AddEventIDs(ids...)
+}
+
+// AddThreadIDs adds the "threads" edge to the Thread entity by IDs.
+func (tc *TeamCreate) AddThreadIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddThreadIDs(ids...)
+	return tc
+}
+
+// AddThreads adds the "threads" edges to the Thread entity.
+func (tc *TeamCreate) AddThreads(t ...*Thread) *TeamCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddThreadIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (tc *TeamCreate) AddWebhookIDs(ids ...int) *TeamCreate {
+	tc.mutation.AddWebhookIDs(ids...)
+	return tc
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (tc *TeamCreate) AddWebhooks(w ...*Webhook) *TeamCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tc.AddWebhookIDs(ids...)
+}
+
 // AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
 func (tc *TeamCreate) AddSkillIDs(ids ...int) *TeamCreate {
 	tc.mutation.AddSkillIDs(ids...)
@@ -114,6 +193,7 @@ func (tc *TeamCreate) Mutation() *TeamMutation {
 
 // Save creates the Team in the database.
 func (tc *TeamCreate) Save(ctx context.Context) (*Team, error) {
+	tc.defaults()
 	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
 }
 
@@ -139,6 +219,14 @@ func (tc *TeamCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tc *TeamCreate) defaults() {
+	if _, ok := tc.mutation.Status(); !ok {
+		v := team.DefaultStatus
+		tc.mutation.SetStatus(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tc *TeamCreate) check() error {
 	if _, ok := tc.mutation.Name(); !ok {
@@ -158,6 +246,14 @@ func (tc *TeamCreate) check() error {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Team.created_by": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Team.status"`)}
+	}
+	if v, ok := tc.mutation.Status(); ok {
+		if err := team.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Team.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -200,6 +296,10 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		_spec.SetField(team.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
 	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.SetField(team.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if nodes := tc.mutation.PositionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -248,6 +348,70 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ThreadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.SkillsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -285,6 +449,7 @@ func (tcb *TeamCreateBulk) Save(ctx context.Context) ([]*Team, error) {
 	for i := range tcb.builders {
 		func(i int, root context.Context) {
 			builder := tcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TeamMutation)
 				if !ok {
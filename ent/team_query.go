@@ -4,11 +4,15 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/event"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/webhook"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -31,6 +35,10 @@ type TeamQuery struct {
 	withPositions     *PositionQuery
 	withMembers       *MemberQuery
 	withAnnouncements *AnnouncementQuery
+	withInvitations   *InvitationQuery
+	withEvents        *EventQuery
+	withThreads       *ThreadQuery
+	withWebhooks      *WebhookQuery
 	withSkills        *SkillQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -135,6 +143,94 @@ func (tq *TeamQuery) QueryAnnouncements() *AnnouncementQuery {
 	return query
 }
 
+// QueryInvitations chains the current query on the "invitations" edge.
+func (tq *TeamQuery) QueryInvitations() *InvitationQuery {
+	query := (&InvitationClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.InvitationsTable, team.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (tq *TeamQuery) QueryEvents() *EventQuery {
+	query := (&EventClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.EventsTable, team.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThreads chains the current query on the "threads" edge.
+func (tq *TeamQuery) QueryThreads() *ThreadQuery {
+	query := (&ThreadClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(thread.Table, thread.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.ThreadsTable, team.ThreadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (tq *TeamQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.WebhooksTable, team.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySkills chains the current query on the "skills" edge.
 func (tq *TeamQuery) QuerySkills() *SkillQuery {
 	query := (&SkillClient{config: tq.config}).Query()
@@ -352,6 +448,10 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		withPositions:     tq.withPositions.Clone(),
 		withMembers:       tq.withMembers.Clone(),
 		withAnnouncements: tq.withAnnouncements.Clone(),
+		withInvitations:   tq.withInvitations.Clone(),
+		withEvents:        tq.withEvents.Clone(),
+		withThreads:       tq.withThreads.Clone(),
+		withWebhooks:      tq.withWebhooks.Clone(),
 		withSkills:        tq.withSkills.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
@@ -392,6 +492,50 @@ func (tq *TeamQuery) WithAnnouncements(opts ...func(*AnnouncementQuery)) *TeamQu
 	return tq
 }
 
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithInvitations(opts ...func(*InvitationQuery)) *TeamQuery {
+	query := (&InvitationClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withInvitations = query
+	return tq
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithEvents(opts ...func(*EventQuery)) *TeamQuery {
+	query := (&EventClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withEvents = query
+	return tq
+}
+
+// WithThreads tells the query-builder to eager-load the nodes that are connected to
+// the "threads" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithThreads(opts ...func(*ThreadQuery)) *TeamQuery {
+	query := (&ThreadClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withThreads = query
+	return tq
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithWebhooks(opts ...func(*WebhookQuery)) *TeamQuery {
+	query := (&WebhookClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withWebhooks = query
+	return tq
+}
+
 // WithSkills tells the query-builder to eager-load the nodes that are connected to
 // the "skills" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithSkills(opts ...func(*SkillQuery)) *TeamQuery {
@@ -481,10 +625,14 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 	var (
 		nodes       = []*Team{}
 		_spec       = tq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [8]bool{
 			tq.withPositions != nil,
 			tq.withMembers != nil,
 			tq.withAnnouncements != nil,
+			tq.withInvitations != nil,
+			tq.withEvents != nil,
+			tq.withThreads != nil,
+			tq.withWebhooks != nil,
 			tq.withSkills != nil,
 		}
 	)
@@ -530,6 +678,34 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 			return nil, err
 		}
 	}
+	if query := tq.withInvitations; query != nil {
+		if err := tq.loadInvitations(ctx, query, nodes,
+			func(n *Team) { n.Edges.Invitations = []*Invitation{} },
+			func(n *Team, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withEvents; query != nil {
+		if err := tq.loadEvents(ctx, query, nodes,
+			func(n *Team) { n.Edges.Events = []*Event{} },
+			func(n *Team, e *Event) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withThreads; query != nil {
+		if err := tq.loadThreads(ctx, query, nodes,
+			func(n *Team) { n.Edges.Threads = []*Thread{} },
+			func(n *Team, e *Thread) { n.Edges.Threads = append(n.Edges.Threads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withWebhooks; query != nil {
+		if err := tq.loadWebhooks(ctx, query, nodes,
+			func(n *Team) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Team, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withSkills; query != nil {
 		if err := tq.loadSkills(ctx, query, nodes,
 			func(n *Team) { n.Edges.Skills = []*Skill{} },
@@ -632,6 +808,128 @@ func (tq *TeamQuery) loadAnnouncements(ctx context.Context, query *AnnouncementQ
 	}
 	return nil
 }
+func (tq *TeamQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*Team, init func(*Team), assign func(*Team, *Invitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invitation.FieldTeamID)
+	}
+	query.Where(predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TeamQuery) loadEvents(ctx context.Context, query *EventQuery, nodes []*Team, init func(*Team), assign func(*Team, *Event)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(event.FieldTeamID)
+	}
+	query.Where(predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TeamQuery) loadThreads(ctx context.Context, query *ThreadQuery, nodes []*Team, init func(*Team), assign func(*Team, *Thread)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(thread.FieldTeamID)
+	}
+	query.Where(predicate.Thread(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.ThreadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TeamQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Team, init func(*Team), assign func(*Team, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhook.FieldTeamID)
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TeamQuery) loadSkills(ctx context.Context, query *SkillQuery, nodes []*Team, init func(*Team), assign func(*Team, *Skill)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Team)
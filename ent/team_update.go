@@ -4,11 +4,16 @@ package ent
 
 import (
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/event"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/webhook"
+	"backend_golang/internal/models"
 	"context"
 	"errors"
 	"fmt"
@@ -94,6 +99,20 @@ func (tu *TeamUpdate) SetNillableCreatedBy(s *string) *TeamUpdate {
 	return tu
 }
 
+// SetStatus sets the "status" field.
+func (tu *TeamUpdate) SetStatus(ms models.TeamStatus) *TeamUpdate {
+	tu.mutation.SetStatus(ms)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TeamUpdate) SetNillableStatus(ms *models.TeamStatus) *TeamUpdate {
+	if ms != nil {
+		tu.SetStatus(*ms)
+	}
+	return tu
+}
+
 // AddPositionIDs adds the "positions" edge to the Position entity by IDs.
 func (tu *TeamUpdate) AddPositionIDs(ids ...int) *TeamUpdate {
 	tu.mutation.AddPositionIDs(ids...)
@@ -139,6 +158,66 @@ func (tu *TeamUpdate) AddAnnouncements(a ...*Announcement) *TeamUpdate {
 	return tu.AddAnnouncementIDs(ids...)
 }
 
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (tu *TeamUpdate) AddInvitationIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddInvitationIDs(ids...)
+	return tu
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (tu *TeamUpdate) AddInvitations(i ...*Invitation) *TeamUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return tu.AddInvitationIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (tu *TeamUpdate) AddEventIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddEventIDs(ids...)
+	return tu
+}
+
+// AddEvents adds the "events" edges to the Event entity.
+func (tu *TeamUpdate) AddEvents(e ...*Event) *TeamUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tu.AddEventIDs(ids...)
+}
+
+// AddThreadIDs adds the "threads" edge to the Thread entity by IDs.
+func (tu *TeamUpdate) AddThreadIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddThreadIDs(ids...)
+	return tu
+}
+
+// AddThreads adds the "threads" edges to the Thread entity.
+func (tu *TeamUpdate) AddThreads(t ...*Thread) *TeamUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddThreadIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (tu *TeamUpdate) AddWebhookIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddWebhookIDs(ids...)
+	return tu
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (tu *TeamUpdate) AddWebhooks(w ...*Webhook) *TeamUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.AddWebhookIDs(ids...)
+}
+
 // AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
 func (tu *TeamUpdate) AddSkillIDs(ids ...int) *TeamUpdate {
 	tu.mutation.AddSkillIDs(ids...)
@@ -222,6 +301,90 @@ func (tu *TeamUpdate) RemoveAnnouncements(a ...*Announcement) *TeamUpdate {
 	return tu.RemoveAnnouncementIDs(ids...)
 }
 
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (tu *TeamUpdate) ClearInvitations() *TeamUpdate {
+	tu.mutation.ClearInvitations()
+	return tu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (tu *TeamUpdate) RemoveInvitationIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveInvitationIDs(ids...)
+	return tu
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (tu *TeamUpdate) RemoveInvitations(i ...*Invitation) *TeamUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return tu.RemoveInvitationIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the Event entity.
+func (tu *TeamUpdate) ClearEvents() *TeamUpdate {
+	tu.mutation.ClearEvents()
+	return tu
+}
+
+// RemoveEventIDs removes the "events" edge to Event entities by IDs.
+func (tu *TeamUpdate) RemoveEventIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveEventIDs(ids...)
+	return tu
+}
+
+// RemoveEvents removes "events" edges to Event entities.
+func (tu *TeamUpdate) RemoveEvents(e ...*Event) *TeamUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tu.RemoveEventIDs(ids...)
+}
+
+// ClearThreads clears all "threads" edges to the Thread entity.
+func (tu *TeamUpdate) ClearThreads() *TeamUpdate {
+	tu.mutation.ClearThreads()
+	return tu
+}
+
+// RemoveThreadIDs removes the "threads" edge to Thread entities by IDs.
+func (tu *TeamUpdate) RemoveThreadIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveThreadIDs(ids...)
+	return tu
+}
+
+// RemoveThreads removes "threads" edges to Thread entities.
+func (tu *TeamUpdate) RemoveThreads(t ...*Thread) *TeamUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveThreadIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (tu *TeamUpdate) ClearWebhooks() *TeamUpdate {
+	tu.mutation.ClearWebhooks()
+	return tu
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (tu *TeamUpdate) RemoveWebhookIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveWebhookIDs(ids...)
+	return tu
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (tu *TeamUpdate) RemoveWebhooks(w ...*Webhook) *TeamUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.RemoveWebhookIDs(ids...)
+}
+
 // ClearSkills clears all "skills" edges to the Skill entity.
 func (tu *TeamUpdate) ClearSkills() *TeamUpdate {
 	tu.mutation.ClearSkills()
@@ -277,6 +440,11 @@ func (tu *TeamUpdate) check() error {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Team.created_by": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.Status(); ok {
+		if err := team.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Team.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -307,6 +475,9 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.CreatedBy(); ok {
 		_spec.SetField(team.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(team.FieldStatus, field.TypeEnum, value)
+	}
 	if tu.mutation.PositionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -442,6 +613,186 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !tu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedEventsIDs(); len(nodes) > 0 && !tu.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedThreadsIDs(); len(nodes) > 0 && !tu.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ThreadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !tu.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.SkillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -570,6 +921,20 @@ func (tuo *TeamUpdateOne) SetNillableCreatedBy(s *string) *TeamUpdateOne {
 	return tuo
 }
 
+// SetStatus sets the "status" field.
+func (tuo *TeamUpdateOne) SetStatus(ms models.TeamStatus) *TeamUpdateOne {
+	tuo.mutation.SetStatus(ms)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TeamUpdateOne) SetNillableStatus(ms *models.TeamStatus) *TeamUpdateOne {
+	if ms != nil {
+		tuo.SetStatus(*ms)
+	}
+	return tuo
+}
+
 // AddPositionIDs adds the "positions" edge to the Position entity by IDs.
 func (tuo *TeamUpdateOne) AddPositionIDs(ids ...int) *TeamUpdateOne {
 	tuo.mutation.AddPositionIDs(ids...)
@@ -615,6 +980,66 @@ func (tuo *TeamUpdateOne) AddAnnouncements(a ...*Announcement) *TeamUpdateOne {
 	return tuo.AddAnnouncementIDs(ids...)
 }
 
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (tuo *TeamUpdateOne) AddInvitationIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddInvitationIDs(ids...)
+	return tuo
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (tuo *TeamUpdateOne) AddInvitations(i ...*Invitation) *TeamUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return tuo.AddInvitationIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (tuo *TeamUpdateOne) AddEventIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddEventIDs(ids...)
+	return tuo
+}
+
+// AddEvents adds the "events" edges to the Event entity.
+func (tuo *TeamUpdateOne) AddEvents(e ...*Event) *TeamUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tuo.AddEventIDs(ids...)
+}
+
+// AddThreadIDs adds the "threads" edge to the Thread entity by IDs.
+func (tuo *TeamUpdateOne) AddThreadIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddThreadIDs(ids...)
+	return tuo
+}
+
+// AddThreads adds the "threads" edges to the Thread entity.
+func (tuo *TeamUpdateOne) AddThreads(t ...*Thread) *TeamUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddThreadIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (tuo *TeamUpdateOne) AddWebhookIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddWebhookIDs(ids...)
+	return tuo
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (tuo *TeamUpdateOne) AddWebhooks(w ...*Webhook) *TeamUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.AddWebhookIDs(ids...)
+}
+
 // AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
 func (tuo *TeamUpdateOne) AddSkillIDs(ids ...int) *TeamUpdateOne {
 	tuo.mutation.AddSkillIDs(ids...)
@@ -698,6 +1123,90 @@ func (tuo *TeamUpdateOne) RemoveAnnouncements(a ...*Announcement) *TeamUpdateOne
 	return tuo.RemoveAnnouncementIDs(ids...)
 }
 
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (tuo *TeamUpdateOne) ClearInvitations() *TeamUpdateOne {
+	tuo.mutation.ClearInvitations()
+	return tuo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (tuo *TeamUpdateOne) RemoveInvitationIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveInvitationIDs(ids...)
+	return tuo
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (tuo *TeamUpdateOne) RemoveInvitations(i ...*Invitation) *TeamUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return tuo.RemoveInvitationIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the Event entity.
+func (tuo *TeamUpdateOne) ClearEvents() *TeamUpdateOne {
+	tuo.mutation.ClearEvents()
+	return tuo
+}
+
+// RemoveEventIDs removes the "events" edge to Event entities by IDs.
+func (tuo *TeamUpdateOne) RemoveEventIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveEventIDs(ids...)
+	return tuo
+}
+
+// RemoveEvents removes "events" edges to Event entities.
+func (tuo *TeamUpdateOne) RemoveEvents(e ...*Event) *TeamUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tuo.RemoveEventIDs(ids...)
+}
+
+// ClearThreads clears all "threads" edges to the Thread entity.
+func (tuo *TeamUpdateOne) ClearThreads() *TeamUpdateOne {
+	tuo.mutation.ClearThreads()
+	return tuo
+}
+
+// RemoveThreadIDs removes the "threads" edge to Thread entities by IDs.
+func (tuo *TeamUpdateOne) RemoveThreadIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveThreadIDs(ids...)
+	return tuo
+}
+
+// RemoveThreads removes "threads" edges to Thread entities.
+func (tuo *TeamUpdateOne) RemoveThreads(t ...*Thread) *TeamUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveThreadIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (tuo *TeamUpdateOne) ClearWebhooks() *TeamUpdateOne {
+	tuo.mutation.ClearWebhooks()
+	return tuo
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (tuo *TeamUpdateOne) RemoveWebhookIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveWebhookIDs(ids...)
+	return tuo
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (tuo *TeamUpdateOne) RemoveWebhooks(w ...*Webhook) *TeamUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.RemoveWebhookIDs(ids...)
+}
+
 // ClearSkills clears all "skills" edges to the Skill entity.
 func (tuo *TeamUpdateOne) ClearSkills() *TeamUpdateOne {
 	tuo.mutation.ClearSkills()
@@ -766,6 +1275,11 @@ func (tuo *TeamUpdateOne) check() error {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Team.created_by": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := team.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Team.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -813,6 +1327,9 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 	if value, ok := tuo.mutation.CreatedBy(); ok {
 		_spec.SetField(team.FieldCreatedBy, field.TypeString, value)
 	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(team.FieldStatus, field.TypeEnum, value)
+	}
 	if tuo.mutation.PositionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -948,6 +1465,186 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !tuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.InvitationsTable,
+			Columns: []string{team.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !tuo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.EventsTable,
+			Columns: []string{team.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedThreadsIDs(); len(nodes) > 0 && !tuo.mutation.ThreadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ThreadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ThreadsTable,
+			Columns: []string{team.ThreadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !tuo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.SkillsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
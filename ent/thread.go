@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Thread is the model entity for the Thread schema.
+type Thread struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int `json:"team_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Mentions holds the value of the "mentions" field.
+	Mentions []string `json:"mentions,omitempty"`
+	// CommentCount holds the value of the "comment_count" field.
+	CommentCount int `json:"comment_count,omitempty"`
+	// LastActivityAt holds the value of the "last_activity_at" field.
+	LastActivityAt time.Time `json:"last_activity_at,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ThreadQuery when eager-loading is set.
+	Edges          ThreadEdges `json:"edges"`
+	member_threads *int
+	selectValues   sql.SelectValues
+}
+
+// ThreadEdges holds the relations/edges for other nodes in the graph.
+type ThreadEdges struct {
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// Author holds the value of the author edge.
+	Author *Member `json:"author,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*ThreadComment `json:"comments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThreadEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThreadEdges) AuthorOrErr() (*Member, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ThreadEdges) CommentsOrErr() ([]*ThreadComment, error) {
+	if e.loadedTypes[2] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Thread) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case thread.FieldMentions:
+			values[i] = new([]byte)
+		case thread.FieldID, thread.FieldTeamID, thread.FieldCommentCount:
+			values[i] = new(sql.NullInt64)
+		case thread.FieldTitle, thread.FieldBody:
+			values[i] = new(sql.NullString)
+		case thread.FieldLastActivityAt, thread.FieldEditedAt, thread.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case thread.ForeignKeys[0]: // member_threads
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Thread fields.
+func (t *Thread) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case thread.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case thread.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				t.TeamID = int(value.Int64)
+			}
+		case thread.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				t.Title = value.String
+			}
+		case thread.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				t.Body = value.String
+			}
+		case thread.FieldMentions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mentions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Mentions); err != nil {
+					return fmt.Errorf("unmarshal field mentions: %w", err)
+				}
+			}
+		case thread.FieldCommentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_count", values[i])
+			} else if value.Valid {
+				t.CommentCount = int(value.Int64)
+			}
+		case thread.FieldLastActivityAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_activity_at", values[i])
+			} else if value.Valid {
+				t.LastActivityAt = value.Time
+			}
+		case thread.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				t.EditedAt = new(time.Time)
+				*t.EditedAt = value.Time
+			}
+		case thread.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		case thread.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field member_threads", value)
+			} else if value.Valid {
+				t.member_threads = new(int)
+				*t.member_threads = int(value.Int64)
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Thread.
+// This includes values selected through modifiers, order, etc.
+func (t *Thread) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QueryTeam queries the "team" edge of the Thread entity.
+func (t *Thread) QueryTeam() *TeamQuery {
+	return NewThreadClient(t.config).QueryTeam(t)
+}
+
+// QueryAuthor queries the "author" edge of the Thread entity.
+func (t *Thread) QueryAuthor() *MemberQuery {
+	return NewThreadClient(t.config).QueryAuthor(t)
+}
+
+// QueryComments queries the "comments" edge of the Thread entity.
+func (t *Thread) QueryComments() *ThreadCommentQuery {
+	return NewThreadClient(t.config).QueryComments(t)
+}
+
+// Update returns a builder for updating this Thread.
+// Note that you need to call Thread.Unwrap() before calling this method if this Thread
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Thread) Update() *ThreadUpdateOne {
+	return NewThreadClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Thread entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Thread) Unwrap() *Thread {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Thread is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Thread) String() string {
+	var builder strings.Builder
+	builder.WriteString("Thread(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(t.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(t.Body)
+	builder.WriteString(", ")
+	builder.WriteString("mentions=")
+	builder.WriteString(fmt.Sprintf("%v", t.Mentions))
+	builder.WriteString(", ")
+	builder.WriteString("comment_count=")
+	builder.WriteString(fmt.Sprintf("%v", t.CommentCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_activity_at=")
+	builder.WriteString(t.LastActivityAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := t.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Threads is a parsable slice of Thread.
+type Threads []*Thread
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadCreate is the builder for creating a Thread entity.
+type ThreadCreate struct {
+	config
+	mutation *ThreadMutation
+	hooks    []Hook
+}
+
+// SetTeamID sets the "team_id" field.
+func (tc *ThreadCreate) SetTeamID(i int) *ThreadCreate {
+	tc.mutation.SetTeamID(i)
+	return tc
+}
+
+// SetTitle sets the "title" field.
+func (tc *ThreadCreate) SetTitle(s string) *ThreadCreate {
+	tc.mutation.SetTitle(s)
+	return tc
+}
+
+// SetBody sets the "body" field.
+func (tc *ThreadCreate) SetBody(s string) *ThreadCreate {
+	tc.mutation.SetBody(s)
+	return tc
+}
+
+// SetMentions sets the "mentions" field.
+func (tc *ThreadCreate) SetMentions(s []string) *ThreadCreate {
+	tc.mutation.SetMentions(s)
+	return tc
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (tc *ThreadCreate) SetCommentCount(i int) *ThreadCreate {
+	tc.mutation.SetCommentCount(i)
+	return tc
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (tc *ThreadCreate) SetNillableCommentCount(i *int) *ThreadCreate {
+	if i != nil {
+		tc.SetCommentCount(*i)
+	}
+	return tc
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (tc *ThreadCreate) SetLastActivityAt(t time.Time) *ThreadCreate {
+	tc.mutation.SetLastActivityAt(t)
+	return tc
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (tc *ThreadCreate) SetNillableLastActivityAt(t *time.Time) *ThreadCreate {
+	if t != nil {
+		tc.SetLastActivityAt(*t)
+	}
+	return tc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tc *ThreadCreate) SetEditedAt(t time.Time) *ThreadCreate {
+	tc.mutation.SetEditedAt(t)
+	return tc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tc *ThreadCreate) SetNillableEditedAt(t *time.Time) *ThreadCreate {
+	if t != nil {
+		tc.SetEditedAt(*t)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *ThreadCreate) SetCreatedAt(t time.Time) *ThreadCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *ThreadCreate) SetNillableCreatedAt(t *time.Time) *ThreadCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (tc *ThreadCreate) SetTeam(t *Team) *ThreadCreate {
+	return tc.SetTeamID(t.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (tc *ThreadCreate) SetAuthorID(id int) *ThreadCreate {
+	tc.mutation.SetAuthorID(id)
+	return tc
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (tc *ThreadCreate) SetAuthor(m *Member) *ThreadCreate {
+	return tc.SetAuthorID(m.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the ThreadComment entity by IDs.
+func (tc *ThreadCreate) AddCommentIDs(ids ...int) *ThreadCreate {
+	tc.mutation.AddCommentIDs(ids...)
+	return tc
+}
+
+// AddComments adds the "comments" edges to the ThreadComment entity.
+func (tc *ThreadCreate) AddComments(t ...*ThreadComment) *ThreadCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddCommentIDs(ids...)
+}
+
+// Mutation returns the ThreadMutation object of the builder.
+func (tc *ThreadCreate) Mutation() *ThreadMutation {
+	return tc.mutation
+}
+
+// Save creates the Thread in the database.
+func (tc *ThreadCreate) Save(ctx context.Context) (*Thread, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *ThreadCreate) SaveX(ctx context.Context) *Thread {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *ThreadCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *ThreadCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *ThreadCreate) defaults() {
+	if _, ok := tc.mutation.CommentCount(); !ok {
+		v := thread.DefaultCommentCount
+		tc.mutation.SetCommentCount(v)
+	}
+	if _, ok := tc.mutation.LastActivityAt(); !ok {
+		v := thread.DefaultLastActivityAt()
+		tc.mutation.SetLastActivityAt(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := thread.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *ThreadCreate) check() error {
+	if _, ok := tc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "Thread.team_id"`)}
+	}
+	if _, ok := tc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Thread.title"`)}
+	}
+	if v, ok := tc.mutation.Title(); ok {
+		if err := thread.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Thread.title": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Thread.body"`)}
+	}
+	if _, ok := tc.mutation.CommentCount(); !ok {
+		return &ValidationError{Name: "comment_count", err: errors.New(`ent: missing required field "Thread.comment_count"`)}
+	}
+	if _, ok := tc.mutation.LastActivityAt(); !ok {
+		return &ValidationError{Name: "last_activity_at", err: errors.New(`ent: missing required field "Thread.last_activity_at"`)}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Thread.created_at"`)}
+	}
+	if len(tc.mutation.TeamIDs()) == 0 {
+		return &ValidationError{Name: "team", err: errors.New(`ent: missing required edge "Thread.team"`)}
+	}
+	if len(tc.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "Thread.author"`)}
+	}
+	return nil
+}
+
+func (tc *ThreadCreate) sqlSave(ctx context.Context) (*Thread, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *ThreadCreate) createSpec() (*Thread, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Thread{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(thread.Table, sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt))
+	)
+	if value, ok := tc.mutation.Title(); ok {
+		_spec.SetField(thread.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := tc.mutation.Body(); ok {
+		_spec.SetField(thread.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := tc.mutation.Mentions(); ok {
+		_spec.SetField(thread.FieldMentions, field.TypeJSON, value)
+		_node.Mentions = value
+	}
+	if value, ok := tc.mutation.CommentCount(); ok {
+		_spec.SetField(thread.FieldCommentCount, field.TypeInt, value)
+		_node.CommentCount = value
+	}
+	if value, ok := tc.mutation.LastActivityAt(); ok {
+		_spec.SetField(thread.FieldLastActivityAt, field.TypeTime, value)
+		_node.LastActivityAt = value
+	}
+	if value, ok := tc.mutation.EditedAt(); ok {
+		_spec.SetField(thread.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.SetField(thread.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.TeamTable,
+			Columns: []string{thread.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.AuthorTable,
+			Columns: []string{thread.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.member_threads = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ThreadCreateBulk is the builder for creating many Thread entities in bulk.
+type ThreadCreateBulk struct {
+	config
+	err      error
+	builders []*ThreadCreate
+}
+
+// Save creates the Thread entities in the database.
+func (tcb *ThreadCreateBulk) Save(ctx context.Context) ([]*Thread, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Thread, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ThreadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *ThreadCreateBulk) SaveX(ctx context.Context) []*Thread {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *ThreadCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *ThreadCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
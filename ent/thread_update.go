@@ -0,0 +1,846 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadUpdate is the builder for updating Thread entities.
+type ThreadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ThreadMutation
+}
+
+// Where appends a list predicates to the ThreadUpdate builder.
+func (tu *ThreadUpdate) Where(ps ...predicate.Thread) *ThreadUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetTeamID sets the "team_id" field.
+func (tu *ThreadUpdate) SetTeamID(i int) *ThreadUpdate {
+	tu.mutation.SetTeamID(i)
+	return tu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (tu *ThreadUpdate) SetNillableTeamID(i *int) *ThreadUpdate {
+	if i != nil {
+		tu.SetTeamID(*i)
+	}
+	return tu
+}
+
+// SetTitle sets the "title" field.
+func (tu *ThreadUpdate) SetTitle(s string) *ThreadUpdate {
+	tu.mutation.SetTitle(s)
+	return tu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tu *ThreadUpdate) SetNillableTitle(s *string) *ThreadUpdate {
+	if s != nil {
+		tu.SetTitle(*s)
+	}
+	return tu
+}
+
+// SetBody sets the "body" field.
+func (tu *ThreadUpdate) SetBody(s string) *ThreadUpdate {
+	tu.mutation.SetBody(s)
+	return tu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tu *ThreadUpdate) SetNillableBody(s *string) *ThreadUpdate {
+	if s != nil {
+		tu.SetBody(*s)
+	}
+	return tu
+}
+
+// SetMentions sets the "mentions" field.
+func (tu *ThreadUpdate) SetMentions(s []string) *ThreadUpdate {
+	tu.mutation.SetMentions(s)
+	return tu
+}
+
+// AppendMentions appends s to the "mentions" field.
+func (tu *ThreadUpdate) AppendMentions(s []string) *ThreadUpdate {
+	tu.mutation.AppendMentions(s)
+	return tu
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (tu *ThreadUpdate) ClearMentions() *ThreadUpdate {
+	tu.mutation.ClearMentions()
+	return tu
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (tu *ThreadUpdate) SetCommentCount(i int) *ThreadUpdate {
+	tu.mutation.ResetCommentCount()
+	tu.mutation.SetCommentCount(i)
+	return tu
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (tu *ThreadUpdate) SetNillableCommentCount(i *int) *ThreadUpdate {
+	if i != nil {
+		tu.SetCommentCount(*i)
+	}
+	return tu
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (tu *ThreadUpdate) AddCommentCount(i int) *ThreadUpdate {
+	tu.mutation.AddCommentCount(i)
+	return tu
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (tu *ThreadUpdate) SetLastActivityAt(t time.Time) *ThreadUpdate {
+	tu.mutation.SetLastActivityAt(t)
+	return tu
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (tu *ThreadUpdate) SetNillableLastActivityAt(t *time.Time) *ThreadUpdate {
+	if t != nil {
+		tu.SetLastActivityAt(*t)
+	}
+	return tu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tu *ThreadUpdate) SetEditedAt(t time.Time) *ThreadUpdate {
+	tu.mutation.SetEditedAt(t)
+	return tu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tu *ThreadUpdate) SetNillableEditedAt(t *time.Time) *ThreadUpdate {
+	if t != nil {
+		tu.SetEditedAt(*t)
+	}
+	return tu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (tu *ThreadUpdate) ClearEditedAt() *ThreadUpdate {
+	tu.mutation.ClearEditedAt()
+	return tu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (tu *ThreadUpdate) SetTeam(t *Team) *ThreadUpdate {
+	return tu.SetTeamID(t.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (tu *ThreadUpdate) SetAuthorID(id int) *ThreadUpdate {
+	tu.mutation.SetAuthorID(id)
+	return tu
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (tu *ThreadUpdate) SetAuthor(m *Member) *ThreadUpdate {
+	return tu.SetAuthorID(m.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the ThreadComment entity by IDs.
+func (tu *ThreadUpdate) AddCommentIDs(ids ...int) *ThreadUpdate {
+	tu.mutation.AddCommentIDs(ids...)
+	return tu
+}
+
+// AddComments adds the "comments" edges to the ThreadComment entity.
+func (tu *ThreadUpdate) AddComments(t ...*ThreadComment) *ThreadUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddCommentIDs(ids...)
+}
+
+// Mutation returns the ThreadMutation object of the builder.
+func (tu *ThreadUpdate) Mutation() *ThreadMutation {
+	return tu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (tu *ThreadUpdate) ClearTeam() *ThreadUpdate {
+	tu.mutation.ClearTeam()
+	return tu
+}
+
+// ClearAuthor clears the "author" edge to the Member entity.
+func (tu *ThreadUpdate) ClearAuthor() *ThreadUpdate {
+	tu.mutation.ClearAuthor()
+	return tu
+}
+
+// ClearComments clears all "comments" edges to the ThreadComment entity.
+func (tu *ThreadUpdate) ClearComments() *ThreadUpdate {
+	tu.mutation.ClearComments()
+	return tu
+}
+
+// RemoveCommentIDs removes the "comments" edge to ThreadComment entities by IDs.
+func (tu *ThreadUpdate) RemoveCommentIDs(ids ...int) *ThreadUpdate {
+	tu.mutation.RemoveCommentIDs(ids...)
+	return tu
+}
+
+// RemoveComments removes "comments" edges to ThreadComment entities.
+func (tu *ThreadUpdate) RemoveComments(t ...*ThreadComment) *ThreadUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveCommentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *ThreadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *ThreadUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *ThreadUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *ThreadUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *ThreadUpdate) check() error {
+	if v, ok := tu.mutation.Title(); ok {
+		if err := thread.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Thread.title": %w`, err)}
+		}
+	}
+	if tu.mutation.TeamCleared() && len(tu.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Thread.team"`)
+	}
+	if tu.mutation.AuthorCleared() && len(tu.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Thread.author"`)
+	}
+	return nil
+}
+
+func (tu *ThreadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(thread.Table, thread.Columns, sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Title(); ok {
+		_spec.SetField(thread.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Body(); ok {
+		_spec.SetField(thread.FieldBody, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Mentions(); ok {
+		_spec.SetField(thread.FieldMentions, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedMentions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, thread.FieldMentions, value)
+		})
+	}
+	if tu.mutation.MentionsCleared() {
+		_spec.ClearField(thread.FieldMentions, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.CommentCount(); ok {
+		_spec.SetField(thread.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedCommentCount(); ok {
+		_spec.AddField(thread.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.LastActivityAt(); ok {
+		_spec.SetField(thread.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if value, ok := tu.mutation.EditedAt(); ok {
+		_spec.SetField(thread.FieldEditedAt, field.TypeTime, value)
+	}
+	if tu.mutation.EditedAtCleared() {
+		_spec.ClearField(thread.FieldEditedAt, field.TypeTime)
+	}
+	if tu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.TeamTable,
+			Columns: []string{thread.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.TeamTable,
+			Columns: []string{thread.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.AuthorTable,
+			Columns: []string{thread.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.AuthorTable,
+			Columns: []string{thread.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{thread.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// ThreadUpdateOne is the builder for updating a single Thread entity.
+type ThreadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ThreadMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (tuo *ThreadUpdateOne) SetTeamID(i int) *ThreadUpdateOne {
+	tuo.mutation.SetTeamID(i)
+	return tuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (tuo *ThreadUpdateOne) SetNillableTeamID(i *int) *ThreadUpdateOne {
+	if i != nil {
+		tuo.SetTeamID(*i)
+	}
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *ThreadUpdateOne) SetTitle(s string) *ThreadUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tuo *ThreadUpdateOne) SetNillableTitle(s *string) *ThreadUpdateOne {
+	if s != nil {
+		tuo.SetTitle(*s)
+	}
+	return tuo
+}
+
+// SetBody sets the "body" field.
+func (tuo *ThreadUpdateOne) SetBody(s string) *ThreadUpdateOne {
+	tuo.mutation.SetBody(s)
+	return tuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tuo *ThreadUpdateOne) SetNillableBody(s *string) *ThreadUpdateOne {
+	if s != nil {
+		tuo.SetBody(*s)
+	}
+	return tuo
+}
+
+// SetMentions sets the "mentions" field.
+func (tuo *ThreadUpdateOne) SetMentions(s []string) *ThreadUpdateOne {
+	tuo.mutation.SetMentions(s)
+	return tuo
+}
+
+// AppendMentions appends s to the "mentions" field.
+func (tuo *ThreadUpdateOne) AppendMentions(s []string) *ThreadUpdateOne {
+	tuo.mutation.AppendMentions(s)
+	return tuo
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (tuo *ThreadUpdateOne) ClearMentions() *ThreadUpdateOne {
+	tuo.mutation.ClearMentions()
+	return tuo
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (tuo *ThreadUpdateOne) SetCommentCount(i int) *ThreadUpdateOne {
+	tuo.mutation.ResetCommentCount()
+	tuo.mutation.SetCommentCount(i)
+	return tuo
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (tuo *ThreadUpdateOne) SetNillableCommentCount(i *int) *ThreadUpdateOne {
+	if i != nil {
+		tuo.SetCommentCount(*i)
+	}
+	return tuo
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (tuo *ThreadUpdateOne) AddCommentCount(i int) *ThreadUpdateOne {
+	tuo.mutation.AddCommentCount(i)
+	return tuo
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (tuo *ThreadUpdateOne) SetLastActivityAt(t time.Time) *ThreadUpdateOne {
+	tuo.mutation.SetLastActivityAt(t)
+	return tuo
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (tuo *ThreadUpdateOne) SetNillableLastActivityAt(t *time.Time) *ThreadUpdateOne {
+	if t != nil {
+		tuo.SetLastActivityAt(*t)
+	}
+	return tuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tuo *ThreadUpdateOne) SetEditedAt(t time.Time) *ThreadUpdateOne {
+	tuo.mutation.SetEditedAt(t)
+	return tuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tuo *ThreadUpdateOne) SetNillableEditedAt(t *time.Time) *ThreadUpdateOne {
+	if t != nil {
+		tuo.SetEditedAt(*t)
+	}
+	return tuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (tuo *ThreadUpdateOne) ClearEditedAt() *ThreadUpdateOne {
+	tuo.mutation.ClearEditedAt()
+	return tuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (tuo *ThreadUpdateOne) SetTeam(t *Team) *ThreadUpdateOne {
+	return tuo.SetTeamID(t.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (tuo *ThreadUpdateOne) SetAuthorID(id int) *ThreadUpdateOne {
+	tuo.mutation.SetAuthorID(id)
+	return tuo
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (tuo *ThreadUpdateOne) SetAuthor(m *Member) *ThreadUpdateOne {
+	return tuo.SetAuthorID(m.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the ThreadComment entity by IDs.
+func (tuo *ThreadUpdateOne) AddCommentIDs(ids ...int) *ThreadUpdateOne {
+	tuo.mutation.AddCommentIDs(ids...)
+	return tuo
+}
+
+// AddComments adds the "comments" edges to the ThreadComment entity.
+func (tuo *ThreadUpdateOne) AddComments(t ...*ThreadComment) *ThreadUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddCommentIDs(ids...)
+}
+
+// Mutation returns the ThreadMutation object of the builder.
+func (tuo *ThreadUpdateOne) Mutation() *ThreadMutation {
+	return tuo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (tuo *ThreadUpdateOne) ClearTeam() *ThreadUpdateOne {
+	tuo.mutation.ClearTeam()
+	return tuo
+}
+
+// ClearAuthor clears the "author" edge to the Member entity.
+func (tuo *ThreadUpdateOne) ClearAuthor() *ThreadUpdateOne {
+	tuo.mutation.ClearAuthor()
+	return tuo
+}
+
+// ClearComments clears all "comments" edges to the ThreadComment entity.
+func (tuo *ThreadUpdateOne) ClearComments() *ThreadUpdateOne {
+	tuo.mutation.ClearComments()
+	return tuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to ThreadComment entities by IDs.
+func (tuo *ThreadUpdateOne) RemoveCommentIDs(ids ...int) *ThreadUpdateOne {
+	tuo.mutation.RemoveCommentIDs(ids...)
+	return tuo
+}
+
+// RemoveComments removes "comments" edges to ThreadComment entities.
+func (tuo *ThreadUpdateOne) RemoveComments(t ...*ThreadComment) *ThreadUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveCommentIDs(ids...)
+}
+
+// Where appends a list predicates to the ThreadUpdate builder.
+func (tuo *ThreadUpdateOne) Where(ps ...predicate.Thread) *ThreadUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *ThreadUpdateOne) Select(field string, fields ...string) *ThreadUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Thread entity.
+func (tuo *ThreadUpdateOne) Save(ctx context.Context) (*Thread, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *ThreadUpdateOne) SaveX(ctx context.Context) *Thread {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *ThreadUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *ThreadUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *ThreadUpdateOne) check() error {
+	if v, ok := tuo.mutation.Title(); ok {
+		if err := thread.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Thread.title": %w`, err)}
+		}
+	}
+	if tuo.mutation.TeamCleared() && len(tuo.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Thread.team"`)
+	}
+	if tuo.mutation.AuthorCleared() && len(tuo.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Thread.author"`)
+	}
+	return nil
+}
+
+func (tuo *ThreadUpdateOne) sqlSave(ctx context.Context) (_node *Thread, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(thread.Table, thread.Columns, sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Thread.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, thread.FieldID)
+		for _, f := range fields {
+			if !thread.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != thread.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Title(); ok {
+		_spec.SetField(thread.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Body(); ok {
+		_spec.SetField(thread.FieldBody, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Mentions(); ok {
+		_spec.SetField(thread.FieldMentions, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedMentions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, thread.FieldMentions, value)
+		})
+	}
+	if tuo.mutation.MentionsCleared() {
+		_spec.ClearField(thread.FieldMentions, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.CommentCount(); ok {
+		_spec.SetField(thread.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedCommentCount(); ok {
+		_spec.AddField(thread.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.LastActivityAt(); ok {
+		_spec.SetField(thread.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if value, ok := tuo.mutation.EditedAt(); ok {
+		_spec.SetField(thread.FieldEditedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.EditedAtCleared() {
+		_spec.ClearField(thread.FieldEditedAt, field.TypeTime)
+	}
+	if tuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.TeamTable,
+			Columns: []string{thread.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.TeamTable,
+			Columns: []string{thread.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.AuthorTable,
+			Columns: []string{thread.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thread.AuthorTable,
+			Columns: []string{thread.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   thread.CommentsTable,
+			Columns: []string{thread.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Thread{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{thread.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
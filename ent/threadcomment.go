@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ThreadComment is the model entity for the ThreadComment schema.
+type ThreadComment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ThreadID holds the value of the "thread_id" field.
+	ThreadID int `json:"thread_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Mentions holds the value of the "mentions" field.
+	Mentions []string `json:"mentions,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ThreadCommentQuery when eager-loading is set.
+	Edges                  ThreadCommentEdges `json:"edges"`
+	member_thread_comments *int
+	selectValues           sql.SelectValues
+}
+
+// ThreadCommentEdges holds the relations/edges for other nodes in the graph.
+type ThreadCommentEdges struct {
+	// Thread holds the value of the thread edge.
+	Thread *Thread `json:"thread,omitempty"`
+	// Author holds the value of the author edge.
+	Author *Member `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ThreadOrErr returns the Thread value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThreadCommentEdges) ThreadOrErr() (*Thread, error) {
+	if e.Thread != nil {
+		return e.Thread, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: thread.Label}
+	}
+	return nil, &NotLoadedError{edge: "thread"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThreadCommentEdges) AuthorOrErr() (*Member, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ThreadComment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case threadcomment.FieldMentions:
+			values[i] = new([]byte)
+		case threadcomment.FieldID, threadcomment.FieldThreadID:
+			values[i] = new(sql.NullInt64)
+		case threadcomment.FieldBody:
+			values[i] = new(sql.NullString)
+		case threadcomment.FieldEditedAt, threadcomment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case threadcomment.ForeignKeys[0]: // member_thread_comments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ThreadComment fields.
+func (tc *ThreadComment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case threadcomment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tc.ID = int(value.Int64)
+		case threadcomment.FieldThreadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_id", values[i])
+			} else if value.Valid {
+				tc.ThreadID = int(value.Int64)
+			}
+		case threadcomment.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				tc.Body = value.String
+			}
+		case threadcomment.FieldMentions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mentions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tc.Mentions); err != nil {
+					return fmt.Errorf("unmarshal field mentions: %w", err)
+				}
+			}
+		case threadcomment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				tc.EditedAt = new(time.Time)
+				*tc.EditedAt = value.Time
+			}
+		case threadcomment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tc.CreatedAt = value.Time
+			}
+		case threadcomment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field member_thread_comments", value)
+			} else if value.Valid {
+				tc.member_thread_comments = new(int)
+				*tc.member_thread_comments = int(value.Int64)
+			}
+		default:
+			tc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ThreadComment.
+// This includes values selected through modifiers, order, etc.
+func (tc *ThreadComment) Value(name string) (ent.Value, error) {
+	return tc.selectValues.Get(name)
+}
+
+// QueryThread queries the "thread" edge of the ThreadComment entity.
+func (tc *ThreadComment) QueryThread() *ThreadQuery {
+	return NewThreadCommentClient(tc.config).QueryThread(tc)
+}
+
+// QueryAuthor queries the "author" edge of the ThreadComment entity.
+func (tc *ThreadComment) QueryAuthor() *MemberQuery {
+	return NewThreadCommentClient(tc.config).QueryAuthor(tc)
+}
+
+// Update returns a builder for updating this ThreadComment.
+// Note that you need to call ThreadComment.Unwrap() before calling this method if this ThreadComment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tc *ThreadComment) Update() *ThreadCommentUpdateOne {
+	return NewThreadCommentClient(tc.config).UpdateOne(tc)
+}
+
+// Unwrap unwraps the ThreadComment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tc *ThreadComment) Unwrap() *ThreadComment {
+	_tx, ok := tc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ThreadComment is not a transactional entity")
+	}
+	tc.config.driver = _tx.drv
+	return tc
+}
+
+// String implements the fmt.Stringer.
+func (tc *ThreadComment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ThreadComment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tc.ID))
+	builder.WriteString("thread_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.ThreadID))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(tc.Body)
+	builder.WriteString(", ")
+	builder.WriteString("mentions=")
+	builder.WriteString(fmt.Sprintf("%v", tc.Mentions))
+	builder.WriteString(", ")
+	if v := tc.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ThreadComments is a parsable slice of ThreadComment.
+type ThreadComments []*ThreadComment
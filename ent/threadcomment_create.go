@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadCommentCreate is the builder for creating a ThreadComment entity.
+type ThreadCommentCreate struct {
+	config
+	mutation *ThreadCommentMutation
+	hooks    []Hook
+}
+
+// SetThreadID sets the "thread_id" field.
+func (tcc *ThreadCommentCreate) SetThreadID(i int) *ThreadCommentCreate {
+	tcc.mutation.SetThreadID(i)
+	return tcc
+}
+
+// SetBody sets the "body" field.
+func (tcc *ThreadCommentCreate) SetBody(s string) *ThreadCommentCreate {
+	tcc.mutation.SetBody(s)
+	return tcc
+}
+
+// SetMentions sets the "mentions" field.
+func (tcc *ThreadCommentCreate) SetMentions(s []string) *ThreadCommentCreate {
+	tcc.mutation.SetMentions(s)
+	return tcc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tcc *ThreadCommentCreate) SetEditedAt(t time.Time) *ThreadCommentCreate {
+	tcc.mutation.SetEditedAt(t)
+	return tcc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tcc *ThreadCommentCreate) SetNillableEditedAt(t *time.Time) *ThreadCommentCreate {
+	if t != nil {
+		tcc.SetEditedAt(*t)
+	}
+	return tcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcc *ThreadCommentCreate) SetCreatedAt(t time.Time) *ThreadCommentCreate {
+	tcc.mutation.SetCreatedAt(t)
+	return tcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcc *ThreadCommentCreate) SetNillableCreatedAt(t *time.Time) *ThreadCommentCreate {
+	if t != nil {
+		tcc.SetCreatedAt(*t)
+	}
+	return tcc
+}
+
+// SetThread sets the "thread" edge to the Thread entity.
+func (tcc *ThreadCommentCreate) SetThread(t *Thread) *ThreadCommentCreate {
+	return tcc.SetThreadID(t.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (tcc *ThreadCommentCreate) SetAuthorID(id int) *ThreadCommentCreate {
+	tcc.mutation.SetAuthorID(id)
+	return tcc
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (tcc *ThreadCommentCreate) SetAuthor(m *Member) *ThreadCommentCreate {
+	return tcc.SetAuthorID(m.ID)
+}
+
+// Mutation returns the ThreadCommentMutation object of the builder.
+func (tcc *ThreadCommentCreate) Mutation() *ThreadCommentMutation {
+	return tcc.mutation
+}
+
+// Save creates the ThreadComment in the database.
+func (tcc *ThreadCommentCreate) Save(ctx context.Context) (*ThreadComment, error) {
+	tcc.defaults()
+	return withHooks(ctx, tcc.sqlSave, tcc.mutation, tcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcc *ThreadCommentCreate) SaveX(ctx context.Context) *ThreadComment {
+	v, err := tcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcc *ThreadCommentCreate) Exec(ctx context.Context) error {
+	_, err := tcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcc *ThreadCommentCreate) ExecX(ctx context.Context) {
+	if err := tcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcc *ThreadCommentCreate) defaults() {
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		v := threadcomment.DefaultCreatedAt()
+		tcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcc *ThreadCommentCreate) check() error {
+	if _, ok := tcc.mutation.ThreadID(); !ok {
+		return &ValidationError{Name: "thread_id", err: errors.New(`ent: missing required field "ThreadComment.thread_id"`)}
+	}
+	if _, ok := tcc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "ThreadComment.body"`)}
+	}
+	if v, ok := tcc.mutation.Body(); ok {
+		if err := threadcomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ThreadComment.body": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ThreadComment.created_at"`)}
+	}
+	if len(tcc.mutation.ThreadIDs()) == 0 {
+		return &ValidationError{Name: "thread", err: errors.New(`ent: missing required edge "ThreadComment.thread"`)}
+	}
+	if len(tcc.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "ThreadComment.author"`)}
+	}
+	return nil
+}
+
+func (tcc *ThreadCommentCreate) sqlSave(ctx context.Context) (*ThreadComment, error) {
+	if err := tcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tcc.mutation.id = &_node.ID
+	tcc.mutation.done = true
+	return _node, nil
+}
+
+func (tcc *ThreadCommentCreate) createSpec() (*ThreadComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ThreadComment{config: tcc.config}
+		_spec = sqlgraph.NewCreateSpec(threadcomment.Table, sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt))
+	)
+	if value, ok := tcc.mutation.Body(); ok {
+		_spec.SetField(threadcomment.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := tcc.mutation.Mentions(); ok {
+		_spec.SetField(threadcomment.FieldMentions, field.TypeJSON, value)
+		_node.Mentions = value
+	}
+	if value, ok := tcc.mutation.EditedAt(); ok {
+		_spec.SetField(threadcomment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := tcc.mutation.CreatedAt(); ok {
+		_spec.SetField(threadcomment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tcc.mutation.ThreadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.ThreadTable,
+			Columns: []string{threadcomment.ThreadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ThreadID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.AuthorTable,
+			Columns: []string{threadcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.member_thread_comments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ThreadCommentCreateBulk is the builder for creating many ThreadComment entities in bulk.
+type ThreadCommentCreateBulk struct {
+	config
+	err      error
+	builders []*ThreadCommentCreate
+}
+
+// Save creates the ThreadComment entities in the database.
+func (tccb *ThreadCommentCreateBulk) Save(ctx context.Context) ([]*ThreadComment, error) {
+	if tccb.err != nil {
+		return nil, tccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tccb.builders))
+	nodes := make([]*ThreadComment, len(tccb.builders))
+	mutators := make([]Mutator, len(tccb.builders))
+	for i := range tccb.builders {
+		func(i int, root context.Context) {
+			builder := tccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ThreadCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tccb *ThreadCommentCreateBulk) SaveX(ctx context.Context) []*ThreadComment {
+	v, err := tccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tccb *ThreadCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := tccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tccb *ThreadCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := tccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/threadcomment"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadCommentDelete is the builder for deleting a ThreadComment entity.
+type ThreadCommentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ThreadCommentMutation
+}
+
+// Where appends a list predicates to the ThreadCommentDelete builder.
+func (tcd *ThreadCommentDelete) Where(ps ...predicate.ThreadComment) *ThreadCommentDelete {
+	tcd.mutation.Where(ps...)
+	return tcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tcd *ThreadCommentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcd.sqlExec, tcd.mutation, tcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcd *ThreadCommentDelete) ExecX(ctx context.Context) int {
+	n, err := tcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tcd *ThreadCommentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(threadcomment.Table, sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt))
+	if ps := tcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tcd.mutation.done = true
+	return affected, err
+}
+
+// ThreadCommentDeleteOne is the builder for deleting a single ThreadComment entity.
+type ThreadCommentDeleteOne struct {
+	tcd *ThreadCommentDelete
+}
+
+// Where appends a list predicates to the ThreadCommentDelete builder.
+func (tcdo *ThreadCommentDeleteOne) Where(ps ...predicate.ThreadComment) *ThreadCommentDeleteOne {
+	tcdo.tcd.mutation.Where(ps...)
+	return tcdo
+}
+
+// Exec executes the deletion query.
+func (tcdo *ThreadCommentDeleteOne) Exec(ctx context.Context) error {
+	n, err := tcdo.tcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{threadcomment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcdo *ThreadCommentDeleteOne) ExecX(ctx context.Context) {
+	if err := tcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
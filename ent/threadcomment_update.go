@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadCommentUpdate is the builder for updating ThreadComment entities.
+type ThreadCommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ThreadCommentMutation
+}
+
+// Where appends a list predicates to the ThreadCommentUpdate builder.
+func (tcu *ThreadCommentUpdate) Where(ps ...predicate.ThreadComment) *ThreadCommentUpdate {
+	tcu.mutation.Where(ps...)
+	return tcu
+}
+
+// SetThreadID sets the "thread_id" field.
+func (tcu *ThreadCommentUpdate) SetThreadID(i int) *ThreadCommentUpdate {
+	tcu.mutation.SetThreadID(i)
+	return tcu
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (tcu *ThreadCommentUpdate) SetNillableThreadID(i *int) *ThreadCommentUpdate {
+	if i != nil {
+		tcu.SetThreadID(*i)
+	}
+	return tcu
+}
+
+// SetBody sets the "body" field.
+func (tcu *ThreadCommentUpdate) SetBody(s string) *ThreadCommentUpdate {
+	tcu.mutation.SetBody(s)
+	return tcu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tcu *ThreadCommentUpdate) SetNillableBody(s *string) *ThreadCommentUpdate {
+	if s != nil {
+		tcu.SetBody(*s)
+	}
+	return tcu
+}
+
+// SetMentions sets the "mentions" field.
+func (tcu *ThreadCommentUpdate) SetMentions(s []string) *ThreadCommentUpdate {
+	tcu.mutation.SetMentions(s)
+	return tcu
+}
+
+// AppendMentions appends s to the "mentions" field.
+func (tcu *ThreadCommentUpdate) AppendMentions(s []string) *ThreadCommentUpdate {
+	tcu.mutation.AppendMentions(s)
+	return tcu
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (tcu *ThreadCommentUpdate) ClearMentions() *ThreadCommentUpdate {
+	tcu.mutation.ClearMentions()
+	return tcu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tcu *ThreadCommentUpdate) SetEditedAt(t time.Time) *ThreadCommentUpdate {
+	tcu.mutation.SetEditedAt(t)
+	return tcu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tcu *ThreadCommentUpdate) SetNillableEditedAt(t *time.Time) *ThreadCommentUpdate {
+	if t != nil {
+		tcu.SetEditedAt(*t)
+	}
+	return tcu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (tcu *ThreadCommentUpdate) ClearEditedAt() *ThreadCommentUpdate {
+	tcu.mutation.ClearEditedAt()
+	return tcu
+}
+
+// SetThread sets the "thread" edge to the Thread entity.
+func (tcu *ThreadCommentUpdate) SetThread(t *Thread) *ThreadCommentUpdate {
+	return tcu.SetThreadID(t.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (tcu *ThreadCommentUpdate) SetAuthorID(id int) *ThreadCommentUpdate {
+	tcu.mutation.SetAuthorID(id)
+	return tcu
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (tcu *ThreadCommentUpdate) SetAuthor(m *Member) *ThreadCommentUpdate {
+	return tcu.SetAuthorID(m.ID)
+}
+
+// Mutation returns the ThreadCommentMutation object of the builder.
+func (tcu *ThreadCommentUpdate) Mutation() *ThreadCommentMutation {
+	return tcu.mutation
+}
+
+// ClearThread clears the "thread" edge to the Thread entity.
+func (tcu *ThreadCommentUpdate) ClearThread() *ThreadCommentUpdate {
+	tcu.mutation.ClearThread()
+	return tcu
+}
+
+// ClearAuthor clears the "author" edge to the Member entity.
+func (tcu *ThreadCommentUpdate) ClearAuthor() *ThreadCommentUpdate {
+	tcu.mutation.ClearAuthor()
+	return tcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcu *ThreadCommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcu.sqlSave, tcu.mutation, tcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcu *ThreadCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcu *ThreadCommentUpdate) Exec(ctx context.Context) error {
+	_, err := tcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcu *ThreadCommentUpdate) ExecX(ctx context.Context) {
+	if err := tcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcu *ThreadCommentUpdate) check() error {
+	if v, ok := tcu.mutation.Body(); ok {
+		if err := threadcomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ThreadComment.body": %w`, err)}
+		}
+	}
+	if tcu.mutation.ThreadCleared() && len(tcu.mutation.ThreadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadComment.thread"`)
+	}
+	if tcu.mutation.AuthorCleared() && len(tcu.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadComment.author"`)
+	}
+	return nil
+}
+
+func (tcu *ThreadCommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(threadcomment.Table, threadcomment.Columns, sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt))
+	if ps := tcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcu.mutation.Body(); ok {
+		_spec.SetField(threadcomment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := tcu.mutation.Mentions(); ok {
+		_spec.SetField(threadcomment.FieldMentions, field.TypeJSON, value)
+	}
+	if value, ok := tcu.mutation.AppendedMentions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, threadcomment.FieldMentions, value)
+		})
+	}
+	if tcu.mutation.MentionsCleared() {
+		_spec.ClearField(threadcomment.FieldMentions, field.TypeJSON)
+	}
+	if value, ok := tcu.mutation.EditedAt(); ok {
+		_spec.SetField(threadcomment.FieldEditedAt, field.TypeTime, value)
+	}
+	if tcu.mutation.EditedAtCleared() {
+		_spec.ClearField(threadcomment.FieldEditedAt, field.TypeTime)
+	}
+	if tcu.mutation.ThreadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.ThreadTable,
+			Columns: []string{threadcomment.ThreadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.ThreadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.ThreadTable,
+			Columns: []string{threadcomment.ThreadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.AuthorTable,
+			Columns: []string{threadcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.AuthorTable,
+			Columns: []string{threadcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{threadcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcu.mutation.done = true
+	return n, nil
+}
+
+// ThreadCommentUpdateOne is the builder for updating a single ThreadComment entity.
+type ThreadCommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ThreadCommentMutation
+}
+
+// SetThreadID sets the "thread_id" field.
+func (tcuo *ThreadCommentUpdateOne) SetThreadID(i int) *ThreadCommentUpdateOne {
+	tcuo.mutation.SetThreadID(i)
+	return tcuo
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (tcuo *ThreadCommentUpdateOne) SetNillableThreadID(i *int) *ThreadCommentUpdateOne {
+	if i != nil {
+		tcuo.SetThreadID(*i)
+	}
+	return tcuo
+}
+
+// SetBody sets the "body" field.
+func (tcuo *ThreadCommentUpdateOne) SetBody(s string) *ThreadCommentUpdateOne {
+	tcuo.mutation.SetBody(s)
+	return tcuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (tcuo *ThreadCommentUpdateOne) SetNillableBody(s *string) *ThreadCommentUpdateOne {
+	if s != nil {
+		tcuo.SetBody(*s)
+	}
+	return tcuo
+}
+
+// SetMentions sets the "mentions" field.
+func (tcuo *ThreadCommentUpdateOne) SetMentions(s []string) *ThreadCommentUpdateOne {
+	tcuo.mutation.SetMentions(s)
+	return tcuo
+}
+
+// AppendMentions appends s to the "mentions" field.
+func (tcuo *ThreadCommentUpdateOne) AppendMentions(s []string) *ThreadCommentUpdateOne {
+	tcuo.mutation.AppendMentions(s)
+	return tcuo
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (tcuo *ThreadCommentUpdateOne) ClearMentions() *ThreadCommentUpdateOne {
+	tcuo.mutation.ClearMentions()
+	return tcuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tcuo *ThreadCommentUpdateOne) SetEditedAt(t time.Time) *ThreadCommentUpdateOne {
+	tcuo.mutation.SetEditedAt(t)
+	return tcuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tcuo *ThreadCommentUpdateOne) SetNillableEditedAt(t *time.Time) *ThreadCommentUpdateOne {
+	if t != nil {
+		tcuo.SetEditedAt(*t)
+	}
+	return tcuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (tcuo *ThreadCommentUpdateOne) ClearEditedAt() *ThreadCommentUpdateOne {
+	tcuo.mutation.ClearEditedAt()
+	return tcuo
+}
+
+// SetThread sets the "thread" edge to the Thread entity.
+func (tcuo *ThreadCommentUpdateOne) SetThread(t *Thread) *ThreadCommentUpdateOne {
+	return tcuo.SetThreadID(t.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Member entity by ID.
+func (tcuo *ThreadCommentUpdateOne) SetAuthorID(id int) *ThreadCommentUpdateOne {
+	tcuo.mutation.SetAuthorID(id)
+	return tcuo
+}
+
+// SetAuthor sets the "author" edge to the Member entity.
+func (tcuo *ThreadCommentUpdateOne) SetAuthor(m *Member) *ThreadCommentUpdateOne {
+	return tcuo.SetAuthorID(m.ID)
+}
+
+// Mutation returns the ThreadCommentMutation object of the builder.
+func (tcuo *ThreadCommentUpdateOne) Mutation() *ThreadCommentMutation {
+	return tcuo.mutation
+}
+
+// ClearThread clears the "thread" edge to the Thread entity.
+func (tcuo *ThreadCommentUpdateOne) ClearThread() *ThreadCommentUpdateOne {
+	tcuo.mutation.ClearThread()
+	return tcuo
+}
+
+// ClearAuthor clears the "author" edge to the Member entity.
+func (tcuo *ThreadCommentUpdateOne) ClearAuthor() *ThreadCommentUpdateOne {
+	tcuo.mutation.ClearAuthor()
+	return tcuo
+}
+
+// Where appends a list predicates to the ThreadCommentUpdate builder.
+func (tcuo *ThreadCommentUpdateOne) Where(ps ...predicate.ThreadComment) *ThreadCommentUpdateOne {
+	tcuo.mutation.Where(ps...)
+	return tcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcuo *ThreadCommentUpdateOne) Select(field string, fields ...string) *ThreadCommentUpdateOne {
+	tcuo.fields = append([]string{field}, fields...)
+	return tcuo
+}
+
+// Save executes the query and returns the updated ThreadComment entity.
+func (tcuo *ThreadCommentUpdateOne) Save(ctx context.Context) (*ThreadComment, error) {
+	return withHooks(ctx, tcuo.sqlSave, tcuo.mutation, tcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcuo *ThreadCommentUpdateOne) SaveX(ctx context.Context) *ThreadComment {
+	node, err := tcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcuo *ThreadCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcuo *ThreadCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := tcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcuo *ThreadCommentUpdateOne) check() error {
+	if v, ok := tcuo.mutation.Body(); ok {
+		if err := threadcomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ThreadComment.body": %w`, err)}
+		}
+	}
+	if tcuo.mutation.ThreadCleared() && len(tcuo.mutation.ThreadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadComment.thread"`)
+	}
+	if tcuo.mutation.AuthorCleared() && len(tcuo.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadComment.author"`)
+	}
+	return nil
+}
+
+func (tcuo *ThreadCommentUpdateOne) sqlSave(ctx context.Context) (_node *ThreadComment, err error) {
+	if err := tcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(threadcomment.Table, threadcomment.Columns, sqlgraph.NewFieldSpec(threadcomment.FieldID, field.TypeInt))
+	id, ok := tcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ThreadComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, threadcomment.FieldID)
+		for _, f := range fields {
+			if !threadcomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != threadcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcuo.mutation.Body(); ok {
+		_spec.SetField(threadcomment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := tcuo.mutation.Mentions(); ok {
+		_spec.SetField(threadcomment.FieldMentions, field.TypeJSON, value)
+	}
+	if value, ok := tcuo.mutation.AppendedMentions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, threadcomment.FieldMentions, value)
+		})
+	}
+	if tcuo.mutation.MentionsCleared() {
+		_spec.ClearField(threadcomment.FieldMentions, field.TypeJSON)
+	}
+	if value, ok := tcuo.mutation.EditedAt(); ok {
+		_spec.SetField(threadcomment.FieldEditedAt, field.TypeTime, value)
+	}
+	if tcuo.mutation.EditedAtCleared() {
+		_spec.ClearField(threadcomment.FieldEditedAt, field.TypeTime)
+	}
+	if tcuo.mutation.ThreadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.ThreadTable,
+			Columns: []string{threadcomment.ThreadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.ThreadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.ThreadTable,
+			Columns: []string{threadcomment.ThreadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.AuthorTable,
+			Columns: []string{threadcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   threadcomment.AuthorTable,
+			Columns: []string{threadcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ThreadComment{config: tcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{threadcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcuo.mutation.done = true
+	return _node, nil
+}
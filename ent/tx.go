@@ -14,16 +14,42 @@ type Tx struct {
 	config
 	// Announcement is the client for interacting with the Announcement builders.
 	Announcement *AnnouncementClient
+	// AnnouncementComment is the client for interacting with the AnnouncementComment builders.
+	AnnouncementComment *AnnouncementCommentClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
+	// EventOverride is the client for interacting with the EventOverride builders.
+	EventOverride *EventOverrideClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
 	// Member is the client for interacting with the Member builders.
 	Member *MemberClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
+	// RSVP is the client for interacting with the RSVP builders.
+	RSVP *RSVPClient
 	// Skill is the client for interacting with the Skill builders.
 	Skill *SkillClient
+	// SkillAlias is the client for interacting with the SkillAlias builders.
+	SkillAlias *SkillAliasClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
+	// Thread is the client for interacting with the Thread builders.
+	Thread *ThreadClient
+	// ThreadComment is the client for interacting with the ThreadComment builders.
+	ThreadComment *ThreadCommentClient
 	// TransientMember is the client for interacting with the TransientMember builders.
 	TransientMember *TransientMemberClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -156,11 +182,24 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Announcement = NewAnnouncementClient(tx.config)
+	tx.AnnouncementComment = NewAnnouncementCommentClient(tx.config)
+	tx.Event = NewEventClient(tx.config)
+	tx.EventOverride = NewEventOverrideClient(tx.config)
+	tx.Invitation = NewInvitationClient(tx.config)
 	tx.Member = NewMemberClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.OutboxMessage = NewOutboxMessageClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
+	tx.RSVP = NewRSVPClient(tx.config)
 	tx.Skill = NewSkillClient(tx.config)
+	tx.SkillAlias = NewSkillAliasClient(tx.config)
 	tx.Team = NewTeamClient(tx.config)
+	tx.Thread = NewThreadClient(tx.config)
+	tx.ThreadComment = NewThreadCommentClient(tx.config)
 	tx.TransientMember = NewTransientMemberClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
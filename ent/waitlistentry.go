@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/position"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/models"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WaitlistEntry is the model entity for the WaitlistEntry schema.
+type WaitlistEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PositionID holds the value of the "position_id" field.
+	PositionID int `json:"position_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status models.WaitlistStatus `json:"status,omitempty"`
+	// OfferExpiresAt holds the value of the "offer_expires_at" field.
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WaitlistEntryQuery when eager-loading is set.
+	Edges           WaitlistEntryEdges `json:"edges"`
+	member_waitlist *int
+	selectValues    sql.SelectValues
+}
+
+// WaitlistEntryEdges holds the relations/edges for other nodes in the graph.
+type WaitlistEntryEdges struct {
+	// Position holds the value of the position edge.
+	Position *Position `json:"position,omitempty"`
+	// Member holds the value of the member edge.
+	Member *Member `json:"member,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PositionOrErr returns the Position value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) PositionOrErr() (*Position, error) {
+	if e.Position != nil {
+		return e.Position, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: position.Label}
+	}
+	return nil, &NotLoadedError{edge: "position"}
+}
+
+// MemberOrErr returns the Member value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) MemberOrErr() (*Member, error) {
+	if e.Member != nil {
+		return e.Member, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "member"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID, waitlistentry.FieldPositionID:
+			values[i] = new(sql.NullInt64)
+		case waitlistentry.FieldStatus:
+			values[i] = new(sql.NullString)
+		case waitlistentry.FieldOfferExpiresAt, waitlistentry.FieldCreatedAt, waitlistentry.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case waitlistentry.ForeignKeys[0]: // member_waitlist
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistEntry fields.
+func (we *WaitlistEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			we.ID = int(value.Int64)
+		case waitlistentry.FieldPositionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position_id", values[i])
+			} else if value.Valid {
+				we.PositionID = int(value.Int64)
+			}
+		case waitlistentry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = models.WaitlistStatus(value.String)
+			}
+		case waitlistentry.FieldOfferExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offer_expires_at", values[i])
+			} else if value.Valid {
+				we.OfferExpiresAt = new(time.Time)
+				*we.OfferExpiresAt = value.Time
+			}
+		case waitlistentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		case waitlistentry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				we.UpdatedAt = value.Time
+			}
+		case waitlistentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field member_waitlist", value)
+			} else if value.Valid {
+				we.member_waitlist = new(int)
+				*we.member_waitlist = int(value.Int64)
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistEntry.
+// This includes values selected through modifiers, order, etc.
+func (we *WaitlistEntry) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// QueryPosition queries the "position" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryPosition() *PositionQuery {
+	return NewWaitlistEntryClient(we.config).QueryPosition(we)
+}
+
+// QueryMember queries the "member" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryMember() *MemberQuery {
+	return NewWaitlistEntryClient(we.config).QueryMember(we)
+}
+
+// Update returns a builder for updating this WaitlistEntry.
+// Note that you need to call WaitlistEntry.Unwrap() before calling this method if this WaitlistEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WaitlistEntry) Update() *WaitlistEntryUpdateOne {
+	return NewWaitlistEntryClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WaitlistEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WaitlistEntry) Unwrap() *WaitlistEntry {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaitlistEntry is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WaitlistEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("position_id=")
+	builder.WriteString(fmt.Sprintf("%v", we.PositionID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", we.Status))
+	builder.WriteString(", ")
+	if v := we.OfferExpiresAt; v != nil {
+		builder.WriteString("offer_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(we.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistEntries is a parsable slice of WaitlistEntry.
+type WaitlistEntries []*WaitlistEntry
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/waitlistentry"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryDelete is the builder for deleting a WaitlistEntry entity.
+type WaitlistEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryDelete builder.
+func (wed *WaitlistEntryDelete) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryDelete {
+	wed.mutation.Where(ps...)
+	return wed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wed *WaitlistEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wed.sqlExec, wed.mutation, wed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wed *WaitlistEntryDelete) ExecX(ctx context.Context) int {
+	n, err := wed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wed *WaitlistEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(waitlistentry.Table, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	if ps := wed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wed.mutation.done = true
+	return affected, err
+}
+
+// WaitlistEntryDeleteOne is the builder for deleting a single WaitlistEntry entity.
+type WaitlistEntryDeleteOne struct {
+	wed *WaitlistEntryDelete
+}
+
+// Where appends a list predicates to the WaitlistEntryDelete builder.
+func (wedo *WaitlistEntryDeleteOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryDeleteOne {
+	wedo.wed.mutation.Where(ps...)
+	return wedo
+}
+
+// Exec executes the deletion query.
+func (wedo *WaitlistEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := wedo.wed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{waitlistentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wedo *WaitlistEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := wedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/position"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/waitlistentry"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryQuery is the builder for querying WaitlistEntry entities.
+type WaitlistEntryQuery struct {
+	config
+	ctx          *QueryContext
+	order        []waitlistentry.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.WaitlistEntry
+	withPosition *PositionQuery
+	withMember   *MemberQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WaitlistEntryQuery builder.
+func (weq *WaitlistEntryQuery) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryQuery {
+	weq.predicates = append(weq.predicates, ps...)
+	return weq
+}
+
+// Limit the number of records to be returned by this query.
+func (weq *WaitlistEntryQuery) Limit(limit int) *WaitlistEntryQuery {
+	weq.ctx.Limit = &limit
+	return weq
+}
+
+// Offset to start from.
+func (weq *WaitlistEntryQuery) Offset(offset int) *WaitlistEntryQuery {
+	weq.ctx.Offset = &offset
+	return weq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (weq *WaitlistEntryQuery) Unique(unique bool) *WaitlistEntryQuery {
+	weq.ctx.Unique = &unique
+	return weq
+}
+
+// Order specifies how the records should be ordered.
+func (weq *WaitlistEntryQuery) Order(o ...waitlistentry.OrderOption) *WaitlistEntryQuery {
+	weq.order = append(weq.order, o...)
+	return weq
+}
+
+// QueryPosition chains the current query on the "position" edge.
+func (weq *WaitlistEntryQuery) QueryPosition() *PositionQuery {
+	query := (&PositionClient{config: weq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, selector),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.PositionTable, waitlistentry.PositionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMember chains the current query on the "member" edge.
+func (weq *WaitlistEntryQuery) QueryMember() *MemberQuery {
+	query := (&MemberClient{config: weq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.MemberTable, waitlistentry.MemberColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WaitlistEntry entity from the query.
+// Returns a *NotFoundError when no WaitlistEntry was found.
+func (weq *WaitlistEntryQuery) First(ctx context.Context) (*WaitlistEntry, error) {
+	nodes, err := weq.Limit(1).All(setContextOp(ctx, weq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{waitlistentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) FirstX(ctx context.Context) *WaitlistEntry {
+	node, err := weq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WaitlistEntry ID from the query.
+// Returns a *NotFoundError when no WaitlistEntry ID was found.
+func (weq *WaitlistEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = weq.Limit(1).IDs(setContextOp(ctx, weq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{waitlistentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := weq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WaitlistEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WaitlistEntry entity is found.
+// Returns a *NotFoundError when no WaitlistEntry entities are found.
+func (weq *WaitlistEntryQuery) Only(ctx context.Context) (*WaitlistEntry, error) {
+	nodes, err := weq.Limit(2).All(setContextOp(ctx, weq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{waitlistentry.Label}
+	default:
+		return nil, &NotSingularError{waitlistentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) OnlyX(ctx context.Context) *WaitlistEntry {
+	node, err := weq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WaitlistEntry ID in the query.
+// Returns a *NotSingularError when more than one WaitlistEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (weq *WaitlistEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = weq.Limit(2).IDs(setContextOp(ctx, weq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = &NotSingularError{waitlistentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := weq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WaitlistEntries.
+func (weq *WaitlistEntryQuery) All(ctx context.Context) ([]*WaitlistEntry, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryAll)
+	if err := weq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WaitlistEntry, *WaitlistEntryQuery]()
+	return withInterceptors[[]*WaitlistEntry](ctx, weq, qr, weq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) AllX(ctx context.Context) []*WaitlistEntry {
+	nodes, err := weq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WaitlistEntry IDs.
+func (weq *WaitlistEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if weq.ctx.Unique == nil && weq.path != nil {
+		weq.Unique(true)
+	}
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryIDs)
+	if err = weq.Select(waitlistentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := weq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (weq *WaitlistEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryCount)
+	if err := weq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, weq, querierCount[*WaitlistEntryQuery](), weq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) CountX(ctx context.Context) int {
+	count, err := weq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (weq *WaitlistEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryExist)
+	switch _, err := weq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := weq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WaitlistEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (weq *WaitlistEntryQuery) Clone() *WaitlistEntryQuery {
+	if weq == nil {
+		return nil
+	}
+	return &WaitlistEntryQuery{
+		config:       weq.config,
+		ctx:          weq.ctx.Clone(),
+		order:        append([]waitlistentry.OrderOption{}, weq.order...),
+		inters:       append([]Interceptor{}, weq.inters...),
+		predicates:   append([]predicate.WaitlistEntry{}, weq.predicates...),
+		withPosition: weq.withPosition.Clone(),
+		withMember:   weq.withMember.Clone(),
+		// clone intermediate query.
+		sql:  weq.sql.Clone(),
+		path: weq.path,
+	}
+}
+
+// WithPosition tells the query-builder to eager-load the nodes that are connected to
+// the "position" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WaitlistEntryQuery) WithPosition(opts ...func(*PositionQuery)) *WaitlistEntryQuery {
+	query := (&PositionClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withPosition = query
+	return weq
+}
+
+// WithMember tells the query-builder to eager-load the nodes that are connected to
+// the "member" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WaitlistEntryQuery) WithMember(opts ...func(*MemberQuery)) *WaitlistEntryQuery {
+	query := (&MemberClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withMember = query
+	return weq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PositionID int `json:"position_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WaitlistEntry.Query().
+//		GroupBy(waitlistentry.FieldPositionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (weq *WaitlistEntryQuery) GroupBy(field string, fields ...string) *WaitlistEntryGroupBy {
+	weq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WaitlistEntryGroupBy{build: weq}
+	grbuild.flds = &weq.ctx.Fields
+	grbuild.label = waitlistentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PositionID int `json:"position_id,omitempty"`
+//	}
+//
+//	client.WaitlistEntry.Query().
+//		Select(waitlistentry.FieldPositionID).
+//		Scan(ctx, &v)
+func (weq *WaitlistEntryQuery) Select(fields ...string) *WaitlistEntrySelect {
+	weq.ctx.Fields = append(weq.ctx.Fields, fields...)
+	sbuild := &WaitlistEntrySelect{WaitlistEntryQuery: weq}
+	sbuild.label = waitlistentry.Label
+	sbuild.flds, sbuild.scan = &weq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WaitlistEntrySelect configured with the given aggregations.
+func (weq *WaitlistEntryQuery) Aggregate(fns ...AggregateFunc) *WaitlistEntrySelect {
+	return weq.Select().Aggregate(fns...)
+}
+
+func (weq *WaitlistEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range weq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, weq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range weq.ctx.Fields {
+		if !waitlistentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if weq.path != nil {
+		prev, err := weq.path(ctx)
+		if err != nil {
+			return err
+		}
+		weq.sql = prev
+	}
+	return nil
+}
+
+func (weq *WaitlistEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WaitlistEntry, error) {
+	var (
+		nodes       = []*WaitlistEntry{}
+		withFKs     = weq.withFKs
+		_spec       = weq.querySpec()
+		loadedTypes = [2]bool{
+			weq.withPosition != nil,
+			weq.withMember != nil,
+		}
+	)
+	if weq.withMember != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WaitlistEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WaitlistEntry{config: weq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(weq.modifiers) > 0 {
+		_spec.Modifiers = weq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, weq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := weq.withPosition; query != nil {
+		if err := weq.loadPosition(ctx, query, nodes, nil,
+			func(n *WaitlistEntry, e *Position) { n.Edges.Position = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := weq.withMember; query != nil {
+		if err := weq.loadMember(ctx, query, nodes, nil,
+			func(n *WaitlistEntry, e *Member) { n.Edges.Member = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (weq *WaitlistEntryQuery) loadPosition(ctx context.Context, query *PositionQuery, nodes []*WaitlistEntry, init func(*WaitlistEntry), assign func(*WaitlistEntry, *Position)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WaitlistEntry)
+	for i := range nodes {
+		fk := nodes[i].PositionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(position.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "position_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (weq *WaitlistEntryQuery) loadMember(ctx context.Context, query *MemberQuery, nodes []*WaitlistEntry, init func(*WaitlistEntry), assign func(*WaitlistEntry, *Member)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WaitlistEntry)
+	for i := range nodes {
+		if nodes[i].member_waitlist == nil {
+			continue
+		}
+		fk := *nodes[i].member_waitlist
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(member.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "member_waitlist" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (weq *WaitlistEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := weq.querySpec()
+	if len(weq.modifiers) > 0 {
+		_spec.Modifiers = weq.modifiers
+	}
+	_spec.Node.Columns = weq.ctx.Fields
+	if len(weq.ctx.Fields) > 0 {
+		_spec.Unique = weq.ctx.Unique != nil && *weq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, weq.driver, _spec)
+}
+
+func (weq *WaitlistEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	_spec.From = weq.sql
+	if unique := weq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if weq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := weq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for i := range fields {
+			if fields[i] != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if weq.withPosition != nil {
+			_spec.Node.AddColumnOnce(waitlistentry.FieldPositionID)
+		}
+	}
+	if ps := weq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := weq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := weq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := weq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (weq *WaitlistEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(weq.driver.Dialect())
+	t1 := builder.Table(waitlistentry.Table)
+	columns := weq.ctx.Fields
+	if len(columns) == 0 {
+		columns = waitlistentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if weq.sql != nil {
+		selector = weq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if weq.ctx.Unique != nil && *weq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range weq.modifiers {
+		m(selector)
+	}
+	for _, p := range weq.predicates {
+		p(selector)
+	}
+	for _, p := range weq.order {
+		p(selector)
+	}
+	if offset := weq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := weq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (weq *WaitlistEntryQuery) ForUpdate(opts ...sql.LockOption) *WaitlistEntryQuery {
+	if weq.driver.Dialect() == dialect.Postgres {
+		weq.Unique(false)
+	}
+	weq.modifiers = append(weq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return weq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (weq *WaitlistEntryQuery) ForShare(opts ...sql.LockOption) *WaitlistEntryQuery {
+	if weq.driver.Dialect() == dialect.Postgres {
+		weq.Unique(false)
+	}
+	weq.modifiers = append(weq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return weq
+}
+
+// WaitlistEntryGroupBy is the group-by builder for WaitlistEntry entities.
+type WaitlistEntryGroupBy struct {
+	selector
+	build *WaitlistEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wegb *WaitlistEntryGroupBy) Aggregate(fns ...AggregateFunc) *WaitlistEntryGroupBy {
+	wegb.fns = append(wegb.fns, fns...)
+	return wegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wegb *WaitlistEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wegb.build.ctx, ent.OpQueryGroupBy)
+	if err := wegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WaitlistEntryQuery, *WaitlistEntryGroupBy](ctx, wegb.build, wegb, wegb.build.inters, v)
+}
+
+func (wegb *WaitlistEntryGroupBy) sqlScan(ctx context.Context, root *WaitlistEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wegb.fns))
+	for _, fn := range wegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wegb.flds)+len(wegb.fns))
+		for _, f := range *wegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WaitlistEntrySelect is the builder for selecting fields of WaitlistEntry entities.
+type WaitlistEntrySelect struct {
+	*WaitlistEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wes *WaitlistEntrySelect) Aggregate(fns ...AggregateFunc) *WaitlistEntrySelect {
+	wes.fns = append(wes.fns, fns...)
+	return wes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wes *WaitlistEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wes.ctx, ent.OpQuerySelect)
+	if err := wes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WaitlistEntryQuery, *WaitlistEntrySelect](ctx, wes.WaitlistEntryQuery, wes, wes.inters, v)
+}
+
+func (wes *WaitlistEntrySelect) sqlScan(ctx context.Context, root *WaitlistEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wes.fns))
+	for _, fn := range wes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
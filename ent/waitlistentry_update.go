@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/member"
+	"backend_golang/ent/position"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weu *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetPositionID sets the "position_id" field.
+func (weu *WaitlistEntryUpdate) SetPositionID(i int) *WaitlistEntryUpdate {
+	weu.mutation.SetPositionID(i)
+	return weu
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillablePositionID(i *int) *WaitlistEntryUpdate {
+	if i != nil {
+		weu.SetPositionID(*i)
+	}
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WaitlistEntryUpdate) SetStatus(ms models.WaitlistStatus) *WaitlistEntryUpdate {
+	weu.mutation.SetStatus(ms)
+	return weu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableStatus(ms *models.WaitlistStatus) *WaitlistEntryUpdate {
+	if ms != nil {
+		weu.SetStatus(*ms)
+	}
+	return weu
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (weu *WaitlistEntryUpdate) SetOfferExpiresAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferExpiresAt(t)
+	return weu
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferExpiresAt(*t)
+	}
+	return weu
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (weu *WaitlistEntryUpdate) ClearOfferExpiresAt() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferExpiresAt()
+	return weu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (weu *WaitlistEntryUpdate) SetUpdatedAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetUpdatedAt(t)
+	return weu
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (weu *WaitlistEntryUpdate) SetPosition(p *Position) *WaitlistEntryUpdate {
+	return weu.SetPositionID(p.ID)
+}
+
+// SetMemberID sets the "member" edge to the Member entity by ID.
+func (weu *WaitlistEntryUpdate) SetMemberID(id int) *WaitlistEntryUpdate {
+	weu.mutation.SetMemberID(id)
+	return weu
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (weu *WaitlistEntryUpdate) SetMember(m *Member) *WaitlistEntryUpdate {
+	return weu.SetMemberID(m.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weu *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return weu.mutation
+}
+
+// ClearPosition clears the "position" edge to the Position entity.
+func (weu *WaitlistEntryUpdate) ClearPosition() *WaitlistEntryUpdate {
+	weu.mutation.ClearPosition()
+	return weu
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (weu *WaitlistEntryUpdate) ClearMember() *WaitlistEntryUpdate {
+	weu.mutation.ClearMember()
+	return weu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	weu.defaults()
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weu *WaitlistEntryUpdate) defaults() {
+	if _, ok := weu.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		weu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WaitlistEntryUpdate) check() error {
+	if v, ok := weu.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if weu.mutation.PositionCleared() && len(weu.mutation.PositionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.position"`)
+	}
+	if weu.mutation.MemberCleared() && len(weu.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.member"`)
+	}
+	return nil
+}
+
+func (weu *WaitlistEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weu.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if weu.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if value, ok := weu.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if weu.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.PositionTable,
+			Columns: []string{waitlistentry.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.PositionTable,
+			Columns: []string{waitlistentry.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weu.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.MemberTable,
+			Columns: []string{waitlistentry.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.MemberTable,
+			Columns: []string{waitlistentry.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetPositionID sets the "position_id" field.
+func (weuo *WaitlistEntryUpdateOne) SetPositionID(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetPositionID(i)
+	return weuo
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillablePositionID(i *int) *WaitlistEntryUpdateOne {
+	if i != nil {
+		weuo.SetPositionID(*i)
+	}
+	return weuo
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WaitlistEntryUpdateOne) SetStatus(ms models.WaitlistStatus) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetStatus(ms)
+	return weuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableStatus(ms *models.WaitlistStatus) *WaitlistEntryUpdateOne {
+	if ms != nil {
+		weuo.SetStatus(*ms)
+	}
+	return weuo
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferExpiresAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferExpiresAt(t)
+	return weuo
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferExpiresAt(*t)
+	}
+	return weuo
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferExpiresAt() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferExpiresAt()
+	return weuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (weuo *WaitlistEntryUpdateOne) SetUpdatedAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetUpdatedAt(t)
+	return weuo
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (weuo *WaitlistEntryUpdateOne) SetPosition(p *Position) *WaitlistEntryUpdateOne {
+	return weuo.SetPositionID(p.ID)
+}
+
+// SetMemberID sets the "member" edge to the Member entity by ID.
+func (weuo *WaitlistEntryUpdateOne) SetMemberID(id int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetMemberID(id)
+	return weuo
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (weuo *WaitlistEntryUpdateOne) SetMember(m *Member) *WaitlistEntryUpdateOne {
+	return weuo.SetMemberID(m.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weuo *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return weuo.mutation
+}
+
+// ClearPosition clears the "position" edge to the Position entity.
+func (weuo *WaitlistEntryUpdateOne) ClearPosition() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearPosition()
+	return weuo
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (weuo *WaitlistEntryUpdateOne) ClearMember() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearMember()
+	return weuo
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weuo *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (weuo *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	weuo.defaults()
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weuo *WaitlistEntryUpdateOne) defaults() {
+	if _, ok := weuo.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		weuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WaitlistEntryUpdateOne) check() error {
+	if v, ok := weuo.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if weuo.mutation.PositionCleared() && len(weuo.mutation.PositionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.position"`)
+	}
+	if weuo.mutation.MemberCleared() && len(weuo.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaitlistEntry.member"`)
+	}
+	return nil
+}
+
+func (weuo *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weuo.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if weuo.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if weuo.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.PositionTable,
+			Columns: []string{waitlistentry.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.PositionTable,
+			Columns: []string{waitlistentry.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weuo.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.MemberTable,
+			Columns: []string{waitlistentry.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.MemberTable,
+			Columns: []string{waitlistentry.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaitlistEntry{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
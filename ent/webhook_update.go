@@ -0,0 +1,882 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/team"
+	"backend_golang/ent/webhook"
+	"backend_golang/ent/webhookdelivery"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetTeamID sets the "team_id" field.
+func (wu *WebhookUpdate) SetTeamID(i int) *WebhookUpdate {
+	wu.mutation.SetTeamID(i)
+	return wu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableTeamID(i *int) *WebhookUpdate {
+	if i != nil {
+		wu.SetTeamID(*i)
+	}
+	return wu
+}
+
+// SetURL sets the "url" field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableURL(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetURL(*s)
+	}
+	return wu
+}
+
+// SetFormat sets the "format" field.
+func (wu *WebhookUpdate) SetFormat(mf models.WebhookFormat) *WebhookUpdate {
+	wu.mutation.SetFormat(mf)
+	return wu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableFormat(mf *models.WebhookFormat) *WebhookUpdate {
+	if mf != nil {
+		wu.SetFormat(*mf)
+	}
+	return wu
+}
+
+// SetSecret sets the "secret" field.
+func (wu *WebhookUpdate) SetSecret(s string) *WebhookUpdate {
+	wu.mutation.SetSecret(s)
+	return wu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableSecret(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetSecret(*s)
+	}
+	return wu
+}
+
+// SetEvents sets the "events" field.
+func (wu *WebhookUpdate) SetEvents(met []models.DomainEventType) *WebhookUpdate {
+	wu.mutation.SetEvents(met)
+	return wu
+}
+
+// AppendEvents appends met to the "events" field.
+func (wu *WebhookUpdate) AppendEvents(met []models.DomainEventType) *WebhookUpdate {
+	wu.mutation.AppendEvents(met)
+	return wu
+}
+
+// ClearEvents clears the value of the "events" field.
+func (wu *WebhookUpdate) ClearEvents() *WebhookUpdate {
+	wu.mutation.ClearEvents()
+	return wu
+}
+
+// SetActive sets the "active" field.
+func (wu *WebhookUpdate) SetActive(b bool) *WebhookUpdate {
+	wu.mutation.SetActive(b)
+	return wu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableActive(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetActive(*b)
+	}
+	return wu
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (wu *WebhookUpdate) SetConsecutiveFailures(i int) *WebhookUpdate {
+	wu.mutation.ResetConsecutiveFailures()
+	wu.mutation.SetConsecutiveFailures(i)
+	return wu
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableConsecutiveFailures(i *int) *WebhookUpdate {
+	if i != nil {
+		wu.SetConsecutiveFailures(*i)
+	}
+	return wu
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (wu *WebhookUpdate) AddConsecutiveFailures(i int) *WebhookUpdate {
+	wu.mutation.AddConsecutiveFailures(i)
+	return wu
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (wu *WebhookUpdate) SetDisabledAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetDisabledAt(t)
+	return wu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableDisabledAt(t *time.Time) *WebhookUpdate {
+	if t != nil {
+		wu.SetDisabledAt(*t)
+	}
+	return wu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (wu *WebhookUpdate) ClearDisabledAt() *WebhookUpdate {
+	wu.mutation.ClearDisabledAt()
+	return wu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wu *WebhookUpdate) SetCreatedBy(s string) *WebhookUpdate {
+	wu.mutation.SetCreatedBy(s)
+	return wu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableCreatedBy(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetCreatedBy(*s)
+	}
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WebhookUpdate) SetUpdatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (wu *WebhookUpdate) SetTeam(t *Team) *WebhookUpdate {
+	return wu.SetTeamID(t.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...int) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (wu *WebhookUpdate) ClearTeam() *WebhookUpdate {
+	wu.mutation.ClearTeam()
+	return wu
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...int) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebhookUpdate) check() error {
+	if v, ok := wu.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Format(); ok {
+		if err := webhook.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Webhook.format": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.CreatedBy(); ok {
+		if err := webhook.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Webhook.created_by": %w`, err)}
+		}
+	}
+	if wu.mutation.TeamCleared() && len(wu.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Webhook.team"`)
+	}
+	return nil
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Format(); ok {
+		_spec.SetField(webhook.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEvents, value)
+		})
+	}
+	if wu.mutation.EventsCleared() {
+		_spec.ClearField(webhook.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.Active(); ok {
+		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wu.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhook.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhook.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.DisabledAt(); ok {
+		_spec.SetField(webhook.FieldDisabledAt, field.TypeTime, value)
+	}
+	if wu.mutation.DisabledAtCleared() {
+		_spec.ClearField(webhook.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := wu.mutation.CreatedBy(); ok {
+		_spec.SetField(webhook.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.TeamTable,
+			Columns: []string{webhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.TeamTable,
+			Columns: []string{webhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (wuo *WebhookUpdateOne) SetTeamID(i int) *WebhookUpdateOne {
+	wuo.mutation.SetTeamID(i)
+	return wuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableTeamID(i *int) *WebhookUpdateOne {
+	if i != nil {
+		wuo.SetTeamID(*i)
+	}
+	return wuo
+}
+
+// SetURL sets the "url" field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableURL(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetURL(*s)
+	}
+	return wuo
+}
+
+// SetFormat sets the "format" field.
+func (wuo *WebhookUpdateOne) SetFormat(mf models.WebhookFormat) *WebhookUpdateOne {
+	wuo.mutation.SetFormat(mf)
+	return wuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableFormat(mf *models.WebhookFormat) *WebhookUpdateOne {
+	if mf != nil {
+		wuo.SetFormat(*mf)
+	}
+	return wuo
+}
+
+// SetSecret sets the "secret" field.
+func (wuo *WebhookUpdateOne) SetSecret(s string) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(s)
+	return wuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableSecret(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetSecret(*s)
+	}
+	return wuo
+}
+
+// SetEvents sets the "events" field.
+func (wuo *WebhookUpdateOne) SetEvents(met []models.DomainEventType) *WebhookUpdateOne {
+	wuo.mutation.SetEvents(met)
+	return wuo
+}
+
+// AppendEvents appends met to the "events" field.
+func (wuo *WebhookUpdateOne) AppendEvents(met []models.DomainEventType) *WebhookUpdateOne {
+	wuo.mutation.AppendEvents(met)
+	return wuo
+}
+
+// ClearEvents clears the value of the "events" field.
+func (wuo *WebhookUpdateOne) ClearEvents() *WebhookUpdateOne {
+	wuo.mutation.ClearEvents()
+	return wuo
+}
+
+// SetActive sets the "active" field.
+func (wuo *WebhookUpdateOne) SetActive(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetActive(b)
+	return wuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableActive(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetActive(*b)
+	}
+	return wuo
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (wuo *WebhookUpdateOne) SetConsecutiveFailures(i int) *WebhookUpdateOne {
+	wuo.mutation.ResetConsecutiveFailures()
+	wuo.mutation.SetConsecutiveFailures(i)
+	return wuo
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableConsecutiveFailures(i *int) *WebhookUpdateOne {
+	if i != nil {
+		wuo.SetConsecutiveFailures(*i)
+	}
+	return wuo
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (wuo *WebhookUpdateOne) AddConsecutiveFailures(i int) *WebhookUpdateOne {
+	wuo.mutation.AddConsecutiveFailures(i)
+	return wuo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (wuo *WebhookUpdateOne) SetDisabledAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetDisabledAt(t)
+	return wuo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableDisabledAt(t *time.Time) *WebhookUpdateOne {
+	if t != nil {
+		wuo.SetDisabledAt(*t)
+	}
+	return wuo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (wuo *WebhookUpdateOne) ClearDisabledAt() *WebhookUpdateOne {
+	wuo.mutation.ClearDisabledAt()
+	return wuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wuo *WebhookUpdateOne) SetCreatedBy(s string) *WebhookUpdateOne {
+	wuo.mutation.SetCreatedBy(s)
+	return wuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableCreatedBy(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetCreatedBy(*s)
+	}
+	return wuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WebhookUpdateOne) SetUpdatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (wuo *WebhookUpdateOne) SetTeam(t *Team) *WebhookUpdateOne {
+	return wuo.SetTeamID(t.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (wuo *WebhookUpdateOne) ClearTeam() *WebhookUpdateOne {
+	wuo.mutation.ClearTeam()
+	return wuo
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebhookUpdateOne) check() error {
+	if v, ok := wuo.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Format(); ok {
+		if err := webhook.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Webhook.format": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.CreatedBy(); ok {
+		if err := webhook.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Webhook.created_by": %w`, err)}
+		}
+	}
+	if wuo.mutation.TeamCleared() && len(wuo.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Webhook.team"`)
+	}
+	return nil
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Format(); ok {
+		_spec.SetField(webhook.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEvents, value)
+		})
+	}
+	if wuo.mutation.EventsCleared() {
+		_spec.ClearField(webhook.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.Active(); ok {
+		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wuo.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhook.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhook.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.DisabledAt(); ok {
+		_spec.SetField(webhook.FieldDisabledAt, field.TypeTime, value)
+	}
+	if wuo.mutation.DisabledAtCleared() {
+		_spec.ClearField(webhook.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := wuo.mutation.CreatedBy(); ok {
+		_spec.SetField(webhook.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.TeamTable,
+			Columns: []string{webhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.TeamTable,
+			Columns: []string{webhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/webhook"
+	"backend_golang/ent/webhookdelivery"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(i)
+	return wdu
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableWebhookID(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetWebhookID(*i)
+	}
+	return wdu
+}
+
+// SetEventID sets the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEventID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetEventID()
+	wdu.mutation.SetEventID(i)
+	return wdu
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventID(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetEventID(*i)
+	}
+	return wdu
+}
+
+// AddEventID adds i to the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) AddEventID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddEventID(i)
+	return wdu
+}
+
+// SetEventType sets the "event_type" field.
+func (wdu *WebhookDeliveryUpdate) SetEventType(met models.DomainEventType) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventType(met)
+	return wdu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventType(met *models.DomainEventType) *WebhookDeliveryUpdate {
+	if met != nil {
+		wdu.SetEventType(*met)
+	}
+	return wdu
+}
+
+// SetBody sets the "body" field.
+func (wdu *WebhookDeliveryUpdate) SetBody(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetBody(s)
+	return wdu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableBody(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetBody(*s)
+	}
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(mds models.WebhookDeliveryStatus) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(mds)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(mds *models.WebhookDeliveryStatus) *WebhookDeliveryUpdate {
+	if mds != nil {
+		wdu.SetStatus(*mds)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptAt(t)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptAt(*t)
+	}
+	return wdu
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseStatus()
+	wdu.mutation.SetResponseStatus(i)
+	return wdu
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseStatus(*i)
+	}
+	return wdu
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseStatus(i)
+	return wdu
+}
+
+// SetLastError sets the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) SetLastError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastError(s)
+	return wdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetLastError(*s)
+	}
+	return wdu
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (wdu *WebhookDeliveryUpdate) SetDurationMs(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetDurationMs()
+	wdu.mutation.SetDurationMs(i)
+	return wdu
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDurationMs(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetDurationMs(*i)
+	}
+	return wdu
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (wdu *WebhookDeliveryUpdate) AddDurationMs(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddDurationMs(i)
+	return wdu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveredAt(t)
+	return wdu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeliveredAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearDeliveredAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeliveredAt()
+	return wdu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.EventType(); ok {
+		if err := webhookdelivery.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event_type": %w`, err)}
+		}
+	}
+	if v, ok := wdu.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if wdu.mutation.WebhookCleared() && len(wdu.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedEventID(); ok {
+		_spec.AddField(webhookdelivery.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeEnum, value)
+	}
+	if value, ok := wdu.mutation.Body(); ok {
+		_spec.SetField(webhookdelivery.FieldBody, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedDurationMs(); ok {
+		_spec.AddField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if wdu.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(i)
+	return wduo
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableWebhookID(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetWebhookID(*i)
+	}
+	return wduo
+}
+
+// SetEventID sets the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetEventID()
+	wduo.mutation.SetEventID(i)
+	return wduo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventID(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetEventID(*i)
+	}
+	return wduo
+}
+
+// AddEventID adds i to the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) AddEventID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddEventID(i)
+	return wduo
+}
+
+// SetEventType sets the "event_type" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventType(met models.DomainEventType) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventType(met)
+	return wduo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventType(met *models.DomainEventType) *WebhookDeliveryUpdateOne {
+	if met != nil {
+		wduo.SetEventType(*met)
+	}
+	return wduo
+}
+
+// SetBody sets the "body" field.
+func (wduo *WebhookDeliveryUpdateOne) SetBody(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetBody(s)
+	return wduo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableBody(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetBody(*s)
+	}
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(mds models.WebhookDeliveryStatus) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(mds)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(mds *models.WebhookDeliveryStatus) *WebhookDeliveryUpdateOne {
+	if mds != nil {
+		wduo.SetStatus(*mds)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptAt(t)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptAt(*t)
+	}
+	return wduo
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseStatus()
+	wduo.mutation.SetResponseStatus(i)
+	return wduo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseStatus(*i)
+	}
+	return wduo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseStatus(i)
+	return wduo
+}
+
+// SetLastError sets the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastError(s)
+	return wduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetLastError(*s)
+	}
+	return wduo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDurationMs(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetDurationMs()
+	wduo.mutation.SetDurationMs(i)
+	return wduo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDurationMs(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetDurationMs(*i)
+	}
+	return wduo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (wduo *WebhookDeliveryUpdateOne) AddDurationMs(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddDurationMs(i)
+	return wduo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveredAt(t)
+	return wduo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeliveredAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeliveredAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeliveredAt()
+	return wduo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.EventType(); ok {
+		if err := webhookdelivery.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event_type": %w`, err)}
+		}
+	}
+	if v, ok := wduo.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if wduo.mutation.WebhookCleared() && len(wduo.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedEventID(); ok {
+		_spec.AddField(webhookdelivery.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeEnum, value)
+	}
+	if value, ok := wduo.mutation.Body(); ok {
+		_spec.SetField(webhookdelivery.FieldBody, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if wduo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
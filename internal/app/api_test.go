@@ -0,0 +1,367 @@
+package app
+
+import (
+	config "backend_golang/configs"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_TeamLifecycle(t *testing.T) {
+	server := newTestServer(t, Config{})
+	leader := server.member("leader")
+	member := server.member("member")
+
+	resp := server.do(http.MethodPost, "/v1/teams", "", map[string]any{"teamName": "gophers"})
+	assert.Equal(t, http.StatusUnauthorized, resp.status)
+
+	teamID := server.createTeam(leader, "gophers", 2)
+	resp = server.do(http.MethodPost, fmt.Sprintf("/v1/teams/%d/join", teamID), member, map[string]any{"role": "BACKEND"})
+	require.Equal(t, http.StatusOK, resp.status, string(resp.body))
+	resp = server.do(http.MethodPost, fmt.Sprintf("/v1/teams/%d/join", teamID), member, map[string]any{"role": "BACKEND"})
+	assert.Equal(t, http.StatusConflict, resp.status)
+
+	// チームは認証しなくても閲覧できる
+	resp = server.do(http.MethodGet, fmt.Sprintf("/v1/teams/%d", teamID), "", nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	assert.NotEmpty(t, resp.header.Get("X-Request-ID"))
+	var team struct {
+		Name    string `json:"name"`
+		Members []struct {
+			ID string `json:"id"`
+		} `json:"members"`
+	}
+	resp.decode(t, &team)
+	assert.Equal(t, "gophers", team.Name)
+	assert.Len(t, team.Members, 2)
+
+	// 参加の通知は outbox から配信される
+	server.dispatch()
+	resp = server.do(http.MethodGet, "/v1/notifications", leader, nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	var notifications struct {
+		Unread        int `json:"unread"`
+		Notifications []struct {
+			Kind    string `json:"kind"`
+			ActorID string `json:"actor_id"`
+		} `json:"notifications"`
+	}
+	resp.decode(t, &notifications)
+	assert.Equal(t, 1, notifications.Unread)
+	require.Len(t, notifications.Notifications, 1)
+	assert.Equal(t, "MEMBER_JOINED", notifications.Notifications[0].Kind)
+	assert.Equal(t, member, notifications.Notifications[0].ActorID)
+}
+
+func TestApp_Signup(t *testing.T) {
+	server := newTestServer(t, Config{})
+	newcomer := server.transientMember("newcomer")
+
+	type meResponse struct {
+		ID            string   `json:"id"`
+		PreferredRole string   `json:"preferred_role"`
+		Roles         []string `json:"roles"`
+		Transient     bool     `json:"transient"`
+	}
+
+	resp := server.do(http.MethodGet, "/v1/me", newcomer, nil)
+	require.Equal(t, http.StatusOK, resp.status, string(resp.body))
+	var me meResponse
+	resp.decode(t, &me)
+	assert.True(t, me.Transient)
+
+	resp = server.do(http.MethodPost, "/v1/auth/signup", newcomer, map[string]any{"bio": " ", "preferredRole": "BACKEND"})
+	assert.Equal(t, http.StatusBadRequest, resp.status)
+
+	resp = server.do(http.MethodPost, "/v1/auth/signup", newcomer, map[string]any{
+		"bio":           "Go が好きです",
+		"preferredRole": "BACKEND",
+		"roles":         []string{"BACKEND", "INFRA"},
+	})
+	require.Equal(t, http.StatusCreated, resp.status, string(resp.body))
+
+	resp = server.do(http.MethodGet, "/v1/me", newcomer, nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	me = meResponse{}
+	resp.decode(t, &me)
+	assert.False(t, me.Transient)
+	assert.Equal(t, newcomer, me.ID)
+	assert.Equal(t, "BACKEND", me.PreferredRole)
+	assert.ElementsMatch(t, []string{"BACKEND", "INFRA"}, me.Roles)
+
+	// サインアップ後はチームを作れる
+	server.createTeam(newcomer, "gophers", 1)
+}
+
+func TestApp_AnnouncementSearch(t *testing.T) {
+	server := newTestServer(t, Config{})
+	leader := server.member("leader")
+	teamID := server.createTeam(leader, "gophers", 2)
+
+	resp := server.do(http.MethodPost, "/v1/announcements", leader, map[string]any{
+		"teamID":  teamID,
+		"title":   "Go のバックエンドエンジニア募集",
+		"content": "Kubernetes で動く API サーバーを一緒に作りましょう",
+	})
+	require.Equal(t, http.StatusCreated, resp.status, string(resp.body))
+	var created struct {
+		AnnouncementID int `json:"announcementID"`
+	}
+	resp.decode(t, &created)
+
+	resp = server.do(http.MethodGet, fmt.Sprintf("/v1/announcements/%d", created.AnnouncementID), "", nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	var announcement struct {
+		Title string `json:"title"`
+		Team  struct {
+			ID int `json:"id"`
+		} `json:"team"`
+	}
+	resp.decode(t, &announcement)
+	assert.Equal(t, "Go のバックエンドエンジニア募集", announcement.Title)
+	assert.Equal(t, teamID, announcement.Team.ID)
+
+	// キーワード検索に使うインデックスは outbox のイベントから更新する
+	server.dispatch()
+	resp = server.do(http.MethodGet, "/v1/announcements?page=1&size=10&keyword=Kubernetes", "", nil)
+	require.Equal(t, http.StatusOK, resp.status, string(resp.body))
+	var listed []struct {
+		ID int `json:"id"`
+	}
+	resp.decode(t, &listed)
+	require.Len(t, listed, 1)
+	assert.Equal(t, created.AnnouncementID, listed[0].ID)
+
+	resp = server.do(http.MethodGet, "/v1/search?q=kubernetes&type=announcement", "", nil)
+	require.Equal(t, http.StatusOK, resp.status, string(resp.body))
+	var results []struct {
+		Type string `json:"type"`
+		ID   int    `json:"id"`
+	}
+	resp.decode(t, &results)
+	require.Len(t, results, 1)
+	assert.Equal(t, "announcement", results[0].Type)
+	assert.Equal(t, created.AnnouncementID, results[0].ID)
+
+	resp = server.do(http.MethodGet, "/v1/search", "", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.status)
+}
+
+func TestApp_AnnouncementKeywordOrder(t *testing.T) {
+	server := newTestServer(t, Config{})
+	// 1チームが24時間に1件しか投稿できないため、DB に直接作る
+	now := time.Now()
+	announce := func(title string, content string, createdAt time.Time) int {
+		teamID := server.createTeam(server.member(title), title, 1)
+		return server.client.Announcement.Create().
+			SetTitle(title).
+			SetContent(content).
+			SetTeamID(teamID).
+			SetCreatedAt(createdAt).
+			SetUpdatedAt(createdAt).
+			SaveX(context.Background()).ID
+	}
+	relevant := announce("Kubernetes 勉強会", "Kubernetes の運用と Kubernetes のネットワークを学びます", now.Add(-2*time.Hour))
+	other := announce("Go 勉強会", "Go の API サーバーを Kubernetes にデプロイします", now.Add(-time.Hour))
+	announce("React 勉強会", "フロントエンドを作ります", now)
+
+	// メモリ上のインデックスは起動時に DB から作り直す
+	restarted, err := New(Config{}, server.client)
+	require.NoError(t, err)
+	list := func(query string) []int {
+		recorder := httptest.NewRecorder()
+		restarted.Engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/announcements?"+query, nil))
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		var listed []struct {
+			ID int `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listed))
+		ids := make([]int, len(listed))
+		for i, announcement := range listed {
+			ids[i] = announcement.ID
+		}
+		return ids
+	}
+
+	// キーワードを指定した場合は新しい順ではなく関連度の順に返す
+	assert.Equal(t, []int{relevant, other}, list("page=1&size=10&keyword=Kubernetes"))
+	assert.Equal(t, []int{other}, list("page=2&size=1&keyword=Kubernetes"))
+	assert.Empty(t, list("page=3&size=1&keyword=Kubernetes"))
+	assert.Equal(t, []int{relevant, other}, list("page=1&size=10&keyword=Kubernetes&skill=Go"))
+	assert.Empty(t, list("page=1&size=10&keyword=Kubernetes&skill=Rust"))
+}
+
+func TestApp_MergeSkill(t *testing.T) {
+	server := newTestServer(t, Config{})
+	admin := server.member("admin")
+	t.Setenv("ADMIN_MEMBER_IDS", admin)
+	previous := config.AdminConfig
+	config.AdminConfig = config.NewAdmin()
+	t.Cleanup(func() { config.AdminConfig = previous })
+
+	gleam := server.client.Skill.Create().SetName("Gleam").SaveX(context.Background())
+	path := fmt.Sprintf("/v1/skills/%d/merge", gleam.ID)
+
+	resp := server.do(http.MethodPost, path, server.member("member"), map[string]any{"into": gleam.ID})
+	assert.Equal(t, http.StatusForbidden, resp.status)
+
+	// 自分自身への統合はリクエストの誤りとして扱う
+	resp = server.do(http.MethodPost, path, admin, map[string]any{"into": gleam.ID})
+	assert.Equal(t, http.StatusBadRequest, resp.status, string(resp.body))
+
+	resp = server.do(http.MethodPost, path, admin, map[string]any{"into": gleam.ID + 1})
+	assert.Equal(t, http.StatusNotFound, resp.status, string(resp.body))
+}
+
+func TestApp_CalendarFeeds(t *testing.T) {
+	const baseURL = "https://teams.example.com"
+	server := newTestServer(t, Config{BaseURL: baseURL})
+	leader := server.member("leader")
+	teamID := server.createTeam(leader, "gophers", 1)
+
+	type calendarFeed struct {
+		Token     string `json:"token"`
+		FeedURL   string `json:"feed_url"`
+		TeamFeeds []struct {
+			TeamID  int    `json:"team_id"`
+			FeedURL string `json:"feed_url"`
+		} `json:"team_feeds"`
+	}
+	resp := server.do(http.MethodGet, "/v1/me/calendar", leader, nil)
+	require.Equal(t, http.StatusOK, resp.status, string(resp.body))
+	var feeds calendarFeed
+	resp.decode(t, &feeds)
+
+	// フィードの URL はリクエストの Host ではなく設定した公開 URL から作る
+	assert.Equal(t, baseURL+"/v1/me/calendar.ics?token="+feeds.Token, feeds.FeedURL)
+	require.Len(t, feeds.TeamFeeds, 1)
+	assert.Equal(t, teamID, feeds.TeamFeeds[0].TeamID)
+	assert.Equal(t, fmt.Sprintf("%s/v1/teams/%d/calendar.ics?token=%s", baseURL, teamID, feeds.Token), feeds.TeamFeeds[0].FeedURL)
+
+	memberFeed := strings.TrimPrefix(feeds.FeedURL, baseURL)
+	teamFeed := strings.TrimPrefix(feeds.TeamFeeds[0].FeedURL, baseURL)
+	resp = server.do(http.MethodGet, memberFeed, "", nil)
+	assert.Equal(t, http.StatusOK, resp.status)
+	resp = server.do(http.MethodGet, teamFeed, "", nil)
+	assert.Equal(t, http.StatusOK, resp.status)
+
+	resp = server.do(http.MethodGet, "/v1/me/calendar", server.member("member"), nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	var unjoined calendarFeed
+	resp.decode(t, &unjoined)
+	assert.Empty(t, unjoined.TeamFeeds)
+
+	// 再発行前のトークンはトークンがない場合と同じく 401 にする
+	resp = server.do(http.MethodPost, "/v1/me/calendar/rotate", leader, nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	for _, path := range []string{memberFeed, teamFeed, "/v1/me/calendar.ics"} {
+		resp = server.do(http.MethodGet, path, "", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.status, path)
+	}
+}
+
+// TestApp_ConcurrentCommentsKeepRateLimit は同時にコメントを投稿しても1人あたりの上限を超えないことを確かめる
+func TestApp_ConcurrentCommentsKeepRateLimit(t *testing.T) {
+	server := newTestServer(t, Config{})
+	leader := server.member("leader")
+	visitor := server.member("visitor")
+	teamID := server.createTeam(leader, "gophers", 1)
+	resp := server.do(http.MethodPost, "/v1/announcements", leader, map[string]any{
+		"teamID":  teamID,
+		"title":   "Go のバックエンドエンジニア募集",
+		"content": "一緒に API サーバーを作りましょう",
+	})
+	require.Equal(t, http.StatusCreated, resp.status, string(resp.body))
+	var created struct {
+		AnnouncementID int `json:"announcementID"`
+	}
+	resp.decode(t, &created)
+	path := fmt.Sprintf("/v1/announcements/%d/comments", created.AnnouncementID)
+
+	const posts = 8
+	statuses := make([]int, posts)
+	errs := make([]error, posts)
+	var wg sync.WaitGroup
+	for i := 0; i < posts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := server.send(http.MethodPost, path, visitor, map[string]any{"body": fmt.Sprintf("質問 %d", i)})
+			statuses[i], errs[i] = resp.status, err
+		}(i)
+	}
+	wg.Wait()
+
+	counts := make(map[int]int)
+	for i, status := range statuses {
+		require.NoError(t, errs[i])
+		counts[status]++
+	}
+	assert.Equal(t, map[int]int{http.StatusCreated: 5, http.StatusTooManyRequests: posts - 5}, counts)
+
+	// 上限はメンバーごと
+	resp = server.do(http.MethodPost, path, leader, map[string]any{"body": "回答します"})
+	assert.Equal(t, http.StatusCreated, resp.status, string(resp.body))
+}
+
+// TestApp_ConcurrentJoinKeepsVacancy は同時に参加しても定員を超えず、エラーにならないことを確かめる
+// SQLite はトランザクションを直列に実行するため、空きを条件付きで減らすことは TestTeamRepository_JoinTeamRejectsStaleVacancy で確かめる
+func TestApp_ConcurrentJoinKeepsVacancy(t *testing.T) {
+	server := newTestServer(t, Config{})
+	leader := server.member("leader")
+	const vacancy = 2
+	teamID := server.createTeam(leader, "gophers", vacancy)
+
+	const joiners = 8
+	statuses := make([]int, joiners)
+	errs := make([]error, joiners)
+	var wg sync.WaitGroup
+	for i := 0; i < joiners; i++ {
+		memberID := server.member(fmt.Sprintf("member-%d", i))
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := server.send(http.MethodPost, fmt.Sprintf("/v1/teams/%d/join", teamID), memberID, map[string]any{"role": "BACKEND"})
+			statuses[i], errs[i] = resp.status, err
+		}(i)
+	}
+	wg.Wait()
+
+	joined := 0
+	for i, status := range statuses {
+		require.NoError(t, errs[i])
+		switch status {
+		case http.StatusOK:
+			joined++
+		case http.StatusConflict:
+		default:
+			t.Fatalf("unexpected status %d", status)
+		}
+	}
+	assert.Equal(t, vacancy, joined)
+
+	resp := server.do(http.MethodGet, fmt.Sprintf("/v1/teams/%d", teamID), "", nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	var team struct {
+		Members   []struct{} `json:"members"`
+		Positions []struct {
+			Role    string `json:"role"`
+			Filled  int    `json:"filled"`
+			Vacancy int    `json:"vacancy"`
+		} `json:"positions"`
+	}
+	resp.decode(t, &team)
+	assert.Len(t, team.Members, vacancy+1)
+	require.Len(t, team.Positions, 1)
+	assert.Equal(t, vacancy, team.Positions[0].Filled)
+	assert.Equal(t, 0, team.Positions[0].Vacancy)
+}
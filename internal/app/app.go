@@ -0,0 +1,289 @@
+// Package app はリポジトリ・サービス・コントローラーを組み立て、ルートを登録した Gin のエンジンを作る
+// main とエンドツーエンドのテストの両方から使う
+package app
+
+import (
+	"backend_golang/api"
+	"backend_golang/cmd/middleware"
+	"backend_golang/ent"
+	"backend_golang/internal/controller"
+	"backend_golang/internal/mail"
+	"backend_golang/internal/models"
+	"backend_golang/internal/openapi"
+	"backend_golang/internal/outbox"
+	"backend_golang/internal/pubsub"
+	"backend_golang/internal/ratelimit"
+	"backend_golang/internal/repository"
+	"backend_golang/internal/search"
+	"backend_golang/internal/service"
+	smodels "backend_golang/internal/service/models"
+	"backend_golang/internal/webhook"
+	"backend_golang/internal/worker"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// mailQueueSize は送信待ちにできるメールの数
+	mailQueueSize       = 256
+	defaultMaxBodyBytes = 1 << 20
+)
+
+type Config struct {
+	// Searcher は検索インデックス。nil の場合はメモリ上のインデックスを使う
+	Searcher search.Searcher
+	// Mailer はメールの送り先。nil の場合は送らずに捨てる
+	Mailer mail.Mailer
+	// WebhookClient は Webhook の送信に使う。nil の場合は内部のアドレスに送らない webhook.NewClient() を使う
+	// httptest のサーバーに送るテストでは webhook.NewUnguardedClient() を渡す
+	WebhookClient *webhook.Client
+	// BaseURL はメールと Webhook に載せるリンクとカレンダーのフィードの URL の先頭部分
+	BaseURL string
+	// AllowOrigins は CORS で許可するオリジン。空の場合は CORS のヘッダーを返さない
+	AllowOrigins []string
+	// MaxBodyBytes はリクエストボディのサイズの上限。0 の場合は defaultMaxBodyBytes
+	MaxBodyBytes            int64
+	LeaderCanSeeMemberEmail bool
+	// RateLimits は gin のルート（例: "POST /v1/teams/:teamID/join"）ごとのレート制限。ないルートは制限しない
+	RateLimits map[string]ratelimit.Policy
+	// RateLimitStore はレート制限のバケットの保存先。nil の場合はメモリ上に保存する
+	RateLimitStore ratelimit.Store
+	// TrustedProxies は X-Forwarded-For を信用するプロキシの IP アドレスまたは CIDR。空の場合はどのヘッダーも信用せず、接続元のアドレスを使う
+	TrustedProxies []string
+	// OnResponseError を指定するとレスポンスを仕様書で検証し、異なる場合に呼び出す。テストで使う
+	OnResponseError func(c *gin.Context, err error)
+}
+
+// job は Start で始める定期実行の処理
+type job struct {
+	name     string
+	interval time.Duration
+	fn       func(ctx context.Context) error
+}
+
+// App は組み立てたアプリケーション。Engine を http.Server に渡して使う
+type App struct {
+	Engine *gin.Engine
+	// Relay は outbox のイベントを購読者に配信する。テストでは Dispatch を直接呼ぶ
+	Relay *outbox.Relay
+
+	jobs            []job
+	workers         *worker.Group
+	mailQueue       *mail.Queue
+	cancelMail      context.CancelFunc
+	mailDone        chan struct{}
+	notificationHub *pubsub.Hub[smodels.NotificationResponse]
+}
+
+// New は cfg と client からアプリケーションを組み立てる。ワーカーは Start するまで動かない
+func New(cfg Config, client *ent.Client) (*App, error) {
+	if cfg.Searcher == nil {
+		cfg.Searcher = search.NewMemoryIndex()
+	}
+	if cfg.Mailer == nil {
+		cfg.Mailer = mail.NewFileMailer(io.Discard, "")
+	}
+	if cfg.WebhookClient == nil {
+		cfg.WebhookClient = webhook.NewClient()
+	}
+	if cfg.MaxBodyBytes == 0 {
+		cfg.MaxBodyBytes = defaultMaxBodyBytes
+	}
+	if cfg.RateLimitStore == nil {
+		cfg.RateLimitStore = ratelimit.NewMemoryStore()
+	}
+
+	mailTemplates, err := mail.LoadTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	a := &App{
+		mailQueue:       mail.NewQueue(cfg.Mailer, mailQueueSize),
+		notificationHub: pubsub.NewHub[smodels.NotificationResponse](),
+	}
+	visibility := service.NewMemberVisibility(cfg.LeaderCanSeeMemberEmail)
+
+	// Repository
+	authRepository := repository.NewAuthRepository(client)
+	notificationRepository := repository.NewNotificationRepository(client)
+	teamRepository := repository.NewTeamRepository(client)
+	waitlistRepository := repository.NewWaitlistRepository(client)
+	invitationRepository := repository.NewInvitationRepository(client)
+	eventRepository := repository.NewEventRepository(client)
+	threadRepository := repository.NewThreadRepository(client)
+	skillRepository := repository.NewSkillRepository(client)
+	announcementRepository := repository.NewAnnouncementRepository(client)
+	announcementCommentRepository := repository.NewAnnouncementCommentRepository(client)
+	searchRepository := repository.NewSearchRepository(client)
+	webhookRepository := repository.NewWebhookRepository(client)
+	outboxRepository := repository.NewOutboxRepository(client)
+
+	// Service
+	notificationMailer := service.NewNotificationMailer(authRepository, a.mailQueue, mailTemplates, cfg.BaseURL)
+	notificationService := service.NewNotificationService(notificationRepository, authRepository, a.notificationHub, notificationMailer)
+	teamService := service.NewTeamService(teamRepository, authRepository, visibility)
+	waitlistService := service.NewWaitlistService(waitlistRepository, teamRepository, authRepository)
+	invitationService := service.NewInvitationService(invitationRepository, teamRepository)
+	eventService := service.NewEventService(eventRepository, teamRepository, notificationService)
+	calendarService := service.NewCalendarService(eventRepository, teamRepository, authRepository, cfg.BaseURL)
+	threadService := service.NewThreadService(threadRepository, teamRepository, notificationService)
+	skillService := service.NewSkillService(skillRepository)
+	announcementService := service.NewAnnouncementService(announcementRepository, teamRepository, skillRepository, visibility, cfg.Searcher)
+	announcementCommentService := service.NewAnnouncementCommentService(announcementCommentRepository, announcementRepository, notificationService, cfg.RateLimitStore)
+	searchService := service.NewSearchService(cfg.Searcher, searchRepository)
+	webhookService := service.NewWebhookService(webhookRepository, teamRepository, cfg.WebhookClient)
+	authService := service.NewAuthService(authRepository)
+	healthService := service.NewHealthService(repository.NewHealthRepository(client))
+
+	if err := skillService.Seed(context.Background()); err != nil {
+		return nil, err
+	}
+	// メモリ上のインデックスは起動するたびに空になるので、DB の内容から作り直す
+	if _, ok := cfg.Searcher.(*search.MemoryIndex); ok {
+		if err := searchService.Rebuild(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Outbox
+	// トランザクション内で記録したドメインイベントを通知・検索インデックス・Webhook に配信する
+	a.Relay = outbox.NewRelay(outboxRepository)
+	a.Relay.Subscribe("notification", service.NewNotificationSubscriber(teamRepository, notificationService), service.NotificationEventTypes...)
+	a.Relay.Subscribe("search", service.NewSearchSubscriber(teamRepository, announcementRepository, cfg.Searcher), service.SearchEventTypes...)
+	a.Relay.Subscribe("webhook", service.NewWebhookSubscriber(webhookRepository, teamRepository, announcementRepository, authRepository, cfg.BaseURL), models.WebhookEventTypes()...)
+
+	// Worker
+	a.jobs = []job{
+		// 承諾期限を過ぎた提示を失効させ、次の待機者に回す
+		{"waitlist-expiry", time.Minute, func(ctx context.Context) error {
+			_, err := waitlistService.ExpireOffers(ctx)
+			return err
+		}},
+		// 開始が近い回のリマインダーを送る。同じ回のリマインダーは一度だけ送られる
+		{"event-reminder", time.Minute, eventService.SendReminders},
+		// 送信待ちを送り、失敗したものは間隔を空けて再送する
+		{"webhook-delivery", 5 * time.Second, webhookService.DeliverPending},
+		{"outbox-relay", time.Second, a.Relay.Dispatch},
+		// 配信済みのイベントは1週間残す
+		{"outbox-purge", time.Hour, func(ctx context.Context) error {
+			_, err := outboxRepository.Purge(ctx, time.Now().Add(-7*24*time.Hour))
+			return err
+		}},
+	}
+
+	spec, err := openapi.Load(api.Specs)
+	if err != nil {
+		return nil, err
+	}
+
+	engine := gin.New()
+	// サービス・リポジトリに渡す gin の context からリクエストのロガーを取り出せるようにする
+	engine.ContextWithFallback = true
+	// クライアントが X-Forwarded-For を書き換えてレート制限を逃れられないようにする
+	if err := engine.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
+	// Middleware
+	engine.Use(middleware.RequestID(), middleware.AccessLog(), middleware.Metrics(), middleware.Recovery(), middleware.BodyLimit(cfg.MaxBodyBytes))
+	if len(cfg.AllowOrigins) > 0 {
+		engine.Use(cors.New(cors.Config{
+			AllowOrigins:     cfg.AllowOrigins,
+			AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+			AllowHeaders:     []string{"Origin", "Content-Type", "Content-Length", "Accept", "X-CSRF-Token", "Authorization", middleware.RequestIDHeader},
+			ExposeHeaders:    []string{middleware.RequestIDHeader},
+			AllowCredentials: true,
+		}))
+	}
+
+	// 仕様書の検証より前に制限し、不正なリクエストの繰り返しも止める
+	engine.Use(middleware.RateLimit(cfg.RateLimitStore, cfg.RateLimits))
+	engine.Use(middleware.OpenAPI(spec, middleware.OpenAPIOptions{OnResponseError: cfg.OnResponseError}))
+
+	registerRoutes(engine, spec, controllers{
+		health:              controller.NewHealthController(healthService),
+		auth:                controller.NewAuthController(authService),
+		notification:        controller.NewNotificationController(notificationService),
+		team:                controller.NewTeamController(teamService),
+		waitlist:            controller.NewWaitlistController(waitlistService),
+		invitation:          controller.NewInvitationController(invitationService),
+		event:               controller.NewEventController(eventService),
+		calendar:            controller.NewCalendarController(calendarService),
+		thread:              controller.NewThreadController(threadService),
+		webhook:             controller.NewWebhookController(webhookService),
+		role:                controller.NewRoleController(),
+		skill:               controller.NewSkillController(skillService),
+		announcement:        controller.NewAnnouncementController(announcementService),
+		announcementComment: controller.NewAnnouncementCommentController(announcementCommentService),
+		search:              controller.NewSearchController(searchService),
+	})
+	if err := checkRateLimitRoutes(engine, cfg.RateLimits); err != nil {
+		return nil, err
+	}
+	a.Engine = engine
+	return a, nil
+}
+
+// checkRateLimitRoutes はレート制限を設定したルートが登録されているかを確かめる。書き間違えた制限が効かないままにならないようにする
+func checkRateLimitRoutes(engine *gin.Engine, policies map[string]ratelimit.Policy) error {
+	routes := make(map[string]bool)
+	for _, route := range engine.Routes() {
+		routes[route.Method+" "+route.Path] = true
+	}
+	for route := range policies {
+		if !routes[route] {
+			return fmt.Errorf("rate limit for unknown route %q", route)
+		}
+	}
+	return nil
+}
+
+// Start は定期実行のワーカーとメールの送信を始める
+func (a *App) Start() {
+	a.workers = worker.NewGroup(context.Background())
+	for _, j := range a.jobs {
+		a.workers.Every(j.name, j.interval, j.fn)
+	}
+
+	// 送信待ちは停止時に送り切るので、ワーカーとは別に止める
+	mailCtx, cancel := context.WithCancel(context.Background())
+	a.cancelMail = cancel
+	a.mailDone = make(chan struct{})
+	go func() {
+		defer close(a.mailDone)
+		a.mailQueue.Run(mailCtx)
+	}()
+}
+
+// CloseStreams は通知のストリームの購読を閉じる。ストリームは終わらないので、サーバーの停止時に接続を終わらせるために使う
+func (a *App) CloseStreams() {
+	a.notificationHub.Close()
+}
+
+// Shutdown はワーカーを止め、送信待ちのメールを送り切る。ctx が先に終わった場合は残りを待たずにエラーを返す
+// リクエストがメールを追加しなくなるよう、サーバーを止めてから呼ぶ
+func (a *App) Shutdown(ctx context.Context) error {
+	a.CloseStreams()
+	if a.workers == nil {
+		a.mailQueue.Close()
+		return nil
+	}
+
+	// ワーカーがメールを追加しなくなってから送信待ちを締め切る
+	err := a.workers.Stop(ctx)
+	a.mailQueue.Close()
+	select {
+	case <-a.mailDone:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	a.cancelMail()
+	<-a.mailDone
+	return err
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	config "backend_golang/configs"
+	"backend_golang/internal/ratelimit"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_HealthAndMetrics(t *testing.T) {
+	server := newTestServer(t, Config{})
+
+	resp := server.do(http.MethodGet, "/healthz", "", nil)
+	assert.Equal(t, http.StatusOK, resp.status)
+
+	resp = server.do(http.MethodGet, "/readyz", "", nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	var readiness struct {
+		Ready  bool              `json:"ready"`
+		Checks map[string]string `json:"checks"`
+	}
+	resp.decode(t, &readiness)
+	assert.True(t, readiness.Ready)
+	assert.Equal(t, map[string]string{"database": "ok", "migrations": "ok"}, readiness.Checks)
+
+	resp = server.do(http.MethodGet, "/metrics", "", nil)
+	assert.Equal(t, http.StatusOK, resp.status)
+	assert.Contains(t, string(resp.body), `http_request_duration_seconds_count{method="GET",route="/readyz",status="200"}`)
+}
+
+func TestApp_RequestLimits(t *testing.T) {
+	server := newTestServer(t, Config{MaxBodyBytes: 64})
+	leader := server.member("leader")
+
+	resp := server.do(http.MethodPost, "/v1/teams", leader, map[string]any{"description": string(bytes.Repeat([]byte("a"), 100))})
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.status)
+
+	resp = server.do(http.MethodGet, "/v1/unknown", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.status)
+}
+
+func TestApp_RateLimit(t *testing.T) {
+	policies, err := ratelimit.ParsePolicies(config.DefaultRateLimits)
+	require.NoError(t, err)
+	policies["POST /v1/teams/:teamID/join"] = ratelimit.Policy{Burst: 2, Period: time.Minute}
+	policies["GET /v1/search"] = ratelimit.Policy{Burst: 1, Period: time.Minute}
+	server := newTestServer(t, Config{RateLimits: policies})
+	leader := server.member("leader")
+	member := server.member("member")
+	other := server.member("other")
+	teamID := server.createTeam(leader, "gophers", 1)
+	join := fmt.Sprintf("/v1/teams/%d/join", teamID)
+
+	resp := server.do(http.MethodPost, join, member, map[string]any{"role": "BACKEND"})
+	require.Equal(t, http.StatusOK, resp.status, string(resp.body))
+	assert.Equal(t, "2", resp.header.Get("RateLimit-Limit"))
+	assert.Equal(t, "1", resp.header.Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", resp.header.Get("RateLimit-Reset"))
+	assert.Equal(t, "2;w=60", resp.header.Get("RateLimit-Policy"))
+
+	// 失敗したリクエストもトークンを使う
+	resp = server.do(http.MethodPost, join, member, map[string]any{"role": "BACKEND"})
+	assert.Equal(t, http.StatusConflict, resp.status)
+	resp = server.do(http.MethodPost, join, member, map[string]any{"role": "BACKEND"})
+	require.Equal(t, http.StatusTooManyRequests, resp.status)
+	assert.Equal(t, "0", resp.header.Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", resp.header.Get("Retry-After"))
+
+	// メンバーごとに制限する
+	resp = server.do(http.MethodPost, join, other, map[string]any{"role": "BACKEND"})
+	assert.Equal(t, http.StatusConflict, resp.status)
+
+	// 未認証のリクエストは IP アドレスごとに制限する
+	resp = server.do(http.MethodGet, "/v1/search?q=go", "", nil)
+	assert.Equal(t, http.StatusOK, resp.status)
+	resp = server.do(http.MethodGet, "/v1/search?q=go", "", nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.status)
+
+	// 制限していないルートにはヘッダーを付けない
+	resp = server.do(http.MethodGet, "/v1/roles", "", nil)
+	assert.Equal(t, http.StatusOK, resp.status)
+	assert.Empty(t, resp.header.Get("RateLimit-Limit"))
+
+	resp = server.do(http.MethodGet, "/metrics", "", nil)
+	assert.Contains(t, string(resp.body), `http_rate_limited_requests_total{route="POST /v1/teams/:teamID/join"}`)
+
+	err = checkRateLimitRoutes(server.app.Engine, map[string]ratelimit.Policy{"POST /v1/team/:teamID/join": {Burst: 1, Period: time.Minute}})
+	assert.ErrorContains(t, err, "unknown route")
+}
+
+func TestApp_RateLimitTrustedProxies(t *testing.T) {
+	policies := map[string]ratelimit.Policy{"GET /v1/search": {Burst: 1, Period: time.Minute}}
+	search := func(engine http.Handler, forwardedFor string) int {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/search?q=go", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		engine.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	// 既定では X-Forwarded-For を書き換えても接続元のアドレスで制限する
+	server := newTestServer(t, Config{RateLimits: policies})
+	assert.Equal(t, http.StatusOK, search(server.app.Engine, "203.0.113.1"))
+	assert.Equal(t, http.StatusTooManyRequests, search(server.app.Engine, "203.0.113.2"))
+
+	// 信用するプロキシから来た場合はヘッダーのアドレスで区別する
+	server = newTestServer(t, Config{RateLimits: policies, TrustedProxies: []string{"192.0.2.0/24"}})
+	assert.Equal(t, http.StatusOK, search(server.app.Engine, "203.0.113.1"))
+	assert.Equal(t, http.StatusOK, search(server.app.Engine, "203.0.113.2"))
+	assert.Equal(t, http.StatusTooManyRequests, search(server.app.Engine, "203.0.113.1"))
+
+	_, err := New(Config{TrustedProxies: []string{"proxy"}}, server.client)
+	assert.ErrorContains(t, err, "invalid trusted proxies")
+}
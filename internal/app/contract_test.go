@@ -0,0 +1,164 @@
+package app
+
+import (
+	"backend_golang/api"
+	"backend_golang/internal/openapi"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContract_RoutesMatchSpec は登録した全てのルートが仕様書にあり、仕様書の全ての操作にルートがあることを確かめる
+func TestContract_RoutesMatchSpec(t *testing.T) {
+	server := newTestServer(t, Config{})
+	spec, err := openapi.Load(api.Specs)
+	require.NoError(t, err)
+
+	var routes []string
+	for _, route := range server.app.Engine.Routes() {
+		routes = append(routes, route.Method+" "+route.Path)
+	}
+	var operations []string
+	for key := range spec.Operations {
+		operations = append(operations, key)
+	}
+	sort.Strings(routes)
+	sort.Strings(operations)
+	assert.Equal(t, operations, routes)
+}
+
+// TestContract_Authentication は仕様書で認証が必須の操作だけがトークンなしで 401 を返すことを確かめる
+// 401 が仕様書に書かれていない場合はレスポンスの検証で失敗する。/v1 の外は外部のサービスを呼ぶものがあるため認証が必須の操作のみ確かめる
+func TestContract_Authentication(t *testing.T) {
+	server := newTestServer(t, Config{})
+	spec, err := openapi.Load(api.Specs)
+	require.NoError(t, err)
+
+	for key, op := range spec.Operations {
+		if !op.RequiresAuth() && !strings.HasPrefix(op.Path, "/v1/") {
+			continue
+		}
+		t.Run(key, func(t *testing.T) {
+			resp := server.do(op.Method, examplePath(op.Path), "", nil)
+			if op.RequiresAuth() {
+				assert.Equal(t, http.StatusUnauthorized, resp.status)
+			} else {
+				assert.NotEqual(t, http.StatusUnauthorized, resp.status)
+			}
+		})
+	}
+}
+
+// examplePath はパスのパラメーターを適当な値で埋める
+func examplePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") {
+			segments[i] = "1"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// TestContract_RequestValidation は仕様書に違反するリクエストがハンドラーに届く前に 400 になることを確かめる
+func TestContract_RequestValidation(t *testing.T) {
+	server := newTestServer(t, Config{})
+	leader := server.member("leader")
+
+	resp := server.do(http.MethodPost, "/v1/teams", leader, map[string]any{"teamName": "", "positions": []any{}})
+	require.Equal(t, http.StatusBadRequest, resp.status)
+	var body struct {
+		Errors []openapi.FieldError `json:"errors"`
+	}
+	resp.decode(t, &body)
+	assert.NotEmpty(t, body.Errors)
+
+	resp = server.do(http.MethodGet, "/v1/teams/abc", "", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.status)
+
+	resp = server.do(http.MethodGet, "/v1/announcements", "", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.status)
+
+	resp = server.do(http.MethodGet, "/v1/teams/999", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.status)
+}
+
+// TestContract_Docs は仕様書と API ドキュメントを配信していることを確かめる
+func TestContract_Docs(t *testing.T) {
+	server := newTestServer(t, Config{})
+
+	resp := server.do(http.MethodGet, "/openapi.json", "", nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	var doc struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	resp.decode(t, &doc)
+	assert.NotEmpty(t, doc.OpenAPI)
+	assert.Contains(t, doc.Paths, "/v1/teams/{teamID}")
+
+	resp = server.do(http.MethodGet, "/docs", "", nil)
+	require.Equal(t, http.StatusOK, resp.status)
+	assert.Contains(t, string(resp.body), "/openapi.json")
+	assert.Contains(t, string(resp.body), `id="mergeSkill"`)
+	assert.NotContains(t, string(resp.body), "<script")
+}
+
+// TestContract_Walkthrough は主な操作を順に呼び出し、レスポンスが仕様書に従っていることを確かめる
+func TestContract_Walkthrough(t *testing.T) {
+	server := newTestServer(t, Config{})
+	leader := server.member("leader")
+	member := server.member("member")
+	waiter := server.member("waiter")
+	teamID := server.createTeam(leader, "gophers", 1)
+	team := fmt.Sprintf("/v1/teams/%d", teamID)
+
+	steps := []struct {
+		method   string
+		path     string
+		memberID string
+		body     any
+		status   int
+	}{
+		{http.MethodGet, "/v1/me", leader, nil, http.StatusOK},
+		{http.MethodGet, "/v1/roles", "", nil, http.StatusOK},
+		{http.MethodGet, "/v1/skills?prefix=g&limit=5", "", nil, http.StatusOK},
+		{http.MethodGet, team, leader, nil, http.StatusOK},
+		{http.MethodPost, team + "/invitations", leader, map[string]any{"memberId": member, "role": "BACKEND"}, http.StatusCreated},
+		{http.MethodGet, team + "/invitations", leader, nil, http.StatusOK},
+		{http.MethodGet, "/v1/me/invitations", member, nil, http.StatusOK},
+		{http.MethodPost, team + "/events", leader, map[string]any{
+			"title":    "キックオフ",
+			"startsAt": "2030-01-01T10:00:00Z",
+			"endsAt":   "2030-01-01T11:00:00Z",
+		}, http.StatusCreated},
+		{http.MethodGet, team + "/events", leader, nil, http.StatusOK},
+		{http.MethodGet, "/v1/me/events", leader, nil, http.StatusOK},
+		{http.MethodGet, "/v1/me/calendar", leader, nil, http.StatusOK},
+		{http.MethodPost, team + "/threads", leader, map[string]any{"title": "はじめに", "body": "よろしくお願いします"}, http.StatusCreated},
+		{http.MethodGet, team + "/threads", leader, nil, http.StatusOK},
+		{http.MethodPost, team + "/webhooks", leader, map[string]any{"url": "https://example.com/hooks"}, http.StatusCreated},
+		{http.MethodGet, team + "/webhooks", leader, nil, http.StatusOK},
+		{http.MethodPost, "/v1/announcements", leader, map[string]any{"teamID": teamID, "title": "募集", "content": "Go エンジニア募集"}, http.StatusCreated},
+		{http.MethodGet, "/v1/announcements?page=1&size=10", "", nil, http.StatusOK},
+		{http.MethodPost, team + "/join", member, map[string]any{"role": "BACKEND"}, http.StatusOK},
+		{http.MethodPost, team + "/waitlist", waiter, nil, http.StatusCreated},
+		{http.MethodGet, "/v1/me/waitlist", waiter, nil, http.StatusOK},
+		{http.MethodGet, "/v1/notifications", leader, nil, http.StatusOK},
+		{http.MethodPost, "/v1/notifications/read", leader, map[string]any{}, http.StatusOK},
+		{http.MethodGet, "/v1/me/notification-preferences", leader, nil, http.StatusOK},
+		{http.MethodPut, "/v1/me/notification-preferences", leader, map[string]any{"locale": "en", "email": map[string]any{}}, http.StatusOK},
+		{http.MethodGet, "/v1/search?q=go", "", nil, http.StatusOK},
+		{http.MethodPost, team + "/close", leader, nil, http.StatusOK},
+		{http.MethodPost, team + "/reopen", leader, nil, http.StatusOK},
+	}
+	for _, step := range steps {
+		resp := server.do(step.method, step.path, step.memberID, step.body)
+		require.Equal(t, step.status, resp.status, "%s %s: %s", step.method, step.path, resp.body)
+	}
+}
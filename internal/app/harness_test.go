@@ -0,0 +1,172 @@
+package app
+
+import (
+	config "backend_golang/configs"
+	"backend_golang/ent"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+var testDBSeq atomic.Int64
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// testServer は SQLite のメモリ上のデータベースで動かすアプリケーション
+type testServer struct {
+	t      *testing.T
+	url    string
+	client *ent.Client
+	app    *App
+}
+
+func newTestServer(t *testing.T, cfg Config) *testServer {
+	t.Helper()
+	// 共有キャッシュではロックを待たずにエラーになるため、memdb VFS でロックを待つメモリ上のデータベースを使う
+	// _txlock=immediate でトランザクションは1つずつ実行される。読んでから書くまでの競合は repository のテストで確かめる
+	dsn := fmt.Sprintf("file:/app_test_%d?vfs=memdb&_fk=1&_busy_timeout=5000&_txlock=immediate", testDBSeq.Add(1))
+	client, err := ent.Open(dialect.SQLite, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	require.NoError(t, client.Schema.Create(context.Background()))
+
+	// 全てのテストでレスポンスが仕様書に従っているかを確かめる
+	if cfg.OnResponseError == nil {
+		cfg.OnResponseError = func(c *gin.Context, err error) {
+			t.Errorf("response does not match the OpenAPI spec: %v", err)
+		}
+	}
+	application, err := New(cfg, client)
+	require.NoError(t, err)
+	server := httptest.NewServer(application.Engine)
+	t.Cleanup(server.Close)
+	return &testServer{t: t, url: server.URL, client: client, app: application}
+}
+
+// member はサインアップ済みのメンバーを作る
+func (s *testServer) member(id string) string {
+	s.t.Helper()
+	s.client.Member.Create().
+		SetMemberID(id).
+		SetEmail(id + "@example.com").
+		SetPicture("").
+		SetNickname(id).
+		SetBio("").
+		SetPreferredRole("BACKEND").
+		SaveX(context.Background())
+	return id
+}
+
+// transientMember は Google でログインしただけでサインアップしていないメンバーを作る
+func (s *testServer) transientMember(id string) string {
+	s.t.Helper()
+	s.client.TransientMember.Create().
+		SetTransientMemberID(id).
+		SetEmail(id + "@example.com").
+		SetPicture("").
+		SetNickname(id).
+		SaveX(context.Background())
+	return id
+}
+
+// dispatch は outbox に溜まったイベントを購読者に配信する
+func (s *testServer) dispatch() {
+	s.t.Helper()
+	require.NoError(s.t, s.app.Relay.Dispatch(context.Background()))
+}
+
+type testResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (r testResponse) decode(t *testing.T, v any) {
+	t.Helper()
+	require.NoError(t, json.Unmarshal(r.body, v), string(r.body))
+}
+
+// do は memberID のメンバーとしてリクエストを送る。memberID が空の場合は認証しない
+func (s *testServer) do(method string, path string, memberID string, body any) testResponse {
+	s.t.Helper()
+	resp, err := s.send(method, path, memberID, body)
+	require.NoError(s.t, err)
+	return resp
+}
+
+// send は do と同じリクエストを送る。テスト用のゴルーチン以外からも呼べるようにエラーを返す
+func (s *testServer) send(method string, path string, memberID string, body any) (testResponse, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return testResponse{}, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequest(method, s.url+path, reader)
+	if err != nil {
+		return testResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if memberID != "" {
+		token, err := accessToken(memberID)
+		if err != nil {
+			return testResponse{}, err
+		}
+		req.AddCookie(&http.Cookie{Name: "access_token", Value: token})
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return testResponse{}, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return testResponse{}, err
+	}
+	return testResponse{status: resp.StatusCode, header: resp.Header, body: respBody}, nil
+}
+
+// accessToken はログイン時に発行するものと同じ形式のアクセストークンを作る
+func accessToken(memberID string) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": "team-recruitment",
+		"sub": memberID,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(config.JWTConfig.GetSecretKey())
+}
+
+// createTeam は leaderID のメンバーをリーダーとしてバックエンドの枠が vacancy 人のチームを作る
+func (s *testServer) createTeam(leaderID string, name string, vacancy int) int {
+	s.t.Helper()
+	resp := s.do(http.MethodPost, "/v1/teams", leaderID, map[string]any{
+		"teamName":    name,
+		"description": name + " のチーム",
+		"headcount":   vacancy + 1,
+		"vacancies":   []map[string]any{{"role": "BACKEND", "vacancy": vacancy}},
+		"skills":      []string{"Go"},
+	})
+	require.Equal(s.t, http.StatusCreated, resp.status, string(resp.body))
+	var created struct {
+		TeamID int `json:"teamID"`
+	}
+	resp.decode(s.t, &created)
+	return created.TeamID
+}
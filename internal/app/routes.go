@@ -0,0 +1,160 @@
+package app
+
+import (
+	"backend_golang/cmd/middleware"
+	"backend_golang/internal/controller"
+	"backend_golang/internal/metrics"
+	"backend_golang/internal/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+type controllers struct {
+	health              controller.HealthController
+	auth                controller.AuthController
+	notification        controller.NotificationController
+	team                controller.TeamController
+	waitlist            controller.WaitlistController
+	invitation          controller.InvitationController
+	event               controller.EventController
+	calendar            controller.CalendarController
+	thread              controller.ThreadController
+	webhook             controller.WebhookController
+	role                controller.RoleController
+	skill               controller.SkillController
+	announcement        controller.AnnouncementController
+	announcementComment controller.AnnouncementCommentController
+	search              controller.SearchController
+}
+
+func registerRoutes(engine *gin.Engine, spec *openapi.Spec, c controllers) {
+	// Health
+	engine.GET("/healthz", c.health.Healthz)
+	engine.GET("/readyz", c.health.Readyz)
+	engine.GET("/metrics", gin.WrapH(metrics.Default.Handler()))
+
+	// Docs
+	engine.GET("/openapi.json", gin.WrapH(spec.Handler()))
+	engine.GET("/docs", gin.WrapH(spec.DocsHandler("/openapi.json")))
+
+	// Google の OAuth のリダイレクト先は登録済みのため /v1 の外に置く
+	engine.GET("/login/oauth2/code/google", c.auth.GoogleCallback)
+
+	v1 := engine.Group("/v1")
+	registerAuthRoutes(v1, c.auth)
+	registerNotificationRoutes(v1, c.notification)
+	registerTeamRoutes(v1, c.team)
+	registerWaitlistRoutes(v1, c.waitlist)
+	registerInvitationRoutes(v1, c.invitation)
+	registerEventRoutes(v1, c.event)
+	registerCalendarRoutes(v1, c.calendar)
+	registerThreadRoutes(v1, c.thread)
+	registerWebhookRoutes(v1, c.webhook)
+	registerSkillRoutes(v1, c.role, c.skill)
+	registerAnnouncementRoutes(v1, c.announcement, c.announcementComment)
+	registerSearchRoutes(v1, c.search)
+}
+
+func registerAuthRoutes(v1 *gin.RouterGroup, auth controller.AuthController) {
+	v1.GET("/auth/login", auth.Login)
+	v1.GET("/auth/logout", middleware.Authentication(), auth.Logout)
+	v1.POST("/auth/signup", middleware.Authentication(), auth.Signup)
+	v1.GET("/me", middleware.Authentication(), auth.GetMember)
+	v1.PUT("/me/roles", middleware.Authentication(), auth.UpdateRoles)
+}
+
+func registerNotificationRoutes(v1 *gin.RouterGroup, notification controller.NotificationController) {
+	v1.GET("/notifications", middleware.Authentication(), notification.GetNotifications)
+	v1.POST("/notifications/read", middleware.Authentication(), notification.MarkRead)
+	v1.GET("/notifications/stream", middleware.Authentication(), notification.Stream)
+	v1.GET("/me/notification-preferences", middleware.Authentication(), notification.GetPreferences)
+	v1.PUT("/me/notification-preferences", middleware.Authentication(), notification.UpdatePreferences)
+}
+
+func registerTeamRoutes(v1 *gin.RouterGroup, team controller.TeamController) {
+	v1.POST("/teams", middleware.Authentication(), team.MakeTeam)
+	v1.DELETE("/teams/:teamID", middleware.Authentication(), team.DeleteTeam)
+	v1.GET("/teams/:teamID", middleware.OptionalAuthentication(), team.GetTeam)
+	v1.POST("/teams/:teamID/join", middleware.Authentication(), team.JoinTeam)
+	v1.POST("/teams/:teamID/leave", middleware.Authentication(), team.LeaveTeam)
+	v1.PUT("/teams/:teamID/positions", middleware.Authentication(), team.UpdatePositions)
+	v1.POST("/teams/:teamID/close", middleware.Authentication(), team.CloseRecruitment)
+	v1.POST("/teams/:teamID/reopen", middleware.Authentication(), team.ReopenRecruitment)
+	v1.POST("/teams/:teamID/archive", middleware.Authentication(), team.ArchiveTeam)
+}
+
+func registerWaitlistRoutes(v1 *gin.RouterGroup, waitlist controller.WaitlistController) {
+	v1.POST("/teams/:teamID/waitlist", middleware.Authentication(), waitlist.JoinWaitlist)
+	v1.GET("/me/waitlist", middleware.Authentication(), waitlist.GetWaitlist)
+	v1.POST("/me/waitlist/:entryID/accept", middleware.Authentication(), waitlist.AcceptOffer)
+	v1.DELETE("/me/waitlist/:entryID", middleware.Authentication(), waitlist.CancelWaitlist)
+}
+
+func registerInvitationRoutes(v1 *gin.RouterGroup, invitation controller.InvitationController) {
+	v1.POST("/teams/:teamID/invitations", middleware.Authentication(), invitation.CreateInvitation)
+	v1.GET("/teams/:teamID/invitations", middleware.Authentication(), invitation.GetTeamInvitations)
+	v1.DELETE("/teams/:teamID/invitations/:invitationID", middleware.Authentication(), invitation.RevokeInvitation)
+	v1.GET("/me/invitations", middleware.Authentication(), invitation.GetMyInvitations)
+	v1.POST("/me/invitations/:invitationID/accept", middleware.Authentication(), invitation.AcceptInvitation)
+	v1.POST("/me/invitations/:invitationID/decline", middleware.Authentication(), invitation.DeclineInvitation)
+	v1.POST("/invitations/:token/redeem", middleware.Authentication(), invitation.RedeemInvitation)
+}
+
+func registerEventRoutes(v1 *gin.RouterGroup, event controller.EventController) {
+	v1.POST("/teams/:teamID/events", middleware.Authentication(), event.CreateEvent)
+	v1.GET("/teams/:teamID/events", middleware.Authentication(), event.GetTeamEvents)
+	v1.GET("/events/:eventID", middleware.Authentication(), event.GetEvent)
+	v1.PUT("/events/:eventID", middleware.Authentication(), event.UpdateEvent)
+	v1.DELETE("/events/:eventID", middleware.Authentication(), event.DeleteEvent)
+	v1.PUT("/events/:eventID/rsvp", middleware.Authentication(), event.RSVP)
+	v1.PUT("/events/:eventID/occurrences/:occurrence", middleware.Authentication(), event.UpdateOccurrence)
+	v1.DELETE("/events/:eventID/occurrences/:occurrence", middleware.Authentication(), event.CancelOccurrence)
+	v1.GET("/me/events", middleware.Authentication(), event.GetMyEvents)
+}
+
+func registerCalendarRoutes(v1 *gin.RouterGroup, calendar controller.CalendarController) {
+	v1.GET("/me/calendar", middleware.Authentication(), calendar.GetFeeds)
+	v1.POST("/me/calendar/rotate", middleware.Authentication(), calendar.RotateToken)
+	// カレンダーアプリは Cookie を送れないため、フィードはトークンで認証する
+	v1.GET("/me/calendar.ics", calendar.GetMemberFeed)
+	v1.GET("/teams/:teamID/calendar.ics", calendar.GetTeamFeed)
+}
+
+func registerThreadRoutes(v1 *gin.RouterGroup, thread controller.ThreadController) {
+	v1.POST("/teams/:teamID/threads", middleware.Authentication(), thread.CreateThread)
+	v1.GET("/teams/:teamID/threads", middleware.Authentication(), thread.GetThreads)
+	v1.GET("/threads/:threadID", middleware.Authentication(), thread.GetThread)
+	v1.PUT("/threads/:threadID", middleware.Authentication(), thread.UpdateThread)
+	v1.DELETE("/threads/:threadID", middleware.Authentication(), thread.DeleteThread)
+	v1.POST("/threads/:threadID/comments", middleware.Authentication(), thread.CreateComment)
+	v1.GET("/threads/:threadID/comments", middleware.Authentication(), thread.GetComments)
+	v1.PUT("/threads/:threadID/comments/:commentID", middleware.Authentication(), thread.UpdateComment)
+	v1.DELETE("/threads/:threadID/comments/:commentID", middleware.Authentication(), thread.DeleteComment)
+}
+
+func registerWebhookRoutes(v1 *gin.RouterGroup, webhook controller.WebhookController) {
+	v1.POST("/teams/:teamID/webhooks", middleware.Authentication(), webhook.CreateWebhook)
+	v1.GET("/teams/:teamID/webhooks", middleware.Authentication(), webhook.GetWebhooks)
+	v1.PUT("/teams/:teamID/webhooks/:webhookID", middleware.Authentication(), webhook.UpdateWebhook)
+	v1.DELETE("/teams/:teamID/webhooks/:webhookID", middleware.Authentication(), webhook.DeleteWebhook)
+	v1.GET("/teams/:teamID/webhooks/:webhookID/deliveries", middleware.Authentication(), webhook.GetDeliveries)
+}
+
+func registerSkillRoutes(v1 *gin.RouterGroup, role controller.RoleController, skill controller.SkillController) {
+	v1.GET("/roles", role.GetRoles)
+	v1.GET("/skills", skill.GetSkills)
+	v1.POST("/skills/:skillID/merge", middleware.Authentication(), middleware.RequireAdmin(), skill.MergeSkill)
+}
+
+func registerAnnouncementRoutes(v1 *gin.RouterGroup, announcement controller.AnnouncementController, comment controller.AnnouncementCommentController) {
+	v1.POST("/announcements", middleware.Authentication(), announcement.Announce)
+	v1.GET("/announcements/:announcementID", middleware.OptionalAuthentication(), announcement.GetAnnouncement)
+	v1.GET("/announcements", announcement.GetAnnouncements)
+	v1.POST("/announcements/:announcementID/comments", middleware.Authentication(), comment.PostComment)
+	v1.GET("/announcements/:announcementID/comments", comment.GetComments)
+	v1.DELETE("/announcements/:announcementID/comments/:commentID", middleware.Authentication(), comment.DeleteComment)
+}
+
+func registerSearchRoutes(v1 *gin.RouterGroup, search controller.SearchController) {
+	v1.GET("/search", search.Search)
+}
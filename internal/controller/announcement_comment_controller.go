@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"backend_golang/internal/controller/request"
+	"backend_golang/internal/models"
+	"backend_golang/internal/service"
+	smodels "backend_golang/internal/service/models"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AnnouncementCommentController interface {
+	PostComment(c *gin.Context)
+	GetComments(c *gin.Context)
+	DeleteComment(c *gin.Context)
+}
+
+type announcementCommentController struct {
+	commentService service.AnnouncementCommentService
+}
+
+func NewAnnouncementCommentController(commentService service.AnnouncementCommentService) AnnouncementCommentController {
+	return &announcementCommentController{commentService: commentService}
+}
+
+func (a *announcementCommentController) PostComment(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	announcementID, err := strconv.Atoi(c.Param("announcementID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &request.AnnouncementCommentRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	comment, err := a.commentService.PostComment(c, announcementID, userID.(string), smodels.AnnouncementCommentInput{
+		Body:     req.Body,
+		ParentID: req.ParentID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+func (a *announcementCommentController) GetComments(c *gin.Context) {
+	announcementID, err := strconv.Atoi(c.Param("announcementID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, size, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comments, err := a.commentService.GetComments(c, announcementID, page, size)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+func (a *announcementCommentController) DeleteComment(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	announcementID, err := strconv.Atoi(c.Param("announcementID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	commentID, err := strconv.Atoi(c.Param("commentID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := a.commentService.DeleteComment(c, announcementID, commentID, userID.(string)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
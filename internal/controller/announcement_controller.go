@@ -5,7 +5,6 @@ import (
 	"backend_golang/internal/models"
 	"backend_golang/internal/service"
 	servicemodels "backend_golang/internal/service/models"
-	"log"
 	"net/http"
 	"strconv"
 	"strings"
@@ -74,9 +73,9 @@ func (a *announcementController) GetAnnouncement(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	announcement, err := a.announcementService.GetAnnouncement(c, announcementID)
+	announcement, err := a.announcementService.GetAnnouncement(c, announcementID, c.GetString("userID"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, announcement)
@@ -84,14 +83,12 @@ func (a *announcementController) GetAnnouncement(c *gin.Context) {
 
 func (a *announcementController) GetAnnouncements(c *gin.Context) {
 	page, err := strconv.Atoi(c.Query("page"))
-	log.Println("page", c.Query("page"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
 	size, err := strconv.Atoi(c.Query("size"))
-	log.Println("size", c.Query("size"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -104,9 +101,16 @@ func (a *announcementController) GetAnnouncements(c *gin.Context) {
 	}
 
 	positionParams := c.Query("position")
-	var positions []string
+	var positions []models.Role
 	if positionParams != "" {
-		positions = strings.Split(positionParams, ",")
+		for _, position := range strings.Split(positionParams, ",") {
+			role := models.Role(position)
+			if !role.IsValid() {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "unknown position: " + position})
+				return
+			}
+			positions = append(positions, role)
+		}
 	}
 
 	keyword := c.Query("keyword")
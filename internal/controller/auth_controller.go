@@ -18,6 +18,7 @@ type AuthController interface {
 	GoogleCallback(c *gin.Context)
 	Signup(c *gin.Context)
 	GetMember(c *gin.Context)
+	UpdateRoles(c *gin.Context)
 }
 
 type authController struct {
@@ -100,6 +101,7 @@ func (a *authController) Signup(c *gin.Context) {
 	signup := smodels.SignupMember{
 		Bio:           req.Bio,
 		PreferredRole: models.Role(req.PreferredRole),
+		Roles:         toRoles(req.Roles),
 	}
 	memberID, err := a.authService.Signup(c, userID, signup)
 	if err != nil {
@@ -125,3 +127,44 @@ func (a *authController) GetMember(c *gin.Context) {
 
 	c.JSON(http.StatusOK, member)
 }
+
+func (a *authController) UpdateRoles(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	req := &request.UpdateRolesRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	member, err := a.authService.UpdateRoles(c, userID, toRoles(req.Roles))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, member)
+}
+
+func toRoles(values []string) []models.Role {
+	roles := make([]models.Role, len(values))
+	for i, value := range values {
+		roles[i] = models.Role(value)
+	}
+	return roles
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"backend_golang/internal/ical"
+	"backend_golang/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CalendarController interface {
+	GetFeeds(c *gin.Context)
+	RotateToken(c *gin.Context)
+	GetMemberFeed(c *gin.Context)
+	GetTeamFeed(c *gin.Context)
+}
+
+type calendarController struct {
+	calendarService service.CalendarService
+}
+
+func NewCalendarController(calendarService service.CalendarService) CalendarController {
+	return &calendarController{calendarService: calendarService}
+}
+
+func (cc *calendarController) GetFeeds(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	feeds, err := cc.calendarService.GetFeeds(c, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feeds)
+}
+
+func (cc *calendarController) RotateToken(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	feeds, err := cc.calendarService.RotateToken(c, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feeds)
+}
+
+// GetMemberFeed はカレンダーアプリから Cookie なしで呼ばれるため、クエリのトークンで認証する
+func (cc *calendarController) GetMemberFeed(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	cal, err := cc.calendarService.GetMemberFeed(c, token)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	writeCalendar(c, cal)
+}
+
+func (cc *calendarController) GetTeamFeed(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cal, err := cc.calendarService.GetTeamFeed(c, teamID, token)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	writeCalendar(c, cal)
+}
+
+func writeCalendar(c *gin.Context, cal *ical.Calendar) {
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Status(http.StatusOK)
+	if err := cal.Encode(c.Writer); err != nil {
+		c.Error(err)
+	}
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/models"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondError はサービス層のエラーを対応する HTTP ステータスに変換して返す
+func respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, models.ErrInvalidMerge):
+		status = http.StatusBadRequest
+	case errors.Is(err, models.ErrInvalidCalendarToken):
+		status = http.StatusUnauthorized
+	case ent.IsNotFound(err),
+		errors.Is(err, models.ErrNoSuchOccurrence):
+		status = http.StatusNotFound
+	case errors.Is(err, models.ErrNotTeamLeader),
+		errors.Is(err, models.ErrNotTeamMember),
+		errors.Is(err, models.ErrNotAuthor):
+		status = http.StatusForbidden
+	case errors.Is(err, models.ErrAlreadyInTeam),
+		errors.Is(err, models.ErrNoVacancy),
+		errors.Is(err, models.ErrRecruitmentClosed),
+		errors.Is(err, models.ErrTeamArchived),
+		errors.Is(err, models.ErrLeaderCannotLeave),
+		errors.Is(err, models.ErrVacancyAvailable),
+		errors.Is(err, models.ErrAlreadyWaitlisted),
+		errors.Is(err, models.ErrNoOffer),
+		errors.Is(err, models.ErrInvitationInvalid),
+		errors.Is(err, models.ErrEventFull),
+		errors.Is(err, models.ErrEventEnded),
+		errors.Is(err, models.ErrEventCancelled),
+		errors.Is(err, models.ErrCommentDeleted):
+		status = http.StatusConflict
+	case errors.Is(err, models.ErrOfferExpired),
+		errors.Is(err, models.ErrInvitationExpired),
+		errors.Is(err, models.ErrInvitationUsedUp):
+		status = http.StatusGone
+	case errors.Is(err, models.ErrRoleNotDeclared),
+		errors.Is(err, models.ErrHeadcountExceeded),
+		errors.Is(err, models.ErrCapacityBelowGoing),
+		errors.Is(err, models.ErrNotRecurring),
+		errors.Is(err, models.ErrInvalidOccurrence),
+		errors.Is(err, models.ErrReplyTooDeep):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, models.ErrRateLimited):
+		status = http.StatusTooManyRequests
+	}
+	// 想定外のエラーはアクセスログに出力する
+	if status == http.StatusInternalServerError {
+		_ = c.Error(err)
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
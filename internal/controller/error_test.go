@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/models"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespondError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{err: models.ErrInvalidMerge, want: http.StatusBadRequest},
+		{err: &ent.NotFoundError{}, want: http.StatusNotFound},
+		{err: models.ErrNotTeamLeader, want: http.StatusForbidden},
+		{err: fmt.Errorf("joining: %w", models.ErrNoVacancy), want: http.StatusConflict},
+		{err: models.ErrInvitationExpired, want: http.StatusGone},
+		{err: models.ErrRoleNotDeclared, want: http.StatusUnprocessableEntity},
+		{err: models.ErrRateLimited, want: http.StatusTooManyRequests},
+		{err: errors.New("unexpected"), want: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			respondError(c, tt.err)
+			assert.Equal(t, tt.want, recorder.Code)
+			assert.JSONEq(t, fmt.Sprintf(`{"error":%q}`, tt.err.Error()), recorder.Body.String())
+		})
+	}
+}
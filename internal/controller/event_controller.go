@@ -0,0 +1,360 @@
+package controller
+
+import (
+	"backend_golang/internal/controller/request"
+	"backend_golang/internal/models"
+	"backend_golang/internal/service"
+	smodels "backend_golang/internal/service/models"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// defaultEventRange は期間を指定しない場合にイベント一覧で返す期間
+	defaultEventRange = 90 * 24 * time.Hour
+	maxEventRange     = 366 * 24 * time.Hour
+)
+
+type EventController interface {
+	CreateEvent(c *gin.Context)
+	GetTeamEvents(c *gin.Context)
+	GetEvent(c *gin.Context)
+	UpdateEvent(c *gin.Context)
+	DeleteEvent(c *gin.Context)
+	RSVP(c *gin.Context)
+	GetMyEvents(c *gin.Context)
+	UpdateOccurrence(c *gin.Context)
+	CancelOccurrence(c *gin.Context)
+}
+
+type eventController struct {
+	eventService service.EventService
+}
+
+func NewEventController(eventService service.EventService) EventController {
+	return &eventController{eventService: eventService}
+}
+
+func (e *eventController) CreateEvent(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input, ok := bindEventRequest(c)
+	if !ok {
+		return
+	}
+
+	event, err := e.eventService.Create(c, teamID, userID.(string), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, event)
+}
+
+func (e *eventController) GetTeamEvents(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, to, err := parseEventRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	events, err := e.eventService.GetTeamEvents(c, teamID, userID.(string), from, to)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+func (e *eventController) GetEvent(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	eventID, err := strconv.Atoi(c.Param("eventID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	event, err := e.eventService.GetEvent(c, eventID, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (e *eventController) UpdateEvent(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	eventID, err := strconv.Atoi(c.Param("eventID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input, ok := bindEventRequest(c)
+	if !ok {
+		return
+	}
+
+	event, err := e.eventService.Update(c, eventID, userID.(string), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (e *eventController) DeleteEvent(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	eventID, err := strconv.Atoi(c.Param("eventID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := e.eventService.Cancel(c, eventID, userID.(string)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (e *eventController) RSVP(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	eventID, err := strconv.Atoi(c.Param("eventID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &request.RSVPRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	event, err := e.eventService.RSVP(c, eventID, userID.(string), models.RSVPStatus(req.Status))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (e *eventController) GetMyEvents(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	events, err := e.eventService.GetUpcomingEvents(c, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+func (e *eventController) UpdateOccurrence(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	eventID, err := strconv.Atoi(c.Param("eventID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	original, err := time.Parse(time.RFC3339, c.Param("occurrence"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &request.OccurrenceRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	event, err := e.eventService.UpdateOccurrence(c, eventID, userID.(string), original, smodels.OccurrenceInput{
+		Title:    req.Title,
+		Agenda:   req.Agenda,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Location: req.Location,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (e *eventController) CancelOccurrence(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	eventID, err := strconv.Atoi(c.Param("eventID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	original, err := time.Parse(time.RFC3339, c.Param("occurrence"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := e.eventService.CancelOccurrence(c, eventID, userID.(string), original); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindEventRequest はイベントの作成・更新リクエストを検証する。失敗した場合はレスポンスを書き込んで false を返す
+func bindEventRequest(c *gin.Context) (smodels.EventInput, bool) {
+	req := &request.EventRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return smodels.EventInput{}, false
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return smodels.EventInput{}, false
+	}
+
+	return smodels.EventInput{
+		Title:      req.Title,
+		Agenda:     req.Agenda,
+		StartsAt:   req.StartsAt,
+		EndsAt:     req.EndsAt,
+		Location:   req.Location,
+		OnlineURL:  req.OnlineURL,
+		Capacity:   req.Capacity,
+		Recurrence: req.Recurrence,
+	}, true
+}
+
+// parseEventRange は RFC 3339 形式の from, to を解析する
+// from を省略した場合は現在時刻、to を省略した場合は from から defaultEventRange 後までとする
+func parseEventRange(fromValue string, toValue string) (time.Time, time.Time, error) {
+	from := time.Now()
+	if fromValue != "" {
+		parsed, err := time.Parse(time.RFC3339, fromValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = parsed
+	}
+
+	to := from.Add(defaultEventRange)
+	if toValue != "" {
+		parsed, err := time.Parse(time.RFC3339, toValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = parsed
+	}
+
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, errors.New("to must be after from")
+	}
+	if to.Sub(from) > maxEventRange {
+		return time.Time{}, time.Time{}, errors.New("range must not exceed 366 days")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"backend_golang/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthController interface {
+	Healthz(c *gin.Context)
+	Readyz(c *gin.Context)
+}
+
+type healthController struct {
+	healthService service.HealthService
+}
+
+func NewHealthController(healthService service.HealthService) HealthController {
+	return &healthController{healthService: healthService}
+}
+
+// Healthz はプロセスが応答できれば成功する
+func (h *healthController) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz はリクエストを受け付けられない場合に 503 を返す
+func (h *healthController) Readyz(c *gin.Context) {
+	readiness := h.healthService.Ready(c)
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, readiness)
+}
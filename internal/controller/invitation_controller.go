@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"backend_golang/internal/controller/request"
+	"backend_golang/internal/models"
+	"backend_golang/internal/service"
+	smodels "backend_golang/internal/service/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type InvitationController interface {
+	CreateInvitation(c *gin.Context)
+	GetTeamInvitations(c *gin.Context)
+	RevokeInvitation(c *gin.Context)
+	GetMyInvitations(c *gin.Context)
+	AcceptInvitation(c *gin.Context)
+	DeclineInvitation(c *gin.Context)
+	RedeemInvitation(c *gin.Context)
+}
+
+type invitationController struct {
+	invitationService service.InvitationService
+}
+
+func NewInvitationController(invitationService service.InvitationService) InvitationController {
+	return &invitationController{invitationService: invitationService}
+}
+
+func (i *invitationController) CreateInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &request.CreateInvitationRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	invitation, err := i.invitationService.Create(c, teamID, smodels.CreateInvitation{
+		MemberID:  userID.(string),
+		InviteeID: req.MemberID,
+		Role:      models.Role(req.Role),
+		ExpiresIn: time.Duration(req.ExpiresInHours) * time.Hour,
+		MaxUses:   req.MaxUses,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+func (i *invitationController) GetTeamInvitations(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invitations, err := i.invitationService.GetTeamInvitations(c, teamID, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+func (i *invitationController) RevokeInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	invitationID, err := strconv.Atoi(c.Param("invitationID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := i.invitationService.Revoke(c, teamID, invitationID, userID.(string)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (i *invitationController) GetMyInvitations(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	invitations, err := i.invitationService.GetMyInvitations(c, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+func (i *invitationController) AcceptInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	invitationID, err := strconv.Atoi(c.Param("invitationID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	teamID, err := i.invitationService.Accept(c, invitationID, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"teamID": teamID})
+}
+
+func (i *invitationController) DeclineInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	invitationID, err := strconv.Atoi(c.Param("invitationID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := i.invitationService.Decline(c, invitationID, userID.(string)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (i *invitationController) RedeemInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := i.invitationService.Redeem(c, c.Param("token"), userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"teamID": teamID})
+}
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"backend_golang/internal/controller/request"
+	"backend_golang/internal/models"
+	"backend_golang/internal/service"
+	smodels "backend_golang/internal/service/models"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// streamHeartbeat は接続を保つためにコメント行を送る間隔
+const streamHeartbeat = 30 * time.Second
+
+type NotificationController interface {
+	GetNotifications(c *gin.Context)
+	MarkRead(c *gin.Context)
+	Stream(c *gin.Context)
+	GetPreferences(c *gin.Context)
+	UpdatePreferences(c *gin.Context)
+}
+
+type notificationController struct {
+	notificationService service.NotificationService
+}
+
+func NewNotificationController(notificationService service.NotificationService) NotificationController {
+	return &notificationController{notificationService: notificationService}
+}
+
+func (n *notificationController) GetNotifications(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	unreadOnly := false
+	if value := c.Query("unread"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		unreadOnly = parsed
+	}
+
+	page, size, err := parsePagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	notifications, err := n.notificationService.GetNotifications(c, userID.(string), unreadOnly, page, size)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, notifications)
+}
+
+func (n *notificationController) MarkRead(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	req := &request.MarkNotificationsReadRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	unread, err := n.notificationService.MarkRead(c, userID.(string), req.IDs)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, unread)
+}
+
+// Stream は Server-Sent Events で新しい通知を送り続ける
+// 接続直後に未読数を unread イベントで送り、以降は通知ごとに notification イベントを送る
+func (n *notificationController) Stream(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// 接続中に届いた通知を取りこぼさないよう、未読数を数える前に購読する
+	notifications, unsubscribe := n.notificationService.Subscribe(userID.(string))
+	defer unsubscribe()
+
+	unread, err := n.notificationService.CountUnread(c, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 接続を保ち続けるので、サーバーの読み書きのタイムアウトを外す
+	controller := http.NewResponseController(c.Writer)
+	_ = controller.SetReadDeadline(time.Time{})
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	c.Header("Cache-Control", "no-cache")
+	// リバースプロキシにバッファリングさせない
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent("unread", unread)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case notification, ok := <-notifications:
+			if !ok {
+				return false
+			}
+			c.SSEvent("notification", notification)
+			return true
+		case <-heartbeat.C:
+			_, err := fmt.Fprint(w, ": ping\n\n")
+			return err == nil
+		}
+	})
+}
+
+func (n *notificationController) GetPreferences(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	preferences, err := n.notificationService.GetPreferences(c, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+func (n *notificationController) UpdatePreferences(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	req := &request.NotificationPreferencesRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	input := smodels.NotificationPreferencesInput{
+		Locale: models.Locale(req.Locale),
+		Email:  make(map[models.NotificationKind]bool, len(req.Email)),
+	}
+	for kind, wants := range req.Email {
+		input.Email[models.NotificationKind(kind)] = wants
+	}
+	preferences, err := n.notificationService.UpdatePreferences(c, userID.(string), input)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// parsePagination は page, size クエリを解析する。省略した場合は1ページ目を defaultPageSize 件とする
+func parsePagination(c *gin.Context) (int, int, error) {
+	page := 1
+	if value := c.Query("page"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, 0, err
+		}
+		page = parsed
+	}
+
+	size := defaultPageSize
+	if value := c.Query("size"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, 0, err
+		}
+		size = parsed
+	}
+
+	if page < 1 {
+		return 0, 0, errors.New("page must be at least 1")
+	}
+	if size < 1 || size > maxPageSize {
+		return 0, 0, errors.New("size must be between 1 and 100")
+	}
+	return page, size, nil
+}
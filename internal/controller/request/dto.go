@@ -2,7 +2,13 @@ package request
 
 import (
 	"backend_golang/internal/models"
+	"backend_golang/internal/recurrence"
+	"backend_golang/internal/webhook"
+	"net"
+	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -10,11 +16,15 @@ import (
 type MakeTeamRequest struct {
 	TeamName    string           `json:"teamName" validate:"required,min=1,notblank"`
 	Description string           `json:"description" validate:"required,min=1,notblank"`
-	Headcount   int8             `json:"headcount"`
-	Vacancies   []models.Vacancy `json:"vacancies" validate:"required,min=1,dive"`
+	Headcount   int8             `json:"headcount" validate:"required,min=1,max=100"`
+	Vacancies   []models.Vacancy `json:"vacancies" validate:"required,min=1,unique=Role,dive"`
 	Skills      []string         `json:"skills" validate:"required,min=1,dive,notblank"`
 }
 
+type UpdatePositionsRequest struct {
+	Vacancies []models.Vacancy `json:"vacancies" validate:"required,unique=Role,dive"`
+}
+
 type PostAnnouncement struct {
 	TeamID  int    `json:"teamID" validate:"required,min=1,notblank"`
 	Title   string `json:"title" validate:"required,min=1,notblank"`
@@ -22,8 +32,17 @@ type PostAnnouncement struct {
 }
 
 type SignUpRequest struct {
-	Bio           string `json:"bio" validate:"required,min=1,notblank"`
-	PreferredRole string `json:"preferredRole" validate:"required,min=1,notblank"`
+	Bio           string   `json:"bio" validate:"required,min=1,notblank"`
+	PreferredRole string   `json:"preferredRole" validate:"required,role"`
+	Roles         []string `json:"roles" validate:"omitempty,unique,dive,role"`
+}
+
+type UpdateRolesRequest struct {
+	Roles []string `json:"roles" validate:"unique,dive,role"`
+}
+
+type JoinTeamRequest struct {
+	Role string `json:"role" validate:"omitempty,role"`
 }
 
 var validate *validator.Validate
@@ -31,6 +50,14 @@ var validate *validator.Validate
 func init() {
 	validate = validator.New()
 	validate.RegisterValidation("notblank", validateNotBlank)
+	validate.RegisterValidation("role", validateRole)
+	validate.RegisterValidation("rsvp", validateRSVP)
+	validate.RegisterValidation("rrule", validateRRule)
+	validate.RegisterValidation("locale", validateLocale)
+	validate.RegisterValidation("email_notification", validateEmailNotification)
+	validate.RegisterValidation("webhook_url", validateWebhookURL)
+	validate.RegisterValidation("webhook_format", validateWebhookFormat)
+	validate.RegisterValidation("webhook_event", validateWebhookEvent)
 }
 
 func validateNotBlank(fl validator.FieldLevel) bool {
@@ -38,10 +65,67 @@ func validateNotBlank(fl validator.FieldLevel) bool {
 	return strings.TrimSpace(value) != ""
 }
 
+// validateRole は models.Role に定義された役割かどうかを検証する
+func validateRole(fl validator.FieldLevel) bool {
+	return models.Role(fl.Field().String()).IsValid()
+}
+
+// validateRSVP は models.RSVPStatus に定義された出欠回答かどうかを検証する
+func validateRSVP(fl validator.FieldLevel) bool {
+	return models.RSVPStatus(fl.Field().String()).IsValid()
+}
+
+// validateRRule は対応している範囲の RRULE かどうかを検証する
+func validateRRule(fl validator.FieldLevel) bool {
+	_, err := recurrence.Parse(fl.Field().String())
+	return err == nil
+}
+
+// validateLocale は対応している言語かどうかを検証する
+func validateLocale(fl validator.FieldLevel) bool {
+	return models.Locale(fl.Field().String()).IsValid()
+}
+
+// validateEmailNotification はメールでも送る通知の種類かどうかを検証する
+func validateEmailNotification(fl validator.FieldLevel) bool {
+	return models.NotificationKind(fl.Field().String()).SendsEmail()
+}
+
+// validateWebhookURL はホストを含む http または https の URL かどうかを検証する
+// 内部のアドレスを直接指定した URL もここで拒否する。ホスト名は送信時に解決したアドレスで webhook.Client が確かめる
+func validateWebhookURL(fl validator.FieldLevel) bool {
+	u, err := url.Parse(fl.Field().String())
+	if err != nil {
+		return false
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return false
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		return webhook.IsPublicIP(ip)
+	}
+	return host != "localhost" && !strings.HasSuffix(host, ".localhost")
+}
+
+// validateWebhookFormat は models.WebhookFormat に定義された形式かどうかを検証する
+func validateWebhookFormat(fl validator.FieldLevel) bool {
+	return slices.Contains(models.WebhookFormats(), models.WebhookFormat(fl.Field().String()))
+}
+
+// validateWebhookEvent は Webhook で受け取れるイベントの種類かどうかを検証する
+func validateWebhookEvent(fl validator.FieldLevel) bool {
+	return models.DomainEventType(fl.Field().String()).IsWebhookEvent()
+}
+
 func (r *MakeTeamRequest) Validate() error {
 	return validate.Struct(r)
 }
 
+func (r *UpdatePositionsRequest) Validate() error {
+	return validate.Struct(r)
+}
+
 func (r *PostAnnouncement) Validate() error {
 	return validate.Struct(r)
 }
@@ -49,3 +133,129 @@ func (r *PostAnnouncement) Validate() error {
 func (r *SignUpRequest) Validate() error {
 	return validate.Struct(r)
 }
+
+func (r *UpdateRolesRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+func (r *JoinTeamRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type MergeSkillRequest struct {
+	Into int `json:"into" validate:"required,min=1"`
+}
+
+func (r *MergeSkillRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type CreateInvitationRequest struct {
+	// MemberID を指定した場合はメンバーへの直接招待、省略した場合は招待リンクを作成する
+	MemberID       string `json:"memberId" validate:"omitempty,notblank"`
+	Role           string `json:"role" validate:"required,role"`
+	ExpiresInHours int    `json:"expiresInHours" validate:"omitempty,min=1,max=720"`
+	MaxUses        int    `json:"maxUses" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *CreateInvitationRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type EventRequest struct {
+	Title     string    `json:"title" validate:"required,notblank,max=200"`
+	Agenda    string    `json:"agenda"`
+	StartsAt  time.Time `json:"startsAt" validate:"required"`
+	EndsAt    time.Time `json:"endsAt" validate:"required,gtfield=StartsAt"`
+	Location  string    `json:"location" validate:"max=200"`
+	OnlineURL string    `json:"onlineUrl" validate:"omitempty,url"`
+	Capacity  int       `json:"capacity" validate:"min=0,max=1000"`
+	// Recurrence は RRULE（例: FREQ=WEEKLY;INTERVAL=2;COUNT=10）。省略した場合は繰り返さない
+	Recurrence string `json:"recurrence" validate:"omitempty,rrule"`
+}
+
+func (r *EventRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+// OccurrenceRequest は繰り返しイベントの1回分の変更。省略した項目は繰り返しイベントの値を使う
+type OccurrenceRequest struct {
+	Title    *string    `json:"title" validate:"omitempty,notblank,max=200"`
+	Agenda   *string    `json:"agenda"`
+	StartsAt *time.Time `json:"startsAt"`
+	EndsAt   *time.Time `json:"endsAt"`
+	Location *string    `json:"location" validate:"omitempty,max=200"`
+}
+
+func (r *OccurrenceRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type RSVPRequest struct {
+	Status string `json:"status" validate:"required,rsvp"`
+}
+
+func (r *RSVPRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type ThreadRequest struct {
+	Title string `json:"title" validate:"required,notblank,max=200"`
+	// Body は Markdown。@nickname でチームのメンバーをメンションできる
+	Body string `json:"body" validate:"required,notblank,max=20000"`
+}
+
+func (r *ThreadRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type CommentRequest struct {
+	Body string `json:"body" validate:"required,notblank,max=20000"`
+}
+
+func (r *CommentRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type AnnouncementCommentRequest struct {
+	Body string `json:"body" validate:"required,notblank,max=5000"`
+	// ParentID を指定すると質問への返信になる
+	ParentID *int `json:"parentId" validate:"omitempty,min=1"`
+}
+
+func (r *AnnouncementCommentRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type MarkNotificationsReadRequest struct {
+	// IDs を省略した場合は全ての通知を既読にする
+	IDs []int `json:"ids" validate:"omitempty,max=100,dive,min=1"`
+}
+
+func (r *MarkNotificationsReadRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type NotificationPreferencesRequest struct {
+	Locale string `json:"locale" validate:"required,locale"`
+	// Email は通知の種類ごとにメールを受け取るかどうか。含まれない種類は変更しない
+	Email map[string]bool `json:"email" validate:"omitempty,dive,keys,email_notification,endkeys"`
+}
+
+func (r *NotificationPreferencesRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+type WebhookRequest struct {
+	URL string `json:"url" validate:"required,max=2000,webhook_url"`
+	// Format を省略した場合は GENERIC
+	Format string `json:"format" validate:"omitempty,webhook_format"`
+	// Events を省略した場合は Webhook で受け取れる全ての種類を送る
+	Events []string `json:"events" validate:"omitempty,unique,dive,webhook_event"`
+	// Active は変更時のみ使う。省略した場合は変更しない
+	Active *bool `json:"active"`
+}
+
+func (r *WebhookRequest) Validate() error {
+	return validate.Struct(r)
+}
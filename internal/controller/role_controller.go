@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"backend_golang/internal/models"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RoleController interface {
+	GetRoles(c *gin.Context)
+}
+
+type roleController struct{}
+
+func NewRoleController() RoleController {
+	return &roleController{}
+}
+
+func (r *roleController) GetRoles(c *gin.Context) {
+	c.JSON(http.StatusOK, models.Roles())
+}
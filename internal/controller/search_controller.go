@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"backend_golang/internal/search"
+	"backend_golang/internal/service"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSearchSize = 20
+	maxSearchSize     = 100
+)
+
+type SearchController interface {
+	Search(c *gin.Context)
+}
+
+type searchController struct {
+	searchService service.SearchService
+}
+
+func NewSearchController(searchService service.SearchService) SearchController {
+	return &searchController{
+		searchService: searchService,
+	}
+}
+
+func (s *searchController) Search(c *gin.Context) {
+	keyword := strings.TrimSpace(c.Query("q"))
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q is required"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+		return
+	}
+
+	size, err := strconv.Atoi(c.DefaultQuery("size", strconv.Itoa(defaultSearchSize)))
+	if err != nil || size < 1 || size > maxSearchSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "size must be between 1 and " + strconv.Itoa(maxSearchSize)})
+		return
+	}
+
+	var kinds []search.Kind
+	if typeParams := c.Query("type"); typeParams != "" {
+		for _, t := range strings.Split(typeParams, ",") {
+			kind := search.Kind(t)
+			if kind != search.KindAnnouncement && kind != search.KindTeam {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "unknown type: " + t})
+				return
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+
+	results, err := s.searchService.Search(c, keyword, kinds, page, size)
+	if err != nil {
+		if errors.Is(err, search.ErrEmptyQuery) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"backend_golang/internal/controller/request"
+	"backend_golang/internal/models"
+	"backend_golang/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 50
+)
+
+type SkillController interface {
+	GetSkills(c *gin.Context)
+	MergeSkill(c *gin.Context)
+}
+
+type skillController struct {
+	skillService service.SkillService
+}
+
+func NewSkillController(skillService service.SkillService) SkillController {
+	return &skillController{
+		skillService: skillService,
+	}
+}
+
+func (s *skillController) GetSkills(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultAutocompleteLimit)))
+	if err != nil || limit < 1 || limit > maxAutocompleteLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and " + strconv.Itoa(maxAutocompleteLimit)})
+		return
+	}
+
+	skills, err := s.skillService.Autocomplete(c, c.Query("prefix"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, skills)
+}
+
+func (s *skillController) MergeSkill(c *gin.Context) {
+	skillID, err := strconv.Atoi(c.Param("skillID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &request.MergeSkillRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	skill, err := s.skillService.Merge(c, skillID, req.Into)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, skill)
+}
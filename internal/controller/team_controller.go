@@ -5,6 +5,9 @@ import (
 	"backend_golang/internal/models"
 	"backend_golang/internal/service"
 	smodels "backend_golang/internal/service/models"
+	"context"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -17,6 +20,11 @@ type TeamController interface {
 	DeleteTeam(c *gin.Context)
 	GetTeam(c *gin.Context)
 	JoinTeam(c *gin.Context)
+	LeaveTeam(c *gin.Context)
+	UpdatePositions(c *gin.Context)
+	CloseRecruitment(c *gin.Context)
+	ReopenRecruitment(c *gin.Context)
+	ArchiveTeam(c *gin.Context)
 }
 
 type teamController struct {
@@ -61,7 +69,7 @@ func (t *teamController) MakeTeam(c *gin.Context) {
 			Skills:      req.Skills,
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -85,9 +93,9 @@ func (t *teamController) GetTeam(c *gin.Context) {
 		return
 	}
 
-	resp, err := t.teamService.GetTeam(c, teamID)
+	resp, err := t.teamService.GetTeam(c, teamID, c.GetString("userID"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -107,11 +115,134 @@ func (t *teamController) JoinTeam(c *gin.Context) {
 		return
 	}
 
-	err = t.teamService.JoinTeam(c, teamID, userID.(string))
+	// 本文は省略可能で、省略した場合は希望する役割で参加する
+	req := &request.JoinTeamRequest{}
+	if err := c.ShouldBindJSON(req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	err = t.teamService.JoinTeam(c, teamID, userID.(string), models.Role(req.Role))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Joined team successfully"})
 }
+
+func (t *teamController) LeaveTeam(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := t.teamService.LeaveTeam(c, teamID, userID.(string)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Left team successfully"})
+}
+
+func (t *teamController) UpdatePositions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &request.UpdatePositionsRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		validationErrors := make([]models.ValidationError, 0)
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, models.NewValidationError(err))
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"errors": validationErrors,
+		})
+		return
+	}
+
+	if err := t.teamService.UpdatePositions(c, teamID, userID.(string), req.Vacancies); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp, err := t.teamService.GetTeam(c, teamID, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (t *teamController) CloseRecruitment(c *gin.Context) {
+	t.changeStatus(c, t.teamService.CloseRecruitment)
+}
+
+func (t *teamController) ReopenRecruitment(c *gin.Context) {
+	t.changeStatus(c, t.teamService.ReopenRecruitment)
+}
+
+func (t *teamController) ArchiveTeam(c *gin.Context) {
+	t.changeStatus(c, t.teamService.Archive)
+}
+
+// changeStatus は募集状態を変更し、変更後のチームを返す
+func (t *teamController) changeStatus(c *gin.Context, change func(ctx context.Context, teamID int, userID string) error) {
+	userID, exists := c.Get("userID")
+	if !exists || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	teamID, err := strconv.Atoi(c.Param("teamID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := change(c, teamID, userID.(string)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp, err := t.teamService.GetTeam(c, teamID, userID.(string))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -10,3 +10,45 @@ type Announcement struct {
 	UpdatedAt time.Time
 	Team      *Team
 }
+
+// AnnouncementSummary は一覧表示用の軽量な射影
+type AnnouncementSummary struct {
+	ID        int
+	Title     string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Team      *TeamSummary
+}
+
+// AnnouncementComment は募集記事への公開のコメント。ParentID が nil のものが質問、それ以外は返信
+type AnnouncementComment struct {
+	ID             int
+	AnnouncementID int
+	ParentID       *int
+	Body           string
+	AuthorID       string
+	// AuthorNickname は投稿者のニックネーム。読み込み時のみ設定する
+	AuthorNickname string
+	// LeaderAnswer は投稿時にチームリーダーだったかどうか
+	LeaderAnswer bool
+	DeletedAt    *time.Time
+	CreatedAt    time.Time
+	// Replies は質問への返信。読み込み時のみ設定する
+	Replies []AnnouncementComment
+}
+
+// IsDeleted は削除済みかどうか
+func (c AnnouncementComment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// IsAnswered はチームリーダーが削除されていない返信をしているかどうか
+func (c AnnouncementComment) IsAnswered() bool {
+	for _, reply := range c.Replies {
+		if reply.LeaderAnswer && !reply.IsDeleted() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"time"
+)
+
+// DomainEvent は状態変化と同じトランザクションで outbox に記録するイベント
+// 購読者は同じイベントを2回以上受け取ることがあるため、ID を使って処理を冪等にする
+type DomainEvent struct {
+	ID          int
+	Type        models.DomainEventType
+	AggregateID int
+	TeamID      int
+	// MemberID はイベントのきっかけになった、または対象になったメンバーの ID
+	MemberID string
+	// Attempts はこれまでに配信に失敗した回数
+	Attempts   int
+	OccurredAt time.Time
+}
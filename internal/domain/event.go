@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"backend_golang/internal/recurrence"
+	"sort"
+	"time"
+)
+
+type Event struct {
+	ID        int
+	TeamID    int
+	TeamName  string
+	Title     string
+	Agenda    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Location  string
+	OnlineURL string
+	// Capacity は定員。0 の場合は定員なし
+	Capacity int
+	Going    int
+	// Recurrence は RRULE。空の場合は繰り返さない。StartsAt, EndsAt は初回の日時
+	Recurrence string
+	// RecurrenceEndsAt は最後の回の終了日時。期限のない繰り返しの場合は nil
+	RecurrenceEndsAt *time.Time
+	// Overrides は個別に変更・取り消しした回
+	Overrides []EventOverride
+	// OccurrenceStart は繰り返しを展開した回の元の開始日時。展開していない場合は nil
+	OccurrenceStart *time.Time
+	// Sequence は内容を変更・取り消しするたびに増える版数
+	Sequence int
+	// CancelledAt は取り消した日時。取り消していない場合は nil
+	CancelledAt *time.Time
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// MyRSVP は閲覧者の出欠回答。未回答の場合は空
+	MyRSVP models.RSVPStatus
+}
+
+// EventOverride は繰り返しイベントの1回分の変更。nil の項目は繰り返しイベントの値を使う
+type EventOverride struct {
+	OriginalStartsAt time.Time
+	Cancelled        bool
+	Title            *string
+	Agenda           *string
+	StartsAt         *time.Time
+	EndsAt           *time.Time
+	Location         *string
+}
+
+// IsRecurring は繰り返しイベントかどうか
+func (e Event) IsRecurring() bool {
+	return e.Recurrence != ""
+}
+
+// SeriesEnd は最後の回の終了日時を求める。期限のない繰り返しの場合は nil を返す
+func (e Event) SeriesEnd() (*time.Time, error) {
+	if !e.IsRecurring() {
+		end := e.EndsAt
+		return &end, nil
+	}
+	rule, err := recurrence.Parse(e.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	last, ok := rule.Last(e.StartsAt)
+	if !ok {
+		return nil, nil
+	}
+	end := last.Add(e.EndsAt.Sub(e.StartsAt))
+	return &end, nil
+}
+
+// HasEnded は now の時点でイベントが終了しているかどうか。繰り返しイベントは最後の回で判定する
+func (e Event) HasEnded(now time.Time) bool {
+	if e.IsRecurring() {
+		return e.RecurrenceEndsAt != nil && !now.Before(*e.RecurrenceEndsAt)
+	}
+	return !now.Before(e.EndsAt)
+}
+
+// IsCancelled は取り消されたイベントかどうか
+func (e Event) IsCancelled() bool {
+	return e.CancelledAt != nil
+}
+
+// IsFull は定員に達しているかどうか
+func (e Event) IsFull() bool {
+	return e.Capacity > 0 && e.Going >= e.Capacity
+}
+
+// Occurrence は original に始まる回を変更を反映して返す
+// original が繰り返しのいずれの回でもない場合は false を返す
+func (e Event) Occurrence(original time.Time) (Event, bool) {
+	if !e.IsRecurring() {
+		return Event{}, false
+	}
+	rule, err := recurrence.Parse(e.Recurrence)
+	if err != nil || !rule.Includes(e.StartsAt, original) {
+		return Event{}, false
+	}
+	return e.occurrence(original, e.findOverride(original)), true
+}
+
+// Occurrences は from から to までの期間に開催される回を開始日時順に返す
+// 繰り返さないイベントは期間に重なる場合にそのまま返す。取り消した回は含めない
+func (e Event) Occurrences(from time.Time, to time.Time) []Event {
+	if !e.IsRecurring() {
+		if e.StartsAt.Before(to) && e.EndsAt.After(from) {
+			return []Event{e}
+		}
+		return nil
+	}
+	rule, err := recurrence.Parse(e.Recurrence)
+	if err != nil {
+		return nil
+	}
+
+	// 変更で日時が動いた回も拾えるよう、元の開始日時が期間外の変更も候補に含める
+	originals := rule.Between(e.StartsAt, from.Add(-e.EndsAt.Sub(e.StartsAt)), to)
+	for _, o := range e.Overrides {
+		if o.StartsAt != nil && rule.Includes(e.StartsAt, o.OriginalStartsAt) {
+			originals = append(originals, o.OriginalStartsAt)
+		}
+	}
+
+	seen := make(map[int64]bool)
+	var result []Event
+	for _, original := range originals {
+		if seen[original.Unix()] {
+			continue
+		}
+		seen[original.Unix()] = true
+
+		override := e.findOverride(original)
+		if override != nil && override.Cancelled {
+			continue
+		}
+		occurrence := e.occurrence(original, override)
+		if occurrence.StartsAt.Before(to) && occurrence.EndsAt.After(from) {
+			result = append(result, occurrence)
+		}
+	}
+	SortEvents(result)
+	return result
+}
+
+func (e Event) findOverride(original time.Time) *EventOverride {
+	for i := range e.Overrides {
+		if e.Overrides[i].OriginalStartsAt.Equal(original) {
+			return &e.Overrides[i]
+		}
+	}
+	return nil
+}
+
+func (e Event) occurrence(original time.Time, override *EventOverride) Event {
+	result := e
+	result.Overrides = nil
+	result.OccurrenceStart = &original
+	result.StartsAt = original
+	result.EndsAt = original.Add(e.EndsAt.Sub(e.StartsAt))
+	if override == nil {
+		return result
+	}
+	if override.Title != nil {
+		result.Title = *override.Title
+	}
+	if override.Agenda != nil {
+		result.Agenda = *override.Agenda
+	}
+	if override.StartsAt != nil {
+		// 終了日時を変更しない場合は長さを保ったまま移動する
+		result.StartsAt = *override.StartsAt
+		result.EndsAt = override.StartsAt.Add(e.EndsAt.Sub(e.StartsAt))
+	}
+	if override.EndsAt != nil {
+		result.EndsAt = *override.EndsAt
+	}
+	if override.Location != nil {
+		result.Location = *override.Location
+	}
+	return result
+}
+
+// SortEvents はイベントを開始日時順に並べる
+func SortEvents(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].StartsAt.Before(events[j].StartsAt)
+	})
+}
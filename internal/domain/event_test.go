@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent_Occurrences(t *testing.T) {
+	start := time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	moved := start.Add(2*week + 24*time.Hour)
+	title := "特別回"
+
+	event := Event{
+		ID:         1,
+		Title:      "もくもく会",
+		StartsAt:   start,
+		EndsAt:     start.Add(2 * time.Hour),
+		Recurrence: "FREQ=WEEKLY;COUNT=5",
+		Overrides: []EventOverride{
+			{OriginalStartsAt: start.Add(week), Cancelled: true},
+			{OriginalStartsAt: start.Add(2 * week), Title: &title, StartsAt: &moved},
+		},
+	}
+
+	t.Run("expands occurrences in range", func(t *testing.T) {
+		got := event.Occurrences(start, start.Add(10*week))
+		require.Len(t, got, 4)
+
+		starts := make([]time.Time, len(got))
+		for i, occurrence := range got {
+			starts[i] = occurrence.StartsAt
+		}
+		// 2回目は取り消し、3回目は翌日に移動している
+		assert.Equal(t, []time.Time{start, moved, start.Add(3 * week), start.Add(4 * week)}, starts)
+
+		assert.Equal(t, "特別回", got[1].Title)
+		assert.Equal(t, start.Add(2*week), *got[1].OccurrenceStart)
+		// 終了日時を変更していない場合は長さを保ったまま移動する
+		assert.Equal(t, moved.Add(2*time.Hour), got[1].EndsAt)
+		assert.Equal(t, "もくもく会", got[2].Title)
+	})
+
+	t.Run("includes occurrences moved into range", func(t *testing.T) {
+		got := event.Occurrences(moved, moved.Add(time.Hour))
+		require.Len(t, got, 1)
+		assert.Equal(t, moved, got[0].StartsAt)
+	})
+
+	t.Run("excludes occurrences moved out of range", func(t *testing.T) {
+		got := event.Occurrences(start.Add(2*week), start.Add(2*week+3*time.Hour))
+		assert.Empty(t, got)
+	})
+
+	t.Run("single event", func(t *testing.T) {
+		single := Event{StartsAt: start, EndsAt: start.Add(time.Hour)}
+		assert.Len(t, single.Occurrences(start.Add(-time.Hour), start.Add(time.Minute)), 1)
+		assert.Empty(t, single.Occurrences(start.Add(time.Hour), start.Add(2*time.Hour)))
+	})
+}
+
+func TestEvent_Occurrence(t *testing.T) {
+	start := time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC)
+	event := Event{
+		StartsAt:   start,
+		EndsAt:     start.Add(2 * time.Hour),
+		Recurrence: "FREQ=WEEKLY;INTERVAL=2",
+	}
+
+	occurrence, ok := event.Occurrence(start.AddDate(0, 0, 14))
+	require.True(t, ok)
+	assert.Equal(t, start.AddDate(0, 0, 14).Add(2*time.Hour), occurrence.EndsAt)
+
+	_, ok = event.Occurrence(start.AddDate(0, 0, 7))
+	assert.False(t, ok)
+
+	_, ok = Event{StartsAt: start, EndsAt: start.Add(time.Hour)}.Occurrence(start)
+	assert.False(t, ok)
+}
+
+func TestEvent_SeriesEndAndHasEnded(t *testing.T) {
+	start := time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC)
+	event := Event{
+		StartsAt:   start,
+		EndsAt:     start.Add(2 * time.Hour),
+		Recurrence: "FREQ=MONTHLY;COUNT=3",
+	}
+
+	end, err := event.SeriesEnd()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC), *end)
+
+	event.RecurrenceEndsAt = end
+	assert.False(t, event.HasEnded(start.AddDate(0, 1, 0)))
+	assert.True(t, event.HasEnded(*end))
+
+	event.Recurrence = "FREQ=MONTHLY"
+	end, err = event.SeriesEnd()
+	require.NoError(t, err)
+	assert.Nil(t, end)
+	event.RecurrenceEndsAt = end
+	assert.False(t, event.HasEnded(start.AddDate(10, 0, 0)))
+}
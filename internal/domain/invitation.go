@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"time"
+)
+
+type Invitation struct {
+	ID       int
+	TeamID   int
+	TeamName string
+	Kind     models.InvitationKind
+	Role     models.Role
+	Status   models.InvitationStatus
+	// Token は招待リンクのトークン。直接招待では空
+	Token string
+	// MaxUses は招待リンクを使える回数。0 の場合は制限なし
+	MaxUses   int
+	Uses      int
+	ExpiresAt *time.Time
+	// InviteeID は直接招待されたメンバーのID
+	InviteeID string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// Usable は now の時点で招待を使ってチームに参加できるかどうかを確認する
+func (i Invitation) Usable(now time.Time) error {
+	if i.Status != models.InvitationActive {
+		return models.ErrInvitationInvalid
+	}
+	if i.ExpiresAt != nil && !now.Before(*i.ExpiresAt) {
+		return models.ErrInvitationExpired
+	}
+	if i.MaxUses > 0 && i.Uses >= i.MaxUses {
+		return models.ErrInvitationUsedUp
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitation_Usable(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		invitation Invitation
+		want       error
+	}{
+		{name: "active link", invitation: Invitation{Status: models.InvitationActive, MaxUses: 3, Uses: 2, ExpiresAt: &future}},
+		{name: "unlimited uses", invitation: Invitation{Status: models.InvitationActive, Uses: 100}},
+		{name: "revoked", invitation: Invitation{Status: models.InvitationRevoked}, want: models.ErrInvitationInvalid},
+		{name: "already accepted", invitation: Invitation{Status: models.InvitationAccepted}, want: models.ErrInvitationInvalid},
+		{name: "expired", invitation: Invitation{Status: models.InvitationActive, ExpiresAt: &past}, want: models.ErrInvitationExpired},
+		{name: "expires exactly now", invitation: Invitation{Status: models.InvitationActive, ExpiresAt: &now}, want: models.ErrInvitationExpired},
+		{name: "used up", invitation: Invitation{Status: models.InvitationActive, MaxUses: 3, Uses: 3}, want: models.ErrInvitationUsedUp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.invitation.Usable(now)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
@@ -1,10 +1,49 @@
 package domain
 
+import "backend_golang/internal/models"
+
 type Member struct {
 	ID            string
 	Email         string
 	Picture       string
 	Nickname      string
 	Bio           string
-	PreferredRole string
+	PreferredRole models.Role
+	Roles         []models.Role
+	// TeamRole はチーム内で担当しているポジションの役割。チームリーダーなどポジションを持たない場合は空
+	TeamRole models.Role
+	// TeamID は所属しているチームのID。所属していない場合や読み込んでいない場合は 0
+	TeamID int
+	// CalendarToken は .ics フィードの認証に使うトークン。未発行の場合は空
+	CalendarToken string
+	// Locale はメールの言語
+	Locale models.Locale
+	// EmailMuted はメールを送らない通知の種類
+	EmailMuted []models.NotificationKind
+}
+
+// WantsEmail は kind の通知をメールでも受け取るかどうか
+func (m Member) WantsEmail(kind models.NotificationKind) bool {
+	if !kind.SendsEmail() {
+		return false
+	}
+	for _, muted := range m.EmailMuted {
+		if muted == kind {
+			return false
+		}
+	}
+	return true
+}
+
+// CanFill は希望する役割または申告済みの役割で role のポジションを担当できるかどうか
+func (m Member) CanFill(role models.Role) bool {
+	if m.PreferredRole.Covers(role) {
+		return true
+	}
+	for _, declared := range m.Roles {
+		if declared.Covers(role) {
+			return true
+		}
+	}
+	return false
 }
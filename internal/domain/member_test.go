@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMember_CanFill(t *testing.T) {
+	tests := []struct {
+		name   string
+		member Member
+		role   models.Role
+		want   bool
+	}{
+		{name: "preferred role", member: Member{PreferredRole: models.Backend}, role: models.Backend, want: true},
+		{name: "declared role", member: Member{PreferredRole: models.Backend, Roles: []models.Role{models.Infra}}, role: models.Infra, want: true},
+		{name: "fullstack fills backend", member: Member{PreferredRole: models.Fullstack}, role: models.Backend, want: true},
+		{name: "fullstack fills frontend", member: Member{PreferredRole: models.Mobile, Roles: []models.Role{models.Fullstack}}, role: models.Frontend, want: true},
+		{name: "fullstack does not fill designer", member: Member{PreferredRole: models.Fullstack}, role: models.Designer, want: false},
+		{name: "undeclared role", member: Member{PreferredRole: models.Backend}, role: models.Frontend, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.member.CanFill(tt.role))
+		})
+	}
+}
+
+func TestMember_WantsEmail(t *testing.T) {
+	member := Member{EmailMuted: []models.NotificationKind{models.NotificationApplicationReceived}}
+
+	assert.False(t, member.WantsEmail(models.NotificationApplicationReceived))
+	assert.True(t, member.WantsEmail(models.NotificationApplicationAccepted))
+	// メールで送らない種類は設定に関わらず送らない
+	assert.False(t, member.WantsEmail(models.NotificationMentioned))
+	assert.True(t, Member{}.WantsEmail(models.NotificationApplicationReceived))
+}
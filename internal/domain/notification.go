@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"time"
+)
+
+type Notification struct {
+	ID          int
+	RecipientID string
+	Kind        models.NotificationKind
+	TeamID      *int
+	// ActorID は通知のきっかけになったメンバーの ID。リマインダーなどでは空
+	ActorID string
+	Subject string
+	Link    string
+	// DedupeKey が同じ通知は1つだけ作成する。空の場合は重複を確認しない
+	DedupeKey string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsRead は既読かどうか
+func (n Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// IsSelfNotification は本人の操作による本人への通知かどうか。このような通知は送らない
+func (n Notification) IsSelfNotification() bool {
+	return n.ActorID != "" && n.ActorID == n.RecipientID
+}
@@ -3,8 +3,16 @@ package domain
 import "backend_golang/internal/models"
 
 type Position struct {
-	// ID      int64
+	ID      int
 	Role    models.Role
 	Vacancy int8
-	// Team    int64
+	// Filled はこのポジションを担当しているメンバー数
+	Filled int
+	// Reserved は待機者に提示して承諾を待っている席の数
+	Reserved int
+}
+
+// Capacity はポジションの定員
+func (p Position) Capacity() int {
+	return p.Filled + p.Reserved + int(p.Vacancy)
 }
@@ -1,5 +1,8 @@
 package domain
 
 type Skill struct {
-	Name string
+	ID         int
+	Name       string
+	Category   string
+	UsageCount int
 }
@@ -1,12 +1,75 @@
 package domain
 
+import "backend_golang/internal/models"
+
 type Team struct {
 	ID          int
 	Name        string
 	Description string
 	Headcount   int8
 	CreatedBy   string
+	Status      models.TeamStatus
 	Members     []Member
 	Positions   []Position
 	Skills      []Skill
 }
+
+// OpenSeats は全ポジションの残り募集人数の合計
+func (t Team) OpenSeats() int {
+	var seats int
+	for _, position := range t.Positions {
+		seats += int(position.Vacancy)
+	}
+	return seats
+}
+
+// Member は memberID のメンバーがチームに所属していれば返す
+func (t Team) Member(memberID string) (Member, bool) {
+	for _, member := range t.Members {
+		if member.ID == memberID {
+			return member, true
+		}
+	}
+	return Member{}, false
+}
+
+// ResolveMentions はニックネームをチームのメンバーの ID に変換する
+// チームにいないニックネームは無視する
+func (t Team) ResolveMentions(nicknames []string) []string {
+	var memberIDs []string
+	for _, nickname := range nicknames {
+		for _, member := range t.Members {
+			if member.Nickname == nickname {
+				memberIDs = append(memberIDs, member.ID)
+			}
+		}
+	}
+	return memberIDs
+}
+
+// CanModerate は userID がチームリーダーまたは作成者本人として投稿を削除できるかどうか
+func (t Team) CanModerate(userID string, authorID string) bool {
+	return userID == authorID || userID == t.CreatedBy
+}
+
+// NextTeamStatus は空きポジションの有無から次のチーム状態を決める
+// CLOSED と ARCHIVED はリーダーが変更するまでそのまま維持する
+func NextTeamStatus(current models.TeamStatus, hasOpenSeat bool) models.TeamStatus {
+	if current.IsManual() {
+		return current
+	}
+	if hasOpenSeat {
+		return models.TeamStatusRecruiting
+	}
+	return models.TeamStatusFull
+}
+
+// TeamSummary は一覧表示用にメンバー詳細を含まないチーム情報
+type TeamSummary struct {
+	ID            int
+	Name          string
+	Status        models.TeamStatus
+	OpenPositions []Position
+	SkillNames    []string
+	MemberCount   int
+}
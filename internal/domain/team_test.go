@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextTeamStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     models.TeamStatus
+		hasOpenSeat bool
+		want        models.TeamStatus
+	}{
+		{name: "recruiting with open seat", current: models.TeamStatusRecruiting, hasOpenSeat: true, want: models.TeamStatusRecruiting},
+		{name: "last seat filled", current: models.TeamStatusRecruiting, hasOpenSeat: false, want: models.TeamStatusFull},
+		{name: "seat freed", current: models.TeamStatusFull, hasOpenSeat: true, want: models.TeamStatusRecruiting},
+		{name: "closed stays closed", current: models.TeamStatusClosed, hasOpenSeat: true, want: models.TeamStatusClosed},
+		{name: "archived stays archived", current: models.TeamStatusArchived, hasOpenSeat: false, want: models.TeamStatusArchived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NextTeamStatus(tt.current, tt.hasOpenSeat))
+		})
+	}
+}
+
+func TestTeam_OpenSeats(t *testing.T) {
+	team := Team{Positions: []Position{
+		{Role: models.Backend, Vacancy: 2},
+		{Role: models.Frontend, Vacancy: 0},
+		{Role: models.Designer, Vacancy: 1},
+	}}
+	assert.Equal(t, 3, team.OpenSeats())
+}
+
+func TestTeam_ResolveMentions(t *testing.T) {
+	team := Team{Members: []Member{
+		{ID: "m1", Nickname: "alice"},
+		{ID: "m2", Nickname: "bob"},
+	}}
+	assert.Equal(t, []string{"m2", "m1"}, team.ResolveMentions([]string{"bob", "carol", "alice"}))
+	assert.Nil(t, team.ResolveMentions(nil))
+}
+
+func TestTeam_CanModerate(t *testing.T) {
+	team := Team{CreatedBy: "leader"}
+	assert.True(t, team.CanModerate("author", "author"))
+	assert.True(t, team.CanModerate("leader", "author"))
+	assert.False(t, team.CanModerate("other", "author"))
+}
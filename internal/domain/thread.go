@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+type Thread struct {
+	ID       int
+	TeamID   int
+	Title    string
+	Body     string
+	AuthorID string
+	// AuthorNickname は作成者のニックネーム。読み込み時のみ設定する
+	AuthorNickname string
+	// Mentions は本文でメンションされたメンバーの ID
+	Mentions       []string
+	CommentCount   int
+	LastActivityAt time.Time
+	EditedAt       *time.Time
+	CreatedAt      time.Time
+}
+
+type ThreadComment struct {
+	ID       int
+	ThreadID int
+	Body     string
+	AuthorID string
+	// AuthorNickname は作成者のニックネーム。読み込み時のみ設定する
+	AuthorNickname string
+	// Mentions は本文でメンションされたメンバーの ID
+	Mentions  []string
+	EditedAt  *time.Time
+	CreatedAt time.Time
+}
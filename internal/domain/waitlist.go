@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"time"
+)
+
+type WaitlistEntry struct {
+	ID       int
+	TeamID   int
+	TeamName string
+	Role     models.Role
+	Status   models.WaitlistStatus
+	// Place は待ち順（1始まり）。WAITING の場合のみ設定される
+	Place          int
+	OfferExpiresAt *time.Time
+	CreatedAt      time.Time
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"slices"
+	"time"
+)
+
+// Webhook はチームのイベントを外部のサービスに送る送信先
+type Webhook struct {
+	ID     int
+	TeamID int
+	URL    string
+	Format models.WebhookFormat
+	Secret string
+	// Events が空の場合は Webhook で受け取れる全ての種類を送る
+	Events              []models.DomainEventType
+	Active              bool
+	ConsecutiveFailures int
+	// DisabledAt は連続した失敗で自動的に無効にした日時
+	DisabledAt *time.Time
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Accepts は eventType のイベントを送るかどうか
+func (w Webhook) Accepts(eventType models.DomainEventType) bool {
+	if !w.Active || !eventType.IsWebhookEvent() {
+		return false
+	}
+	return len(w.Events) == 0 || slices.Contains(w.Events, eventType)
+}
+
+// WebhookDelivery はドメインイベント1件の Webhook への送信
+type WebhookDelivery struct {
+	ID        int
+	WebhookID int
+	EventID   int
+	EventType models.DomainEventType
+	Body      string
+	Status    models.WebhookDeliveryStatus
+	Attempts  int
+	// NextAttemptAt は次に送る日時。送信待ちの場合のみ意味を持つ
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	LastError      string
+	Duration       time.Duration
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	// Webhook は送信先。送信待ちの読み込み時のみ設定する
+	Webhook *Webhook
+}
+
+// WebhookAttempt は1回の送信の結果と、それによる次の状態
+type WebhookAttempt struct {
+	Status         models.WebhookDeliveryStatus
+	ResponseStatus int
+	Error          string
+	Duration       time.Duration
+	NextAttemptAt  time.Time
+	At             time.Time
+}
+
+// Succeeded は送信に成功したかどうか
+func (a WebhookAttempt) Succeeded() bool {
+	return a.Status == models.WebhookDeliverySucceeded
+}
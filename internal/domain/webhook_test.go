@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"backend_golang/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook_Accepts(t *testing.T) {
+	all := Webhook{Active: true}
+	assert.True(t, all.Accepts(models.DomainEventAnnouncementCreated))
+	assert.True(t, all.Accepts(models.DomainEventMemberLeft))
+	// Webhook で受け取れない種類は送らない
+	assert.False(t, all.Accepts(models.DomainEventTeamCreated))
+	assert.False(t, all.Accepts(models.DomainEventWaitlistOffered))
+
+	filtered := Webhook{Active: true, Events: []models.DomainEventType{models.DomainEventAnnouncementCreated}}
+	assert.True(t, filtered.Accepts(models.DomainEventAnnouncementCreated))
+	assert.False(t, filtered.Accepts(models.DomainEventMemberJoined))
+
+	disabled := Webhook{}
+	assert.False(t, disabled.Accepts(models.DomainEventAnnouncementCreated))
+}
@@ -0,0 +1,166 @@
+// Package ical は RFC 5545 の iCalendar 形式でイベントを書き出す
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateTimeFormat = "20060102T150405Z"
+	// maxLineOctets は折り返し前の1行の最大オクテット数（CRLF を除く）
+	maxLineOctets = 75
+)
+
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+type Calendar struct {
+	ProdID string
+	// Name はカレンダーアプリに表示されるカレンダー名
+	Name   string
+	Events []Event
+}
+
+type Event struct {
+	// UID はイベントごとに変わらない識別子。更新・取り消しは同じ UID で配信する
+	UID string
+	// Sequence はイベントを更新するたびに増やす版数
+	Sequence     int
+	Stamp        time.Time
+	LastModified time.Time
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Status       Status
+	// RRule は繰り返しのルール。保存している RRULE をそのまま書き出す
+	RRule string
+	// ExDates は繰り返しから除く回の開始日時
+	ExDates []time.Time
+	// RecurrenceID は繰り返しの特定の回を上書きする VEVENT の場合に、その回の元の開始日時を指定する
+	RecurrenceID *time.Time
+}
+
+// Encode は cal を iCalendar 形式で w に書き出す
+func (cal Calendar) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	e := &encoder{w: bw}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", cal.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+	for _, event := range cal.Events {
+		e.event(event)
+	}
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) event(event Event) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", event.UID)
+	e.line("DTSTAMP", formatTime(event.Stamp))
+	if !event.LastModified.IsZero() {
+		e.line("LAST-MODIFIED", formatTime(event.LastModified))
+	}
+	e.line("SEQUENCE", fmt.Sprint(event.Sequence))
+	e.line("DTSTART", formatTime(event.Start))
+	e.line("DTEND", formatTime(event.End))
+	if event.RecurrenceID != nil {
+		e.line("RECURRENCE-ID", formatTime(*event.RecurrenceID))
+	}
+	if event.RRule != "" {
+		e.line("RRULE", event.RRule)
+	}
+	if len(event.ExDates) > 0 {
+		dates := make([]string, len(event.ExDates))
+		for i, date := range event.ExDates {
+			dates[i] = formatTime(date)
+		}
+		e.line("EXDATE", strings.Join(dates, ","))
+	}
+	e.line("SUMMARY", escapeText(event.Summary))
+	if event.Description != "" {
+		e.line("DESCRIPTION", escapeText(event.Description))
+	}
+	if event.Location != "" {
+		e.line("LOCATION", escapeText(event.Location))
+	}
+	if event.URL != "" {
+		e.line("URL", event.URL)
+	}
+	if event.Status != "" {
+		e.line("STATUS", string(event.Status))
+	}
+	e.line("END", "VEVENT")
+}
+
+// line は "名前:値" の1行を折り返して書き出す
+func (e *encoder) line(name string, value string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.WriteString(fold(name + ":" + value))
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// escapeText は TEXT 型の値のバックスラッシュ・セミコロン・カンマ・改行をエスケープする
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// fold は75オクテットを超える行を CRLF と空白で折り返す
+// マルチバイト文字の途中では折り返さない
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// 継続行は先頭の空白も1オクテットに数える
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	cal := Calendar{
+		ProdID: "-//team-recruitment//events//JA",
+		Name:   "gophers",
+		Events: []Event{
+			{
+				UID:          "event-1@team-recruitment",
+				Sequence:     2,
+				Stamp:        time.Date(2025, 3, 20, 10, 0, 0, 0, time.UTC),
+				LastModified: time.Date(2025, 3, 20, 10, 0, 0, 0, time.UTC),
+				Start:        time.Date(2025, 4, 1, 19, 0, 0, 0, jst),
+				End:          time.Date(2025, 4, 1, 21, 0, 0, 0, jst),
+				Summary:      "Go 勉強会, #12",
+				Description:  "Generics; 実践\n持ち物: PC",
+				Location:     "渋谷",
+				URL:          "https://meet.example.com/go",
+				Status:       StatusCancelled,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, cal.Encode(&buf))
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//team-recruitment//events//JA",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:gophers",
+		"BEGIN:VEVENT",
+		"UID:event-1@team-recruitment",
+		"DTSTAMP:20250320T100000Z",
+		"LAST-MODIFIED:20250320T100000Z",
+		"SEQUENCE:2",
+		"DTSTART:20250401T100000Z",
+		"DTEND:20250401T120000Z",
+		`SUMMARY:Go 勉強会\, #12`,
+		`DESCRIPTION:Generics\; 実践\n持ち物: PC`,
+		"LOCATION:渋谷",
+		"URL:https://meet.example.com/go",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	assert.Equal(t, want, buf.String())
+}
+
+func TestFold(t *testing.T) {
+	t.Run("short line is not folded", func(t *testing.T) {
+		assert.Equal(t, "SUMMARY:short\r\n", fold("SUMMARY:short"))
+	})
+
+	t.Run("long line is folded at 75 octets", func(t *testing.T) {
+		folded := fold("DESCRIPTION:" + strings.Repeat("a", 100))
+		lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+		require.Len(t, lines, 2)
+		assert.Len(t, lines[0], 75)
+		assert.True(t, strings.HasPrefix(lines[1], " "))
+		assert.Equal(t, "DESCRIPTION:"+strings.Repeat("a", 100), lines[0]+lines[1][1:])
+	})
+
+	t.Run("multibyte characters are not split", func(t *testing.T) {
+		value := "SUMMARY:" + strings.Repeat("勉強会", 20)
+		folded := fold(value)
+		for _, line := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), 75)
+			assert.True(t, strings.ToValidUTF8(line, "") == line, "line must be valid UTF-8: %q", line)
+		}
+		assert.Equal(t, value, strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""))
+	})
+}
+
+func TestCalendar_EncodeRecurrence(t *testing.T) {
+	start := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	original := start.AddDate(0, 0, 14)
+	stamp := time.Date(2025, 3, 20, 10, 0, 0, 0, time.UTC)
+	cal := Calendar{
+		ProdID: "-//team-recruitment//events//JA",
+		Events: []Event{
+			{
+				UID:      "event-1@team-recruitment",
+				Stamp:    stamp,
+				Start:    start,
+				End:      start.Add(2 * time.Hour),
+				Summary:  "もくもく会",
+				RRule:    "FREQ=WEEKLY;INTERVAL=2;COUNT=10",
+				ExDates:  []time.Time{start.AddDate(0, 0, 28), start.AddDate(0, 0, 42)},
+				Status:   StatusConfirmed,
+				Sequence: 3,
+			},
+			{
+				UID:          "event-1@team-recruitment",
+				Stamp:        stamp,
+				Start:        original.Add(time.Hour),
+				End:          original.Add(3 * time.Hour),
+				Summary:      "もくもく会（時間変更）",
+				RecurrenceID: &original,
+				Status:       StatusConfirmed,
+				Sequence:     3,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, cal.Encode(&buf))
+	out := buf.String()
+
+	assert.Contains(t, out, "RRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=10\r\n")
+	assert.Contains(t, out, "EXDATE:20250429T100000Z,20250513T100000Z\r\n")
+	assert.Contains(t, out, "RECURRENCE-ID:20250415T100000Z\r\n")
+	assert.Equal(t, 2, strings.Count(out, "UID:event-1@team-recruitment\r\n"))
+}
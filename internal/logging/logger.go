@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+type contextKey struct{}
+
+var (
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`)
+	bearerPattern = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._\-~+/]+=*`)
+)
+
+// sensitiveKeys を名前に含む属性は値ごと伏せる
+var sensitiveKeys = []string{"email", "token", "secret", "password", "authorization", "cookie"}
+
+const redacted = "[REDACTED]"
+
+// New は JSON 形式で w に書き出すロガーを作る。メールアドレスとトークンは伏せて出力する
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redactAttr,
+	}))
+}
+
+// ParseLevel は debug, info, warn, error のいずれかをログレベルに変換する。不明な値は info とする
+func ParseLevel(value string) slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
+
+// WithContext は logger を ctx に持たせる
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext は ctx のロガーを返す。持っていない場合は slog.Default() を返す
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// With は ctx のロガーに属性を加えた context を返す
+func With(ctx context.Context, args ...any) context.Context {
+	return WithContext(ctx, FromContext(ctx).With(args...))
+}
+
+// Redact は文字列に含まれるメールアドレスとトークンを伏せる
+func Redact(value string) string {
+	value = emailPattern.ReplaceAllString(value, redacted)
+	value = jwtPattern.ReplaceAllString(value, redacted)
+	return bearerPattern.ReplaceAllString(value, "Bearer "+redacted)
+}
+
+func redactAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 || (a.Key != slog.TimeKey && a.Key != slog.LevelKey && a.Key != slog.MessageKey && a.Key != slog.SourceKey) {
+		key := strings.ToLower(a.Key)
+		for _, sensitive := range sensitiveKeys {
+			if strings.Contains(key, sensitive) {
+				return slog.String(a.Key, redacted)
+			}
+		}
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, Redact(a.Value.String()))
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, Redact(err.Error()))
+		}
+	}
+	return a
+}
@@ -0,0 +1,32 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// FileMailer は開発用に、メールを送らずに読める形で w に書き出す
+type FileMailer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	from string
+}
+
+func NewFileMailer(w io.Writer, from string) *FileMailer {
+	return &FileMailer{w: w, from: from}
+}
+
+func (f *FileMailer) Send(ctx context.Context, message Message) error {
+	if err := message.validate(); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err := fmt.Fprintf(f.w, "From: %s\nTo: %s\nSubject: %s\nDate: %s\n\n%s\n-----\n",
+		f.from, message.To, message.Subject, time.Now().Format(time.RFC1123Z), message.Body)
+	return err
+}
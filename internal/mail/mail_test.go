@@ -0,0 +1,61 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"mime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_Encode(t *testing.T) {
+	message := Message{To: "member@example.com", Subject: "【gophers】新しい応募者がいます", Body: strings.Repeat("こんにちは", 20)}
+	data, err := message.encode("no-reply@example.com", time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	header, body, found := strings.Cut(string(data), "\r\n\r\n")
+	require.True(t, found)
+	assert.Contains(t, header, "To: member@example.com\r\n")
+	assert.Contains(t, header, "Date: Tue, 01 Apr 2025 10:00:00 +0000\r\n")
+
+	var subject string
+	for _, line := range strings.Split(header, "\r\n") {
+		if value, ok := strings.CutPrefix(line, "Subject: "); ok {
+			subject, err = new(mime.WordDecoder).DecodeHeader(value)
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, message.Subject, subject)
+
+	// 本文は76文字ごとに折り返した Base64
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 76)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, message.Body, string(decoded))
+}
+
+func TestMessage_RejectsHeaderInjection(t *testing.T) {
+	_, err := Message{To: "member@example.com\r\nBcc: other@example.com", Subject: "hello"}.encode("no-reply@example.com", time.Now())
+	assert.ErrorIs(t, err, ErrInvalidHeader)
+
+	var buf bytes.Buffer
+	err = NewFileMailer(&buf, "no-reply@example.com").Send(context.Background(), Message{To: "member@example.com", Subject: "a\nb"})
+	assert.ErrorIs(t, err, ErrInvalidHeader)
+	assert.Zero(t, buf.Len())
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	var buf bytes.Buffer
+	mailer := NewFileMailer(&buf, "no-reply@example.com")
+	require.NoError(t, mailer.Send(context.Background(), Message{To: "member@example.com", Subject: "件名", Body: "本文\n"}))
+
+	assert.Contains(t, buf.String(), "From: no-reply@example.com\nTo: member@example.com\nSubject: 件名\n")
+	assert.Contains(t, buf.String(), "\n\n本文\n")
+}
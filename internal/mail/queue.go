@@ -0,0 +1,108 @@
+package mail
+
+import (
+	"backend_golang/internal/logging"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 5
+	defaultBackoff     = time.Second
+	// sendTimeout は1回の送信にかける時間の上限
+	sendTimeout = 30 * time.Second
+)
+
+// Queue はメールを非同期に送る。送信に失敗した場合は間隔を倍にしながら再送する
+// リクエストの処理をメールの送信で待たせないために使う
+type Queue struct {
+	mailer Mailer
+	// mu は Close の後に messages に送らないようにする
+	mu          sync.RWMutex
+	closed      bool
+	messages    chan Message
+	maxAttempts int
+	backoff     time.Duration
+}
+
+func NewQueue(mailer Mailer, size int) *Queue {
+	return &Queue{
+		mailer:      mailer,
+		messages:    make(chan Message, size),
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+	}
+}
+
+// Enqueue はメールを送信待ちに追加する。待ちが一杯の場合や Close の後は追加せずに false を返す
+func (q *Queue) Enqueue(message Message) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		slog.Warn("mail queue is closed, dropping mail", "subject", message.Subject)
+		return false
+	}
+	select {
+	case q.messages <- message:
+		return true
+	default:
+		slog.Warn("mail queue is full, dropping mail", "subject", message.Subject)
+		return false
+	}
+}
+
+// Run は送信待ちのメールを順に送る。Close すると残りのメールを送り終えてから戻る
+// ctx がキャンセルされた場合は残りを送らずに戻る
+func (q *Queue) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			if pending := len(q.messages); pending > 0 {
+				logging.FromContext(ctx).Warn("mail queue stopped with pending messages", "pending", pending)
+			}
+			return
+		case message, ok := <-q.messages:
+			if !ok {
+				return
+			}
+			q.deliver(ctx, message)
+		}
+	}
+}
+
+// Close は送信待ちへの追加を締め切る。Run は残りのメールを送り終えると戻る
+func (q *Queue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		close(q.messages)
+	}
+}
+
+// deliver は成功するか maxAttempts 回失敗するまで送信を繰り返す
+func (q *Queue) deliver(ctx context.Context, message Message) {
+	backoff := q.backoff
+	for attempt := 1; ; attempt++ {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := q.mailer.Send(sendCtx, message)
+		cancel()
+		if err == nil {
+			return
+		}
+		if attempt >= q.maxAttempts {
+			logging.FromContext(ctx).Error("giving up mail", "subject", message.Subject, "attempts", attempt, "error", err)
+			return
+		}
+		logging.FromContext(ctx).Warn("mail failed", "subject", message.Subject, "attempt", attempt, "max_attempts", q.maxAttempts, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,98 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyMailer は最初の failures 回だけ送信に失敗する
+type flakyMailer struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	sent     []Message
+}
+
+func (f *flakyMailer) Send(ctx context.Context, message Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attempts++
+	if f.attempts <= f.failures {
+		return errors.New("temporary failure")
+	}
+	f.sent = append(f.sent, message)
+	return nil
+}
+
+func (f *flakyMailer) result() (int, []Message) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.attempts, f.sent
+}
+
+func TestQueue_RetriesUntilSent(t *testing.T) {
+	mailer := &flakyMailer{failures: 2}
+	queue := NewQueue(mailer, 1)
+	queue.backoff = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go queue.Run(ctx)
+
+	assert.True(t, queue.Enqueue(Message{To: "member@example.com", Subject: "hello"}))
+	assert.Eventually(t, func() bool {
+		_, sent := mailer.result()
+		return len(sent) == 1
+	}, time.Second, time.Millisecond)
+	attempts, _ := mailer.result()
+	assert.Equal(t, 3, attempts)
+}
+
+func TestQueue_GivesUpAfterMaxAttempts(t *testing.T) {
+	mailer := &flakyMailer{failures: 100}
+	queue := NewQueue(mailer, 1)
+	queue.backoff = time.Millisecond
+	queue.maxAttempts = 3
+
+	queue.deliver(context.Background(), Message{Subject: "hello"})
+	attempts, sent := mailer.result()
+	assert.Equal(t, 3, attempts)
+	assert.Empty(t, sent)
+}
+
+func TestQueue_EnqueueDoesNotBlock(t *testing.T) {
+	queue := NewQueue(&flakyMailer{}, 1)
+	assert.True(t, queue.Enqueue(Message{Subject: "first"}))
+	// 送信待ちが一杯の場合は捨てる
+	assert.False(t, queue.Enqueue(Message{Subject: "second"}))
+}
+
+func TestQueue_CloseDrainsPendingMessages(t *testing.T) {
+	mailer := &flakyMailer{}
+	queue := NewQueue(mailer, 2)
+	assert.True(t, queue.Enqueue(Message{Subject: "first"}))
+	assert.True(t, queue.Enqueue(Message{Subject: "second"}))
+
+	queue.Close()
+	queue.Close()
+	assert.False(t, queue.Enqueue(Message{Subject: "late"}))
+
+	// Close の後も Run は残りを送り終えてから戻る
+	done := make(chan struct{})
+	go func() {
+		queue.Run(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("queue did not stop after close")
+	}
+	_, sent := mailer.result()
+	assert.Len(t, sent, 2)
+}
@@ -0,0 +1,53 @@
+package mail
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Templates は templates/<名前>.<言語>.tmpl のテンプレートからメールを作る
+// テンプレートは subject と body の2つを define する
+type Templates struct {
+	templates map[string]*template.Template
+}
+
+// LoadTemplates は埋め込んだテンプレートを読み込む
+func LoadTemplates() (*Templates, error) {
+	files, err := templateFS.ReadDir("templates")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Templates{templates: make(map[string]*template.Template)}
+	for _, file := range files {
+		tmpl, err := template.ParseFS(templateFS, path.Join("templates", file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result.templates[strings.TrimSuffix(file.Name(), ".tmpl")] = tmpl
+	}
+	return result, nil
+}
+
+// Render は name のテンプレートを locale の言語で実行し、件名と本文を返す
+func (t *Templates) Render(name string, locale string, data any) (string, string, error) {
+	tmpl, ok := t.templates[name+"."+locale]
+	if !ok {
+		return "", "", fmt.Errorf("mail template %s.%s not found", name, locale)
+	}
+
+	var subject, body strings.Builder
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", err
+	}
+	return strings.TrimSpace(subject.String()), strings.TrimSpace(body.String()) + "\n", nil
+}
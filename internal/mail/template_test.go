@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates_RenderAllLocales(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	data := struct {
+		Nickname      string
+		ActorNickname string
+		Subject       string
+		URL           string
+	}{
+		Nickname:      "alice",
+		ActorNickname: "bob",
+		Subject:       "gophers",
+		URL:           "http://localhost:8080/v1/teams/1",
+	}
+	// 全てのメールを全ての言語で用意する
+	for _, name := range []string{"application_received", "application_accepted"} {
+		for _, locale := range []string{"ja", "ko", "en"} {
+			subject, body, err := templates.Render(name, locale, data)
+			require.NoError(t, err, "%s.%s", name, locale)
+			assert.Contains(t, subject, "gophers")
+			assert.NotContains(t, subject, "\n")
+			assert.Contains(t, body, "alice")
+			assert.Contains(t, body, data.URL)
+		}
+	}
+
+	_, _, err = templates.Render("application_received", "fr", data)
+	assert.Error(t, err)
+}
@@ -0,0 +1,43 @@
+// Package mention は Markdown の本文から @nickname 形式のメンションを取り出す
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// 直前が英数字の場合（メールアドレスなど）はメンションとみなさない
+	mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([\p{L}\p{N}_\-.]+)`)
+	codeSpan       = regexp.MustCompile("`[^`\n]*`")
+)
+
+// Parse は本文中のメンションされたニックネームを出現順に重複なく返す
+// コードブロックとインラインコードの中は対象にしない
+func Parse(markdown string) []string {
+	var nicknames []string
+	seen := make(map[string]bool)
+	inFence := false
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		line = codeSpan.ReplaceAllString(line, " ")
+		for _, match := range mentionPattern.FindAllStringSubmatch(line, -1) {
+			// 文末の句読点はニックネームに含めない
+			nickname := strings.TrimRight(match[1], ".-")
+			if nickname == "" || seen[nickname] {
+				continue
+			}
+			seen[nickname] = true
+			nicknames = append(nicknames, nickname)
+		}
+	}
+	return nicknames
+}
@@ -0,0 +1,30 @@
+package mention
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     []string
+	}{
+		{name: "single mention", markdown: "@gopher レビューお願いします", want: []string{"gopher"}},
+		{name: "multiple mentions in order", markdown: "@bob と @alice、@bob", want: []string{"bob", "alice"}},
+		{name: "unicode nickname", markdown: "こんにちは @たろう さん", want: []string{"たろう"}},
+		{name: "trailing period", markdown: "thanks @alice.", want: []string{"alice"}},
+		{name: "email is not a mention", markdown: "mail to alice@example.com", want: nil},
+		{name: "inline code is ignored", markdown: "run `@bob` then ask @carol", want: []string{"carol"}},
+		{name: "fenced code is ignored", markdown: "```go\n// @bob\n```\n@dave", want: []string{"dave"}},
+		{name: "no mention", markdown: "plain text", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Parse(tt.markdown))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package metrics
+
+// Default はアプリケーションのメトリクスを登録するレジストリ。/metrics で公開する
+var Default = NewRegistry()
+
+var (
+	// HTTPRequestDuration はルートごとのリクエストの処理時間
+	HTTPRequestDuration = Default.NewHistogram(
+		"http_request_duration_seconds",
+		"HTTP request latency by route.",
+		DefaultBuckets,
+		"method", "route", "status",
+	)
+	// DBTransactionDuration は TransactionManager.WithTx のトランザクションの所要時間。outcome は commit か rollback
+	DBTransactionDuration = Default.NewHistogram(
+		"db_transaction_duration_seconds",
+		"Database transaction duration by outcome.",
+		DefaultBuckets,
+		"outcome",
+	)
+	// DomainEvents はコミットしたドメインイベントの数。チームの作成・参加・募集記事の公開などを type で数える
+	DomainEvents = Default.NewCounter(
+		"domain_events_total",
+		"Committed domain events by type.",
+		"type",
+	)
+	// RateLimitedRequests はレート制限で 429 を返したリクエストの数
+	RateLimitedRequests = Default.NewCounter(
+		"http_rate_limited_requests_total",
+		"Requests rejected by the rate limiter by route.",
+		"route",
+	)
+)
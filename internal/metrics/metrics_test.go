@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	registry := NewRegistry()
+	requests := registry.NewCounter("requests_total", "Requests.", "route")
+	latency := registry.NewHistogram("latency_seconds", "Latency.", []float64{0.1, 1})
+
+	requests.Inc("/v1/teams")
+	requests.Add(2, "/v1/teams")
+	requests.Inc(`/v1/"quoted"`)
+	latency.Observe(0.05)
+	latency.Observe(0.5)
+	latency.Observe(3)
+
+	var buf bytes.Buffer
+	_, err := registry.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, `# HELP requests_total Requests.
+# TYPE requests_total counter
+requests_total{route="/v1/\"quoted\""} 1
+requests_total{route="/v1/teams"} 3
+# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="1"} 2
+latency_seconds_bucket{le="+Inf"} 3
+latency_seconds_sum 3.55
+latency_seconds_count 3
+`, buf.String())
+
+	assert.Equal(t, float64(3), requests.Value("/v1/teams"))
+	assert.Equal(t, uint64(3), latency.Count())
+}
+
+func TestRegistry_PanicsOnMisuse(t *testing.T) {
+	registry := NewRegistry()
+	counter := registry.NewCounter("events_total", "Events.", "type")
+
+	assert.Panics(t, func() { registry.NewCounter("events_total", "Events.") })
+	assert.Panics(t, func() { counter.Inc() })
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	registry := NewRegistry()
+	registry.NewCounter("events_total", "Events.").Inc()
+
+	recorder := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "version=0.0.4")
+	assert.Contains(t, recorder.Body.String(), "events_total 1\n")
+}
@@ -0,0 +1,104 @@
+// Package migration は ent の自動マイグレーションの前に実行するデータの移行をまとめる
+//
+// ent はカラムの型を変更するが既存の値は変換しない。型を変更する前に値を新しい型に合わせておく。
+package migration
+
+import (
+	"backend_golang/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// roleColumns は文字列から役割の Enum に変更したカラム
+var roleColumns = []struct {
+	table  string
+	column string
+}{
+	{"members", "preferred_role"},
+	{"positions", "role"},
+}
+
+// NormalizeRoles は役割のカラムを Enum に変更する前に、既存の値を定義済みの役割に揃える
+// 前後の空白と大文字・小文字の違い（例: " backend"）は直し、定義済みの役割にならない値があれば何も変更せずにエラーを返す。
+// MySQL では Enum にない値は sql_mode によってマイグレーションが失敗するか空文字列に変わるため、先に止める
+func NormalizeRoles(ctx context.Context, db *sql.DB, driver string) error {
+	roles := models.Roles()
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(roles)), ", ")
+	args := make([]any, len(roles))
+	for i, role := range roles {
+		args[i] = string(role)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, target := range roleColumns {
+		exists, err := tableExists(ctx, tx, driver, target.table)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+
+		invalid, err := queryStrings(ctx, tx,
+			fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE UPPER(TRIM(%s)) NOT IN (%s)", target.column, target.table, target.column, placeholders),
+			args...)
+		if err != nil {
+			return err
+		}
+		if len(invalid) > 0 {
+			return fmt.Errorf("%s.%s has values that are not roles: %q (roles: %s)", target.table, target.column, invalid, strings.Join(models.Role("").Values(), ", "))
+		}
+
+		for _, role := range roles {
+			_, err := tx.ExecContext(ctx,
+				fmt.Sprintf("UPDATE %s SET %s = ? WHERE UPPER(TRIM(%s)) = ? AND %s <> ?", target.table, target.column, target.column, target.column),
+				role, role, role)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// tableExists はテーブルがあるかどうか。初回の起動ではまだテーブルがない
+func tableExists(ctx context.Context, tx *sql.Tx, driver string, table string) (bool, error) {
+	var query string
+	switch driver {
+	case dialect.MySQL:
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+	case dialect.SQLite:
+		query = "SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?"
+	default:
+		return false, fmt.Errorf("unsupported dialect %s", driver)
+	}
+	names, err := queryStrings(ctx, tx, query, table)
+	return len(names) > 0, err
+}
+
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
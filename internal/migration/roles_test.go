@@ -0,0 +1,81 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDBSeq atomic.Int64
+
+func newTestDB(t *testing.T, statements ...string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:migration_test_%d?mode=memory&cache=shared", testDBSeq.Add(1)))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	for _, statement := range statements {
+		_, err := db.Exec(statement)
+		require.NoError(t, err)
+	}
+	return db
+}
+
+func columnValues(t *testing.T, db *sql.DB, query string) []string {
+	t.Helper()
+	rows, err := db.Query(query)
+	require.NoError(t, err)
+	defer rows.Close()
+	var values []string
+	for rows.Next() {
+		var value string
+		require.NoError(t, rows.Scan(&value))
+		values = append(values, value)
+	}
+	require.NoError(t, rows.Err())
+	return values
+}
+
+func TestNormalizeRoles(t *testing.T) {
+	db := newTestDB(t,
+		"CREATE TABLE members (id INTEGER PRIMARY KEY, preferred_role TEXT)",
+		"CREATE TABLE positions (id INTEGER PRIMARY KEY, role TEXT)",
+		"INSERT INTO members (preferred_role) VALUES ('BACKEND'), ('backend'), (' Frontend '), ('fullstack')",
+		"INSERT INTO positions (role) VALUES ('infra'), ('DESIGNER')",
+	)
+
+	require.NoError(t, NormalizeRoles(context.Background(), db, dialect.SQLite))
+	assert.Equal(t, []string{"BACKEND", "BACKEND", "FRONTEND", "FULLSTACK"}, columnValues(t, db, "SELECT preferred_role FROM members ORDER BY id"))
+	assert.Equal(t, []string{"INFRA", "DESIGNER"}, columnValues(t, db, "SELECT role FROM positions ORDER BY id"))
+
+	// 2回目以降は何も変更しない
+	require.NoError(t, NormalizeRoles(context.Background(), db, dialect.SQLite))
+}
+
+func TestNormalizeRoles_RejectsUnknownValues(t *testing.T) {
+	db := newTestDB(t,
+		"CREATE TABLE members (id INTEGER PRIMARY KEY, preferred_role TEXT)",
+		"CREATE TABLE positions (id INTEGER PRIMARY KEY, role TEXT)",
+		"INSERT INTO members (preferred_role) VALUES ('backend')",
+		"INSERT INTO positions (role) VALUES ('qa'), ('')",
+	)
+
+	err := NormalizeRoles(context.Background(), db, dialect.SQLite)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "positions.role")
+	assert.Contains(t, err.Error(), `"qa"`)
+
+	// 一部だけ変更しない
+	assert.Equal(t, []string{"backend"}, columnValues(t, db, "SELECT preferred_role FROM members"))
+}
+
+func TestNormalizeRoles_SkipsMissingTables(t *testing.T) {
+	db := newTestDB(t)
+	assert.NoError(t, NormalizeRoles(context.Background(), db, dialect.SQLite))
+}
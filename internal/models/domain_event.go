@@ -0,0 +1,40 @@
+package models
+
+// DomainEventType は outbox に記録する状態変化の種類
+type DomainEventType string
+
+const (
+	DomainEventTeamCreated DomainEventType = "TEAM_CREATED"
+	DomainEventTeamDeleted DomainEventType = "TEAM_DELETED"
+	// DomainEventMemberJoined は直接の参加・待機リストの承諾・招待による参加のいずれでも記録する
+	DomainEventMemberJoined DomainEventType = "MEMBER_JOINED"
+	DomainEventMemberLeft   DomainEventType = "MEMBER_LEFT"
+	// DomainEventWaitlistJoined はメンバーが待機リストに並んだことを表す。集約は待機リストのエントリー
+	DomainEventWaitlistJoined DomainEventType = "WAITLIST_JOINED"
+	// DomainEventWaitlistOffered は待機者に空きを提示したことを表す。集約は待機リストのエントリー
+	DomainEventWaitlistOffered     DomainEventType = "WAITLIST_OFFERED"
+	DomainEventAnnouncementCreated DomainEventType = "ANNOUNCEMENT_CREATED"
+)
+
+// DomainEventTypes は定義済みの全てのドメインイベントの種類を返す
+func DomainEventTypes() []DomainEventType {
+	return []DomainEventType{
+		DomainEventTeamCreated,
+		DomainEventTeamDeleted,
+		DomainEventMemberJoined,
+		DomainEventMemberLeft,
+		DomainEventWaitlistJoined,
+		DomainEventWaitlistOffered,
+		DomainEventAnnouncementCreated,
+	}
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (DomainEventType) Values() []string {
+	types := DomainEventTypes()
+	values := make([]string, len(types))
+	for i, t := range types {
+		values[i] = string(t)
+	}
+	return values
+}
@@ -1,6 +1,50 @@
 package models
 
-import "github.com/go-playground/validator/v10"
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	ErrNotTeamLeader   = errors.New("you are not the team leader")
+	ErrAlreadyInTeam   = errors.New("member already belongs to a team")
+	ErrNoVacancy       = errors.New("no available position for role")
+	ErrRoleNotDeclared = errors.New("role is not one of the member's declared roles")
+
+	ErrHeadcountExceeded = errors.New("members and open positions exceed the team headcount")
+	ErrRecruitmentClosed = errors.New("team is not recruiting")
+	ErrTeamArchived      = errors.New("team is archived")
+	ErrNotTeamMember     = errors.New("member does not belong to the team")
+	ErrLeaderCannotLeave = errors.New("team leader cannot leave the team")
+
+	ErrVacancyAvailable  = errors.New("position has an open seat, join the team instead")
+	ErrAlreadyWaitlisted = errors.New("member is already on the waitlist for this position")
+	ErrNoOffer           = errors.New("no seat has been offered for this waitlist entry")
+	ErrOfferExpired      = errors.New("waitlist offer has expired")
+
+	ErrInvitationInvalid = errors.New("invitation is no longer valid")
+	ErrInvitationExpired = errors.New("invitation has expired")
+	ErrInvitationUsedUp  = errors.New("invitation has reached its maximum number of uses")
+
+	ErrEventFull          = errors.New("event has reached its capacity")
+	ErrEventEnded         = errors.New("event has already ended")
+	ErrEventCancelled     = errors.New("event has been cancelled")
+	ErrNotRecurring       = errors.New("event does not repeat")
+	ErrNoSuchOccurrence   = errors.New("event has no occurrence at the given time")
+	ErrInvalidOccurrence  = errors.New("invalid occurrence")
+	ErrCapacityBelowGoing = errors.New("capacity is less than the number of members going")
+
+	ErrNotAuthor      = errors.New("only the author can modify this post")
+	ErrReplyTooDeep   = errors.New("replies can only be posted to top-level comments")
+	ErrCommentDeleted = errors.New("comment has been deleted")
+	ErrRateLimited    = errors.New("too many requests")
+
+	ErrInvalidMerge = errors.New("cannot merge a skill into itself")
+
+	ErrInvalidCalendarToken = errors.New("calendar token is invalid")
+)
 
 type ValidationError struct {
 	Field   string `json:"field"`
@@ -23,6 +67,40 @@ func getValidationErrorMessage(err validator.FieldError) string {
 			return "At least one item is required"
 		}
 		return "This field must be at least " + err.Param() + " characters long"
+	case "max":
+		return "This field must be at most " + err.Param()
+	case "role":
+		return "This field must be one of " + strings.Join(Role("").Values(), ", ")
+	case "gtfield":
+		return "This field must be after " + err.Param()
+	case "url":
+		return "This field must be a valid URL"
+	case "rsvp":
+		return "This field must be one of " + strings.Join(RSVPStatus("").Values(), ", ")
+	case "rrule":
+		return "This field must be an RRULE with FREQ=WEEKLY or MONTHLY and optional INTERVAL, COUNT or UNTIL"
+	case "locale":
+		return "This field must be one of " + strings.Join(Locale("").Values(), ", ")
+	case "email_notification":
+		kinds := EmailNotificationKinds()
+		values := make([]string, len(kinds))
+		for i, kind := range kinds {
+			values[i] = string(kind)
+		}
+		return "This field must be one of " + strings.Join(values, ", ")
+	case "webhook_url":
+		return "This field must be an http or https URL"
+	case "webhook_format":
+		return "This field must be one of " + strings.Join(WebhookFormat("").Values(), ", ")
+	case "webhook_event":
+		types := WebhookEventTypes()
+		values := make([]string, len(types))
+		for i, t := range types {
+			values[i] = string(t)
+		}
+		return "This field must be one of " + strings.Join(values, ", ")
+	case "unique":
+		return "Duplicate " + strings.ToLower(err.Param()) + " values are not allowed"
 	default:
 		return "Invalid value"
 	}
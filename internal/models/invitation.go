@@ -0,0 +1,34 @@
+package models
+
+type InvitationKind string
+
+const (
+	// InvitationDirect は特定のメンバーへの招待
+	InvitationDirect InvitationKind = "DIRECT"
+	// InvitationLink は共有できる招待リンク
+	InvitationLink InvitationKind = "LINK"
+)
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (InvitationKind) Values() []string {
+	return []string{string(InvitationDirect), string(InvitationLink)}
+}
+
+type InvitationStatus string
+
+const (
+	InvitationActive   InvitationStatus = "ACTIVE"
+	InvitationAccepted InvitationStatus = "ACCEPTED"
+	InvitationDeclined InvitationStatus = "DECLINED"
+	InvitationRevoked  InvitationStatus = "REVOKED"
+)
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (InvitationStatus) Values() []string {
+	return []string{
+		string(InvitationActive),
+		string(InvitationAccepted),
+		string(InvitationDeclined),
+		string(InvitationRevoked),
+	}
+}
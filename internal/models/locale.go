@@ -0,0 +1,38 @@
+package models
+
+// Locale はメールなどメンバーに送るメッセージの言語
+type Locale string
+
+const (
+	LocaleJapanese Locale = "ja"
+	LocaleKorean   Locale = "ko"
+	LocaleEnglish  Locale = "en"
+)
+
+// DefaultLocale は言語を設定していないメンバーに使う言語
+const DefaultLocale = LocaleJapanese
+
+// Locales は対応している全ての言語を返す
+func Locales() []Locale {
+	return []Locale{LocaleJapanese, LocaleKorean, LocaleEnglish}
+}
+
+// IsValid は対応している言語かどうか
+func (l Locale) IsValid() bool {
+	for _, locale := range Locales() {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (Locale) Values() []string {
+	locales := Locales()
+	values := make([]string, len(locales))
+	for i, locale := range locales {
+		values[i] = string(locale)
+	}
+	return values
+}
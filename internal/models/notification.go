@@ -0,0 +1,56 @@
+package models
+
+type NotificationKind string
+
+const (
+	// NotificationApplicationReceived はチームリーダーへの、待機リストに新しい応募者が並んだ通知
+	NotificationApplicationReceived NotificationKind = "APPLICATION_RECEIVED"
+	// NotificationApplicationAccepted は応募者への、待機していたポジションの空きが提示された通知
+	NotificationApplicationAccepted NotificationKind = "APPLICATION_ACCEPTED"
+	NotificationMemberJoined        NotificationKind = "MEMBER_JOINED"
+	NotificationMemberLeft          NotificationKind = "MEMBER_LEFT"
+	NotificationNewComment          NotificationKind = "NEW_COMMENT"
+	NotificationMentioned           NotificationKind = "MENTIONED"
+	NotificationEventReminder       NotificationKind = "EVENT_REMINDER"
+)
+
+// NotificationKinds は定義済みの全ての通知の種類を返す
+func NotificationKinds() []NotificationKind {
+	return []NotificationKind{
+		NotificationApplicationReceived,
+		NotificationApplicationAccepted,
+		NotificationMemberJoined,
+		NotificationMemberLeft,
+		NotificationNewComment,
+		NotificationMentioned,
+		NotificationEventReminder,
+	}
+}
+
+// EmailNotificationKinds はメールでも送る通知の種類を返す。メンバーは種類ごとにメールを止められる
+func EmailNotificationKinds() []NotificationKind {
+	return []NotificationKind{
+		NotificationApplicationReceived,
+		NotificationApplicationAccepted,
+	}
+}
+
+// SendsEmail はメールでも送る通知の種類かどうか
+func (k NotificationKind) SendsEmail() bool {
+	for _, kind := range EmailNotificationKinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (NotificationKind) Values() []string {
+	kinds := NotificationKinds()
+	values := make([]string, len(kinds))
+	for i, kind := range kinds {
+		values[i] = string(kind)
+	}
+	return values
+}
@@ -11,3 +11,37 @@ const (
 	Fullstack Role = "FULLSTACK"
 	Mobile    Role = "MOBILE"
 )
+
+// Roles は定義済みの全ての役割を返す
+func Roles() []Role {
+	return []Role{Frontend, Backend, Infra, Designer, Manager, Fullstack, Mobile}
+}
+
+// IsValid は定義済みの役割かどうか
+func (r Role) IsValid() bool {
+	for _, role := range Roles() {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers は r の役割を持つメンバーが other のポジションを担当できるかどうか
+// FULLSTACK はフロントエンドとバックエンドのポジションも担当できる
+func (r Role) Covers(other Role) bool {
+	if r == other {
+		return true
+	}
+	return r == Fullstack && (other == Frontend || other == Backend)
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (Role) Values() []string {
+	roles := Roles()
+	values := make([]string, len(roles))
+	for i, role := range roles {
+		values[i] = string(role)
+	}
+	return values
+}
@@ -0,0 +1,34 @@
+package models
+
+type RSVPStatus string
+
+const (
+	RSVPGoing    RSVPStatus = "GOING"
+	RSVPMaybe    RSVPStatus = "MAYBE"
+	RSVPDeclined RSVPStatus = "DECLINED"
+)
+
+// RSVPStatuses は定義済みの全ての出欠回答を返す
+func RSVPStatuses() []RSVPStatus {
+	return []RSVPStatus{RSVPGoing, RSVPMaybe, RSVPDeclined}
+}
+
+// IsValid は定義済みの出欠回答かどうか
+func (s RSVPStatus) IsValid() bool {
+	for _, status := range RSVPStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (RSVPStatus) Values() []string {
+	statuses := RSVPStatuses()
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+	return values
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type SkillCategory string
+
+const (
+	Language       SkillCategory = "LANGUAGE"
+	Framework      SkillCategory = "FRAMEWORK"
+	Database       SkillCategory = "DATABASE"
+	Infrastructure SkillCategory = "INFRASTRUCTURE"
+	Tool           SkillCategory = "TOOL"
+)
+
+// SkillDefinition は正規スキルとその別名の定義
+type SkillDefinition struct {
+	Name     string
+	Category SkillCategory
+	Aliases  []string
+}
+
+// DefaultSkillTaxonomy は起動時に登録する代表的なスキルと別名
+var DefaultSkillTaxonomy = []SkillDefinition{
+	{Name: "Go", Category: Language, Aliases: []string{"golang"}},
+	{Name: "JavaScript", Category: Language, Aliases: []string{"js", "ecmascript"}},
+	{Name: "TypeScript", Category: Language, Aliases: []string{"ts"}},
+	{Name: "Python", Category: Language, Aliases: []string{"py", "python3"}},
+	{Name: "Java", Category: Language},
+	{Name: "Kotlin", Category: Language, Aliases: []string{"kt"}},
+	{Name: "Swift", Category: Language},
+	{Name: "Rust", Category: Language, Aliases: []string{"rustlang"}},
+	{Name: "Ruby", Category: Language, Aliases: []string{"rb"}},
+	{Name: "C#", Category: Language, Aliases: []string{"csharp"}},
+	{Name: "C++", Category: Language, Aliases: []string{"cpp"}},
+	{Name: "React", Category: Framework, Aliases: []string{"reactjs"}},
+	{Name: "Vue.js", Category: Framework, Aliases: []string{"vue"}},
+	{Name: "Next.js", Category: Framework, Aliases: []string{"next"}},
+	{Name: "Node.js", Category: Framework, Aliases: []string{"node"}},
+	{Name: "Spring Boot", Category: Framework, Aliases: []string{"spring"}},
+	{Name: "Ruby on Rails", Category: Framework, Aliases: []string{"rails", "ror"}},
+	{Name: "Django", Category: Framework},
+	{Name: "Flutter", Category: Framework},
+	{Name: "MySQL", Category: Database},
+	{Name: "PostgreSQL", Category: Database, Aliases: []string{"postgres", "psql"}},
+	{Name: "Redis", Category: Database},
+	{Name: "MongoDB", Category: Database, Aliases: []string{"mongo"}},
+	{Name: "Docker", Category: Infrastructure},
+	{Name: "Kubernetes", Category: Infrastructure, Aliases: []string{"k8s"}},
+	{Name: "AWS", Category: Infrastructure, Aliases: []string{"amazon web services"}},
+	{Name: "Google Cloud", Category: Infrastructure, Aliases: []string{"gcp", "google cloud platform"}},
+	{Name: "Terraform", Category: Infrastructure},
+	{Name: "Git", Category: Tool},
+	{Name: "Figma", Category: Tool},
+}
+
+// SkillKey はスキル名を比較用に正規化する
+// 全角・半角を揃えて小文字化し、空白や区切り記号（- _ .）を取り除く
+func SkillKey(name string) string {
+	name = strings.ToLower(norm.NFKC.String(name))
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' || r == '.' {
+			return -1
+		}
+		return r
+	}, name)
+}
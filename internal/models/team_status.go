@@ -0,0 +1,35 @@
+package models
+
+type TeamStatus string
+
+const (
+	// TeamStatusRecruiting は空きポジションがあり参加を受け付けている状態
+	TeamStatusRecruiting TeamStatus = "RECRUITING"
+	// TeamStatusFull は全てのポジションが埋まっている状態
+	TeamStatusFull TeamStatus = "FULL"
+	// TeamStatusClosed はリーダーが募集を締め切った状態
+	TeamStatusClosed TeamStatus = "CLOSED"
+	// TeamStatusArchived は活動を終えたチーム。再開できない
+	TeamStatusArchived TeamStatus = "ARCHIVED"
+)
+
+// TeamStatuses は定義済みの全てのチーム状態を返す
+func TeamStatuses() []TeamStatus {
+	return []TeamStatus{TeamStatusRecruiting, TeamStatusFull, TeamStatusClosed, TeamStatusArchived}
+}
+
+// IsManual はリーダーが手動で設定した状態かどうか
+// 手動で設定した状態は参加・脱退・ポジション変更では変わらない
+func (s TeamStatus) IsManual() bool {
+	return s == TeamStatusClosed || s == TeamStatusArchived
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (TeamStatus) Values() []string {
+	statuses := TeamStatuses()
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+	return values
+}
@@ -1,8 +1,8 @@
 package models
 
 type Vacancy struct {
-	Role    Role `json:"role"`
-	Vacancy int8 `json:"vacancy"`
+	Role    Role `json:"role" validate:"required,role"`
+	Vacancy int8 `json:"vacancy" validate:"min=0,max=100"`
 }
 
 // NewVacancy creates a new Vacancy instance
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+type WaitlistStatus string
+
+const (
+	// WaitlistWaiting は空きを待っている状態
+	WaitlistWaiting WaitlistStatus = "WAITING"
+	// WaitlistOffered は空きを確保して承諾を待っている状態
+	WaitlistOffered   WaitlistStatus = "OFFERED"
+	WaitlistAccepted  WaitlistStatus = "ACCEPTED"
+	WaitlistExpired   WaitlistStatus = "EXPIRED"
+	WaitlistCancelled WaitlistStatus = "CANCELLED"
+)
+
+// WaitlistOfferTTL は空きを提示してから承諾できる期間
+const WaitlistOfferTTL = 24 * time.Hour
+
+// WaitlistStatuses は定義済みの全ての待機状態を返す
+func WaitlistStatuses() []WaitlistStatus {
+	return []WaitlistStatus{WaitlistWaiting, WaitlistOffered, WaitlistAccepted, WaitlistExpired, WaitlistCancelled}
+}
+
+// IsActive はまだ待ち行列に並んでいる状態かどうか
+func (s WaitlistStatus) IsActive() bool {
+	return s == WaitlistWaiting || s == WaitlistOffered
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (WaitlistStatus) Values() []string {
+	statuses := WaitlistStatuses()
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+	return values
+}
@@ -0,0 +1,74 @@
+package models
+
+// WebhookFormat は Webhook に送る本文の形式
+type WebhookFormat string
+
+const (
+	// WebhookGeneric はイベントの内容をそのまま JSON で送る
+	WebhookGeneric WebhookFormat = "GENERIC"
+	// WebhookSlack は Slack の Incoming Webhook の形式で送る
+	WebhookSlack WebhookFormat = "SLACK"
+	// WebhookDiscord は Discord の Webhook の形式で送る
+	WebhookDiscord WebhookFormat = "DISCORD"
+)
+
+// WebhookFormats は定義済みの全ての本文の形式を返す
+func WebhookFormats() []WebhookFormat {
+	return []WebhookFormat{WebhookGeneric, WebhookSlack, WebhookDiscord}
+}
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (WebhookFormat) Values() []string {
+	formats := WebhookFormats()
+	values := make([]string, len(formats))
+	for i, format := range formats {
+		values[i] = string(format)
+	}
+	return values
+}
+
+// WebhookEventTypes は Webhook で受け取れるドメインイベントの種類を返す
+func WebhookEventTypes() []DomainEventType {
+	return []DomainEventType{
+		DomainEventAnnouncementCreated,
+		DomainEventMemberJoined,
+		DomainEventMemberLeft,
+		DomainEventWaitlistJoined,
+	}
+}
+
+// IsWebhookEvent は Webhook で受け取れるドメインイベントかどうか
+func (t DomainEventType) IsWebhookEvent() bool {
+	for _, eventType := range WebhookEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending は送信待ち、または再送待ちの状態
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed は再送の上限に達したか、Webhook が無効になって送るのを諦めた状態
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+// Values は ent の Enum フィールドで使う値の一覧
+func (WebhookDeliveryStatus) Values() []string {
+	return []string{
+		string(WebhookDeliveryPending),
+		string(WebhookDeliverySucceeded),
+		string(WebhookDeliveryFailed),
+	}
+}
+
+const (
+	// WebhookMaxAttempts は1件の送信を試みる回数の上限
+	WebhookMaxAttempts = 6
+	// WebhookDisableAfter は Webhook を自動で無効にするまでに連続して失敗できる回数
+	WebhookDisableAfter = 10
+)
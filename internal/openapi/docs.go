@@ -0,0 +1,250 @@
+package openapi
+
+import (
+	"backend_golang/internal/logging"
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+//go:embed docs.html
+var docsHTML string
+
+var docsTemplate = template.Must(template.New("docs").Parse(docsHTML))
+
+// docsPage は API ドキュメントのページに表示する内容。外部のスクリプトを使わず、仕様書からサーバーで HTML を作る
+type docsPage struct {
+	Title       string
+	Version     string
+	Description string
+	SpecURL     string
+	Tags        []*docsTag
+	Schemas     []docsSchema
+}
+
+type docsTag struct {
+	Name       string
+	Operations []docsOperation
+}
+
+type docsOperation struct {
+	Method       string
+	Path         string
+	OperationID  string
+	Summary      string
+	Description  string
+	RequiresAuth bool
+	Parameters   []docsParameter
+	RequestBody  string
+	Responses    []docsResponse
+}
+
+type docsParameter struct {
+	Name        string
+	In          string
+	Required    bool
+	Type        string
+	Description string
+}
+
+type docsResponse struct {
+	Status      string
+	Description string
+	Schema      string
+}
+
+type docsSchema struct {
+	Name   string
+	Schema string
+}
+
+// docsDocument は仕様書のうちドキュメントに表示する部分
+type docsDocument struct {
+	Info struct {
+		Title       string `json:"title"`
+		Version     string `json:"version"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Paths      map[string]map[string]docsOperationDoc `json:"paths"`
+	Components struct {
+		Schemas    map[string]json.RawMessage  `json:"schemas"`
+		Parameters map[string]docsParameterDoc `json:"parameters"`
+		Responses  map[string]docsResponseDoc  `json:"responses"`
+	} `json:"components"`
+}
+
+type docsOperationDoc struct {
+	OperationID string             `json:"operationId"`
+	Summary     string             `json:"summary"`
+	Description string             `json:"description"`
+	Tags        []string           `json:"tags"`
+	Parameters  []docsParameterDoc `json:"parameters"`
+	RequestBody *struct {
+		Content map[string]docsMediaDoc `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]docsResponseDoc `json:"responses"`
+	Security  []map[string][]string      `json:"security"`
+}
+
+type docsParameterDoc struct {
+	Ref         string `json:"$ref"`
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Required    bool   `json:"required"`
+	Description string `json:"description"`
+	Schema      struct {
+		Type   string `json:"type"`
+		Format string `json:"format"`
+	} `json:"schema"`
+}
+
+type docsResponseDoc struct {
+	Ref         string                  `json:"$ref"`
+	Description string                  `json:"description"`
+	Content     map[string]docsMediaDoc `json:"content"`
+}
+
+type docsMediaDoc struct {
+	Schema json.RawMessage `json:"schema"`
+}
+
+// newDocsPage はまとめた仕様書からページの内容を作る。操作はタグごとにパスとメソッドの順に並べる
+func newDocsPage(raw []byte, specURL string) (*docsPage, error) {
+	var doc docsDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	page := &docsPage{
+		Title:       doc.Info.Title,
+		Version:     doc.Info.Version,
+		Description: doc.Info.Description,
+		SpecURL:     specURL,
+	}
+	tags := make(map[string]*docsTag)
+	paths := make([]string, 0, len(doc.Paths))
+	for p := range doc.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		for _, method := range methods {
+			op, ok := doc.Paths[p][method]
+			if !ok {
+				continue
+			}
+			operation := docsOperation{
+				Method:       strings.ToUpper(method),
+				Path:         p,
+				OperationID:  op.OperationID,
+				Summary:      op.Summary,
+				Description:  op.Description,
+				RequiresAuth: (&Operation{Security: op.Security}).RequiresAuth(),
+			}
+			for _, param := range op.Parameters {
+				if param.Ref != "" {
+					param = doc.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+				}
+				typ := param.Schema.Type
+				if param.Schema.Format != "" {
+					typ += " (" + param.Schema.Format + ")"
+				}
+				operation.Parameters = append(operation.Parameters, docsParameter{
+					Name:        param.Name,
+					In:          param.In,
+					Required:    param.Required,
+					Type:        typ,
+					Description: param.Description,
+				})
+			}
+			if op.RequestBody != nil {
+				operation.RequestBody = mediaSchema(op.RequestBody.Content)
+			}
+			statuses := make([]string, 0, len(op.Responses))
+			for status := range op.Responses {
+				statuses = append(statuses, status)
+			}
+			sort.Strings(statuses)
+			for _, status := range statuses {
+				resp := op.Responses[status]
+				if resp.Ref != "" {
+					resp = doc.Components.Responses[strings.TrimPrefix(resp.Ref, "#/components/responses/")]
+				}
+				operation.Responses = append(operation.Responses, docsResponse{
+					Status:      status,
+					Description: resp.Description,
+					Schema:      mediaSchema(resp.Content),
+				})
+			}
+
+			name := "その他"
+			if len(op.Tags) > 0 {
+				name = op.Tags[0]
+			}
+			tag, ok := tags[name]
+			if !ok {
+				tag = &docsTag{Name: name}
+				tags[name] = tag
+				page.Tags = append(page.Tags, tag)
+			}
+			tag.Operations = append(tag.Operations, operation)
+		}
+	}
+
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		page.Schemas = append(page.Schemas, docsSchema{Name: name, Schema: indentJSON(doc.Components.Schemas[name])})
+	}
+	return page, nil
+}
+
+// mediaSchema は JSON のスキーマを優先して、整形したスキーマを返す
+func mediaSchema(content map[string]docsMediaDoc) string {
+	if media, ok := content["application/json"]; ok {
+		return indentJSON(media.Schema)
+	}
+	for _, media := range content {
+		return indentJSON(media.Schema)
+	}
+	return ""
+}
+
+func indentJSON(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, raw, "", "  "); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
+// DocsHandler は仕様書の内容を表示する API ドキュメントのページを返す。specURL は仕様書をダウンロードするリンクに使う
+func (s *Spec) DocsHandler(specURL string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, err := newDocsPage(s.raw, specURL)
+		if err != nil {
+			logging.FromContext(r.Context()).Error("failed building docs page", "error", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		// 途中まで書いたページを返さないように、すべて作ってから書き込む
+		var buf bytes.Buffer
+		if err := docsTemplate.Execute(&buf, page); err != nil {
+			logging.FromContext(r.Context()).Error("failed rendering docs page", "error", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(buf.Bytes())
+	})
+}
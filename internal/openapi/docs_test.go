@@ -0,0 +1,37 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_DocsHandler(t *testing.T) {
+	spec, err := Load(fstest.MapFS{
+		"openapi.yaml":       {Data: []byte(testRoot)},
+		"teams.yaml":         {Data: []byte(testTeams)},
+		"announcements.yaml": {Data: []byte(testAnnouncements)},
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	spec.DocsHandler("/openapi.json").ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+	body := recorder.Body.String()
+	assert.Contains(t, body, `href="/openapi.json"`)
+	assert.Contains(t, body, `id="getTeam"`)
+	assert.Contains(t, body, "/v1/teams/{teamID}")
+	// $ref のパラメーターとレスポンスは参照先の内容を表示する
+	assert.Contains(t, body, "<td>teamID</td><td>path</td><td>integer</td>")
+	assert.Contains(t, body, "<td>429</td><td>rate limited")
+	assert.Contains(t, body, `id="schema-Member"`)
+	// 外部のスクリプトやスタイルシートを読み込まない
+	assert.NotContains(t, body, "<script")
+	assert.NotContains(t, body, "https://")
+}
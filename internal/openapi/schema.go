@@ -0,0 +1,264 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema は仕様書で使っている範囲の JSON Schema
+type Schema struct {
+	Ref                  string                `json:"$ref"`
+	Type                 string                `json:"type"`
+	Format               string                `json:"format"`
+	Nullable             bool                  `json:"nullable"`
+	Enum                 []any                 `json:"enum"`
+	Properties           map[string]*Schema    `json:"properties"`
+	Required             []string              `json:"required"`
+	AdditionalProperties *AdditionalProperties `json:"additionalProperties"`
+	Items                *Schema               `json:"items"`
+	MinItems             *int                  `json:"minItems"`
+	MaxItems             *int                  `json:"maxItems"`
+	UniqueItems          bool                  `json:"uniqueItems"`
+	MinLength            *int                  `json:"minLength"`
+	MaxLength            *int                  `json:"maxLength"`
+	Minimum              *float64              `json:"minimum"`
+	Maximum              *float64              `json:"maximum"`
+}
+
+// AdditionalProperties は additionalProperties の真偽値かスキーマ
+type AdditionalProperties struct {
+	Allowed bool
+	Schema  *Schema
+}
+
+func (a *AdditionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.Allowed); err == nil {
+		return nil
+	}
+	a.Allowed = true
+	return json.Unmarshal(data, &a.Schema)
+}
+
+// FieldError は検証に失敗した値の場所と理由
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Validate は encoding/json で UseNumber を指定して読み込んだ value を検証し、違反を全て返す
+func (s *Schema) Validate(value any) []FieldError {
+	var errs []FieldError
+	s.validate("", value, &errs)
+	return errs
+}
+
+func (s *Schema) validate(field string, value any, errs *[]FieldError) {
+	if s == nil {
+		return
+	}
+	fail := func(format string, args ...any) {
+		*errs = append(*errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if value == nil {
+		if !s.Nullable && s.Type != "" {
+			fail("must be %s, not null", s.Type)
+		}
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			fail("must be an object")
+			return
+		}
+		s.validateObject(field, object, errs)
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			fail("must be an array")
+			return
+		}
+		s.validateArray(field, array, errs)
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			fail("must be a string")
+			return
+		}
+		s.validateString(str, fail)
+	case "integer", "number":
+		number, ok := toFloat(value)
+		if !ok {
+			fail("must be %s", typeName(s.Type))
+			return
+		}
+		if s.Type == "integer" && number != math.Trunc(number) {
+			fail("must be an integer")
+			return
+		}
+		if s.Minimum != nil && number < *s.Minimum {
+			fail("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && number > *s.Maximum {
+			fail("must be at most %v", *s.Maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("must be a boolean")
+			return
+		}
+	}
+
+	if len(s.Enum) > 0 && !s.inEnum(value) {
+		fail("must be one of %s", s.enumString())
+	}
+}
+
+func (s *Schema) validateObject(field string, object map[string]any, errs *[]FieldError) {
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			*errs = append(*errs, FieldError{Field: join(field, name), Message: "This field is required"})
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if property, ok := s.Properties[name]; ok {
+			property.validate(join(field, name), object[name], errs)
+			continue
+		}
+		if s.AdditionalProperties == nil {
+			continue
+		}
+		if !s.AdditionalProperties.Allowed {
+			*errs = append(*errs, FieldError{Field: join(field, name), Message: "unknown field"})
+			continue
+		}
+		s.AdditionalProperties.Schema.validate(join(field, name), object[name], errs)
+	}
+}
+
+func (s *Schema) validateArray(field string, array []any, errs *[]FieldError) {
+	if s.MinItems != nil && len(array) < *s.MinItems {
+		*errs = append(*errs, FieldError{Field: field, Message: fmt.Sprintf("must have at least %d items", *s.MinItems)})
+	}
+	if s.MaxItems != nil && len(array) > *s.MaxItems {
+		*errs = append(*errs, FieldError{Field: field, Message: fmt.Sprintf("must have at most %d items", *s.MaxItems)})
+	}
+	for i, item := range array {
+		if s.UniqueItems {
+			for _, prev := range array[:i] {
+				if reflect.DeepEqual(prev, item) {
+					*errs = append(*errs, FieldError{Field: fmt.Sprintf("%s[%d]", field, i), Message: "must be unique"})
+					break
+				}
+			}
+		}
+		s.Items.validate(fmt.Sprintf("%s[%d]", field, i), item, errs)
+	}
+}
+
+func (s *Schema) validateString(str string, fail func(format string, args ...any)) {
+	length := len([]rune(str))
+	if s.MinLength != nil && length < *s.MinLength {
+		fail("must be at least %d characters long", *s.MinLength)
+	}
+	if s.MaxLength != nil && length > *s.MaxLength {
+		fail("must be at most %d characters long", *s.MaxLength)
+	}
+	switch s.Format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			fail("must be an RFC 3339 date-time")
+		}
+	case "uri":
+		if u, err := url.Parse(str); err != nil || u.Scheme == "" {
+			fail("must be an absolute URI")
+		}
+	}
+}
+
+func (s *Schema) inEnum(value any) bool {
+	for _, candidate := range s.Enum {
+		if fmt.Sprint(candidate) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schema) enumString() string {
+	values := make([]string, len(s.Enum))
+	for i, value := range s.Enum {
+		values[i] = fmt.Sprint(value)
+	}
+	return strings.Join(values, ", ")
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// typeName は数値の型をメッセージに使う形にする
+func typeName(typ string) string {
+	if typ == "integer" {
+		return "an integer"
+	}
+	return "a number"
+}
+
+func join(field string, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
+
+// ValidateParameter はパスやクエリの文字列の値を型に合わせて変換してから検証する
+func (s *Schema) ValidateParameter(name string, raw string) []FieldError {
+	var value any = raw
+	switch s.Type {
+	case "integer", "number":
+		value = json.Number(raw)
+		if _, err := json.Number(raw).Float64(); err != nil {
+			return []FieldError{{Field: name, Message: "must be " + typeName(s.Type)}}
+		}
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return []FieldError{{Field: name, Message: "must be a boolean"}}
+		}
+		value = b
+	}
+	var errs []FieldError
+	s.validate(name, value, &errs)
+	return errs
+}
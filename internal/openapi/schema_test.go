@@ -0,0 +1,115 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeSchema(t *testing.T, data string) *Schema {
+	t.Helper()
+	var schema Schema
+	require.NoError(t, json.Unmarshal([]byte(data), &schema))
+	return &schema
+}
+
+func decodeValue(t *testing.T, data string) any {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	require.NoError(t, decoder.Decode(&value))
+	return value
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := decodeSchema(t, `{
+		"type": "object",
+		"required": ["teamName", "positions"],
+		"additionalProperties": false,
+		"properties": {
+			"teamName": {"type": "string", "minLength": 1, "maxLength": 5},
+			"startsAt": {"type": "string", "format": "date-time"},
+			"url": {"type": "string", "format": "uri"},
+			"note": {"type": "string", "nullable": true},
+			"positions": {
+				"type": "array",
+				"minItems": 1,
+				"uniqueItems": true,
+				"items": {
+					"type": "object",
+					"properties": {
+						"role": {"type": "string", "enum": ["BACKEND", "FRONTEND"]},
+						"vacancy": {"type": "integer", "minimum": 1}
+					}
+				}
+			}
+		}
+	}`)
+
+	assert.Empty(t, schema.Validate(decodeValue(t, `{
+		"teamName": "go",
+		"startsAt": "2030-01-01T10:00:00+09:00",
+		"url": "https://example.com",
+		"note": null,
+		"positions": [{"role": "BACKEND", "vacancy": 2}]
+	}`)))
+
+	errs := schema.Validate(decodeValue(t, `{
+		"teamName": "gophers",
+		"startsAt": "2030-01-01",
+		"url": "example.com",
+		"positions": [{"role": "DESIGN", "vacancy": 1.5}, {"role": "DESIGN", "vacancy": 1.5}],
+		"extra": true
+	}`))
+	assert.ElementsMatch(t, []FieldError{
+		{Field: "teamName", Message: "must be at most 5 characters long"},
+		{Field: "startsAt", Message: "must be an RFC 3339 date-time"},
+		{Field: "url", Message: "must be an absolute URI"},
+		{Field: "positions[0].role", Message: "must be one of BACKEND, FRONTEND"},
+		{Field: "positions[0].vacancy", Message: "must be an integer"},
+		{Field: "positions[1]", Message: "must be unique"},
+		{Field: "positions[1].role", Message: "must be one of BACKEND, FRONTEND"},
+		{Field: "positions[1].vacancy", Message: "must be an integer"},
+		{Field: "extra", Message: "unknown field"},
+	}, errs)
+
+	errs = schema.Validate(decodeValue(t, `{"teamName": null, "positions": []}`))
+	assert.ElementsMatch(t, []FieldError{
+		{Field: "teamName", Message: "must be string, not null"},
+		{Field: "positions", Message: "must have at least 1 items"},
+	}, errs)
+
+	errs = schema.Validate(decodeValue(t, `{}`))
+	assert.ElementsMatch(t, []FieldError{
+		{Field: "teamName", Message: "This field is required"},
+		{Field: "positions", Message: "This field is required"},
+	}, errs)
+
+	assert.Equal(t, []FieldError{{Message: "must be an object"}}, schema.Validate(decodeValue(t, `[]`)))
+}
+
+func TestSchema_ValidateAdditionalPropertiesSchema(t *testing.T) {
+	schema := decodeSchema(t, `{"type": "object", "additionalProperties": {"type": "boolean"}}`)
+
+	assert.Empty(t, schema.Validate(decodeValue(t, `{"MEMBER_JOINED": true}`)))
+	assert.Equal(t, []FieldError{{Field: "MEMBER_JOINED", Message: "must be a boolean"}},
+		schema.Validate(decodeValue(t, `{"MEMBER_JOINED": "yes"}`)))
+}
+
+func TestSchema_ValidateParameter(t *testing.T) {
+	id := decodeSchema(t, `{"type": "integer", "minimum": 1}`)
+	assert.Empty(t, id.ValidateParameter("teamID", "3"))
+	assert.Equal(t, []FieldError{{Field: "teamID", Message: "must be an integer"}}, id.ValidateParameter("teamID", "abc"))
+	assert.Equal(t, []FieldError{{Field: "teamID", Message: "must be at least 1"}}, id.ValidateParameter("teamID", "0"))
+
+	flag := decodeSchema(t, `{"type": "boolean"}`)
+	assert.Empty(t, flag.ValidateParameter("unread", "true"))
+	assert.Equal(t, []FieldError{{Field: "unread", Message: "must be a boolean"}}, flag.ValidateParameter("unread", "yes"))
+
+	kind := decodeSchema(t, `{"type": "string", "enum": ["team", "announcement"]}`)
+	assert.Equal(t, []FieldError{{Field: "type", Message: "must be one of team, announcement"}}, kind.ValidateParameter("type", "event"))
+}
@@ -0,0 +1,335 @@
+// Package openapi は api/*.yaml の OpenAPI 仕様書を1つにまとめ、リクエストとレスポンスを検証する
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// rootFile は info や servers など全体の設定を書いた仕様書。他の仕様書の paths と components をこれにまとめる
+const rootFile = "openapi.yaml"
+
+// methods は検証対象の HTTP メソッド
+var methods = []string{"get", "post", "put", "patch", "delete"}
+
+// Spec はまとめた仕様書
+type Spec struct {
+	// Operations は gin のルート（例: "GET /v1/teams/:teamID"）ごとの操作
+	Operations map[string]*Operation
+	raw        []byte
+}
+
+type Operation struct {
+	Method      string                `json:"-"`
+	Path        string                `json:"-"`
+	OperationID string                `json:"operationId"`
+	Parameters  []*Parameter          `json:"parameters"`
+	RequestBody *RequestBody          `json:"requestBody"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security"`
+}
+
+type Parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type document struct {
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components struct {
+		Schemas    map[string]*Schema    `json:"schemas"`
+		Parameters map[string]*Parameter `json:"parameters"`
+		Responses  map[string]*Response  `json:"responses"`
+	} `json:"components"`
+}
+
+// Load は fsys の *.yaml を openapi.yaml にまとめる。
+// 別の仕様書の定義を指す $ref（例: teams.yaml#/components/schemas/Member）はまとめた仕様書の中を指すように書き換える
+func Load(fsys fs.FS) (*Spec, error) {
+	root, err := readYAML(fsys, rootFile)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := fs.Glob(fsys, "*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == rootFile {
+			continue
+		}
+		doc, err := readYAML(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := merge(root, doc, name); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := json.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	return parse(raw)
+}
+
+func readYAML(fsys fs.FS, name string) (map[string]any, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return rewriteRefs(doc).(map[string]any), nil
+}
+
+// rewriteRefs は他の仕様書を指す $ref をファイル名を除いた参照に書き換える
+func rewriteRefs(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if ref, ok := child.(string); ok && key == "$ref" {
+				if i := strings.Index(ref, "#"); i > 0 {
+					v[key] = ref[i:]
+				}
+				continue
+			}
+			v[key] = rewriteRefs(child)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = rewriteRefs(child)
+		}
+	}
+	return value
+}
+
+// merge は doc の paths、components、tags を root に加える。同じ名前で内容の異なる定義があればエラーにする
+func merge(root map[string]any, doc map[string]any, name string) error {
+	rootPaths := child(root, "paths")
+	for p, item := range asMap(doc["paths"]) {
+		rootItem := child(rootPaths, p)
+		for method, op := range asMap(item) {
+			if _, ok := rootItem[method]; ok {
+				return fmt.Errorf("%s: %s %s is already defined", name, strings.ToUpper(method), p)
+			}
+			rootItem[method] = op
+		}
+	}
+
+	rootComponents := child(root, "components")
+	for kind, defs := range asMap(doc["components"]) {
+		rootDefs := child(rootComponents, kind)
+		for defName, def := range asMap(defs) {
+			if existing, ok := rootDefs[defName]; ok && !reflect.DeepEqual(existing, def) {
+				return fmt.Errorf("%s: components.%s.%s conflicts with another definition", name, kind, defName)
+			}
+			rootDefs[defName] = def
+		}
+	}
+
+	if tags, ok := doc["tags"].([]any); ok {
+		rootTags, _ := root["tags"].([]any)
+		root["tags"] = append(rootTags, tags...)
+	}
+	return nil
+}
+
+func child(parent map[string]any, key string) map[string]any {
+	m, ok := parent[key].(map[string]any)
+	if !ok {
+		m = make(map[string]any)
+		parent[key] = m
+	}
+	return m
+}
+
+func asMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
+
+// parse はまとめた仕様書から検証に使う操作を取り出し、$ref を解決する
+func parse(raw []byte) (*Spec, error) {
+	var doc document
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	r := &resolver{schemas: doc.Components.Schemas, seen: make(map[*Schema]bool)}
+	for name, schema := range doc.Components.Schemas {
+		if err := r.resolve(&schema); err != nil {
+			return nil, err
+		}
+		doc.Components.Schemas[name] = schema
+	}
+
+	spec := &Spec{Operations: make(map[string]*Operation), raw: raw}
+	for p, item := range doc.Paths {
+		for _, method := range methods {
+			op, ok := item[method]
+			if !ok {
+				continue
+			}
+			op.Method = strings.ToUpper(method)
+			op.Path = p
+
+			for i, param := range op.Parameters {
+				if param.Ref != "" {
+					resolved, ok := doc.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+					if !ok {
+						return nil, fmt.Errorf("%s %s: unknown parameter %s", op.Method, p, param.Ref)
+					}
+					op.Parameters[i] = resolved
+				}
+				if err := r.resolve(&op.Parameters[i].Schema); err != nil {
+					return nil, err
+				}
+			}
+			if op.RequestBody != nil {
+				for _, media := range op.RequestBody.Content {
+					if err := r.resolve(&media.Schema); err != nil {
+						return nil, err
+					}
+				}
+			}
+			for status, resp := range op.Responses {
+				if resp == nil {
+					continue
+				}
+				if resp.Ref != "" {
+					resolved, ok := doc.Components.Responses[strings.TrimPrefix(resp.Ref, "#/components/responses/")]
+					if !ok {
+						return nil, fmt.Errorf("%s %s: unknown response %s", op.Method, p, resp.Ref)
+					}
+					resp = resolved
+					op.Responses[status] = resp
+				}
+				for _, media := range resp.Content {
+					if err := r.resolve(&media.Schema); err != nil {
+						return nil, err
+					}
+				}
+			}
+			spec.Operations[op.Method+" "+RoutePath(p)] = op
+		}
+	}
+	return spec, nil
+}
+
+// RoutePath は OpenAPI のパス（/v1/teams/{teamID}）を gin のルート（/v1/teams/:teamID）に変換する
+func RoutePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + segment[1:len(segment)-1]
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// Operation は gin のルートに対応する操作を返す
+func (s *Spec) Operation(method string, route string) (*Operation, bool) {
+	op, ok := s.Operations[method+" "+route]
+	return op, ok
+}
+
+// Handler はまとめた仕様書を JSON で返す
+func (s *Spec) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(s.raw)
+	})
+}
+
+// RequiresAuth は認証が必須の操作かどうか。security に空の要件（{}）を含む場合は認証は任意
+func (o *Operation) RequiresAuth() bool {
+	if len(o.Security) == 0 {
+		return false
+	}
+	for _, requirement := range o.Security {
+		if len(requirement) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Response は status に対応するレスポンスの定義を返す。なければ default を返す
+func (o *Operation) Response(status int) (*Response, bool) {
+	resp, ok := o.Responses[fmt.Sprint(status)]
+	if !ok {
+		resp, ok = o.Responses["default"]
+	}
+	if ok && resp == nil {
+		resp = &Response{}
+	}
+	return resp, ok
+}
+
+type resolver struct {
+	schemas map[string]*Schema
+	seen    map[*Schema]bool
+}
+
+// resolve は schema が $ref なら components.schemas の定義に置き換え、その下の $ref も解決する
+func (r *resolver) resolve(schema **Schema) error {
+	if *schema == nil {
+		return nil
+	}
+	if ref := (*schema).Ref; ref != "" {
+		target, ok := r.schemas[strings.TrimPrefix(ref, "#/components/schemas/")]
+		if !ok {
+			return fmt.Errorf("unknown schema %s", ref)
+		}
+		*schema = target
+	}
+	s := *schema
+	if r.seen[s] {
+		return nil
+	}
+	r.seen[s] = true
+
+	for name, property := range s.Properties {
+		if err := r.resolve(&property); err != nil {
+			return err
+		}
+		s.Properties[name] = property
+	}
+	if err := r.resolve(&s.Items); err != nil {
+		return err
+	}
+	if s.AdditionalProperties != nil {
+		return r.resolve(&s.AdditionalProperties.Schema)
+	}
+	return nil
+}
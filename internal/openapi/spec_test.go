@@ -0,0 +1,164 @@
+package openapi
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRoot = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths: {}
+components:
+  responses:
+    TooManyRequests:
+      description: rate limited
+      content:
+        application/json:
+          schema:
+            type: object
+            required: [error]
+`
+
+const testTeams = `
+paths:
+  /v1/teams/{teamID}:
+    get:
+      operationId: getTeam
+      security:
+        - {}
+        - CookieAuth: []
+      parameters:
+        - $ref: '#/components/parameters/TeamID'
+      responses:
+        '200':
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Team'
+        '429':
+          $ref: 'openapi.yaml#/components/responses/TooManyRequests'
+        default:
+          description: error
+components:
+  parameters:
+    TeamID:
+      name: teamID
+      in: path
+      required: true
+      schema:
+        type: integer
+        minimum: 1
+  schemas:
+    Team:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        members:
+          type: array
+          items:
+            $ref: '#/components/schemas/Member'
+    Member:
+      type: object
+      properties:
+        id:
+          type: string
+`
+
+const testAnnouncements = `
+paths:
+  /v1/announcements:
+    post:
+      security:
+        - CookieAuth: []
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                team:
+                  $ref: 'teams.yaml#/components/schemas/Team'
+      responses:
+        '201':
+          description: created
+components:
+  schemas:
+    Member:
+      type: object
+      properties:
+        id:
+          type: string
+`
+
+func TestLoad(t *testing.T) {
+	spec, err := Load(fstest.MapFS{
+		"openapi.yaml":       {Data: []byte(testRoot)},
+		"teams.yaml":         {Data: []byte(testTeams)},
+		"announcements.yaml": {Data: []byte(testAnnouncements)},
+		"README.md":          {Data: []byte("ignored")},
+	})
+	require.NoError(t, err)
+	assert.Len(t, spec.Operations, 2)
+
+	op, ok := spec.Operation("GET", "/v1/teams/:teamID")
+	require.True(t, ok)
+	assert.Equal(t, "getTeam", op.OperationID)
+	assert.False(t, op.RequiresAuth())
+	require.Len(t, op.Parameters, 1)
+	assert.Equal(t, "teamID", op.Parameters[0].Name)
+
+	resp, ok := op.Response(200)
+	require.True(t, ok)
+	team := resp.Content["application/json"].Schema
+	assert.Equal(t, "object", team.Type)
+	assert.Equal(t, "string", team.Properties["members"].Items.Properties["id"].Type)
+	resp, ok = op.Response(429)
+	require.True(t, ok)
+	assert.Equal(t, []string{"error"}, resp.Content["application/json"].Schema.Required)
+	_, ok = op.Response(404)
+	assert.True(t, ok, "default のレスポンスを返す")
+
+	// 他のファイルを指す $ref はまとめた仕様書の定義に解決する
+	op, ok = spec.Operation("POST", "/v1/announcements")
+	require.True(t, ok)
+	assert.True(t, op.RequiresAuth())
+	assert.Equal(t, []string{"name"}, op.RequestBody.Content["application/json"].Schema.Properties["team"].Required)
+	_, ok = op.Response(404)
+	assert.False(t, ok)
+}
+
+func TestLoad_Conflicts(t *testing.T) {
+	_, err := Load(fstest.MapFS{
+		"openapi.yaml": {Data: []byte(testRoot)},
+		"a.yaml":       {Data: []byte(testTeams)},
+		"b.yaml":       {Data: []byte(testTeams)},
+	})
+	assert.ErrorContains(t, err, "GET /v1/teams/{teamID} is already defined")
+
+	_, err = Load(fstest.MapFS{
+		"openapi.yaml": {Data: []byte(testRoot)},
+		"a.yaml":       {Data: []byte("components:\n  schemas:\n    Member:\n      type: string\n")},
+		"b.yaml":       {Data: []byte("components:\n  schemas:\n    Member:\n      type: integer\n")},
+	})
+	assert.ErrorContains(t, err, "components.schemas.Member conflicts")
+
+	_, err = Load(fstest.MapFS{
+		"openapi.yaml": {Data: []byte(testRoot)},
+		"a.yaml":       {Data: []byte("components:\n  schemas:\n    Team:\n      $ref: '#/components/schemas/Missing'\n")},
+	})
+	assert.ErrorContains(t, err, "unknown schema #/components/schemas/Missing")
+}
+
+func TestRoutePath(t *testing.T) {
+	assert.Equal(t, "/v1/teams", RoutePath("/v1/teams"))
+	assert.Equal(t, "/v1/teams/:teamID/webhooks/:webhookID", RoutePath("/v1/teams/{teamID}/webhooks/{webhookID}"))
+}
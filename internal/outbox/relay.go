@@ -0,0 +1,126 @@
+package outbox
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultBatchSize   = 100
+	defaultMaxAttempts = 10
+	defaultBackoff     = 10 * time.Second
+	maxBackoff         = time.Hour
+)
+
+// Handler はドメインイベントを処理する。同じイベントを2回以上受け取っても結果が変わらないようにする
+type Handler func(ctx context.Context, event domain.DomainEvent) error
+
+// Store は未配信のドメインイベントの読み出しと配信結果の記録を行う
+type Store interface {
+	FindPending(ctx context.Context, now time.Time, limit int) ([]domain.DomainEvent, error)
+	MarkDispatched(ctx context.Context, id int, now time.Time) error
+	Retry(ctx context.Context, id int, cause string, at time.Time) error
+	Abandon(ctx context.Context, id int, cause string, now time.Time) error
+}
+
+type subscriber struct {
+	name    string
+	types   map[models.DomainEventType]bool
+	handler Handler
+}
+
+// Relay は outbox に記録されたドメインイベントをプロセス内の購読者に配信する
+// 全ての購読者が成功したイベントだけを配信済みにするため、少なくとも1回は配信される
+type Relay struct {
+	store       Store
+	subscribers []subscriber
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+func NewRelay(store Store) *Relay {
+	return &Relay{
+		store:       store,
+		batchSize:   defaultBatchSize,
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+		now:         time.Now,
+	}
+}
+
+// Subscribe は types のイベントを handler に配信する。types を省略すると全てのイベントを配信する
+// Dispatch を呼び出す前に登録する
+func (r *Relay) Subscribe(name string, handler Handler, types ...models.DomainEventType) {
+	s := subscriber{name: name, handler: handler}
+	if len(types) > 0 {
+		s.types = make(map[models.DomainEventType]bool, len(types))
+		for _, t := range types {
+			s.types[t] = true
+		}
+	}
+	r.subscribers = append(r.subscribers, s)
+}
+
+// Dispatch は配信時刻を過ぎたイベントを記録順に配信する。定期的に実行する
+// 購読者が失敗したイベントは間隔を空けて全ての購読者に再配信し、上限に達したら諦める
+func (r *Relay) Dispatch(ctx context.Context) error {
+	events, err := r.store.FindPending(ctx, r.now(), r.batchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := r.deliver(ctx, event); err != nil {
+			if err := r.fail(ctx, event, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.store.MarkDispatched(ctx, event.ID, r.now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Relay) deliver(ctx context.Context, event domain.DomainEvent) error {
+	var errs []error
+	for _, s := range r.subscribers {
+		if s.types != nil && !s.types[event.Type] {
+			continue
+		}
+		if err := s.handler(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Relay) fail(ctx context.Context, event domain.DomainEvent, cause error) error {
+	attempts := event.Attempts + 1
+	if attempts >= r.maxAttempts {
+		logging.FromContext(ctx).Error("abandoned outbox event", "event_id", event.ID, "type", event.Type, "attempts", attempts, "error", cause)
+		return r.store.Abandon(ctx, event.ID, cause.Error(), r.now())
+	}
+	logging.FromContext(ctx).Warn("outbox event failed", "event_id", event.ID, "type", event.Type, "attempts", attempts, "error", cause)
+	return r.store.Retry(ctx, event.ID, cause.Error(), r.now().Add(r.retryDelay(attempts)))
+}
+
+// retryDelay は attempts 回目の失敗の後に待つ時間。失敗するたびに倍にする
+func (r *Relay) retryDelay(attempts int) time.Duration {
+	delay := r.backoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	events     []domain.DomainEvent
+	nextAt     map[int]time.Time
+	dispatched map[int]bool
+	abandoned  map[int]bool
+}
+
+func newMemoryStore(events ...domain.DomainEvent) *memoryStore {
+	return &memoryStore{
+		events:     events,
+		nextAt:     make(map[int]time.Time),
+		dispatched: make(map[int]bool),
+		abandoned:  make(map[int]bool),
+	}
+}
+
+func (m *memoryStore) FindPending(ctx context.Context, now time.Time, limit int) ([]domain.DomainEvent, error) {
+	var result []domain.DomainEvent
+	for _, event := range m.events {
+		if m.dispatched[event.ID] || m.abandoned[event.ID] || m.nextAt[event.ID].After(now) {
+			continue
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+func (m *memoryStore) MarkDispatched(ctx context.Context, id int, now time.Time) error {
+	m.dispatched[id] = true
+	return nil
+}
+
+func (m *memoryStore) Retry(ctx context.Context, id int, cause string, at time.Time) error {
+	m.fail(id)
+	m.nextAt[id] = at
+	return nil
+}
+
+func (m *memoryStore) Abandon(ctx context.Context, id int, cause string, now time.Time) error {
+	m.fail(id)
+	m.abandoned[id] = true
+	return nil
+}
+
+func (m *memoryStore) fail(id int) {
+	for i := range m.events {
+		if m.events[i].ID == id {
+			m.events[i].Attempts++
+		}
+	}
+}
+
+func TestRelay_DispatchRetriesFailedEvents(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(
+		domain.DomainEvent{ID: 1, Type: models.DomainEventTeamCreated},
+		domain.DomainEvent{ID: 2, Type: models.DomainEventMemberJoined},
+	)
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	relay := NewRelay(store)
+	relay.now = func() time.Time { return now }
+
+	var all, joined []int
+	failing := true
+	relay.Subscribe("all", func(ctx context.Context, event domain.DomainEvent) error {
+		all = append(all, event.ID)
+		return nil
+	})
+	relay.Subscribe("joined", func(ctx context.Context, event domain.DomainEvent) error {
+		joined = append(joined, event.ID)
+		if failing {
+			return errors.New("unavailable")
+		}
+		return nil
+	}, models.DomainEventMemberJoined)
+
+	require.NoError(t, relay.Dispatch(ctx))
+	assert.Equal(t, []int{1, 2}, all)
+	assert.Equal(t, []int{2}, joined)
+	assert.True(t, store.dispatched[1])
+	assert.False(t, store.dispatched[2])
+
+	// 待つ時間が過ぎるまでは再配信しない
+	require.NoError(t, relay.Dispatch(ctx))
+	assert.Equal(t, []int{2}, joined)
+
+	// 再配信は全ての購読者に行う
+	now = now.Add(defaultBackoff)
+	failing = false
+	require.NoError(t, relay.Dispatch(ctx))
+	assert.Equal(t, []int{1, 2, 2}, all)
+	assert.Equal(t, []int{2, 2}, joined)
+	assert.True(t, store.dispatched[2])
+}
+
+func TestRelay_DispatchAbandonsAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(domain.DomainEvent{ID: 1, Type: models.DomainEventTeamDeleted})
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	relay := NewRelay(store)
+	relay.now = func() time.Time { return now }
+
+	calls := 0
+	relay.Subscribe("search", func(ctx context.Context, event domain.DomainEvent) error {
+		calls++
+		return errors.New("unavailable")
+	})
+
+	for range defaultMaxAttempts + 2 {
+		require.NoError(t, relay.Dispatch(ctx))
+		now = now.Add(maxBackoff)
+	}
+	assert.Equal(t, defaultMaxAttempts, calls)
+	assert.True(t, store.abandoned[1])
+	assert.False(t, store.dispatched[1])
+}
+
+func TestRelay_RetryDelay(t *testing.T) {
+	relay := NewRelay(newMemoryStore())
+	assert.Equal(t, defaultBackoff, relay.retryDelay(1))
+	assert.Equal(t, 2*defaultBackoff, relay.retryDelay(2))
+	assert.Equal(t, 4*defaultBackoff, relay.retryDelay(3))
+	assert.Equal(t, maxBackoff, relay.retryDelay(20))
+}
@@ -0,0 +1,96 @@
+// Package pubsub はプロセス内でメンバーごとにメッセージを配信する
+package pubsub
+
+import "sync"
+
+// subscriberBuffer は購読者ごとに溜めておけるメッセージ数
+// 受信が追いつかない購読者へのメッセージは捨てる
+const subscriberBuffer = 16
+
+// Hub はキーごとの購読者にメッセージを配信する。複数の goroutine から安全に使える
+type Hub[T any] struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan T]struct{}
+	closed      bool
+}
+
+func NewHub[T any]() *Hub[T] {
+	return &Hub[T]{subscribers: make(map[string]map[chan T]struct{})}
+}
+
+// Subscribe は key へのメッセージを受け取るチャネルと、購読をやめる関数を返す
+// 購読をやめるとチャネルは閉じられる
+func (h *Hub[T]) Subscribe(key string) (<-chan T, func()) {
+	ch := make(chan T, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	if h.subscribers[key] == nil {
+		h.subscribers[key] = make(map[chan T]struct{})
+	}
+	h.subscribers[key][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			// Close で既に閉じている場合は何もしない
+			if _, ok := h.subscribers[key][ch]; !ok {
+				return
+			}
+			delete(h.subscribers[key], ch)
+			if len(h.subscribers[key]) == 0 {
+				delete(h.subscribers, key)
+			}
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// Publish は key の全ての購読者にメッセージを送り、受け取った購読者数を返す
+// 送信でブロックしないよう、バッファが埋まっている購読者には送らない
+func (h *Hub[T]) Publish(key string, message T) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	delivered := 0
+	for ch := range h.subscribers[key] {
+		select {
+		case ch <- message:
+			delivered++
+		default:
+		}
+	}
+	return delivered
+}
+
+// Subscribers は key の購読者数を返す
+func (h *Hub[T]) Subscribers(key string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscribers[key])
+}
+
+// Close は全ての購読者のチャネルを閉じる。Close の後の Subscribe は閉じたチャネルを返す
+// サーバーの停止時に、購読し続けている接続を終わらせるために使う
+func (h *Hub[T]) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return
+	}
+	h.closed = true
+	for _, channels := range h.subscribers {
+		for ch := range channels {
+			close(ch)
+		}
+	}
+	h.subscribers = make(map[string]map[chan T]struct{})
+}
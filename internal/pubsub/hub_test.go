@@ -0,0 +1,62 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub_PublishToSubscribers(t *testing.T) {
+	hub := NewHub[string]()
+	first, unsubscribeFirst := hub.Subscribe("alice")
+	second, unsubscribeSecond := hub.Subscribe("alice")
+	other, unsubscribeOther := hub.Subscribe("bob")
+	defer unsubscribeSecond()
+	defer unsubscribeOther()
+
+	assert.Equal(t, 2, hub.Publish("alice", "hello"))
+	assert.Equal(t, "hello", <-first)
+	assert.Equal(t, "hello", <-second)
+	assert.Empty(t, other)
+
+	// 購読をやめるとチャネルが閉じられ、配信されなくなる
+	unsubscribeFirst()
+	unsubscribeFirst()
+	_, ok := <-first
+	assert.False(t, ok)
+	assert.Equal(t, 1, hub.Publish("alice", "again"))
+	assert.Equal(t, 1, hub.Subscribers("alice"))
+	assert.Zero(t, hub.Publish("carol", "nobody"))
+}
+
+func TestHub_DropsWhenSubscriberIsSlow(t *testing.T) {
+	hub := NewHub[int]()
+	ch, unsubscribe := hub.Subscribe("alice")
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer; i++ {
+		assert.Equal(t, 1, hub.Publish("alice", i))
+	}
+	// バッファが埋まっていても Publish はブロックしない
+	assert.Zero(t, hub.Publish("alice", subscriberBuffer))
+	assert.Len(t, ch, subscriberBuffer)
+	assert.Equal(t, 0, <-ch)
+}
+
+func TestHub_Close(t *testing.T) {
+	hub := NewHub[string]()
+	ch, unsubscribe := hub.Subscribe("alice")
+
+	hub.Close()
+	hub.Close()
+	_, ok := <-ch
+	assert.False(t, ok)
+	// 閉じた後に購読をやめても二重に閉じない
+	unsubscribe()
+
+	late, unsubscribeLate := hub.Subscribe("alice")
+	defer unsubscribeLate()
+	_, ok = <-late
+	assert.False(t, ok)
+	assert.Zero(t, hub.Publish("alice", "hello"))
+}
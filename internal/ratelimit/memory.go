@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval は満タンに戻ったバケットを消す間隔
+const sweepInterval = time.Minute
+
+// MemoryStore はメモリ上のバケット。制限はプロセスごとになる
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]time.Time
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]time.Time)}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, policy Policy, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+
+	fullAt, result := policy.Take(s.buckets[key], now)
+	s.buckets[key] = fullAt
+	return result, nil
+}
+
+// Len は保存しているバケットの数
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
+
+// sweep は満タンに戻ったバケットを消す。消したキーは次に取り出すときに満タンとして扱われる
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	for key, fullAt := range s.buckets {
+		if !fullAt.After(now) {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
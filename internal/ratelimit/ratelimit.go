@@ -0,0 +1,113 @@
+// Package ratelimit はトークンバケットでクライアントごとのリクエスト数を制限する
+//
+// バケットはトークンが満タンに戻る時刻だけで表す（GCRA）。共有のストアでもキーごとに時刻を1つ保存すればよい。
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidPolicy = errors.New("invalid rate limit policy")
+
+// Policy は Burst 回までまとめて受け付け、Period かけて Burst 回分のトークンを補充する制限
+type Policy struct {
+	Burst  int
+	Period time.Duration
+}
+
+// ParsePolicy は 10/1m のような「回数/期間」の形式の制限を読む
+func ParsePolicy(value string) (Policy, error) {
+	burst, period, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Policy{}, fmt.Errorf("%w: %q", ErrInvalidPolicy, value)
+	}
+	n, err := strconv.Atoi(burst)
+	if err != nil || n < 1 {
+		return Policy{}, fmt.Errorf("%w: %q: count must be a positive integer", ErrInvalidPolicy, value)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Policy{}, fmt.Errorf("%w: %q: period must be a positive duration", ErrInvalidPolicy, value)
+	}
+	return Policy{Burst: n, Period: d}, nil
+}
+
+// ParsePolicies は "POST /v1/teams/:teamID/join=10/1m,GET /v1/search=60/1m" のような gin のルートごとの制限を読む
+func ParsePolicies(value string) (map[string]Policy, error) {
+	policies := make(map[string]Policy)
+	for _, part := range strings.Split(value, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		route, policy, ok := strings.Cut(part, "=")
+		route = strings.Join(strings.Fields(route), " ")
+		if !ok || len(strings.Fields(route)) != 2 {
+			return nil, fmt.Errorf("%w: %q: must be METHOD /route=count/period", ErrInvalidPolicy, part)
+		}
+		if _, ok := policies[route]; ok {
+			return nil, fmt.Errorf("%w: duplicate %s", ErrInvalidPolicy, route)
+		}
+		parsed, err := ParsePolicy(policy)
+		if err != nil {
+			return nil, err
+		}
+		policies[route] = parsed
+	}
+	return policies, nil
+}
+
+func (p Policy) String() string {
+	return fmt.Sprintf("%d/%s", p.Burst, p.Period)
+}
+
+// interval は1回分のトークンを補充する間隔
+func (p Policy) interval() time.Duration {
+	return p.Period / time.Duration(p.Burst)
+}
+
+// Result はトークンを取り出した結果
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset はトークンが満タンに戻るまでの時間
+	Reset time.Duration
+	// RetryAfter は拒否した場合に次のトークンが補充されるまでの時間
+	RetryAfter time.Duration
+}
+
+// Take は fullAt に満タンに戻るバケットから now にトークンを1つ取り出す。
+// 受け付けた場合は新しく満タンに戻る時刻を返し、拒否した場合は fullAt をそのまま返す。
+// ゼロ値の fullAt は満タンのバケットとして扱う。Store の実装から使う
+func (p Policy) Take(fullAt time.Time, now time.Time) (time.Time, Result) {
+	interval := p.interval()
+	if fullAt.Before(now) {
+		fullAt = now
+	}
+	next := fullAt.Add(interval)
+	// 取り出した後に満タンまでの時間が Period を超える場合はトークンが足りない
+	if allowAt := next.Add(-p.Period); now.Before(allowAt) {
+		return fullAt, Result{
+			Limit:      p.Burst,
+			Reset:      fullAt.Sub(now),
+			RetryAfter: allowAt.Sub(now),
+		}
+	}
+	return next, Result{
+		Allowed:   true,
+		Limit:     p.Burst,
+		Remaining: int((p.Period - next.Sub(now)) / interval),
+		Reset:     next.Sub(now),
+	}
+}
+
+// Store はキーごとのバケットを保存する。複数のサーバーで制限を共有する場合は Redis などで実装する
+type Store interface {
+	// Take は key のバケットからトークンを1つ取り出す。読み込みから書き込みまでを不可分に行う
+	Take(ctx context.Context, key string, policy Policy, now time.Time) (Result, error)
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicies(t *testing.T) {
+	policies, err := ParsePolicies("POST /v1/teams/:teamID/join=10/1m, GET  /v1/search=60/30s,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Policy{
+		"POST /v1/teams/:teamID/join": {Burst: 10, Period: time.Minute},
+		"GET /v1/search":              {Burst: 60, Period: 30 * time.Second},
+	}, policies)
+
+	policies, err = ParsePolicies("")
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	for _, value := range []string{
+		"/v1/search=60/1m",
+		"GET /v1/search",
+		"GET /v1/search=0/1m",
+		"GET /v1/search=10/0s",
+		"GET /v1/search=10",
+		"GET /v1/search=10/1m,GET /v1/search=5/1m",
+	} {
+		_, err := ParsePolicies(value)
+		assert.ErrorIs(t, err, ErrInvalidPolicy, value)
+	}
+}
+
+func TestPolicy_Take(t *testing.T) {
+	policy := Policy{Burst: 2, Period: time.Minute}
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	fullAt, result := policy.Take(time.Time{}, now)
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second}, result)
+	fullAt, result = policy.Take(fullAt, now)
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 0, Reset: time.Minute}, result)
+
+	// トークンがなくなると次の補充まで拒否する
+	rejectedAt, result := policy.Take(fullAt, now.Add(10*time.Second))
+	assert.Equal(t, Result{Limit: 2, Reset: 50 * time.Second, RetryAfter: 20 * time.Second}, result)
+	assert.Equal(t, fullAt, rejectedAt)
+
+	fullAt, result = policy.Take(fullAt, now.Add(30*time.Second))
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 0, Reset: time.Minute}, result)
+
+	// 満タンを超えてトークンは貯まらない
+	_, result = policy.Take(fullAt, now.Add(time.Hour))
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second}, result)
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	policy := Policy{Burst: 1, Period: time.Minute}
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	result, err := store.Take(ctx, "member:a", policy, now)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	result, err = store.Take(ctx, "member:a", policy, now)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Minute, result.RetryAfter)
+
+	// キーごとに別のバケットを使う
+	result, err = store.Take(ctx, "member:b", policy, now)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// 満タンに戻ったバケットは消す
+	_, err = store.Take(ctx, "member:c", policy, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, store.Len())
+}
@@ -0,0 +1,160 @@
+// Package recurrence は RFC 5545 の RRULE のうち、勉強会の定期開催に必要な範囲を扱う
+//
+// 対応しているのは FREQ（WEEKLY, MONTHLY）、INTERVAL、COUNT、UNTIL のみ。
+// UNTIL は UTC の日時（例: 20250331T150000Z）で指定する。
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+const (
+	untilFormat = "20060102T150405Z"
+	// maxIterations は展開の上限。不正なルールで無限に回らないようにする
+	maxIterations = 10000
+)
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	// Count は繰り返しの回数。0 の場合は回数の制限なし
+	Count int
+	// Until はこの日時までに始まる回を含める。nil の場合は期限なし
+	Until *time.Time
+}
+
+// Parse は "FREQ=WEEKLY;INTERVAL=2;COUNT=10" の形式の RRULE を解析する
+func Parse(value string) (Rule, error) {
+	rule := Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return Rule{}, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+		if seen[key] {
+			return Rule{}, fmt.Errorf("%w: duplicate %s", ErrInvalidRule, key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			rule.Freq = Frequency(val)
+			if rule.Freq != Weekly && rule.Freq != Monthly {
+				return Rule{}, fmt.Errorf("%w: unsupported FREQ %s", ErrInvalidRule, val)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return Rule{}, fmt.Errorf("%w: INTERVAL must be a positive integer", ErrInvalidRule)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return Rule{}, fmt.Errorf("%w: COUNT must be a positive integer", ErrInvalidRule)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := time.Parse(untilFormat, val)
+			if err != nil {
+				return Rule{}, fmt.Errorf("%w: UNTIL must be a UTC date-time such as 20250331T150000Z", ErrInvalidRule)
+			}
+			rule.Until = &until
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part %s", ErrInvalidRule, key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return Rule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return Rule{}, fmt.Errorf("%w: COUNT and UNTIL must not be used together", ErrInvalidRule)
+	}
+	return rule, nil
+}
+
+// Between は start を初回とする繰り返しのうち、from 以上 to 未満に始まる回の開始日時を返す
+func (r Rule) Between(start time.Time, from time.Time, to time.Time) []time.Time {
+	var result []time.Time
+	r.each(start, func(t time.Time) bool {
+		if !t.Before(to) {
+			return false
+		}
+		if !t.Before(from) {
+			result = append(result, t)
+		}
+		return true
+	})
+	return result
+}
+
+// Includes は t が start を初回とする繰り返しのいずれかの回の開始日時かどうか
+func (r Rule) Includes(start time.Time, t time.Time) bool {
+	found := false
+	r.each(start, func(occurrence time.Time) bool {
+		if occurrence.Equal(t) {
+			found = true
+		}
+		return occurrence.Before(t)
+	})
+	return found
+}
+
+// Last は最後の回の開始日時を返す。COUNT・UNTIL のどちらもない場合は false を返す
+func (r Rule) Last(start time.Time) (time.Time, bool) {
+	if r.Count == 0 && r.Until == nil {
+		return time.Time{}, false
+	}
+	var last time.Time
+	r.each(start, func(t time.Time) bool {
+		last = t
+		return true
+	})
+	return last, true
+}
+
+// each は各回の開始日時を順に fn に渡す。fn が false を返すと止める
+// MONTHLY で存在しない日（31日など）になる月は RFC 5545 のとおり飛ばし、回数にも数えない
+func (r Rule) each(start time.Time, fn func(time.Time) bool) {
+	count := 0
+	for i := 0; i < maxIterations; i++ {
+		var t time.Time
+		switch r.Freq {
+		case Weekly:
+			t = start.AddDate(0, 0, 7*r.Interval*i)
+		case Monthly:
+			t = start.AddDate(0, r.Interval*i, 0)
+			if t.Day() != start.Day() {
+				continue
+			}
+		default:
+			return
+		}
+
+		if r.Until != nil && t.After(*r.Until) {
+			return
+		}
+		if !fn(t) {
+			return
+		}
+		count++
+		if r.Count > 0 && count >= r.Count {
+			return
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	until := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		value   string
+		want    Rule
+		wantErr bool
+	}{
+		{name: "weekly", value: "FREQ=WEEKLY", want: Rule{Freq: Weekly, Interval: 1}},
+		{name: "biweekly with count", value: "FREQ=WEEKLY;INTERVAL=2;COUNT=10", want: Rule{Freq: Weekly, Interval: 2, Count: 10}},
+		{name: "monthly with until", value: "FREQ=MONTHLY;UNTIL=20250331T150000Z", want: Rule{Freq: Monthly, Interval: 1, Until: &until}},
+		{name: "missing freq", value: "INTERVAL=2", wantErr: true},
+		{name: "unsupported freq", value: "FREQ=DAILY", wantErr: true},
+		{name: "unsupported part", value: "FREQ=WEEKLY;BYDAY=MO", wantErr: true},
+		{name: "count and until", value: "FREQ=WEEKLY;COUNT=3;UNTIL=20250331T150000Z", wantErr: true},
+		{name: "date only until", value: "FREQ=WEEKLY;UNTIL=20250331", wantErr: true},
+		{name: "zero interval", value: "FREQ=WEEKLY;INTERVAL=0", wantErr: true},
+		{name: "duplicate part", value: "FREQ=WEEKLY;FREQ=MONTHLY", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRule_Between(t *testing.T) {
+	start := time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC)
+
+	t.Run("biweekly", func(t *testing.T) {
+		rule, err := Parse("FREQ=WEEKLY;INTERVAL=2")
+		require.NoError(t, err)
+		got := rule.Between(start, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 19, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, []time.Time{
+			time.Date(2025, 1, 21, 10, 0, 0, 0, time.UTC),
+			time.Date(2025, 2, 4, 10, 0, 0, 0, time.UTC),
+			time.Date(2025, 2, 18, 10, 0, 0, 0, time.UTC),
+		}, got)
+	})
+
+	t.Run("count limits occurrences", func(t *testing.T) {
+		rule, err := Parse("FREQ=WEEKLY;COUNT=2")
+		require.NoError(t, err)
+		got := rule.Between(start, start, start.AddDate(1, 0, 0))
+		assert.Equal(t, []time.Time{start, start.AddDate(0, 0, 7)}, got)
+	})
+
+	t.Run("until is inclusive", func(t *testing.T) {
+		rule, err := Parse("FREQ=WEEKLY;UNTIL=20250114T100000Z")
+		require.NoError(t, err)
+		got := rule.Between(start, start, start.AddDate(1, 0, 0))
+		assert.Equal(t, []time.Time{start, start.AddDate(0, 0, 7)}, got)
+	})
+
+	t.Run("monthly skips months without the day", func(t *testing.T) {
+		rule, err := Parse("FREQ=MONTHLY;COUNT=3")
+		require.NoError(t, err)
+		jan31 := time.Date(2025, 1, 31, 19, 0, 0, 0, time.UTC)
+		got := rule.Between(jan31, jan31, jan31.AddDate(1, 0, 0))
+		assert.Equal(t, []time.Time{
+			jan31,
+			time.Date(2025, 3, 31, 19, 0, 0, 0, time.UTC),
+			time.Date(2025, 5, 31, 19, 0, 0, 0, time.UTC),
+		}, got)
+	})
+}
+
+func TestRule_IncludesAndLast(t *testing.T) {
+	start := time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC)
+	rule, err := Parse("FREQ=WEEKLY;INTERVAL=2;COUNT=3")
+	require.NoError(t, err)
+
+	assert.True(t, rule.Includes(start, start))
+	assert.True(t, rule.Includes(start, start.AddDate(0, 0, 28)))
+	assert.False(t, rule.Includes(start, start.AddDate(0, 0, 7)))
+	assert.False(t, rule.Includes(start, start.AddDate(0, 0, 42)))
+
+	last, ok := rule.Last(start)
+	assert.True(t, ok)
+	assert.Equal(t, start.AddDate(0, 0, 28), last)
+
+	forever, err := Parse("FREQ=MONTHLY")
+	require.NoError(t, err)
+	_, ok = forever.Last(start)
+	assert.False(t, ok)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/announcementcomment"
+	"backend_golang/ent/member"
+	"backend_golang/internal/domain"
+	"context"
+	"time"
+)
+
+type AnnouncementCommentRepository interface {
+	Create(ctx context.Context, comment *domain.AnnouncementComment) (*domain.AnnouncementComment, error)
+	FindByID(ctx context.Context, announcementID int, commentID int) (*domain.AnnouncementComment, error)
+	FindQuestions(ctx context.Context, announcementID int, page int, size int) ([]domain.AnnouncementComment, error)
+	SoftDelete(ctx context.Context, announcementID int, commentID int, now time.Time) error
+}
+
+type announcementCommentRepository struct {
+	client *ent.Client
+}
+
+func NewAnnouncementCommentRepository(client *ent.Client) AnnouncementCommentRepository {
+	return &announcementCommentRepository{client: client}
+}
+
+func (a *announcementCommentRepository) Create(ctx context.Context, comment *domain.AnnouncementComment) (*domain.AnnouncementComment, error) {
+	author, err := a.client.Member.Query().Where(member.MemberID(comment.AuthorID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := a.client.AnnouncementComment.Create().
+		SetAnnouncementID(comment.AnnouncementID).
+		SetNillableParentID(comment.ParentID).
+		SetBody(comment.Body).
+		SetLeaderAnswer(comment.LeaderAnswer).
+		SetAuthor(author).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return a.FindByID(ctx, comment.AnnouncementID, created.ID)
+}
+
+func (a *announcementCommentRepository) FindByID(ctx context.Context, announcementID int, commentID int) (*domain.AnnouncementComment, error) {
+	found, err := a.query().
+		Where(
+			announcementcomment.ID(commentID),
+			announcementcomment.AnnouncementID(announcementID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainAnnouncementComment(found)
+	return &result, nil
+}
+
+// FindQuestions は募集記事の質問を投稿順に、返信と一緒に返す。返信のない削除済みの質問は含めない
+func (a *announcementCommentRepository) FindQuestions(ctx context.Context, announcementID int, page int, size int) ([]domain.AnnouncementComment, error) {
+	questions, err := a.query().
+		Where(
+			announcementcomment.AnnouncementID(announcementID),
+			announcementcomment.ParentIDIsNil(),
+			announcementcomment.Or(
+				announcementcomment.DeletedAtIsNil(),
+				announcementcomment.HasReplies(),
+			),
+		).
+		WithReplies(func(rq *ent.AnnouncementCommentQuery) {
+			rq.WithAuthor(func(mq *ent.MemberQuery) {
+				mq.Select(member.FieldMemberID, member.FieldNickname)
+			}).
+				Order(ent.Asc(announcementcomment.FieldID))
+		}).
+		Order(ent.Asc(announcementcomment.FieldID)).
+		Offset((page - 1) * size).
+		Limit(size).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.AnnouncementComment, len(questions))
+	for i, question := range questions {
+		result[i] = toDomainAnnouncementComment(question)
+		for _, reply := range question.Edges.Replies {
+			result[i].Replies = append(result[i].Replies, toDomainAnnouncementComment(reply))
+		}
+	}
+	return result, nil
+}
+
+// SoftDelete は返信の並びを保つため、行を残したまま削除済みにする
+func (a *announcementCommentRepository) SoftDelete(ctx context.Context, announcementID int, commentID int, now time.Time) error {
+	_, err := a.client.AnnouncementComment.Update().
+		Where(
+			announcementcomment.ID(commentID),
+			announcementcomment.AnnouncementID(announcementID),
+			announcementcomment.DeletedAtIsNil(),
+		).
+		SetDeletedAt(now).
+		Save(ctx)
+	return err
+}
+
+func (a *announcementCommentRepository) query() *ent.AnnouncementCommentQuery {
+	return a.client.AnnouncementComment.Query().
+		WithAuthor(func(mq *ent.MemberQuery) {
+			mq.Select(member.FieldMemberID, member.FieldNickname)
+		})
+}
+
+func toDomainAnnouncementComment(found *ent.AnnouncementComment) domain.AnnouncementComment {
+	result := domain.AnnouncementComment{
+		ID:             found.ID,
+		AnnouncementID: found.AnnouncementID,
+		ParentID:       found.ParentID,
+		Body:           found.Body,
+		LeaderAnswer:   found.LeaderAnswer,
+		DeletedAt:      found.DeletedAt,
+		CreatedAt:      found.CreatedAt,
+	}
+	if found.Edges.Author != nil {
+		result.AuthorID = found.Edges.Author.MemberID
+		result.AuthorNickname = found.Edges.Author.Nickname
+	}
+	return result
+}
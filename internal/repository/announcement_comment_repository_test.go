@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"backend_golang/internal/domain"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncementCommentRepository_Questions(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	comments := NewAnnouncementCommentRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	announcement := client.Announcement.Create().
+		SetTitle("バックエンド募集").
+		SetContent("Go を書ける方を募集しています").
+		SetTeamID(created.ID).
+		SaveX(ctx)
+	visitor := newTestMember(t, client, "visitor")
+
+	post := func(authorID string, body string, parentID *int, leaderAnswer bool) *domain.AnnouncementComment {
+		comment, err := comments.Create(ctx, &domain.AnnouncementComment{
+			AnnouncementID: announcement.ID,
+			ParentID:       parentID,
+			Body:           body,
+			AuthorID:       authorID,
+			LeaderAnswer:   leaderAnswer,
+		})
+		require.NoError(t, err)
+		return comment
+	}
+	answered := post(visitor.MemberID, "リモートでも参加できますか", nil, false)
+	assert.Equal(t, visitor.Nickname, answered.AuthorNickname)
+	post(visitor.MemberID, "ありがとうございます", &answered.ID, false)
+	post(leader.MemberID, "はい、できます", &answered.ID, true)
+	open := post(visitor.MemberID, "週に何時間くらいですか", nil, false)
+	withdrawn := post(visitor.MemberID, "間違えて投稿しました", nil, false)
+
+	require.NoError(t, comments.SoftDelete(ctx, announcement.ID, withdrawn.ID, time.Now()))
+	// 返信のある質問は削除しても一覧に残す
+	require.NoError(t, comments.SoftDelete(ctx, announcement.ID, open.ID, time.Now()))
+	post(leader.MemberID, "週に5時間程度です", &open.ID, true)
+
+	questions, err := comments.FindQuestions(ctx, announcement.ID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+	assert.Equal(t, answered.ID, questions[0].ID)
+	require.Len(t, questions[0].Replies, 2)
+	assert.Equal(t, leader.Nickname, questions[0].Replies[1].AuthorNickname)
+	assert.True(t, questions[0].IsAnswered())
+	assert.Equal(t, open.ID, questions[1].ID)
+	assert.True(t, questions[1].IsDeleted())
+
+	page, err := comments.FindQuestions(ctx, announcement.ID, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, open.ID, page[0].ID)
+
+	_, err = comments.FindByID(ctx, announcement.ID+1, answered.ID)
+	assert.Error(t, err)
+}
@@ -3,23 +3,26 @@ package repository
 import (
 	"backend_golang/ent"
 	"backend_golang/ent/announcement"
+	"backend_golang/ent/member"
 	"backend_golang/ent/position"
 	"backend_golang/ent/predicate"
 	"backend_golang/ent/skill"
 	"backend_golang/ent/team"
 	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
 	imodels "backend_golang/internal/models"
 	"backend_golang/internal/service/models"
 	"context"
-	"log"
 	"math"
+
+	"entgo.io/ent/dialect/sql"
 )
 
 type AnnouncementRepository interface {
 	CreateAnnouncement(ctx context.Context, announcement models.RegisterAnnouncement) (*domain.Announcement, error)
 	GetAnnouncement(ctx context.Context, announcementID int) (*domain.Announcement, error)
 	GetLastAnnouncement(ctx context.Context, announcementID int) (*domain.Announcement, error)
-	GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []string, keyword string) ([]domain.Announcement, error)
+	GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []imodels.Role, announcementIDs []int) ([]domain.AnnouncementSummary, error)
 }
 
 type announcementRepository struct {
@@ -35,6 +38,7 @@ func NewAnnouncementRepository(client *ent.Client) AnnouncementRepository {
 }
 
 func (a *announcementRepository) CreateAnnouncement(ctx context.Context, announcement models.RegisterAnnouncement) (*domain.Announcement, error) {
+	teamID := announcement.TeamID
 	var result *domain.Announcement
 	err := a.tx.WithTx(ctx, func(tx *ent.Tx) error {
 		announcement, err := tx.Announcement.Create().
@@ -43,7 +47,15 @@ func (a *announcementRepository) CreateAnnouncement(ctx context.Context, announc
 			SetTeamID(announcement.TeamID).
 			Save(ctx)
 		if err != nil {
-			log.Printf("error creating announcement: %v", err)
+			logging.FromContext(ctx).Error("error creating announcement", "error", err)
+			return err
+		}
+		err = recordEvent(ctx, tx, domain.DomainEvent{
+			Type:        imodels.DomainEventAnnouncementCreated,
+			AggregateID: announcement.ID,
+			TeamID:      teamID,
+		})
+		if err != nil {
 			return err
 		}
 		result = &domain.Announcement{
@@ -56,7 +68,7 @@ func (a *announcementRepository) CreateAnnouncement(ctx context.Context, announc
 		return nil
 	})
 	if err != nil {
-		log.Printf("error getting announcement: %v", err)
+		logging.FromContext(ctx).Error("error getting announcement", "error", err)
 		return nil, err
 	}
 	return result, nil
@@ -64,63 +76,24 @@ func (a *announcementRepository) CreateAnnouncement(ctx context.Context, announc
 
 func (a *announcementRepository) GetAnnouncement(ctx context.Context, announcementID int) (*domain.Announcement, error) {
 	announcement, err := a.client.Announcement.Query().
-		WithTeam(
-			func(tq *ent.TeamQuery) {
-				tq.WithMembers().
-					WithPositions().
-					WithSkills()
-			},
-		).
+		WithTeam(withTeamDetail).
 		Where(announcement.ID(announcementID)).
 		First(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var skills []domain.Skill
-	for _, skill := range announcement.Edges.Team.Edges.Skills {
-		skills = append(skills, domain.Skill{
-			Name: skill.Name,
-		})
-	}
-
-	var positions []domain.Position
-	for _, position := range announcement.Edges.Team.Edges.Positions {
-		positions = append(positions, domain.Position{
-			Role:    imodels.Role(position.Role),
-			Vacancy: position.Vacancy,
-		})
-	}
-
-	var members []domain.Member
-	for _, member := range announcement.Edges.Team.Edges.Members {
-		members = append(members, domain.Member{
-			ID:            member.MemberID,
-			Email:         member.Email,
-			Nickname:      member.Nickname,
-			Picture:       member.Picture,
-			Bio:           member.Bio,
-			PreferredRole: member.PreferredRole,
-		})
-	}
-
-	return &domain.Announcement{
+	result := &domain.Announcement{
 		ID:        announcement.ID,
 		Title:     announcement.Title,
 		Content:   announcement.Content,
 		CreatedAt: announcement.CreatedAt,
 		UpdatedAt: announcement.UpdatedAt,
-		Team: &domain.Team{
-			ID:          announcement.Edges.Team.ID,
-			Name:        announcement.Edges.Team.Name,
-			Description: announcement.Edges.Team.Description,
-			Headcount:   announcement.Edges.Team.Headcount,
-			CreatedBy:   announcement.Edges.Team.CreatedBy,
-			Members:     members,
-			Skills:      skills,
-			Positions:   positions,
-		},
-	}, nil
+	}
+	if announcement.Edges.Team != nil {
+		result.Team = toDomainTeam(announcement.Edges.Team)
+	}
+	return result, nil
 }
 
 func (a *announcementRepository) GetLastAnnouncement(ctx context.Context, announcementID int) (*domain.Announcement, error) {
@@ -139,11 +112,14 @@ func (a *announcementRepository) GetLastAnnouncement(ctx context.Context, announ
 	}, nil
 }
 
-func (a *announcementRepository) GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []string, keyword string) ([]domain.Announcement, error) {
+func (a *announcementRepository) GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []imodels.Role, announcementIDs []int) ([]domain.AnnouncementSummary, error) {
+	// 一覧ではメンバー詳細を読み込まず、チーム名・募集中ポジション・スキル名のみをバッチで取得する
 	query := a.client.Announcement.Query().WithTeam(
 		func(tq *ent.TeamQuery) {
-			tq.WithMembers().
-				WithPositions().
+			tq.Select(team.FieldName, team.FieldStatus).
+				WithPositions(func(pq *ent.PositionQuery) {
+					pq.Where(position.VacancyGT(0))
+				}).
 				WithSkills()
 		},
 	)
@@ -160,11 +136,9 @@ func (a *announcementRepository) GetAnnouncements(ctx context.Context, page int,
 		))
 	}
 
-	if keyword != "" {
-		conditions = append(conditions, announcement.Or(
-			announcement.TitleContains(keyword),
-			announcement.ContentContains(keyword),
-		))
+	// キーワード検索は検索サービスで解決したIDで絞り込む
+	if announcementIDs != nil {
+		conditions = append(conditions, announcement.IDIn(announcementIDs...))
 	}
 
 	if len(conditions) > 0 {
@@ -180,52 +154,81 @@ func (a *announcementRepository) GetAnnouncements(ctx context.Context, page int,
 		return nil, err
 	}
 
-	var result []domain.Announcement
+	teamIDs := make([]int, 0, len(announcements))
 	for _, announcement := range announcements {
-		var members []domain.Member
-		for _, member := range announcement.Edges.Team.Edges.Members {
-			members = append(members, domain.Member{
-				ID:            member.MemberID,
-				Email:         member.Email,
-				Nickname:      member.Nickname,
-				Picture:       member.Picture,
-				Bio:           member.Bio,
-				PreferredRole: member.PreferredRole,
-			})
-		}
-
-		var skills []domain.Skill
-		for _, skill := range announcement.Edges.Team.Edges.Skills {
-			skills = append(skills, domain.Skill{
-				Name: skill.Name,
-			})
-		}
-
-		var positions []domain.Position
-		for _, position := range announcement.Edges.Team.Edges.Positions {
-			positions = append(positions, domain.Position{
-				Role:    imodels.Role(position.Role),
-				Vacancy: position.Vacancy,
-			})
+		if announcement.Edges.Team != nil {
+			teamIDs = append(teamIDs, announcement.Edges.Team.ID)
 		}
+	}
+	memberCounts, err := a.countMembers(ctx, teamIDs)
+	if err != nil {
+		return nil, err
+	}
 
-		result = append(result, domain.Announcement{
+	result := make([]domain.AnnouncementSummary, 0, len(announcements))
+	for _, announcement := range announcements {
+		summary := domain.AnnouncementSummary{
 			ID:        announcement.ID,
 			Title:     announcement.Title,
 			Content:   announcement.Content,
 			CreatedAt: announcement.CreatedAt,
 			UpdatedAt: announcement.UpdatedAt,
-			Team: &domain.Team{
-				ID:          announcement.Edges.Team.ID,
-				Name:        announcement.Edges.Team.Name,
-				Description: announcement.Edges.Team.Description,
-				Headcount:   announcement.Edges.Team.Headcount,
-				CreatedBy:   announcement.Edges.Team.CreatedBy,
-				Members:     members,
-				Skills:      skills,
-				Positions:   positions,
-			},
-		})
+		}
+
+		if t := announcement.Edges.Team; t != nil {
+			openPositions := make([]domain.Position, len(t.Edges.Positions))
+			for i, position := range t.Edges.Positions {
+				openPositions[i] = domain.Position{
+					Role:    imodels.Role(position.Role),
+					Vacancy: position.Vacancy,
+				}
+			}
+
+			skillNames := make([]string, len(t.Edges.Skills))
+			for i, skill := range t.Edges.Skills {
+				skillNames[i] = skill.Name
+			}
+
+			summary.Team = &domain.TeamSummary{
+				ID:            t.ID,
+				Name:          t.Name,
+				Status:        t.Status,
+				OpenPositions: openPositions,
+				SkillNames:    skillNames,
+				MemberCount:   memberCounts[t.ID],
+			}
+		}
+		result = append(result, summary)
 	}
 	return result, nil
 }
+
+// countMembers はチームごとのメンバー数を1回のクエリで集計する
+func (a *announcementRepository) countMembers(ctx context.Context, teamIDs []int) (map[int]int, error) {
+	counts := make(map[int]int, len(teamIDs))
+	if len(teamIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+	err := a.client.Team.Query().
+		Where(team.IDIn(teamIDs...)).
+		GroupBy(team.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			t := sql.Table(member.Table)
+			s.LeftJoin(t).On(s.C(team.FieldID), t.C(team.MembersColumn))
+			return sql.As(sql.Count(t.C(member.FieldID)), "count")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ID] = row.Count
+	}
+	return counts, nil
+}
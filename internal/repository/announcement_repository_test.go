@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDriver は発行されたクエリ数を数えるドライバ
+type countingDriver struct {
+	dialect.Driver
+	queries atomic.Int64
+}
+
+func (d *countingDriver) Query(ctx context.Context, query string, args, v any) error {
+	d.queries.Add(1)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+var testDBSeq atomic.Int64
+
+func newCountingClient(tb testing.TB) (*ent.Client, *countingDriver) {
+	tb.Helper()
+	dsn := fmt.Sprintf("file:repository_test_%d?mode=memory&cache=shared&_fk=1", testDBSeq.Add(1))
+	drv, err := entsql.Open(dialect.SQLite, dsn)
+	require.NoError(tb, err)
+
+	counter := &countingDriver{Driver: drv}
+	client := ent.NewClient(ent.Driver(counter))
+	tb.Cleanup(func() { client.Close() })
+	require.NoError(tb, client.Schema.Create(context.Background()))
+	return client, counter
+}
+
+// seedAnnouncements は n 個のチームとそれぞれのお知らせを作成する
+func seedAnnouncements(tb testing.TB, client *ent.Client, n int) {
+	tb.Helper()
+	ctx := context.Background()
+
+	skills := client.Skill.CreateBulk(
+		client.Skill.Create().SetName("Go"),
+		client.Skill.Create().SetName("Docker"),
+	).SaveX(ctx)
+
+	for i := 0; i < n; i++ {
+		leader := client.Member.Create().
+			SetMemberID(fmt.Sprintf("leader-%d", i)).
+			SetEmail(fmt.Sprintf("leader-%d@example.com", i)).
+			SetPicture("").
+			SetNickname(fmt.Sprintf("leader-%d", i)).
+			SetBio("").
+			SetPreferredRole("BACKEND").
+			SaveX(ctx)
+		member := client.Member.Create().
+			SetMemberID(fmt.Sprintf("member-%d", i)).
+			SetEmail(fmt.Sprintf("member-%d@example.com", i)).
+			SetPicture("").
+			SetNickname(fmt.Sprintf("member-%d", i)).
+			SetBio("").
+			SetPreferredRole("FRONTEND").
+			SaveX(ctx)
+		positions := client.Position.CreateBulk(
+			client.Position.Create().SetRole("BACKEND").SetVacancy(2),
+			client.Position.Create().SetRole("FRONTEND").SetVacancy(0),
+		).SaveX(ctx)
+
+		t := client.Team.Create().
+			SetName(fmt.Sprintf("team-%d", i)).
+			SetDescription("description").
+			SetHeadcount(5).
+			SetCreatedBy(leader.MemberID).
+			AddMembers(leader, member).
+			AddPositions(positions...).
+			AddSkills(skills...).
+			SaveX(ctx)
+
+		client.Announcement.Create().
+			SetTitle(fmt.Sprintf("announcement-%d", i)).
+			SetContent("content").
+			SetTeam(t).
+			SaveX(ctx)
+	}
+}
+
+func TestGetAnnouncements_Summary(t *testing.T) {
+	client, _ := newCountingClient(t)
+	seedAnnouncements(t, client, 3)
+	repo := NewAnnouncementRepository(client)
+
+	announcements, err := repo.GetAnnouncements(context.Background(), 1, 10, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, announcements, 3)
+
+	for _, announcement := range announcements {
+		require.NotNil(t, announcement.Team)
+		assert.Equal(t, 2, announcement.Team.MemberCount)
+		assert.ElementsMatch(t, []string{"Go", "Docker"}, announcement.Team.SkillNames)
+		// 募集が埋まっているポジションは含まない
+		require.Len(t, announcement.Team.OpenPositions, 1)
+		assert.Equal(t, "BACKEND", string(announcement.Team.OpenPositions[0].Role))
+	}
+}
+
+func TestGetAnnouncements_QueryCountIsConstant(t *testing.T) {
+	client, counter := newCountingClient(t)
+	seedAnnouncements(t, client, 50)
+	repo := NewAnnouncementRepository(client)
+
+	var expected int64
+	for _, size := range []int{1, 10, 50} {
+		counter.queries.Store(0)
+		announcements, err := repo.GetAnnouncements(context.Background(), 1, size, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, announcements, size)
+
+		queries := counter.queries.Load()
+		if expected == 0 {
+			expected = queries
+		}
+		assert.Equal(t, expected, queries, "query count changed for page size %d", size)
+	}
+}
+
+func BenchmarkGetAnnouncements(b *testing.B) {
+	client, counter := newCountingClient(b)
+	seedAnnouncements(b, client, 100)
+	repo := NewAnnouncementRepository(client)
+
+	for _, size := range []int{10, 50, 100} {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			counter.queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.GetAnnouncements(context.Background(), 1, size, nil, nil, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(counter.queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
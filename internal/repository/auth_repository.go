@@ -5,9 +5,10 @@ import (
 	"backend_golang/ent/member"
 	"backend_golang/ent/transientmember"
 	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/models"
 	"context"
 	"fmt"
-	"log"
 )
 
 type AuthRepository interface {
@@ -15,7 +16,11 @@ type AuthRepository interface {
 	GetTransientMemberByID(c context.Context, id string) (*domain.TransientMember, error)
 	CreateMember(c context.Context, member *domain.Member) (*domain.Member, error)
 	GetMemberByID(c context.Context, id string) (*domain.Member, error)
+	UpdateMemberRoles(c context.Context, id string, roles []models.Role) (*domain.Member, error)
 	DeleteTransientMemberByID(c context.Context, id string) error
+	GetMemberByCalendarToken(c context.Context, token string) (*domain.Member, error)
+	SetCalendarToken(c context.Context, id string, token string) error
+	UpdateNotificationPreferences(c context.Context, id string, locale models.Locale, emailMuted []models.NotificationKind) (*domain.Member, error)
 }
 
 type authRepository struct {
@@ -75,7 +80,7 @@ func (a *authRepository) GetTransientMemberByID(c context.Context, id string) (*
 
 		return nil
 	}); err != nil {
-		log.Printf("error getting transient member by id: %v", err)
+		logging.FromContext(c).Error("error getting transient member by id", "error", err)
 		return nil, err
 	}
 	return result, nil
@@ -100,19 +105,14 @@ func (a *authRepository) CreateMember(c context.Context, member *domain.Member)
 			SetNickname(transientMember.Nickname).
 			SetBio(member.Bio).
 			SetPreferredRole(member.PreferredRole).
+			SetRoles(member.Roles).
 			Save(c)
 		if err != nil {
 			return err
 		}
 
-		result = &domain.Member{
-			ID:            member.MemberID,
-			Email:         transientMember.Email,
-			Picture:       transientMember.Picture,
-			Nickname:      transientMember.Nickname,
-			Bio:           member.Bio,
-			PreferredRole: member.PreferredRole,
-		}
+		converted := toDomainMember(member)
+		result = &converted
 
 		return nil
 	})
@@ -120,29 +120,84 @@ func (a *authRepository) CreateMember(c context.Context, member *domain.Member)
 }
 
 func (a *authRepository) GetMemberByID(c context.Context, id string) (*domain.Member, error) {
-	member, err := a.client.Member.Query().Where(member.MemberID(id)).First(c)
+	member, err := a.client.Member.Query().Where(member.MemberID(id)).WithTeams().First(c)
 	if err != nil {
-		log.Printf("error getting member by id: %v", err)
+		logging.FromContext(c).Error("error getting member by id", "error", err)
 		return nil, err
 	}
 
-	return &domain.Member{
-		ID:            member.MemberID,
-		Email:         member.Email,
-		Picture:       member.Picture,
-		Nickname:      member.Nickname,
-		Bio:           member.Bio,
-		PreferredRole: member.PreferredRole,
-	}, nil
+	result := toDomainMember(member)
+	return &result, nil
+}
+
+func (a *authRepository) UpdateMemberRoles(c context.Context, id string, roles []models.Role) (*domain.Member, error) {
+	var result *domain.Member
+	err := a.tx.WithTx(c, func(tx *ent.Tx) error {
+		_, err := tx.Member.Update().
+			Where(member.MemberID(id)).
+			SetRoles(roles).
+			Save(c)
+		if err != nil {
+			return err
+		}
+
+		updated, err := tx.Member.Query().Where(member.MemberID(id)).Only(c)
+		if err != nil {
+			return err
+		}
+		converted := toDomainMember(updated)
+		result = &converted
+		return nil
+	})
+	if err != nil {
+		logging.FromContext(c).Error("error updating member roles", "error", err)
+		return nil, err
+	}
+	return result, nil
 }
 
 func (a *authRepository) DeleteTransientMemberByID(c context.Context, id string) error {
 	return a.tx.WithTx(c, func(tx *ent.Tx) error {
 		_, err := tx.TransientMember.Delete().Where(transientmember.TransientMemberID(id)).Exec(c)
 		if err != nil {
-			log.Printf("error deleting transient member by id: %v", err)
+			logging.FromContext(c).Error("error deleting transient member by id", "error", err)
 			return err
 		}
 		return nil
 	})
 }
+
+func (a *authRepository) GetMemberByCalendarToken(c context.Context, token string) (*domain.Member, error) {
+	member, err := a.client.Member.Query().Where(member.CalendarToken(token)).Only(c)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toDomainMember(member)
+	return &result, nil
+}
+
+func (a *authRepository) SetCalendarToken(c context.Context, id string, token string) error {
+	_, err := a.client.Member.Update().
+		Where(member.MemberID(id)).
+		SetCalendarToken(token).
+		Save(c)
+	if err != nil {
+		logging.FromContext(c).Error("error setting calendar token", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (a *authRepository) UpdateNotificationPreferences(c context.Context, id string, locale models.Locale, emailMuted []models.NotificationKind) (*domain.Member, error) {
+	_, err := a.client.Member.Update().
+		Where(member.MemberID(id)).
+		SetLocale(locale).
+		SetEmailMuted(emailMuted).
+		Save(c)
+	if err != nil {
+		logging.FromContext(c).Error("error updating notification preferences", "error", err)
+		return nil, err
+	}
+	return a.GetMemberByID(c, id)
+}
@@ -0,0 +1,395 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/member"
+	"backend_golang/ent/predicate"
+	"backend_golang/ent/rsvp"
+	"backend_golang/ent/team"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type EventRepository interface {
+	Create(ctx context.Context, createEvent *domain.Event) (*domain.Event, error)
+	Update(ctx context.Context, updateEvent *domain.Event) error
+	Cancel(ctx context.Context, eventID int, now time.Time) error
+	FindByID(ctx context.Context, eventID int, viewerID string) (*domain.Event, error)
+	FindByTeam(ctx context.Context, teamID int, from time.Time, to time.Time, viewerID string) ([]domain.Event, error)
+	FindUpcomingByMember(ctx context.Context, memberID string, from time.Time, to time.Time) ([]domain.Event, error)
+	FindFeedByTeam(ctx context.Context, teamID int, since time.Time) ([]domain.Event, error)
+	FindFeedByMember(ctx context.Context, memberID string, since time.Time) ([]domain.Event, error)
+	FindBetween(ctx context.Context, from time.Time, to time.Time) ([]domain.Event, error)
+	FindAttendees(ctx context.Context, eventID int) ([]string, error)
+	SaveOverride(ctx context.Context, eventID int, override domain.EventOverride) error
+	RSVP(ctx context.Context, eventID int, memberID string, status models.RSVPStatus) error
+}
+
+type eventRepository struct {
+	client *ent.Client
+	tx     *TransactionManager
+}
+
+func NewEventRepository(client *ent.Client) EventRepository {
+	return &eventRepository{
+		client: client,
+		tx:     NewTransactionManager(client),
+	}
+}
+
+func (e *eventRepository) Create(ctx context.Context, createEvent *domain.Event) (*domain.Event, error) {
+	created, err := e.client.Event.Create().
+		SetTeamID(createEvent.TeamID).
+		SetTitle(createEvent.Title).
+		SetAgenda(createEvent.Agenda).
+		SetStartsAt(createEvent.StartsAt).
+		SetEndsAt(createEvent.EndsAt).
+		SetLocation(createEvent.Location).
+		SetOnlineURL(createEvent.OnlineURL).
+		SetCapacity(createEvent.Capacity).
+		SetRecurrence(createEvent.Recurrence).
+		SetNillableRecurrenceEndsAt(createEvent.RecurrenceEndsAt).
+		SetCreatedBy(createEvent.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return e.FindByID(ctx, created.ID, createEvent.CreatedBy)
+}
+
+// Update はイベントを変更する。初回の開始日時か繰り返しを変えた場合、回ごとの変更は対応する回がなくなるので削除する
+func (e *eventRepository) Update(ctx context.Context, updateEvent *domain.Event) error {
+	return e.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		existing, err := tx.Event.Get(ctx, updateEvent.ID)
+		if err != nil {
+			return err
+		}
+		if !existing.StartsAt.Equal(updateEvent.StartsAt) || existing.Recurrence != updateEvent.Recurrence {
+			_, err := tx.EventOverride.Delete().
+				Where(eventoverride.EventID(updateEvent.ID)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		update := tx.Event.UpdateOneID(updateEvent.ID).
+			SetTitle(updateEvent.Title).
+			SetAgenda(updateEvent.Agenda).
+			SetStartsAt(updateEvent.StartsAt).
+			SetEndsAt(updateEvent.EndsAt).
+			SetLocation(updateEvent.Location).
+			SetOnlineURL(updateEvent.OnlineURL).
+			SetCapacity(updateEvent.Capacity).
+			SetRecurrence(updateEvent.Recurrence).
+			AddSequence(1)
+		if updateEvent.RecurrenceEndsAt != nil {
+			update.SetRecurrenceEndsAt(*updateEvent.RecurrenceEndsAt)
+		} else {
+			update.ClearRecurrenceEndsAt()
+		}
+		return update.Exec(ctx)
+	})
+}
+
+// Cancel はイベントを取り消す。カレンダーに取り消しを伝えるためイベント自体は残す
+func (e *eventRepository) Cancel(ctx context.Context, eventID int, now time.Time) error {
+	_, err := e.client.Event.Update().
+		Where(
+			event.ID(eventID),
+			event.CancelledAtIsNil(),
+		).
+		SetCancelledAt(now).
+		AddSequence(1).
+		Save(ctx)
+	return err
+}
+
+func (e *eventRepository) FindByID(ctx context.Context, eventID int, viewerID string) (*domain.Event, error) {
+	found, err := e.query(viewerID).Where(event.ID(eventID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainEvent(found)
+	return &result, nil
+}
+
+// FindByTeam は from から to までの期間に開催されるチームのイベントを開始日時順に返す
+func (e *eventRepository) FindByTeam(ctx context.Context, teamID int, from time.Time, to time.Time, viewerID string) ([]domain.Event, error) {
+	events, err := e.query(viewerID).
+		Where(
+			event.TeamID(teamID),
+			event.CancelledAtIsNil(),
+			event.StartsAtLT(to),
+			endsAfter(from),
+		).
+		Order(ent.Asc(event.FieldStartsAt), ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEvents(events), nil
+}
+
+// FindUpcomingByMember はメンバーが所属するチームの from から to までの期間に開催されるイベントを開始日時順に返す
+func (e *eventRepository) FindUpcomingByMember(ctx context.Context, memberID string, from time.Time, to time.Time) ([]domain.Event, error) {
+	events, err := e.query(memberID).
+		Where(
+			event.HasTeamWith(team.HasMembersWith(member.MemberID(memberID))),
+			event.CancelledAtIsNil(),
+			event.StartsAtLT(to),
+			endsAfter(from),
+		).
+		Order(ent.Asc(event.FieldStartsAt), ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEvents(events), nil
+}
+
+// FindBetween は from から to までの期間に開催される全チームのイベントを返す。取り消したものは含めない
+func (e *eventRepository) FindBetween(ctx context.Context, from time.Time, to time.Time) ([]domain.Event, error) {
+	events, err := e.query("").
+		Where(
+			event.CancelledAtIsNil(),
+			event.StartsAtLT(to),
+			endsAfter(from),
+		).
+		Order(ent.Asc(event.FieldStartsAt), ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEvents(events), nil
+}
+
+// FindAttendees は参加または未定と回答した、チームに所属しているメンバーの ID を返す
+func (e *eventRepository) FindAttendees(ctx context.Context, eventID int) ([]string, error) {
+	return e.client.Member.Query().
+		Where(
+			member.HasRsvpsWith(
+				rsvp.EventID(eventID),
+				rsvp.StatusIn(models.RSVPGoing, models.RSVPMaybe),
+			),
+			member.HasTeamsWith(team.HasEventsWith(event.ID(eventID))),
+		).
+		Order(ent.Asc(member.FieldID)).
+		Select(member.FieldMemberID).
+		Strings(ctx)
+}
+
+// FindFeedByTeam は since 以降に終わるチームのイベントを、取り消したものも含めて返す
+func (e *eventRepository) FindFeedByTeam(ctx context.Context, teamID int, since time.Time) ([]domain.Event, error) {
+	events, err := e.query("").
+		Where(
+			event.TeamID(teamID),
+			endsAfter(since),
+		).
+		Order(ent.Asc(event.FieldStartsAt), ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEvents(events), nil
+}
+
+// FindFeedByMember は since 以降に終わる所属チームのイベントを、取り消したものも含めて返す
+// 不参加と回答したイベントは含めない
+func (e *eventRepository) FindFeedByMember(ctx context.Context, memberID string, since time.Time) ([]domain.Event, error) {
+	events, err := e.query(memberID).
+		Where(
+			event.HasTeamWith(team.HasMembersWith(member.MemberID(memberID))),
+			endsAfter(since),
+			event.Not(event.HasRsvpsWith(
+				rsvp.HasMemberWith(member.MemberID(memberID)),
+				rsvp.StatusEQ(models.RSVPDeclined),
+			)),
+		).
+		Order(ent.Asc(event.FieldStartsAt), ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEvents(events), nil
+}
+
+// RSVP はメンバーの出欠回答を登録・変更し、参加人数を更新する
+func (e *eventRepository) RSVP(ctx context.Context, eventID int, memberID string, status models.RSVPStatus) error {
+	return e.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		memberEnt, err := tx.Member.Query().Where(member.MemberID(memberID)).Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		existing, err := tx.RSVP.Query().
+			Where(
+				rsvp.EventID(eventID),
+				rsvp.HasMemberWith(member.ID(memberEnt.ID)),
+			).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		var previous models.RSVPStatus
+		if existing != nil {
+			previous = existing.Status
+		}
+
+		switch {
+		case status == models.RSVPGoing && previous != models.RSVPGoing:
+			// 定員を超えないよう条件付き UPDATE で参加人数を増やす
+			updated, err := tx.Event.Update().
+				Where(
+					event.ID(eventID),
+					event.Or(
+						event.Capacity(0),
+						func(s *sql.Selector) {
+							s.Where(sql.ColumnsLT(s.C(event.FieldGoingCount), s.C(event.FieldCapacity)))
+						},
+					),
+				).
+				AddGoingCount(1).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if updated == 0 {
+				return models.ErrEventFull
+			}
+		case status != models.RSVPGoing && previous == models.RSVPGoing:
+			err := tx.Event.UpdateOneID(eventID).AddGoingCount(-1).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if existing != nil {
+			return existing.Update().SetStatus(status).Exec(ctx)
+		}
+		return tx.RSVP.Create().
+			SetEventID(eventID).
+			SetMember(memberEnt).
+			SetStatus(status).
+			Exec(ctx)
+	})
+}
+
+// SaveOverride は繰り返しイベントの1回分の変更を保存する。同じ回の変更は置き換える
+func (e *eventRepository) SaveOverride(ctx context.Context, eventID int, override domain.EventOverride) error {
+	return e.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		original := override.OriginalStartsAt.UTC()
+		_, err := tx.EventOverride.Delete().
+			Where(
+				eventoverride.EventID(eventID),
+				eventoverride.OriginalStartsAt(original),
+			).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = tx.EventOverride.Create().
+			SetEventID(eventID).
+			SetOriginalStartsAt(original).
+			SetCancelled(override.Cancelled).
+			SetNillableTitle(override.Title).
+			SetNillableAgenda(override.Agenda).
+			SetNillableStartsAt(override.StartsAt).
+			SetNillableEndsAt(override.EndsAt).
+			SetNillableLocation(override.Location).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// カレンダーに変更を伝えるため、繰り返しイベント全体の版数を上げる
+		return tx.Event.UpdateOneID(eventID).AddSequence(1).Exec(ctx)
+	})
+}
+
+// endsAfter は t より後に終わるイベントを絞り込む。繰り返しイベントは最後の回の終了日時で判定する
+func endsAfter(t time.Time) predicate.Event {
+	return event.Or(
+		event.And(
+			event.Recurrence(""),
+			event.EndsAtGT(t),
+		),
+		event.And(
+			event.RecurrenceNEQ(""),
+			event.Or(
+				event.RecurrenceEndsAtIsNil(),
+				event.RecurrenceEndsAtGT(t),
+			),
+		),
+	)
+}
+
+// query は閲覧者の出欠回答とチーム名を一緒に読み込むクエリを返す
+func (e *eventRepository) query(viewerID string) *ent.EventQuery {
+	return e.client.Event.Query().
+		WithTeam(func(tq *ent.TeamQuery) {
+			tq.Select(team.FieldName)
+		}).
+		WithRsvps(func(rq *ent.RSVPQuery) {
+			rq.Where(rsvp.HasMemberWith(member.MemberID(viewerID)))
+		}).
+		WithOverrides(func(oq *ent.EventOverrideQuery) {
+			oq.Order(ent.Asc(eventoverride.FieldOriginalStartsAt))
+		})
+}
+
+func toDomainEvents(events []*ent.Event) []domain.Event {
+	result := make([]domain.Event, len(events))
+	for i, found := range events {
+		result[i] = toDomainEvent(found)
+	}
+	return result
+}
+
+func toDomainEvent(found *ent.Event) domain.Event {
+	result := domain.Event{
+		ID:               found.ID,
+		TeamID:           found.TeamID,
+		Title:            found.Title,
+		Agenda:           found.Agenda,
+		StartsAt:         found.StartsAt,
+		EndsAt:           found.EndsAt,
+		Location:         found.Location,
+		OnlineURL:        found.OnlineURL,
+		Capacity:         found.Capacity,
+		Going:            found.GoingCount,
+		Recurrence:       found.Recurrence,
+		RecurrenceEndsAt: found.RecurrenceEndsAt,
+		Sequence:         found.Sequence,
+		CancelledAt:      found.CancelledAt,
+		CreatedBy:        found.CreatedBy,
+		CreatedAt:        found.CreatedAt,
+		UpdatedAt:        found.UpdatedAt,
+	}
+	if found.Edges.Team != nil {
+		result.TeamName = found.Edges.Team.Name
+	}
+	if len(found.Edges.Rsvps) > 0 {
+		result.MyRSVP = found.Edges.Rsvps[0].Status
+	}
+	for _, o := range found.Edges.Overrides {
+		result.Overrides = append(result.Overrides, domain.EventOverride{
+			OriginalStartsAt: o.OriginalStartsAt,
+			Cancelled:        o.Cancelled,
+			Title:            o.Title,
+			Agenda:           o.Agenda,
+			StartsAt:         o.StartsAt,
+			EndsAt:           o.EndsAt,
+			Location:         o.Location,
+		})
+	}
+	return result
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRepository_RSVPRespectsCapacity(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	events := NewEventRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	member := newTestMember(t, client, "member")
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, member.MemberID, models.Backend))
+
+	startsAt := time.Now().Add(24 * time.Hour)
+	event, err := events.Create(ctx, &domain.Event{
+		TeamID:    created.ID,
+		Title:     "Go 勉強会",
+		StartsAt:  startsAt,
+		EndsAt:    startsAt.Add(2 * time.Hour),
+		Capacity:  1,
+		CreatedBy: leader.MemberID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gophers", event.TeamName)
+
+	require.NoError(t, events.RSVP(ctx, event.ID, leader.MemberID, models.RSVPGoing))
+	// 同じ回答を繰り返しても人数は増えない
+	require.NoError(t, events.RSVP(ctx, event.ID, leader.MemberID, models.RSVPGoing))
+
+	err = events.RSVP(ctx, event.ID, member.MemberID, models.RSVPGoing)
+	assert.ErrorIs(t, err, models.ErrEventFull)
+	require.NoError(t, events.RSVP(ctx, event.ID, member.MemberID, models.RSVPMaybe))
+
+	found, err := events.FindByID(ctx, event.ID, member.MemberID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, found.Going)
+	assert.Equal(t, models.RSVPMaybe, found.MyRSVP)
+
+	// 不参加に変えると枠が空く
+	require.NoError(t, events.RSVP(ctx, event.ID, leader.MemberID, models.RSVPDeclined))
+	require.NoError(t, events.RSVP(ctx, event.ID, member.MemberID, models.RSVPGoing))
+
+	found, err = events.FindByID(ctx, event.ID, leader.MemberID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, found.Going)
+	assert.Equal(t, models.RSVPDeclined, found.MyRSVP)
+
+	// 不参加と回答したメンバーはリマインダーの対象にしない
+	attendees, err := events.FindAttendees(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{member.MemberID}, attendees)
+
+	between, err := events.FindBetween(ctx, startsAt.Add(-time.Hour), startsAt.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []int{event.ID}, eventIDs(between))
+	between, err = events.FindBetween(ctx, startsAt.Add(3*time.Hour), startsAt.Add(4*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, between)
+}
+
+func TestEventRepository_FindUpcomingByMember(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	events := NewEventRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	otherLeader := newTestMember(t, client, "other")
+	other, err := teams.CreateTeam(ctx, domainTeam("rustaceans", otherLeader.MemberID))
+	require.NoError(t, err)
+
+	now := time.Now()
+	create := func(teamID int, title string, startsAt time.Time) {
+		_, err := events.Create(ctx, &domain.Event{
+			TeamID:    teamID,
+			Title:     title,
+			StartsAt:  startsAt,
+			EndsAt:    startsAt.Add(time.Hour),
+			CreatedBy: "leader",
+		})
+		require.NoError(t, err)
+	}
+	create(created.ID, "past", now.Add(-48*time.Hour))
+	create(created.ID, "next week", now.Add(7*24*time.Hour))
+	create(created.ID, "tomorrow", now.Add(24*time.Hour))
+	create(other.ID, "other team", now.Add(24*time.Hour))
+
+	upcoming, err := events.FindUpcomingByMember(ctx, leader.MemberID, now, now.Add(30*24*time.Hour))
+	require.NoError(t, err)
+	titles := make([]string, len(upcoming))
+	for i, event := range upcoming {
+		titles[i] = event.Title
+	}
+	assert.Equal(t, []string{"tomorrow", "next week"}, titles)
+
+	inRange, err := events.FindByTeam(ctx, created.ID, now.Add(-72*time.Hour), now.Add(48*time.Hour), leader.MemberID)
+	require.NoError(t, err)
+	require.Len(t, inRange, 2)
+	assert.Equal(t, "past", inRange[0].Title)
+	assert.Equal(t, "tomorrow", inRange[1].Title)
+}
+
+func TestEventRepository_CancelKeepsEventInFeed(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	events := NewEventRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	member := newTestMember(t, client, "member")
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, member.MemberID, models.Backend))
+
+	now := time.Now()
+	newEvent := func(title string) *domain.Event {
+		event, err := events.Create(ctx, &domain.Event{
+			TeamID:    created.ID,
+			Title:     title,
+			StartsAt:  now.Add(24 * time.Hour),
+			EndsAt:    now.Add(26 * time.Hour),
+			CreatedBy: leader.MemberID,
+		})
+		require.NoError(t, err)
+		return event
+	}
+	kept := newEvent("もくもく会")
+	cancelled := newEvent("Go 勉強会")
+	declined := newEvent("懇親会")
+
+	require.NoError(t, events.Update(ctx, cancelled))
+	require.NoError(t, events.Cancel(ctx, cancelled.ID, now))
+	// 取り消し済みのイベントを再度取り消しても版数は変わらない
+	require.NoError(t, events.Cancel(ctx, cancelled.ID, now))
+	require.NoError(t, events.RSVP(ctx, declined.ID, member.MemberID, models.RSVPDeclined))
+
+	found, err := events.FindByID(ctx, cancelled.ID, member.MemberID)
+	require.NoError(t, err)
+	assert.True(t, found.IsCancelled())
+	assert.Equal(t, 2, found.Sequence)
+
+	listed, err := events.FindByTeam(ctx, created.ID, now, now.Add(48*time.Hour), member.MemberID)
+	require.NoError(t, err)
+	assert.Equal(t, []int{kept.ID, declined.ID}, eventIDs(listed))
+
+	upcoming, err := events.FindUpcomingByMember(ctx, member.MemberID, now, now.Add(48*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []int{kept.ID, declined.ID}, eventIDs(upcoming))
+
+	teamFeed, err := events.FindFeedByTeam(ctx, created.ID, now)
+	require.NoError(t, err)
+	assert.Equal(t, []int{kept.ID, cancelled.ID, declined.ID}, eventIDs(teamFeed))
+
+	// 不参加と回答したイベントは本人のフィードに含めない
+	memberFeed, err := events.FindFeedByMember(ctx, member.MemberID, now)
+	require.NoError(t, err)
+	assert.Equal(t, []int{kept.ID, cancelled.ID}, eventIDs(memberFeed))
+
+	leaderFeed, err := events.FindFeedByMember(ctx, leader.MemberID, now)
+	require.NoError(t, err)
+	assert.Equal(t, []int{kept.ID, cancelled.ID, declined.ID}, eventIDs(leaderFeed))
+}
+
+func eventIDs(events []domain.Event) []int {
+	ids := make([]int, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+func TestEventRepository_RecurringEvents(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	events := NewEventRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	// 4週間前から毎週、期限なしで開催している
+	now := time.Now().UTC().Truncate(time.Second)
+	start := now.Add(-28 * 24 * time.Hour)
+	event, err := events.Create(ctx, &domain.Event{
+		TeamID:     created.ID,
+		Title:      "もくもく会",
+		StartsAt:   start,
+		EndsAt:     start.Add(2 * time.Hour),
+		Recurrence: "FREQ=WEEKLY",
+		CreatedBy:  leader.MemberID,
+	})
+	require.NoError(t, err)
+
+	upcoming, err := events.FindUpcomingByMember(ctx, leader.MemberID, now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []int{event.ID}, eventIDs(upcoming))
+
+	inRange, err := events.FindByTeam(ctx, created.ID, now.Add(time.Hour), now.Add(24*time.Hour), leader.MemberID)
+	require.NoError(t, err)
+	assert.Equal(t, []int{event.ID}, eventIDs(inRange))
+
+	original := start.Add(7 * 24 * time.Hour)
+	require.NoError(t, events.SaveOverride(ctx, event.ID, domain.EventOverride{OriginalStartsAt: original, Cancelled: true}))
+	// 同じ回の変更は置き換える
+	require.NoError(t, events.SaveOverride(ctx, event.ID, domain.EventOverride{OriginalStartsAt: original}))
+
+	found, err := events.FindByID(ctx, event.ID, leader.MemberID)
+	require.NoError(t, err)
+	require.Len(t, found.Overrides, 1)
+	assert.False(t, found.Overrides[0].Cancelled)
+	assert.True(t, original.Equal(found.Overrides[0].OriginalStartsAt))
+	assert.Equal(t, 2, found.Sequence)
+
+	// 繰り返しを変えると回ごとの変更は削除する
+	found.Recurrence = "FREQ=WEEKLY;INTERVAL=2"
+	require.NoError(t, events.Update(ctx, found))
+	found, err = events.FindByID(ctx, event.ID, leader.MemberID)
+	require.NoError(t, err)
+	assert.Empty(t, found.Overrides)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2", found.Recurrence)
+
+	// 終了した繰り返しは一覧に含めない
+	ended := start.Add(2 * time.Hour)
+	found.RecurrenceEndsAt = &ended
+	require.NoError(t, events.Update(ctx, found))
+	upcoming, err = events.FindUpcomingByMember(ctx, leader.MemberID, now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, upcoming)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"context"
+)
+
+type HealthRepository interface {
+	// Ping はデータベースにクエリを実行できるかどうかを確認する
+	Ping(ctx context.Context) error
+	// MigrationsCurrent はデータベースのスキーマが ent のスキーマに追いついているかどうかを返す
+	MigrationsCurrent(ctx context.Context) (bool, error)
+}
+
+type healthRepository struct {
+	client *ent.Client
+}
+
+func NewHealthRepository(client *ent.Client) HealthRepository {
+	return &healthRepository{
+		client: client,
+	}
+}
+
+func (h *healthRepository) Ping(ctx context.Context) error {
+	_, err := h.client.Member.Query().Limit(1).Exist(ctx)
+	return err
+}
+
+// MigrationsCurrent はマイグレーションで実行する SQL を書き出し、何も出力されなければ最新とみなす
+func (h *healthRepository) MigrationsCurrent(ctx context.Context) (bool, error) {
+	var pending countingWriter
+	if err := h.client.Schema.WriteTo(ctx, &pending); err != nil {
+		return false, err
+	}
+	return pending == 0, nil
+}
+
+// countingWriter は書き込まれたバイト数だけを数える
+type countingWriter int
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	*c += countingWriter(len(p))
+	return len(p), nil
+}
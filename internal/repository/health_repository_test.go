@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthRepository(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	health := NewHealthRepository(client)
+
+	require.NoError(t, health.Ping(ctx))
+	current, err := health.MigrationsCurrent(ctx)
+	require.NoError(t, err)
+	assert.True(t, current)
+
+	// マイグレーションしていないデータベースは最新ではなく、テーブルがないので Ping も失敗する
+	empty, err := ent.Open(dialect.SQLite, "file:health_test?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	t.Cleanup(func() { empty.Close() })
+	health = NewHealthRepository(empty)
+
+	assert.Error(t, health.Ping(ctx))
+	current, err = health.MigrationsCurrent(ctx)
+	require.NoError(t, err)
+	assert.False(t, current)
+
+	// 接続を閉じた後は確認に失敗する
+	require.NoError(t, empty.Close())
+	assert.Error(t, health.Ping(ctx))
+	_, err = health.MigrationsCurrent(ctx)
+	assert.Error(t, err)
+}
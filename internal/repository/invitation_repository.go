@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/invitation"
+	"backend_golang/ent/member"
+	"backend_golang/ent/team"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"time"
+)
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *domain.Invitation) (*domain.Invitation, error)
+	FindByTeam(ctx context.Context, teamID int) ([]domain.Invitation, error)
+	FindPendingByInvitee(ctx context.Context, memberID string, now time.Time) ([]domain.Invitation, error)
+	Revoke(ctx context.Context, teamID int, invitationID int) error
+	Decline(ctx context.Context, invitationID int, memberID string) error
+	Accept(ctx context.Context, invitationID int, memberID string, now time.Time) (int, error)
+	Redeem(ctx context.Context, token string, memberID string, now time.Time) (int, error)
+}
+
+type invitationRepository struct {
+	client *ent.Client
+	tx     *TransactionManager
+}
+
+func NewInvitationRepository(client *ent.Client) InvitationRepository {
+	return &invitationRepository{
+		client: client,
+		tx:     NewTransactionManager(client),
+	}
+}
+
+func (i *invitationRepository) Create(ctx context.Context, createInvitation *domain.Invitation) (*domain.Invitation, error) {
+	create := i.client.Invitation.Create().
+		SetTeamID(createInvitation.TeamID).
+		SetKind(createInvitation.Kind).
+		SetRole(createInvitation.Role).
+		SetMaxUses(createInvitation.MaxUses).
+		SetNillableExpiresAt(createInvitation.ExpiresAt).
+		SetCreatedBy(createInvitation.CreatedBy)
+
+	if createInvitation.Token != "" {
+		create.SetToken(createInvitation.Token)
+	}
+	if createInvitation.InviteeID != "" {
+		invitee, err := i.client.Member.Query().Where(member.MemberID(createInvitation.InviteeID)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		create.SetInvitee(invitee)
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := i.query().Where(invitation.ID(created.ID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainInvitation(found)
+	return &result, nil
+}
+
+func (i *invitationRepository) FindByTeam(ctx context.Context, teamID int) ([]domain.Invitation, error) {
+	invitations, err := i.query().
+		Where(invitation.TeamID(teamID)).
+		Order(ent.Desc(invitation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainInvitations(invitations), nil
+}
+
+// FindPendingByInvitee はメンバーが受け取った未回答で期限内の直接招待を返す
+func (i *invitationRepository) FindPendingByInvitee(ctx context.Context, memberID string, now time.Time) ([]domain.Invitation, error) {
+	invitations, err := i.query().
+		Where(
+			invitation.HasInviteeWith(member.MemberID(memberID)),
+			invitation.StatusEQ(models.InvitationActive),
+			invitation.Or(
+				invitation.ExpiresAtIsNil(),
+				invitation.ExpiresAtGT(now),
+			),
+		).
+		Order(ent.Desc(invitation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainInvitations(invitations), nil
+}
+
+func (i *invitationRepository) Revoke(ctx context.Context, teamID int, invitationID int) error {
+	updated, err := i.client.Invitation.Update().
+		Where(
+			invitation.ID(invitationID),
+			invitation.TeamID(teamID),
+			invitation.StatusEQ(models.InvitationActive),
+		).
+		SetStatus(models.InvitationRevoked).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return models.ErrInvitationInvalid
+	}
+	return nil
+}
+
+func (i *invitationRepository) Decline(ctx context.Context, invitationID int, memberID string) error {
+	updated, err := i.client.Invitation.Update().
+		Where(
+			invitation.ID(invitationID),
+			invitation.HasInviteeWith(member.MemberID(memberID)),
+			invitation.StatusEQ(models.InvitationActive),
+		).
+		SetStatus(models.InvitationDeclined).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return models.ErrInvitationInvalid
+	}
+	return nil
+}
+
+// Accept は直接招待を承諾してチームに参加し、参加したチームのIDを返す
+func (i *invitationRepository) Accept(ctx context.Context, invitationID int, memberID string, now time.Time) (int, error) {
+	var teamID int
+	err := i.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		found, err := tx.Invitation.Query().
+			Where(
+				invitation.ID(invitationID),
+				invitation.HasInviteeWith(member.MemberID(memberID)),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		teamID = found.TeamID
+		return useInvitation(ctx, tx, found, memberID, now)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return teamID, nil
+}
+
+// Redeem は招待リンクを使ってチームに参加し、参加したチームのIDを返す
+func (i *invitationRepository) Redeem(ctx context.Context, token string, memberID string, now time.Time) (int, error) {
+	var teamID int
+	err := i.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		found, err := tx.Invitation.Query().
+			Where(
+				invitation.Token(token),
+				invitation.KindEQ(models.InvitationLink),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		teamID = found.TeamID
+		return useInvitation(ctx, tx, found, memberID, now)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return teamID, nil
+}
+
+// useInvitation は招待の利用回数を1つ増やし、通常の参加と同じ手順で空きを確保してメンバーを所属させる
+func useInvitation(ctx context.Context, tx *ent.Tx, found *ent.Invitation, memberID string, now time.Time) error {
+	if err := toDomainInvitation(found).Usable(now); err != nil {
+		return err
+	}
+
+	// 同時に使われても上限を超えないよう条件付き UPDATE で回数を増やす
+	update := tx.Invitation.Update().
+		Where(
+			invitation.ID(found.ID),
+			invitation.StatusEQ(models.InvitationActive),
+		).
+		AddUses(1)
+	if found.MaxUses > 0 {
+		update.Where(invitation.UsesLT(found.MaxUses))
+	}
+	if found.Kind == models.InvitationDirect {
+		update.SetStatus(models.InvitationAccepted)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return models.ErrInvitationUsedUp
+	}
+
+	return seatMember(ctx, tx, found.TeamID, memberID, found.Role, admitInvited)
+}
+
+func (i *invitationRepository) query() *ent.InvitationQuery {
+	return i.client.Invitation.Query().
+		WithTeam(func(tq *ent.TeamQuery) {
+			tq.Select(team.FieldName)
+		}).
+		WithInvitee(func(mq *ent.MemberQuery) {
+			mq.Select(member.FieldMemberID)
+		})
+}
+
+func toDomainInvitations(invitations []*ent.Invitation) []domain.Invitation {
+	result := make([]domain.Invitation, len(invitations))
+	for i, found := range invitations {
+		result[i] = toDomainInvitation(found)
+	}
+	return result
+}
+
+func toDomainInvitation(found *ent.Invitation) domain.Invitation {
+	result := domain.Invitation{
+		ID:        found.ID,
+		TeamID:    found.TeamID,
+		Kind:      found.Kind,
+		Role:      found.Role,
+		Status:    found.Status,
+		MaxUses:   found.MaxUses,
+		Uses:      found.Uses,
+		ExpiresAt: found.ExpiresAt,
+		CreatedBy: found.CreatedBy,
+		CreatedAt: found.CreatedAt,
+	}
+	if found.Token != nil {
+		result.Token = *found.Token
+	}
+	if found.Edges.Team != nil {
+		result.TeamName = found.Edges.Team.Name
+	}
+	if found.Edges.Invitee != nil {
+		result.InviteeID = found.Edges.Invitee.MemberID
+	}
+	return result
+}
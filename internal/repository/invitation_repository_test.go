@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitationRepository_RedeemLink(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	invitations := NewInvitationRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	link, err := invitations.Create(ctx, &domain.Invitation{
+		TeamID:    created.ID,
+		Kind:      models.InvitationLink,
+		Role:      models.Backend,
+		Token:     "token",
+		MaxUses:   1,
+		ExpiresAt: &expiresAt,
+		CreatedBy: leader.MemberID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gophers", link.TeamName)
+
+	newTestMember(t, client, "first")
+	newTestMember(t, client, "second")
+
+	_, err = invitations.Redeem(ctx, "token", "first", expiresAt)
+	assert.ErrorIs(t, err, models.ErrInvitationExpired)
+
+	teamID, err := invitations.Redeem(ctx, "token", "first", now)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, teamID)
+
+	_, err = invitations.Redeem(ctx, "token", "second", now)
+	assert.ErrorIs(t, err, models.ErrInvitationUsedUp)
+
+	_, err = invitations.Redeem(ctx, "unknown", "second", now)
+	assert.True(t, ent.IsNotFound(err))
+
+	team, err := teams.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, team.Positions[0].Filled)
+	assert.Equal(t, int8(1), team.Positions[0].Vacancy)
+}
+
+func TestInvitationRepository_DirectInviteToClosedTeam(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	invitations := NewInvitationRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	require.NoError(t, teams.UpdateStatus(ctx, created.ID, models.TeamStatusClosed))
+
+	invitee := newTestMember(t, client, "invitee")
+	other := newTestMember(t, client, "other")
+	invitation, err := invitations.Create(ctx, &domain.Invitation{
+		TeamID:    created.ID,
+		Kind:      models.InvitationDirect,
+		Role:      models.Backend,
+		MaxUses:   1,
+		InviteeID: invitee.MemberID,
+		CreatedBy: leader.MemberID,
+	})
+	require.NoError(t, err)
+
+	pending, err := invitations.FindPendingByInvitee(ctx, invitee.MemberID, time.Now())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, invitation.ID, pending[0].ID)
+
+	// 招待されたメンバー以外は承諾できない
+	_, err = invitations.Accept(ctx, invitation.ID, other.MemberID, time.Now())
+	assert.True(t, ent.IsNotFound(err))
+
+	// 募集を締め切っていても招待されたメンバーは参加できる
+	_, err = invitations.Accept(ctx, invitation.ID, invitee.MemberID, time.Now())
+	require.NoError(t, err)
+
+	team, err := teams.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	_, ok := team.Member(invitee.MemberID)
+	assert.True(t, ok)
+	assert.Equal(t, models.TeamStatusClosed, team.Status)
+
+	_, err = invitations.Accept(ctx, invitation.ID, invitee.MemberID, time.Now())
+	assert.ErrorIs(t, err, models.ErrInvitationInvalid)
+
+	pending, err = invitations.FindPendingByInvitee(ctx, invitee.MemberID, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
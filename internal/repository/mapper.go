@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+)
+
+func toDomainMember(member *ent.Member) domain.Member {
+	result := domain.Member{
+		ID:            member.MemberID,
+		Email:         member.Email,
+		Picture:       member.Picture,
+		Nickname:      member.Nickname,
+		Bio:           member.Bio,
+		PreferredRole: member.PreferredRole,
+		Roles:         member.Roles,
+		Locale:        member.Locale,
+		EmailMuted:    member.EmailMuted,
+	}
+	if member.CalendarToken != nil {
+		result.CalendarToken = *member.CalendarToken
+	}
+	if member.Edges.Teams != nil {
+		result.TeamID = member.Edges.Teams.ID
+	}
+	if member.Edges.Position != nil {
+		result.TeamRole = member.Edges.Position.Role
+	}
+	return result
+}
+
+// withTeamDetail はチーム詳細の表示に必要なエッジを読み込む
+func withTeamDetail(tq *ent.TeamQuery) {
+	tq.WithMembers(func(mq *ent.MemberQuery) {
+		mq.WithPosition()
+	}).
+		WithPositions(func(pq *ent.PositionQuery) {
+			pq.WithWaitlist(func(wq *ent.WaitlistEntryQuery) {
+				wq.Where(waitlistentry.StatusEQ(models.WaitlistOffered))
+			})
+		}).
+		WithSkills()
+}
+
+// toDomainTeam は withTeamDetail で読み込んだチームをドメインモデルに変換する
+func toDomainTeam(team *ent.Team) *domain.Team {
+	filled := make(map[int]int)
+	members := make([]domain.Member, len(team.Edges.Members))
+	for i, member := range team.Edges.Members {
+		members[i] = toDomainMember(member)
+		if member.Edges.Position != nil {
+			filled[member.Edges.Position.ID]++
+		}
+	}
+
+	positions := make([]domain.Position, len(team.Edges.Positions))
+	for i, position := range team.Edges.Positions {
+		positions[i] = domain.Position{
+			ID:      position.ID,
+			Role:    position.Role,
+			Vacancy: position.Vacancy,
+			Filled:  filled[position.ID],
+			// 待機者に提示中の席
+			Reserved: len(position.Edges.Waitlist),
+		}
+	}
+
+	skills := make([]domain.Skill, len(team.Edges.Skills))
+	for i, skill := range team.Edges.Skills {
+		skills[i] = domain.Skill{
+			ID:       skill.ID,
+			Name:     skill.Name,
+			Category: skill.Category,
+		}
+	}
+
+	return &domain.Team{
+		ID:          team.ID,
+		Name:        team.Name,
+		Description: team.Description,
+		Headcount:   team.Headcount,
+		CreatedBy:   team.CreatedBy,
+		Status:      team.Status,
+		Members:     members,
+		Positions:   positions,
+		Skills:      skills,
+	}
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/member"
+	"backend_golang/ent/notification"
+	"backend_golang/internal/domain"
+	"context"
+	"time"
+)
+
+type NotificationRepository interface {
+	// Create は通知を保存する。DedupeKey が同じ通知が既にある場合は作成せずに false を返す
+	Create(ctx context.Context, notification *domain.Notification) (*domain.Notification, bool, error)
+	FindByRecipient(ctx context.Context, memberID string, unreadOnly bool, page int, size int) ([]domain.Notification, error)
+	CountUnread(ctx context.Context, memberID string) (int, error)
+	// MarkRead は通知を既読にする。ids が空の場合は全ての未読の通知を既読にする
+	MarkRead(ctx context.Context, memberID string, ids []int, now time.Time) (int, error)
+}
+
+type notificationRepository struct {
+	client *ent.Client
+}
+
+func NewNotificationRepository(client *ent.Client) NotificationRepository {
+	return &notificationRepository{client: client}
+}
+
+func (n *notificationRepository) Create(ctx context.Context, input *domain.Notification) (*domain.Notification, bool, error) {
+	if input.DedupeKey != "" {
+		exists, err := n.client.Notification.Query().
+			Where(notification.DedupeKey(input.DedupeKey)).
+			Exist(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if exists {
+			return nil, false, nil
+		}
+	}
+
+	recipient, err := n.client.Member.Query().Where(member.MemberID(input.RecipientID)).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	create := n.client.Notification.Create().
+		SetRecipient(recipient).
+		SetKind(input.Kind).
+		SetNillableTeamID(input.TeamID).
+		SetSubject(input.Subject).
+		SetLink(input.Link)
+	if input.ActorID != "" {
+		create.SetActorID(input.ActorID)
+	}
+	if input.DedupeKey != "" {
+		create.SetDedupeKey(input.DedupeKey)
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		// 同時に作成された場合は一意制約で弾かれる
+		if input.DedupeKey != "" && ent.IsConstraintError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	result := toDomainNotification(created)
+	result.RecipientID = input.RecipientID
+	return &result, true, nil
+}
+
+// FindByRecipient はメンバーへの通知を新しい順に返す
+func (n *notificationRepository) FindByRecipient(ctx context.Context, memberID string, unreadOnly bool, page int, size int) ([]domain.Notification, error) {
+	query := n.client.Notification.Query().
+		Where(notification.HasRecipientWith(member.MemberID(memberID)))
+	if unreadOnly {
+		query.Where(notification.ReadAtIsNil())
+	}
+	found, err := query.
+		Order(ent.Desc(notification.FieldID)).
+		Offset((page - 1) * size).
+		Limit(size).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Notification, len(found))
+	for i, f := range found {
+		result[i] = toDomainNotification(f)
+		result[i].RecipientID = memberID
+	}
+	return result, nil
+}
+
+func (n *notificationRepository) CountUnread(ctx context.Context, memberID string) (int, error) {
+	return n.client.Notification.Query().
+		Where(
+			notification.HasRecipientWith(member.MemberID(memberID)),
+			notification.ReadAtIsNil(),
+		).
+		Count(ctx)
+}
+
+func (n *notificationRepository) MarkRead(ctx context.Context, memberID string, ids []int, now time.Time) (int, error) {
+	update := n.client.Notification.Update().
+		Where(
+			notification.HasRecipientWith(member.MemberID(memberID)),
+			notification.ReadAtIsNil(),
+		)
+	if len(ids) > 0 {
+		update.Where(notification.IDIn(ids...))
+	}
+	return update.SetReadAt(now).Save(ctx)
+}
+
+func toDomainNotification(found *ent.Notification) domain.Notification {
+	result := domain.Notification{
+		ID:        found.ID,
+		Kind:      found.Kind,
+		TeamID:    found.TeamID,
+		Subject:   found.Subject,
+		Link:      found.Link,
+		ReadAt:    found.ReadAt,
+		CreatedAt: found.CreatedAt,
+	}
+	if found.ActorID != nil {
+		result.ActorID = *found.ActorID
+	}
+	if found.DedupeKey != nil {
+		result.DedupeKey = *found.DedupeKey
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRepository_UnreadAndDedupe(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	notifications := NewNotificationRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	member := newTestMember(t, client, "member")
+
+	teamID := 1
+	joined, ok, err := notifications.Create(ctx, &domain.Notification{
+		RecipientID: leader.MemberID,
+		Kind:        models.NotificationMemberJoined,
+		TeamID:      &teamID,
+		ActorID:     member.MemberID,
+		Subject:     "gophers",
+		Link:        "/v1/teams/1",
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, leader.MemberID, joined.RecipientID)
+	assert.Equal(t, member.MemberID, joined.ActorID)
+	assert.False(t, joined.IsRead())
+
+	reminder := &domain.Notification{
+		RecipientID: leader.MemberID,
+		Kind:        models.NotificationEventReminder,
+		Subject:     "Go 勉強会",
+		DedupeKey:   "event-reminder:1:0:leader",
+	}
+	_, ok, err = notifications.Create(ctx, reminder)
+	require.NoError(t, err)
+	require.True(t, ok)
+	// 同じキーの通知は作成しない
+	_, ok, err = notifications.Create(ctx, reminder)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	listed, err := notifications.FindByRecipient(ctx, leader.MemberID, false, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, models.NotificationEventReminder, listed[0].Kind)
+	assert.Empty(t, listed[0].ActorID)
+	assert.Equal(t, joined.ID, listed[1].ID)
+
+	unread, err := notifications.CountUnread(ctx, leader.MemberID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, unread)
+
+	// 他のメンバーの通知は既読にできない
+	marked, err := notifications.MarkRead(ctx, member.MemberID, []int{joined.ID}, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, marked)
+
+	marked, err = notifications.MarkRead(ctx, leader.MemberID, []int{joined.ID}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+	unreadOnly, err := notifications.FindByRecipient(ctx, leader.MemberID, true, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, unreadOnly, 1)
+	assert.Equal(t, models.NotificationEventReminder, unreadOnly[0].Kind)
+
+	marked, err = notifications.MarkRead(ctx, leader.MemberID, nil, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+	unread, err = notifications.CountUnread(ctx, leader.MemberID)
+	require.NoError(t, err)
+	assert.Zero(t, unread)
+}
+
+func TestAuthRepository_UpdateNotificationPreferences(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	auth := NewAuthRepository(client)
+	newTestMember(t, client, "member")
+
+	// 既定では日本語ですべてのメールを受け取る
+	found, err := auth.GetMemberByID(ctx, "member")
+	require.NoError(t, err)
+	assert.Equal(t, models.LocaleJapanese, found.Locale)
+	assert.True(t, found.WantsEmail(models.NotificationApplicationAccepted))
+
+	updated, err := auth.UpdateNotificationPreferences(ctx, "member", models.LocaleKorean, []models.NotificationKind{models.NotificationApplicationAccepted})
+	require.NoError(t, err)
+	assert.Equal(t, models.LocaleKorean, updated.Locale)
+	assert.False(t, updated.WantsEmail(models.NotificationApplicationAccepted))
+	assert.True(t, updated.WantsEmail(models.NotificationApplicationReceived))
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/outboxmessage"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/metrics"
+	"context"
+	"time"
+)
+
+// OutboxRepository はリレーが未配信のドメインイベントを読み出し、配信結果を記録するために使う
+type OutboxRepository interface {
+	FindPending(ctx context.Context, now time.Time, limit int) ([]domain.DomainEvent, error)
+	MarkDispatched(ctx context.Context, id int, now time.Time) error
+	Retry(ctx context.Context, id int, cause string, at time.Time) error
+	Abandon(ctx context.Context, id int, cause string, now time.Time) error
+	// Purge は before より前に配信したイベントを削除し、削除した件数を返す
+	Purge(ctx context.Context, before time.Time) (int, error)
+}
+
+type outboxRepository struct {
+	client *ent.Client
+}
+
+func NewOutboxRepository(client *ent.Client) OutboxRepository {
+	return &outboxRepository{client: client}
+}
+
+// recordEvent はトランザクション内でドメインイベントを outbox に記録する
+// 状態変化と一緒にコミットされるため、ロールバックされた変更のイベントは配信されない
+// コミットしたイベントは種類ごとに数える
+func recordEvent(ctx context.Context, tx *ent.Tx, event domain.DomainEvent) error {
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			metrics.DomainEvents.Inc(string(event.Type))
+			return nil
+		})
+	})
+	return tx.OutboxMessage.Create().
+		SetType(event.Type).
+		SetAggregateID(event.AggregateID).
+		SetTeamID(event.TeamID).
+		SetMemberID(event.MemberID).
+		Exec(ctx)
+}
+
+// FindPending は配信時刻を過ぎた未配信のイベントを記録順に返す
+func (o *outboxRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]domain.DomainEvent, error) {
+	messages, err := o.client.OutboxMessage.Query().
+		Where(
+			outboxmessage.DispatchedAtIsNil(),
+			outboxmessage.AbandonedAtIsNil(),
+			outboxmessage.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(outboxmessage.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.DomainEvent, len(messages))
+	for i, message := range messages {
+		result[i] = domain.DomainEvent{
+			ID:          message.ID,
+			Type:        message.Type,
+			AggregateID: message.AggregateID,
+			TeamID:      message.TeamID,
+			MemberID:    message.MemberID,
+			Attempts:    message.Attempts,
+			OccurredAt:  message.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (o *outboxRepository) MarkDispatched(ctx context.Context, id int, now time.Time) error {
+	return o.client.OutboxMessage.UpdateOneID(id).
+		SetDispatchedAt(now).
+		Exec(ctx)
+}
+
+// Retry は失敗を記録し、at 以降に再び配信する
+func (o *outboxRepository) Retry(ctx context.Context, id int, cause string, at time.Time) error {
+	return o.client.OutboxMessage.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(cause).
+		SetNextAttemptAt(at).
+		Exec(ctx)
+}
+
+// Abandon は失敗を記録し、以降は配信しない
+func (o *outboxRepository) Abandon(ctx context.Context, id int, cause string, now time.Time) error {
+	return o.client.OutboxMessage.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(cause).
+		SetAbandonedAt(now).
+		Exec(ctx)
+}
+
+func (o *outboxRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	return o.client.OutboxMessage.Delete().
+		Where(outboxmessage.DispatchedAtLT(before)).
+		Exec(ctx)
+}
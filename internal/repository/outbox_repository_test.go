@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"backend_golang/internal/metrics"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_RecordsEventsWithStateChanges(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	waitlist := NewWaitlistRepository(client)
+	outbox := NewOutboxRepository(client)
+	joined := metrics.DomainEvents.Value(string(models.DomainEventMemberJoined))
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	member := newTestMember(t, client, "member")
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, member.MemberID, models.Backend))
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, newTestMember(t, client, "second").MemberID, models.Backend))
+
+	// ロールバックされた参加のイベントは記録しない
+	err = teams.JoinTeam(ctx, created.ID, newTestMember(t, client, "late").MemberID, models.Backend)
+	assert.ErrorIs(t, err, models.ErrNoVacancy)
+
+	entry, err := waitlist.Enqueue(ctx, created.ID, "late", models.Backend)
+	require.NoError(t, err)
+	require.NoError(t, teams.LeaveTeam(ctx, created.ID, member.MemberID))
+
+	pending, err := outbox.FindPending(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	type recorded struct {
+		Type        models.DomainEventType
+		AggregateID int
+		MemberID    string
+	}
+	events := make([]recorded, len(pending))
+	for i, event := range pending {
+		assert.Equal(t, created.ID, event.TeamID)
+		events[i] = recorded{event.Type, event.AggregateID, event.MemberID}
+	}
+	assert.Equal(t, []recorded{
+		{models.DomainEventTeamCreated, created.ID, "leader"},
+		{models.DomainEventMemberJoined, created.ID, "member"},
+		{models.DomainEventMemberJoined, created.ID, "second"},
+		{models.DomainEventWaitlistJoined, entry.ID, "late"},
+		// 脱退で空いた席の提示は脱退と同じトランザクションで記録する
+		{models.DomainEventWaitlistOffered, entry.ID, "late"},
+		{models.DomainEventMemberLeft, created.ID, "member"},
+	}, events)
+	// コミットしたイベントだけを数える
+	assert.Equal(t, joined+2, metrics.DomainEvents.Value(string(models.DomainEventMemberJoined)))
+
+	limited, err := outbox.FindPending(ctx, time.Now(), 2)
+	require.NoError(t, err)
+	assert.Len(t, limited, 2)
+}
+
+func TestOutboxRepository_DeliveryState(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	outbox := NewOutboxRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	first, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	second, err := teams.CreateTeam(ctx, domainTeam("rustaceans", newTestMember(t, client, "other").MemberID))
+	require.NoError(t, err)
+	third, err := teams.CreateTeam(ctx, domainTeam("pythonistas", newTestMember(t, client, "third").MemberID))
+	require.NoError(t, err)
+
+	now := time.Now()
+	pending, err := outbox.FindPending(ctx, now, 100)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+
+	require.NoError(t, outbox.MarkDispatched(ctx, pending[0].ID, now))
+	require.NoError(t, outbox.Retry(ctx, pending[1].ID, "search: unavailable", now.Add(time.Minute)))
+	require.NoError(t, outbox.Abandon(ctx, pending[2].ID, "search: unavailable", now))
+
+	// 再試行は指定した日時まで待つ
+	pending, err = outbox.FindPending(ctx, now, 100)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	pending, err = outbox.FindPending(ctx, now.Add(time.Minute), 100)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, second.ID, pending[0].AggregateID)
+	assert.Equal(t, 1, pending[0].Attempts)
+
+	purged, err := outbox.Purge(ctx, now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	remaining, err := client.OutboxMessage.Query().All(ctx)
+	require.NoError(t, err)
+	ids := make([]int, len(remaining))
+	for i, message := range remaining {
+		ids[i] = message.AggregateID
+	}
+	assert.ElementsMatch(t, []int{second.ID, third.ID}, ids)
+	assert.NotContains(t, ids, first.ID)
+}
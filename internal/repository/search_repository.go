@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/search"
+	"context"
+)
+
+type SearchRepository interface {
+	Documents(ctx context.Context) ([]search.Document, error)
+}
+
+type searchRepository struct {
+	client *ent.Client
+}
+
+func NewSearchRepository(client *ent.Client) SearchRepository {
+	return &searchRepository{
+		client: client,
+	}
+}
+
+// Documents は検索インデックスを再構築するために全てのお知らせとチームを文書として返す
+func (s *searchRepository) Documents(ctx context.Context) ([]search.Document, error) {
+	announcements, err := s.client.Announcement.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.client.Team.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]search.Document, 0, len(announcements)+len(teams))
+	for _, announcement := range announcements {
+		docs = append(docs, search.Document{
+			Kind:  search.KindAnnouncement,
+			ID:    announcement.ID,
+			Title: announcement.Title,
+			Body:  announcement.Content,
+		})
+	}
+	for _, team := range teams {
+		docs = append(docs, search.Document{
+			Kind:  search.KindTeam,
+			ID:    team.ID,
+			Title: team.Name,
+			Body:  team.Description,
+		})
+	}
+	return docs, nil
+}
@@ -1,8 +1,336 @@
 package repository
 
-type SkillRepository interface{}
-type skillRepository struct{}
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/skill"
+	"backend_golang/ent/skillalias"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/models"
+	"context"
+	"fmt"
+	"strings"
 
-func NewSkillRepository() SkillRepository {
-	return &skillRepository{}
+	"entgo.io/ent/dialect/sql"
+)
+
+type SkillRepository interface {
+	Seed(ctx context.Context, definitions []models.SkillDefinition) error
+	Resolve(ctx context.Context, names []string) ([]string, error)
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]domain.Skill, error)
+	Merge(ctx context.Context, sourceID int, targetID int) (*domain.Skill, error)
+}
+
+type skillRepository struct {
+	client *ent.Client
+	tx     *TransactionManager
+}
+
+func NewSkillRepository(client *ent.Client) SkillRepository {
+	return &skillRepository{
+		client: client,
+		tx:     NewTransactionManager(client),
+	}
+}
+
+// Seed は正規スキルと別名を登録し、別名を持たない既存スキルに自身の正規化名を別名として追加する
+func (s *skillRepository) Seed(ctx context.Context, definitions []models.SkillDefinition) error {
+	return s.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		for _, definition := range definitions {
+			found, err := findOrCreateSkill(ctx, tx, definition.Name)
+			if err != nil {
+				return err
+			}
+			if found.Category == "" {
+				if found, err = found.Update().SetCategory(string(definition.Category)).Save(ctx); err != nil {
+					return err
+				}
+			}
+			for _, alias := range definition.Aliases {
+				if err := addSkillAlias(ctx, tx, found, alias); err != nil {
+					return err
+				}
+			}
+		}
+
+		unaliased, err := tx.Skill.Query().Where(skill.Not(skill.HasAliases())).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, found := range unaliased {
+			if err := addSkillAlias(ctx, tx, found, found.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Resolve は入力されたスキル名を正規スキル名に変換する
+// 登録されていないスキル名はそのまま返す
+func (s *skillRepository) Resolve(ctx context.Context, names []string) ([]string, error) {
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = models.SkillKey(name)
+	}
+
+	aliases, err := s.client.SkillAlias.Query().
+		Where(skillalias.NameIn(keys...)).
+		WithSkill().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	canonical := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		canonical[alias.Name] = alias.Edges.Skill.Name
+	}
+
+	resolved := make([]string, len(names))
+	for i, name := range names {
+		if found, ok := canonical[keys[i]]; ok {
+			resolved[i] = found
+		} else {
+			resolved[i] = strings.TrimSpace(name)
+		}
+	}
+	return resolved, nil
+}
+
+// Autocomplete は正規名または別名が prefix で始まるスキルを利用数の多い順に返す
+func (s *skillRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]domain.Skill, error) {
+	query := s.client.Skill.Query()
+	if key := models.SkillKey(prefix); key != "" {
+		query = query.Where(skill.HasAliasesWith(skillalias.NameHasPrefix(key)))
+	}
+	// 利用数の順に並べてから件数を絞る。利用数は countUsage と同じくチーム数とメンバー数の合計
+	query = query.Order(byUsage, ent.Asc(skill.FieldName))
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	skills, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(skills))
+	for i, skill := range skills {
+		ids[i] = skill.ID
+	}
+	usage, err := s.countUsage(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Skill, len(skills))
+	for i, skill := range skills {
+		result[i] = domain.Skill{
+			ID:         skill.ID,
+			Name:       skill.Name,
+			Category:   skill.Category,
+			UsageCount: usage[skill.ID],
+		}
+	}
+	return result, nil
+}
+
+// byUsage はスキルを利用しているチーム数とメンバー数の合計の多い順に並べる
+func byUsage(s *sql.Selector) {
+	count := func(table string, column string) string {
+		return fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s.%s = %s)", table, table, column, s.C(skill.FieldID))
+	}
+	s.OrderExpr(sql.Expr(count(skill.TeamsTable, skill.TeamsPrimaryKey[0]) + " + " + count(skill.UsersTable, skill.UsersPrimaryKey[0]) + " DESC"))
+}
+
+// countUsage はスキルごとに利用しているチーム数とメンバー数の合計を1回のクエリで集計する
+func (s *skillRepository) countUsage(ctx context.Context, skillIDs []int) (map[int]int, error) {
+	usage := make(map[int]int, len(skillIDs))
+	if len(skillIDs) == 0 {
+		return usage, nil
+	}
+
+	var rows []struct {
+		ID      int `json:"id"`
+		Teams   int `json:"teams"`
+		Members int `json:"members"`
+	}
+	err := s.client.Skill.Query().
+		Where(skill.IDIn(skillIDs...)).
+		GroupBy(skill.FieldID).
+		Aggregate(
+			func(s *sql.Selector) string {
+				t := sql.Table(skill.TeamsTable)
+				s.LeftJoin(t).On(s.C(skill.FieldID), t.C(skill.TeamsPrimaryKey[0]))
+				return sql.As(sql.Count(sql.Distinct(t.C(skill.TeamsPrimaryKey[1]))), "teams")
+			},
+			func(s *sql.Selector) string {
+				u := sql.Table(skill.UsersTable)
+				s.LeftJoin(u).On(s.C(skill.FieldID), u.C(skill.UsersPrimaryKey[0]))
+				return sql.As(sql.Count(sql.Distinct(u.C(skill.UsersPrimaryKey[1]))), "members")
+			},
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		usage[row.ID] = row.Teams + row.Members
+	}
+	return usage, nil
+}
+
+// Merge は sourceID のスキルを targetID のスキルに統合する
+// チームとメンバーの紐づけ、別名を付け替えた上で統合元のスキルを削除する
+func (s *skillRepository) Merge(ctx context.Context, sourceID int, targetID int) (*domain.Skill, error) {
+	if sourceID == targetID {
+		return nil, models.ErrInvalidMerge
+	}
+
+	var result *domain.Skill
+	err := s.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		source, err := tx.Skill.Query().
+			Where(skill.ID(sourceID)).
+			WithTeams().
+			WithUsers().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		target, err := tx.Skill.Query().
+			Where(skill.ID(targetID)).
+			WithTeams().
+			WithUsers().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		linkedTeams := make(map[int]bool, len(target.Edges.Teams))
+		for _, team := range target.Edges.Teams {
+			linkedTeams[team.ID] = true
+		}
+		var teamIDs []int
+		for _, team := range source.Edges.Teams {
+			if !linkedTeams[team.ID] {
+				teamIDs = append(teamIDs, team.ID)
+			}
+		}
+
+		linkedMembers := make(map[int]bool, len(target.Edges.Users))
+		for _, member := range target.Edges.Users {
+			linkedMembers[member.ID] = true
+		}
+		var memberIDs []int
+		for _, member := range source.Edges.Users {
+			if !linkedMembers[member.ID] {
+				memberIDs = append(memberIDs, member.ID)
+			}
+		}
+
+		err = tx.Skill.UpdateOneID(sourceID).ClearTeams().ClearUsers().Exec(ctx)
+		if err != nil {
+			return err
+		}
+		target, err = target.Update().
+			AddTeamIDs(teamIDs...).
+			AddUserIDs(memberIDs...).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.SkillAlias.Update().
+			Where(skillalias.HasSkillWith(skill.ID(sourceID))).
+			SetSkillID(targetID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Skill.DeleteOneID(sourceID).Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// 統合元の正規名でも引き続き検索できるようにする
+		if err := addSkillAlias(ctx, tx, target, source.Name); err != nil {
+			return err
+		}
+
+		result = &domain.Skill{
+			ID:       target.ID,
+			Name:     target.Name,
+			Category: target.Category,
+		}
+		return nil
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("error merging skill", "source_id", sourceID, "target_id", targetID, "error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// findOrCreateSkill は別名からスキルを探し、なければ入力された表記を正規名としてスキルを作成する
+func findOrCreateSkill(ctx context.Context, tx *ent.Tx, name string) (*ent.Skill, error) {
+	name = strings.TrimSpace(name)
+	key := models.SkillKey(name)
+	if key == "" {
+		return nil, fmt.Errorf("skill name is empty")
+	}
+
+	found, err := tx.SkillAlias.Query().
+		Where(skillalias.Name(key)).
+		QuerySkill().
+		Only(ctx)
+	if err == nil {
+		return found, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// 別名が未登録の既存スキル
+	found, err = tx.Skill.Query().Where(skill.NameEqualFold(name)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if found == nil {
+		found, err = tx.Skill.Create().
+			SetName(name).
+			Save(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error("error creating skill", "error", err)
+			return nil, err
+		}
+	}
+
+	if err := addSkillAlias(ctx, tx, found, name); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// addSkillAlias は別名を登録する。既に同じスキルの別名であれば何もしない
+func addSkillAlias(ctx context.Context, tx *ent.Tx, target *ent.Skill, alias string) error {
+	key := models.SkillKey(alias)
+	existing, err := tx.SkillAlias.Query().
+		Where(skillalias.Name(key)).
+		WithSkill().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if existing != nil {
+		if existing.Edges.Skill.ID != target.ID {
+			logging.FromContext(ctx).Warn("skill alias already belongs to another skill", "alias", key, "skill", existing.Edges.Skill.Name)
+		}
+		return nil
+	}
+
+	return tx.SkillAlias.Create().
+		SetName(key).
+		SetSkill(target).
+		Exec(ctx)
 }
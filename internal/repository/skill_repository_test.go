@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMember(t *testing.T, client *ent.Client, id string) *ent.Member {
+	t.Helper()
+	return client.Member.Create().
+		SetMemberID(id).
+		SetEmail(id + "@example.com").
+		SetPicture("").
+		SetNickname(id).
+		SetBio("").
+		SetPreferredRole("BACKEND").
+		SaveX(context.Background())
+}
+
+func TestSkillRepository_Resolve(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewSkillRepository(client)
+	require.NoError(t, repo.Seed(ctx, models.DefaultSkillTaxonomy))
+
+	resolved, err := repo.Resolve(ctx, []string{"golang", "GoLang", " Go ", "k8s", "ＴｙｐｅＳｃｒｉｐｔ", "Unknown Skill"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Go", "Go", "Go", "Kubernetes", "TypeScript", "Unknown Skill"}, resolved)
+}
+
+func TestSkillRepository_CreateTeamCanonicalizesSkills(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	require.NoError(t, NewSkillRepository(client).Seed(ctx, models.DefaultSkillTaxonomy))
+	leader := newTestMember(t, client, "leader")
+
+	created, err := NewTeamRepository(client).CreateTeam(ctx, domainTeam("gophers", leader.MemberID, "Go", "golang", "GoLang", "Gin"))
+	require.NoError(t, err)
+
+	names := client.Team.GetX(ctx, created.ID).QuerySkills().Select("name").StringsX(ctx)
+	assert.ElementsMatch(t, []string{"Go", "Gin"}, names)
+
+	// 新しく作成されたスキルにも別名が登録される
+	resolved, err := NewSkillRepository(client).Resolve(ctx, []string{"gin"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Gin"}, resolved)
+}
+
+func TestSkillRepository_Autocomplete(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewSkillRepository(client)
+	require.NoError(t, repo.Seed(ctx, models.DefaultSkillTaxonomy))
+
+	leader := newTestMember(t, client, "leader")
+	_, err := NewTeamRepository(client).CreateTeam(ctx, domainTeam("gophers", leader.MemberID, "Google Cloud"))
+	require.NoError(t, err)
+
+	skills, err := repo.Autocomplete(ctx, "go", 10)
+	require.NoError(t, err)
+	require.Len(t, skills, 2)
+	assert.Equal(t, "Google Cloud", skills[0].Name)
+	assert.Equal(t, 1, skills[0].UsageCount)
+	assert.Equal(t, "Go", skills[1].Name)
+	assert.Equal(t, 0, skills[1].UsageCount)
+
+	// 件数を絞る前に利用数で並べる。名前順で後ろのスキルも利用数が多ければ候補に入る
+	_, err = NewTeamRepository(client).CreateTeam(ctx, domainTeam("typed", newTestMember(t, client, "typed").MemberID, "TypeScript", "Google Cloud"))
+	require.NoError(t, err)
+	skills, err = repo.Autocomplete(ctx, "", 2)
+	require.NoError(t, err)
+	require.Len(t, skills, 2)
+	assert.Equal(t, "Google Cloud", skills[0].Name)
+	assert.Equal(t, 2, skills[0].UsageCount)
+	assert.Equal(t, "TypeScript", skills[1].Name)
+	assert.Equal(t, 1, skills[1].UsageCount)
+
+	// 別名でも候補に含まれる
+	skills, err = repo.Autocomplete(ctx, "k8", 10)
+	require.NoError(t, err)
+	require.Len(t, skills, 1)
+	assert.Equal(t, "Kubernetes", skills[0].Name)
+}
+
+func TestSkillRepository_Merge(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewSkillRepository(client)
+	require.NoError(t, repo.Seed(ctx, models.DefaultSkillTaxonomy))
+
+	first := newTestMember(t, client, "first")
+	second := newTestMember(t, client, "second")
+	teamRepo := NewTeamRepository(client)
+	withGo, err := teamRepo.CreateTeam(ctx, domainTeam("with-go", first.MemberID, "Go"))
+	require.NoError(t, err)
+	withGoLang, err := teamRepo.CreateTeam(ctx, domainTeam("with-golang", second.MemberID, "Go Language"))
+	require.NoError(t, err)
+
+	goSkill := client.Team.GetX(ctx, withGo.ID).QuerySkills().OnlyX(ctx)
+	duplicate := client.Team.GetX(ctx, withGoLang.ID).QuerySkills().OnlyX(ctx)
+	require.NotEqual(t, goSkill.ID, duplicate.ID)
+
+	_, err = repo.Merge(ctx, goSkill.ID, goSkill.ID)
+	assert.ErrorIs(t, err, models.ErrInvalidMerge)
+
+	merged, err := repo.Merge(ctx, duplicate.ID, goSkill.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Go", merged.Name)
+
+	assert.Equal(t, goSkill.ID, client.Team.GetX(ctx, withGoLang.ID).QuerySkills().OnlyX(ctx).ID)
+	assert.Equal(t, 2, client.Skill.GetX(ctx, goSkill.ID).QueryTeams().CountX(ctx))
+
+	_, err = client.Skill.Get(ctx, duplicate.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	resolved, err := repo.Resolve(ctx, []string{"go language"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Go"}, resolved)
+}
+
+func domainTeam(name string, leaderID string, skills ...string) *domain.Team {
+	team := &domain.Team{
+		Name:        name,
+		Description: name,
+		Headcount:   3,
+		CreatedBy:   leaderID,
+		Positions: []domain.Position{
+			{Role: models.Backend, Vacancy: 2},
+		},
+	}
+	for _, skill := range skills {
+		team.Skills = append(team.Skills, domain.Skill{Name: skill})
+	}
+	return team
+}
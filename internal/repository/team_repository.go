@@ -2,21 +2,34 @@ package repository
 
 import (
 	"backend_golang/ent"
+	"backend_golang/ent/event"
+	"backend_golang/ent/eventoverride"
+	"backend_golang/ent/invitation"
 	"backend_golang/ent/member"
 	"backend_golang/ent/position"
-	"backend_golang/ent/skill"
+	"backend_golang/ent/rsvp"
 	"backend_golang/ent/team"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/ent/webhook"
+	"backend_golang/ent/webhookdelivery"
 	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
 	"backend_golang/internal/models"
 	"context"
-	"log"
+	"fmt"
+	"time"
 )
 
 type TeamRepository interface {
 	CreateTeam(ctx context.Context, createTeam *domain.Team) (*domain.Team, error)
 	DeleteTeam(ctx context.Context, teamID int) error
 	FindByID(ctx context.Context, teamID int) (*domain.Team, error)
-	JoinTeam(ctx context.Context, teamID int, memberID string) error
+	JoinTeam(ctx context.Context, teamID int, memberID string, role models.Role) error
+	LeaveTeam(ctx context.Context, teamID int, memberID string) error
+	UpdatePositions(ctx context.Context, teamID int, positions []domain.Position) error
+	UpdateStatus(ctx context.Context, teamID int, status models.TeamStatus) error
 }
 
 type teamRepository struct {
@@ -36,32 +49,28 @@ func (t *teamRepository) CreateTeam(ctx context.Context, createTeam *domain.Team
 	var result *domain.Team
 	err := t.tx.WithTx(ctx, func(tx *ent.Tx) error {
 
-		// 技術スタックがあるか探し、なければ作成する
+		// 技術スタックを別名から探し、なければ作成する
+		// 表記ゆれで同じスキルが複数回指定された場合は1つにまとめる
 		var skills []*ent.Skill
+		seenSkills := make(map[int]bool, len(createTeam.Skills))
 		for _, s := range createTeam.Skills {
-			// TODO skill repository に移行するのが良いかも
-			foundSkill, err := tx.Skill.Query().Where(skill.Name(s.Name)).First(ctx)
+			foundSkill, err := findOrCreateSkill(ctx, tx, s.Name)
 			if err != nil {
-				if ent.IsNotFound(err) {
-					// TODO skill repository に移行するのが良いかも
-					foundSkill, err = tx.Skill.Create().
-						SetName(s.Name).
-						Save(ctx)
-					if err != nil {
-						log.Printf("error creating skill: %v", err)
-						return err
-					}
-				} else {
-					return err
-				}
+				return err
 			}
+			if seenSkills[foundSkill.ID] {
+				continue
+			}
+			seenSkills[foundSkill.ID] = true
 			skills = append(skills, foundSkill)
 		}
 
 		positions := []*ent.Position{}
+		hasOpenSeat := false
 		for _, vacancy := range createTeam.Positions {
+			hasOpenSeat = hasOpenSeat || vacancy.Vacancy > 0
 			savedPosition, err := tx.Position.Create().
-				SetRole(string(vacancy.Role)).
+				SetRole(vacancy.Role).
 				SetVacancy(vacancy.Vacancy).
 				Save(ctx)
 			if err != nil {
@@ -70,9 +79,9 @@ func (t *teamRepository) CreateTeam(ctx context.Context, createTeam *domain.Team
 			positions = append(positions, savedPosition)
 		}
 
-		foundMember, err := t.client.Member.Query().Where(member.MemberID(createTeam.CreatedBy)).First(ctx)
+		foundMember, err := tx.Member.Query().Where(member.MemberID(createTeam.CreatedBy)).First(ctx)
 		if err != nil {
-			log.Printf("error finding member: %v", err)
+			logging.FromContext(ctx).Error("error finding member", "error", err)
 			return err
 		}
 
@@ -81,6 +90,7 @@ func (t *teamRepository) CreateTeam(ctx context.Context, createTeam *domain.Team
 			SetDescription(createTeam.Description).
 			SetHeadcount(createTeam.Headcount).
 			SetCreatedBy(createTeam.CreatedBy).
+			SetStatus(domain.NextTeamStatus(models.TeamStatusRecruiting, hasOpenSeat)).
 			AddMembers(foundMember).
 			AddPositions(positions...).
 			AddSkills(skills...).
@@ -96,7 +106,17 @@ func (t *teamRepository) CreateTeam(ctx context.Context, createTeam *domain.Team
 		}
 		_, err = member.Update().SetTeams(team).Save(ctx)
 		if err != nil {
-			log.Printf("error updating member: %v", err)
+			logging.FromContext(ctx).Error("error updating member", "error", err)
+			return err
+		}
+
+		err = recordEvent(ctx, tx, domain.DomainEvent{
+			Type:        models.DomainEventTeamCreated,
+			AggregateID: team.ID,
+			TeamID:      team.ID,
+			MemberID:    createTeam.CreatedBy,
+		})
+		if err != nil {
 			return err
 		}
 
@@ -121,13 +141,14 @@ func (t *teamRepository) CreateTeam(ctx context.Context, createTeam *domain.Team
 			Description: team.Description,
 			Headcount:   team.Headcount,
 			CreatedBy:   team.CreatedBy,
+			Status:      team.Status,
 			Positions:   relatedPositions,
 			Skills:      relatedSkills,
 		}
 		return nil
 	})
 	if err != nil {
-		log.Printf("error creating team: %v", err)
+		logging.FromContext(ctx).Error("error creating team", "error", err)
 		return nil, err
 	}
 	return result, nil
@@ -135,8 +156,64 @@ func (t *teamRepository) CreateTeam(ctx context.Context, createTeam *domain.Team
 
 func (t *teamRepository) DeleteTeam(ctx context.Context, teamID int) error {
 	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		// 待機リスト・招待・イベント・スレッド・Webhook はポジション・チームを参照しているので先に削除する
+		_, err := tx.WebhookDelivery.Delete().Where(
+			webhookdelivery.HasWebhookWith(webhook.TeamID(teamID)),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Webhook.Delete().Where(
+			webhook.TeamID(teamID),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.WaitlistEntry.Delete().Where(
+			waitlistentry.HasPositionWith(position.TeamID(teamID)),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Invitation.Delete().Where(
+			invitation.TeamID(teamID),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.RSVP.Delete().Where(
+			rsvp.HasEventWith(event.TeamID(teamID)),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ThreadComment.Delete().Where(
+			threadcomment.HasThreadWith(thread.TeamID(teamID)),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Thread.Delete().Where(
+			thread.TeamID(teamID),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.EventOverride.Delete().Where(
+			eventoverride.HasEventWith(event.TeamID(teamID)),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Event.Delete().Where(
+			event.TeamID(teamID),
+		).Exec(ctx)
+		if err != nil {
+			return err
+		}
+
 		// First, delete all positions associated with the team
-		_, err := tx.Position.Delete().Where(
+		_, err = tx.Position.Delete().Where(
 			position.HasTeamWith(team.ID(teamID)),
 		).Exec(ctx)
 		if err != nil {
@@ -148,101 +225,283 @@ func (t *teamRepository) DeleteTeam(ctx context.Context, teamID int) error {
 		if err != nil {
 			return err
 		}
-		return nil
+		return recordEvent(ctx, tx, domain.DomainEvent{
+			Type:        models.DomainEventTeamDeleted,
+			AggregateID: teamID,
+			TeamID:      teamID,
+		})
 	})
 }
 
 func (t *teamRepository) FindByID(ctx context.Context, teamID int) (*domain.Team, error) {
-	team, err := t.client.Team.Query().Where(team.ID(teamID)).WithMembers().WithPositions().WithSkills().First(ctx)
+	query := t.client.Team.Query().Where(team.ID(teamID))
+	withTeamDetail(query)
+	team, err := query.First(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return toDomainTeam(team), nil
+}
 
-	members := make([]domain.Member, len(team.Edges.Members))
-	for i, member := range team.Edges.Members {
-		members[i] = domain.Member{
-			ID:            member.MemberID,
-			Email:         member.Email,
-			Picture:       member.Picture,
-			Nickname:      member.Nickname,
-			Bio:           member.Bio,
-			PreferredRole: member.PreferredRole,
-		}
+func (t *teamRepository) JoinTeam(ctx context.Context, teamID int, memberID string, role models.Role) error {
+	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		return joinTeam(ctx, tx, teamID, memberID, role)
+	})
+}
+
+// joinTeam はトランザクション内で role のポジションの空きを1つ確保し、メンバーをチームに所属させる
+func joinTeam(ctx context.Context, tx *ent.Tx, teamID int, memberID string, role models.Role) error {
+	return seatMember(ctx, tx, teamID, memberID, role, admitRecruiting)
+}
+
+// admitRecruiting は募集を締め切ったチームへの参加を拒否する
+func admitRecruiting(status models.TeamStatus) error {
+	if status.IsManual() {
+		return models.ErrRecruitmentClosed
 	}
+	return nil
+}
 
-	positions := make([]domain.Position, len(team.Edges.Positions))
-	for i, position := range team.Edges.Positions {
-		positions[i] = domain.Position{
-			Role:    models.Role(position.Role),
-			Vacancy: position.Vacancy,
-		}
+// admitInvited は招待による参加を判定する。募集を締め切った後でも招待されたメンバーは参加できる
+func admitInvited(status models.TeamStatus) error {
+	if status == models.TeamStatusArchived {
+		return models.ErrTeamArchived
+	}
+	return nil
+}
+
+// seatMember はチームの状態を admit で確認した上で空きを1つ確保してメンバーを所属させる
+func seatMember(ctx context.Context, tx *ent.Tx, teamID int, memberID string, role models.Role, admit func(models.TeamStatus) error) error {
+	// 멤버 조회
+	memberEnt, err := tx.Member.Query().Where(member.MemberID(memberID)).First(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("error finding member", "error", err)
+		return err
+	}
+
+	// 이미 다른 팀에 소속되어 있는지 확인
+	joined, err := memberEnt.QueryTeams().Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if joined {
+		return models.ErrAlreadyInTeam
+	}
+
+	// 팀 상태 확인 (모집 마감 등)
+	teamEnt, err := tx.Team.Get(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if err := admit(teamEnt.Status); err != nil {
+		return err
 	}
-	skills := make([]domain.Skill, len(team.Edges.Skills))
-	for i, skill := range team.Edges.Skills {
-		skills[i] = domain.Skill{
-			Name: skill.Name,
+
+	// 포지션 조회 (role, teamID로)
+	posEnt, err := tx.Position.Query().
+		Where(
+			position.RoleEQ(role),
+			position.TeamID(teamID),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w %s", models.ErrNoVacancy, role)
 		}
+		logging.FromContext(ctx).Error("error finding position", "error", err)
+		return err
+	}
+
+	// vacancy 감소
+	// 조건부 UPDATE 로 행 잠금을 걸어 동시에 참여해도 vacancy 가 음수가 되지 않도록 한다
+	updated, err := tx.Position.Update().
+		Where(
+			position.ID(posEnt.ID),
+			position.VacancyGT(0),
+		).
+		AddVacancy(-1).
+		Save(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("error updating position", "error", err)
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w %s", models.ErrNoVacancy, role)
+	}
+
+	// 멤버와 팀, 담당 포지션 연결 (1 : 1 관계)
+	_, err = memberEnt.Update().
+		SetTeamsID(teamID).
+		SetPosition(posEnt).
+		Save(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("error updating member", "error", err)
+		return err
 	}
 
-	return &domain.Team{
-		ID:          team.ID,
-		Name:        team.Name,
-		Description: team.Description,
-		Headcount:   team.Headcount,
-		CreatedBy:   team.CreatedBy,
-		Members:     members,
-		Positions:   positions,
-		Skills:      skills,
-	}, nil
+	// 참여한 멤버의 다른 대기열은 취소한다
+	if err := cancelWaitlist(ctx, tx, memberID, time.Now()); err != nil {
+		return err
+	}
+
+	err = recordEvent(ctx, tx, domain.DomainEvent{
+		Type:        models.DomainEventMemberJoined,
+		AggregateID: teamID,
+		TeamID:      teamID,
+		MemberID:    memberID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return refreshTeamStatus(ctx, tx, teamID)
 }
 
-func (t *teamRepository) JoinTeam(ctx context.Context, teamID int, memberID string) error {
+func (t *teamRepository) LeaveTeam(ctx context.Context, teamID int, memberID string) error {
 	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
-		// 멤버 조회
-		memberEnt, err := tx.Member.Query().Where(member.MemberID(memberID)).First(ctx)
-		if err != nil {
-			log.Printf("error finding member: %v", err)
-			return err
+		return leaveTeam(ctx, tx, teamID, memberID)
+	})
+}
+
+// leaveTeam はメンバーをチームから外し、担当していたポジションの空きを1つ戻す
+func leaveTeam(ctx context.Context, tx *ent.Tx, teamID int, memberID string) error {
+	memberEnt, err := tx.Member.Query().
+		Where(
+			member.MemberID(memberID),
+			member.HasTeamsWith(team.ID(teamID)),
+		).
+		WithPosition().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return models.ErrNotTeamMember
 		}
+		return err
+	}
 
-		// 팀 조회
-		teamEnt, err := tx.Team.Query().Where(team.ID(teamID)).First(ctx)
+	err = memberEnt.Update().
+		ClearTeams().
+		ClearPosition().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 空いた席は待機している人に提示する
+	if posEnt := memberEnt.Edges.Position; posEnt != nil {
+		err = tx.Position.UpdateOneID(posEnt.ID).AddVacancy(1).Exec(ctx)
 		if err != nil {
-			log.Printf("error finding team: %v", err)
 			return err
 		}
+		if err := offerSeats(ctx, tx, posEnt.ID, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	err = recordEvent(ctx, tx, domain.DomainEvent{
+		Type:        models.DomainEventMemberLeft,
+		AggregateID: teamID,
+		TeamID:      teamID,
+		MemberID:    memberID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return refreshTeamStatus(ctx, tx, teamID)
+}
 
-		// 포지션 조회 (role, teamID로)
-		posEnt, err := tx.Position.Query().
-			Where(
-				position.RoleEQ(memberEnt.PreferredRole),
-				position.HasTeamWith(team.ID(teamID)),
-			).
-			ForUpdate(). // lock 획득
-			First(ctx)
+// UpdatePositions はチームの募集ポジションを positions の内容に置き換える
+// positions に含まれないポジションは担当者がいなければ削除し、いれば募集人数を0にする
+func (t *teamRepository) UpdatePositions(ctx context.Context, teamID int, positions []domain.Position) error {
+	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		existing, err := tx.Position.Query().
+			Where(position.TeamID(teamID)).
+			WithMembers().
+			All(ctx)
 		if err != nil {
-			log.Printf("error finding position: %v", err)
 			return err
 		}
 
-		// vacancy 감소
-		_, err = posEnt.Update().
-			SetVacancy(posEnt.Vacancy - 1).
-			Save(ctx)
-		if err != nil {
-			log.Printf("error updating position: %v", err)
+		byRole := make(map[models.Role]*ent.Position, len(existing))
+		for _, p := range existing {
+			byRole[p.Role] = p
+		}
+
+		for _, p := range positions {
+			found, ok := byRole[p.Role]
+			if !ok {
+				_, err := tx.Position.Create().
+					SetRole(p.Role).
+					SetVacancy(p.Vacancy).
+					SetTeamID(teamID).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			delete(byRole, p.Role)
+			if err := found.Update().SetVacancy(p.Vacancy).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		for _, removed := range byRole {
+			var err error
+			if len(removed.Edges.Members) == 0 {
+				err = tx.Position.DeleteOne(removed).Exec(ctx)
+			} else {
+				err = removed.Update().SetVacancy(0).Exec(ctx)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		// 増えた席は待機している人に提示する
+		if err := offerTeamSeats(ctx, tx, teamID, time.Now()); err != nil {
 			return err
 		}
+		return refreshTeamStatus(ctx, tx, teamID)
+	})
+}
 
-		// 멤버와 팀 연결 (1 : 1 관계)
-		_, err = memberEnt.Update().
-			SetTeamsID(teamEnt.ID).
-			Save(ctx)
+// UpdateStatus はリーダーによる募集の締め切り・再開・アーカイブを反映する
+// RECRUITING を指定した場合は空きポジションの有無から状態を再計算する
+func (t *teamRepository) UpdateStatus(ctx context.Context, teamID int, status models.TeamStatus) error {
+	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		err := tx.Team.UpdateOneID(teamID).SetStatus(status).Exec(ctx)
 		if err != nil {
-			log.Printf("error updating member: %v", err)
 			return err
 		}
+		if err := offerTeamSeats(ctx, tx, teamID, time.Now()); err != nil {
+			return err
+		}
+		return refreshTeamStatus(ctx, tx, teamID)
+	})
+}
 
+// refreshTeamStatus は空きポジションの有無からチームの状態を再計算する
+func refreshTeamStatus(ctx context.Context, tx *ent.Tx, teamID int) error {
+	teamEnt, err := tx.Team.Get(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	hasOpenSeat, err := tx.Position.Query().
+		Where(
+			position.TeamID(teamID),
+			position.VacancyGT(0),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := domain.NextTeamStatus(teamEnt.Status, hasOpenSeat)
+	if next == teamEnt.Status {
 		return nil
-	})
+	}
+	return teamEnt.Update().SetStatus(next).Exec(ctx)
 }
@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"database/sql"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staleReadDriver はトランザクションでポジションを読んだ直後、次の書き込みの前に空きを0にする
+// 読んでから書き込むまでの間に別のリクエストが最後の空きを埋めた状況を再現する
+type staleReadDriver struct {
+	dialect.Driver
+	armed atomic.Bool
+}
+
+func (d *staleReadDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &staleReadTx{Tx: tx, driver: d}, nil
+}
+
+type staleReadTx struct {
+	dialect.Tx
+	driver  *staleReadDriver
+	pending bool
+}
+
+func (t *staleReadTx) Query(ctx context.Context, query string, args, v any) error {
+	if strings.Contains(query, "FROM `positions`") && t.driver.armed.CompareAndSwap(true, false) {
+		t.pending = true
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+func (t *staleReadTx) Exec(ctx context.Context, query string, args, v any) error {
+	if t.pending {
+		t.pending = false
+		var result sql.Result
+		if err := t.Tx.Exec(ctx, "UPDATE `positions` SET `vacancy` = 0", []any{}, &result); err != nil {
+			return err
+		}
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// outsideTxDriver はトランザクションの実行中に、トランザクションの外で実行したクエリを数える
+type outsideTxDriver struct {
+	dialect.Driver
+	open    atomic.Int32
+	outside atomic.Int32
+}
+
+func (d *outsideTxDriver) Query(ctx context.Context, query string, args, v any) error {
+	if d.open.Load() > 0 {
+		d.outside.Add(1)
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *outsideTxDriver) Exec(ctx context.Context, query string, args, v any) error {
+	if d.open.Load() > 0 {
+		d.outside.Add(1)
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *outsideTxDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.open.Add(1)
+	return &outsideTxTx{Tx: tx, driver: d}, nil
+}
+
+type outsideTxTx struct {
+	dialect.Tx
+	driver *outsideTxDriver
+}
+
+func (t *outsideTxTx) Commit() error {
+	defer t.driver.open.Add(-1)
+	return t.Tx.Commit()
+}
+
+func (t *outsideTxTx) Rollback() error {
+	defer t.driver.open.Add(-1)
+	return t.Tx.Rollback()
+}
+
+// TestTeamRepository_CreateTeamStaysInTransaction はチームの作成中のクエリがすべて同じトランザクションで実行されることを確かめる
+// リーダーをトランザクションの外で読むと、書き込み中のトランザクションとロックを取り合い、
+// SQLite（_txlock=immediate）ではロックの待ち時間を過ぎて "database is locked" で失敗する
+func TestTeamRepository_CreateTeamStaysInTransaction(t *testing.T) {
+	ctx := context.Background()
+	base, counter := newCountingClient(t)
+	leader := newTestMember(t, base, "leader")
+
+	drv := &outsideTxDriver{Driver: counter}
+	client := ent.NewClient(ent.Driver(drv))
+	created, err := NewTeamRepository(client).CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	assert.Zero(t, drv.outside.Load(), "トランザクションの外でクエリを実行した")
+
+	team, err := NewTeamRepository(base).FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, team.Members, 1)
+	assert.Equal(t, leader.MemberID, team.Members[0].ID)
+}
+
+func TestTeamRepository_JoinTeam(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewTeamRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := repo.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	first := newTestMember(t, client, "first")
+	second := newTestMember(t, client, "second")
+	third := newTestMember(t, client, "third")
+
+	require.NoError(t, repo.JoinTeam(ctx, created.ID, first.MemberID, models.Backend))
+	require.NoError(t, repo.JoinTeam(ctx, created.ID, second.MemberID, models.Backend))
+
+	err = repo.JoinTeam(ctx, created.ID, third.MemberID, models.Backend)
+	assert.ErrorIs(t, err, models.ErrNoVacancy)
+
+	err = repo.JoinTeam(ctx, created.ID, third.MemberID, models.Designer)
+	assert.ErrorIs(t, err, models.ErrNoVacancy)
+
+	err = repo.JoinTeam(ctx, created.ID, leader.MemberID, models.Backend)
+	assert.ErrorIs(t, err, models.ErrAlreadyInTeam)
+
+	team, err := repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, team.Positions, 1)
+	assert.Equal(t, 2, team.Positions[0].Filled)
+	assert.Equal(t, int8(0), team.Positions[0].Vacancy)
+	assert.Equal(t, 2, team.Positions[0].Capacity())
+
+	roles := make(map[string]models.Role)
+	for _, member := range team.Members {
+		roles[member.ID] = member.TeamRole
+	}
+	assert.Equal(t, map[string]models.Role{
+		"leader": "",
+		"first":  models.Backend,
+		"second": models.Backend,
+	}, roles)
+}
+
+// TestTeamRepository_JoinTeamRejectsStaleVacancy は読んだ後に空きがなくなった場合に、条件付きの UPDATE で参加を拒否することを確かめる
+// 読んだ値で空きを確認してから減らす実装に戻すと、空きが負になって参加できてしまい失敗する
+// SQLite は書き込みを直列に実行するため、同時に参加する E2E のテストだけではこの競合を再現できない
+func TestTeamRepository_JoinTeamRejectsStaleVacancy(t *testing.T) {
+	ctx := context.Background()
+	base, counter := newCountingClient(t)
+	leader := newTestMember(t, base, "leader")
+	joiner := newTestMember(t, base, "joiner")
+	created, err := NewTeamRepository(base).CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	drv := &staleReadDriver{Driver: counter}
+	client := ent.NewClient(ent.Driver(drv))
+	drv.armed.Store(true)
+	err = NewTeamRepository(client).JoinTeam(ctx, created.ID, joiner.MemberID, models.Backend)
+	assert.ErrorIs(t, err, models.ErrNoVacancy)
+	assert.False(t, drv.armed.Load(), "ポジションを読まずに参加した")
+
+	// 失敗したトランザクションは再現のための変更も含めてロールバックされる
+	team, err := NewTeamRepository(base).FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, team.Positions, 1)
+	assert.Equal(t, int8(2), team.Positions[0].Vacancy)
+	assert.Equal(t, 0, team.Positions[0].Filled)
+	assert.False(t, base.Member.GetX(ctx, joiner.ID).QueryTeams().ExistX(ctx))
+}
+
+func TestTeamRepository_StatusFollowsVacancies(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewTeamRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := repo.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusRecruiting, created.Status)
+
+	first := newTestMember(t, client, "first")
+	second := newTestMember(t, client, "second")
+	require.NoError(t, repo.JoinTeam(ctx, created.ID, first.MemberID, models.Backend))
+	require.NoError(t, repo.JoinTeam(ctx, created.ID, second.MemberID, models.Backend))
+
+	team, err := repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusFull, team.Status)
+
+	// 脱退すると空きが戻り、募集中に戻る
+	require.NoError(t, repo.LeaveTeam(ctx, created.ID, second.MemberID))
+	team, err = repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusRecruiting, team.Status)
+	assert.Equal(t, int8(1), team.Positions[0].Vacancy)
+	assert.Len(t, team.Members, 2)
+
+	err = repo.LeaveTeam(ctx, created.ID, second.MemberID)
+	assert.ErrorIs(t, err, models.ErrNotTeamMember)
+
+	// 担当者がいるポジションは残し、募集人数だけを0にする
+	require.NoError(t, repo.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Designer, Vacancy: 0},
+	}))
+	team, err = repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusFull, team.Status)
+	require.Len(t, team.Positions, 2)
+	for _, p := range team.Positions {
+		assert.Equal(t, int8(0), p.Vacancy)
+	}
+	assert.Equal(t, 2, client.Position.Query().CountX(ctx))
+
+	require.NoError(t, repo.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Backend, Vacancy: 1},
+	}))
+	team, err = repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusRecruiting, team.Status)
+	// 担当者のいない DESIGNER は削除される
+	require.Len(t, team.Positions, 1)
+	assert.Equal(t, 2, team.Positions[0].Capacity())
+}
+
+func TestTeamRepository_ClosedTeamKeepsStatus(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewTeamRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := repo.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.UpdateStatus(ctx, created.ID, models.TeamStatusClosed))
+
+	member := newTestMember(t, client, "member")
+	err = repo.JoinTeam(ctx, created.ID, member.MemberID, models.Backend)
+	assert.ErrorIs(t, err, models.ErrRecruitmentClosed)
+
+	// ポジションを変更しても締め切ったままになる
+	require.NoError(t, repo.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Backend, Vacancy: 1},
+	}))
+	team, err := repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusClosed, team.Status)
+
+	// 再開すると空きの有無から状態が決まる
+	require.NoError(t, repo.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Backend, Vacancy: 0},
+	}))
+	require.NoError(t, repo.UpdateStatus(ctx, created.ID, models.TeamStatusRecruiting))
+	team, err = repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusFull, team.Status)
+}
+
+func TestTeamRepository_DeleteTeamWithWaitlistAndInvitations(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	repo := NewTeamRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := repo.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	require.NoError(t, repo.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Backend, Vacancy: 0},
+	}))
+
+	newTestMember(t, client, "waiter")
+	_, err = NewWaitlistRepository(client).Enqueue(ctx, created.ID, "waiter", models.Backend)
+	require.NoError(t, err)
+	_, err = NewInvitationRepository(client).Create(ctx, &domain.Invitation{
+		TeamID:    created.ID,
+		Kind:      models.InvitationLink,
+		Role:      models.Backend,
+		Token:     "token",
+		CreatedBy: leader.MemberID,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteTeam(ctx, created.ID))
+	assert.Zero(t, client.WaitlistEntry.Query().CountX(ctx))
+	assert.Zero(t, client.Invitation.Query().CountX(ctx))
+}
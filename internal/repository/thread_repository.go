@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/member"
+	"backend_golang/ent/thread"
+	"backend_golang/ent/threadcomment"
+	"backend_golang/internal/domain"
+	"context"
+	"time"
+)
+
+type ThreadRepository interface {
+	CreateThread(ctx context.Context, createThread *domain.Thread) (*domain.Thread, error)
+	FindThreads(ctx context.Context, teamID int, page int, size int) ([]domain.Thread, error)
+	FindThread(ctx context.Context, threadID int) (*domain.Thread, error)
+	UpdateThread(ctx context.Context, updateThread *domain.Thread, now time.Time) error
+	DeleteThread(ctx context.Context, threadID int) error
+	CreateComment(ctx context.Context, comment *domain.ThreadComment) (*domain.ThreadComment, error)
+	FindComments(ctx context.Context, threadID int, page int, size int) ([]domain.ThreadComment, error)
+	FindComment(ctx context.Context, threadID int, commentID int) (*domain.ThreadComment, error)
+	UpdateComment(ctx context.Context, comment *domain.ThreadComment, now time.Time) error
+	DeleteComment(ctx context.Context, threadID int, commentID int) error
+}
+
+type threadRepository struct {
+	client *ent.Client
+	tx     *TransactionManager
+}
+
+func NewThreadRepository(client *ent.Client) ThreadRepository {
+	return &threadRepository{
+		client: client,
+		tx:     NewTransactionManager(client),
+	}
+}
+
+func (t *threadRepository) CreateThread(ctx context.Context, createThread *domain.Thread) (*domain.Thread, error) {
+	author, err := t.client.Member.Query().Where(member.MemberID(createThread.AuthorID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := t.client.Thread.Create().
+		SetTeamID(createThread.TeamID).
+		SetTitle(createThread.Title).
+		SetBody(createThread.Body).
+		SetMentions(createThread.Mentions).
+		SetAuthor(author).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t.FindThread(ctx, created.ID)
+}
+
+// FindThreads はチームのスレッドを最後に書き込みがあった順に返す
+func (t *threadRepository) FindThreads(ctx context.Context, teamID int, page int, size int) ([]domain.Thread, error) {
+	threads, err := t.threadQuery().
+		Where(thread.TeamID(teamID)).
+		Order(ent.Desc(thread.FieldLastActivityAt), ent.Desc(thread.FieldID)).
+		Offset((page - 1) * size).
+		Limit(size).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Thread, len(threads))
+	for i, found := range threads {
+		result[i] = toDomainThread(found)
+	}
+	return result, nil
+}
+
+func (t *threadRepository) FindThread(ctx context.Context, threadID int) (*domain.Thread, error) {
+	found, err := t.threadQuery().Where(thread.ID(threadID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainThread(found)
+	return &result, nil
+}
+
+func (t *threadRepository) UpdateThread(ctx context.Context, updateThread *domain.Thread, now time.Time) error {
+	return t.client.Thread.UpdateOneID(updateThread.ID).
+		SetTitle(updateThread.Title).
+		SetBody(updateThread.Body).
+		SetMentions(updateThread.Mentions).
+		SetEditedAt(now).
+		Exec(ctx)
+}
+
+func (t *threadRepository) DeleteThread(ctx context.Context, threadID int) error {
+	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		_, err := tx.ThreadComment.Delete().Where(threadcomment.ThreadID(threadID)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Thread.DeleteOneID(threadID).Exec(ctx)
+	})
+}
+
+// CreateComment はコメントを追加し、スレッドのコメント数と最終書き込み日時を更新する
+func (t *threadRepository) CreateComment(ctx context.Context, comment *domain.ThreadComment) (*domain.ThreadComment, error) {
+	var commentID int
+	err := t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		author, err := tx.Member.Query().Where(member.MemberID(comment.AuthorID)).Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		created, err := tx.ThreadComment.Create().
+			SetThreadID(comment.ThreadID).
+			SetBody(comment.Body).
+			SetMentions(comment.Mentions).
+			SetAuthor(author).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		commentID = created.ID
+
+		return tx.Thread.UpdateOneID(comment.ThreadID).
+			AddCommentCount(1).
+			SetLastActivityAt(created.CreatedAt).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.FindComment(ctx, comment.ThreadID, commentID)
+}
+
+// FindComments はスレッドのコメントを投稿順に返す
+func (t *threadRepository) FindComments(ctx context.Context, threadID int, page int, size int) ([]domain.ThreadComment, error) {
+	comments, err := t.commentQuery().
+		Where(threadcomment.ThreadID(threadID)).
+		Order(ent.Asc(threadcomment.FieldID)).
+		Offset((page - 1) * size).
+		Limit(size).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.ThreadComment, len(comments))
+	for i, found := range comments {
+		result[i] = toDomainThreadComment(found)
+	}
+	return result, nil
+}
+
+func (t *threadRepository) FindComment(ctx context.Context, threadID int, commentID int) (*domain.ThreadComment, error) {
+	found, err := t.commentQuery().
+		Where(
+			threadcomment.ID(commentID),
+			threadcomment.ThreadID(threadID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainThreadComment(found)
+	return &result, nil
+}
+
+func (t *threadRepository) UpdateComment(ctx context.Context, comment *domain.ThreadComment, now time.Time) error {
+	return t.client.ThreadComment.UpdateOneID(comment.ID).
+		SetBody(comment.Body).
+		SetMentions(comment.Mentions).
+		SetEditedAt(now).
+		Exec(ctx)
+}
+
+func (t *threadRepository) DeleteComment(ctx context.Context, threadID int, commentID int) error {
+	return t.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		deleted, err := tx.ThreadComment.Delete().
+			Where(
+				threadcomment.ID(commentID),
+				threadcomment.ThreadID(threadID),
+			).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return nil
+		}
+		return tx.Thread.UpdateOneID(threadID).AddCommentCount(-1).Exec(ctx)
+	})
+}
+
+func (t *threadRepository) threadQuery() *ent.ThreadQuery {
+	return t.client.Thread.Query().
+		WithAuthor(func(mq *ent.MemberQuery) {
+			mq.Select(member.FieldMemberID, member.FieldNickname)
+		})
+}
+
+func (t *threadRepository) commentQuery() *ent.ThreadCommentQuery {
+	return t.client.ThreadComment.Query().
+		WithAuthor(func(mq *ent.MemberQuery) {
+			mq.Select(member.FieldMemberID, member.FieldNickname)
+		})
+}
+
+func toDomainThread(found *ent.Thread) domain.Thread {
+	result := domain.Thread{
+		ID:             found.ID,
+		TeamID:         found.TeamID,
+		Title:          found.Title,
+		Body:           found.Body,
+		Mentions:       found.Mentions,
+		CommentCount:   found.CommentCount,
+		LastActivityAt: found.LastActivityAt,
+		EditedAt:       found.EditedAt,
+		CreatedAt:      found.CreatedAt,
+	}
+	if found.Edges.Author != nil {
+		result.AuthorID = found.Edges.Author.MemberID
+		result.AuthorNickname = found.Edges.Author.Nickname
+	}
+	return result
+}
+
+func toDomainThreadComment(found *ent.ThreadComment) domain.ThreadComment {
+	result := domain.ThreadComment{
+		ID:        found.ID,
+		ThreadID:  found.ThreadID,
+		Body:      found.Body,
+		Mentions:  found.Mentions,
+		EditedAt:  found.EditedAt,
+		CreatedAt: found.CreatedAt,
+	}
+	if found.Edges.Author != nil {
+		result.AuthorID = found.Edges.Author.MemberID
+		result.AuthorNickname = found.Edges.Author.Nickname
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreadRepository_Comments(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	threads := NewThreadRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	member := newTestMember(t, client, "member")
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, member.MemberID, models.Backend))
+
+	older, err := threads.CreateThread(ctx, &domain.Thread{
+		TeamID:   created.ID,
+		Title:    "自己紹介",
+		Body:     "よろしくお願いします",
+		AuthorID: leader.MemberID,
+	})
+	require.NoError(t, err)
+	newer, err := threads.CreateThread(ctx, &domain.Thread{
+		TeamID:   created.ID,
+		Title:    "技術選定",
+		Body:     "@member DB は何にしますか",
+		AuthorID: leader.MemberID,
+		Mentions: []string{member.MemberID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, leader.Nickname, newer.AuthorNickname)
+	assert.Equal(t, []string{member.MemberID}, newer.Mentions)
+
+	// コメントがついたスレッドが一覧の先頭になる
+	time.Sleep(10 * time.Millisecond)
+	first, err := threads.CreateComment(ctx, &domain.ThreadComment{ThreadID: older.ID, Body: "よろしく", AuthorID: member.MemberID})
+	require.NoError(t, err)
+	_, err = threads.CreateComment(ctx, &domain.ThreadComment{ThreadID: older.ID, Body: "👋", AuthorID: leader.MemberID})
+	require.NoError(t, err)
+
+	listed, err := threads.FindThreads(ctx, created.ID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, older.ID, listed[0].ID)
+	assert.Equal(t, 2, listed[0].CommentCount)
+
+	page, err := threads.FindThreads(ctx, created.ID, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, newer.ID, page[0].ID)
+
+	comments, err := threads.FindComments(ctx, older.ID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, first.ID, comments[0].ID)
+
+	first.Body = "よろしくお願いします"
+	require.NoError(t, threads.UpdateComment(ctx, first, time.Now()))
+	edited, err := threads.FindComment(ctx, older.ID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "よろしくお願いします", edited.Body)
+	assert.NotNil(t, edited.EditedAt)
+
+	// 別のスレッドの ID では見つからない
+	_, err = threads.FindComment(ctx, newer.ID, first.ID)
+	assert.Error(t, err)
+
+	require.NoError(t, threads.DeleteComment(ctx, older.ID, first.ID))
+	found, err := threads.FindThread(ctx, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, found.CommentCount)
+
+	// チームを削除するとスレッドとコメントも削除される
+	require.NoError(t, teams.DeleteTeam(ctx, created.ID))
+	assert.Zero(t, client.Thread.Query().CountX(ctx))
+	assert.Zero(t, client.ThreadComment.Query().CountX(ctx))
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"backend_golang/ent"
+	"backend_golang/internal/metrics"
 	"context"
+	"time"
 )
 
 // TransactionManager handles database transactions
@@ -18,7 +20,9 @@ func NewTransactionManager(client *ent.Client) *TransactionManager {
 }
 
 // WithTx executes the given function within a transaction
-func (tm *TransactionManager) WithTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+// The duration of each transaction is recorded by its outcome
+func (tm *TransactionManager) WithTx(ctx context.Context, fn func(tx *ent.Tx) error) (err error) {
+	start := time.Now()
 	tx, err := tm.client.Tx(ctx)
 	if err != nil {
 		return err
@@ -26,8 +30,10 @@ func (tm *TransactionManager) WithTx(ctx context.Context, fn func(tx *ent.Tx) er
 	defer func() {
 		if v := recover(); v != nil {
 			tx.Rollback()
+			observeTx(start, false)
 			panic(v)
 		}
+		observeTx(start, err == nil)
 	}()
 	if err := fn(tx); err != nil {
 		if rerr := tx.Rollback(); rerr != nil {
@@ -40,3 +46,11 @@ func (tm *TransactionManager) WithTx(ctx context.Context, fn func(tx *ent.Tx) er
 	}
 	return nil
 }
+
+func observeTx(start time.Time, committed bool) {
+	outcome := "commit"
+	if !committed {
+		outcome = "rollback"
+	}
+	metrics.DBTransactionDuration.Observe(time.Since(start).Seconds(), outcome)
+}
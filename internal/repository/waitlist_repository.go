@@ -0,0 +1,392 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/member"
+	"backend_golang/ent/position"
+	"backend_golang/ent/team"
+	"backend_golang/ent/waitlistentry"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+type WaitlistRepository interface {
+	Enqueue(ctx context.Context, teamID int, memberID string, role models.Role) (*domain.WaitlistEntry, error)
+	FindByMember(ctx context.Context, memberID string) ([]domain.WaitlistEntry, error)
+	Accept(ctx context.Context, entryID int, memberID string, now time.Time) error
+	Cancel(ctx context.Context, entryID int, memberID string, now time.Time) error
+	ExpireOffers(ctx context.Context, now time.Time) (int, error)
+}
+
+type waitlistRepository struct {
+	client *ent.Client
+	tx     *TransactionManager
+}
+
+func NewWaitlistRepository(client *ent.Client) WaitlistRepository {
+	return &waitlistRepository{
+		client: client,
+		tx:     NewTransactionManager(client),
+	}
+}
+
+func (w *waitlistRepository) Enqueue(ctx context.Context, teamID int, memberID string, role models.Role) (*domain.WaitlistEntry, error) {
+	var result *domain.WaitlistEntry
+	err := w.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		memberEnt, err := tx.Member.Query().Where(member.MemberID(memberID)).Only(ctx)
+		if err != nil {
+			return err
+		}
+		joined, err := memberEnt.QueryTeams().Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if joined {
+			return models.ErrAlreadyInTeam
+		}
+
+		posEnt, err := tx.Position.Query().
+			Where(
+				position.RoleEQ(role),
+				position.TeamID(teamID),
+			).
+			WithTeam().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return fmt.Errorf("%w %s", models.ErrNoVacancy, role)
+			}
+			return err
+		}
+		if posEnt.Vacancy > 0 {
+			return models.ErrVacancyAvailable
+		}
+
+		waiting, err := posEnt.QueryWaitlist().
+			Where(
+				waitlistentry.HasMemberWith(member.ID(memberEnt.ID)),
+				waitlistentry.StatusIn(models.WaitlistWaiting, models.WaitlistOffered),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if waiting {
+			return models.ErrAlreadyWaitlisted
+		}
+
+		entry, err := tx.WaitlistEntry.Create().
+			SetPosition(posEnt).
+			SetMember(memberEnt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		err = recordEvent(ctx, tx, domain.DomainEvent{
+			Type:        models.DomainEventWaitlistJoined,
+			AggregateID: entry.ID,
+			TeamID:      teamID,
+			MemberID:    memberID,
+		})
+		if err != nil {
+			return err
+		}
+
+		place, err := waitlistPlace(ctx, tx.Client(), entry)
+		if err != nil {
+			return err
+		}
+		result = &domain.WaitlistEntry{
+			ID:        entry.ID,
+			TeamID:    teamID,
+			TeamName:  posEnt.Edges.Team.Name,
+			Role:      role,
+			Status:    entry.Status,
+			Place:     place,
+			CreatedAt: entry.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (w *waitlistRepository) FindByMember(ctx context.Context, memberID string) ([]domain.WaitlistEntry, error) {
+	entries, err := w.client.WaitlistEntry.Query().
+		Where(
+			waitlistentry.HasMemberWith(member.MemberID(memberID)),
+			waitlistentry.StatusIn(models.WaitlistWaiting, models.WaitlistOffered),
+		).
+		WithPosition(func(pq *ent.PositionQuery) {
+			pq.WithTeam(func(tq *ent.TeamQuery) {
+				tq.Select(team.FieldName)
+			})
+		}).
+		Order(ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.WaitlistEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = domain.WaitlistEntry{
+			ID:             entry.ID,
+			Role:           entry.Edges.Position.Role,
+			Status:         entry.Status,
+			OfferExpiresAt: entry.OfferExpiresAt,
+			CreatedAt:      entry.CreatedAt,
+		}
+		if t := entry.Edges.Position.Edges.Team; t != nil {
+			result[i].TeamID = t.ID
+			result[i].TeamName = t.Name
+		}
+		if entry.Status == models.WaitlistWaiting {
+			result[i].Place, err = waitlistPlace(ctx, w.client, entry)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Accept は提示された空きを承諾してチームに参加する
+func (w *waitlistRepository) Accept(ctx context.Context, entryID int, memberID string, now time.Time) error {
+	return w.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		entry, err := findMemberEntry(ctx, tx, entryID, memberID)
+		if err != nil {
+			return err
+		}
+		if entry.Status != models.WaitlistOffered {
+			return models.ErrNoOffer
+		}
+		if entry.OfferExpiresAt != nil && !now.Before(*entry.OfferExpiresAt) {
+			return models.ErrOfferExpired
+		}
+
+		err = entry.Update().SetStatus(models.WaitlistAccepted).Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// 確保していた空きを戻し、通常の参加と同じ手順で参加させる
+		err = tx.Position.UpdateOneID(entry.PositionID).AddVacancy(1).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return joinTeam(ctx, tx, entry.Edges.Position.TeamID, memberID, entry.Edges.Position.Role)
+	})
+}
+
+// Cancel は待機をやめる。空きが提示されていた場合は次の人に回す
+func (w *waitlistRepository) Cancel(ctx context.Context, entryID int, memberID string, now time.Time) error {
+	return w.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		entry, err := findMemberEntry(ctx, tx, entryID, memberID)
+		if err != nil {
+			return err
+		}
+		if !entry.Status.IsActive() {
+			return nil
+		}
+		err = releaseWaitlistEntry(ctx, tx, entry, models.WaitlistCancelled, now)
+		if err != nil {
+			return err
+		}
+		return refreshTeamStatus(ctx, tx, entry.Edges.Position.TeamID)
+	})
+}
+
+// ExpireOffers は承諾期限を過ぎた提示を失効させ、空きを次の人に回す
+func (w *waitlistRepository) ExpireOffers(ctx context.Context, now time.Time) (int, error) {
+	var expired int
+	err := w.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		entries, err := tx.WaitlistEntry.Query().
+			Where(
+				waitlistentry.StatusEQ(models.WaitlistOffered),
+				waitlistentry.OfferExpiresAtLTE(now),
+			).
+			WithPosition().
+			Order(ent.Asc(waitlistentry.FieldID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		teamIDs := make(map[int]bool)
+		for _, entry := range entries {
+			err := releaseWaitlistEntry(ctx, tx, entry, models.WaitlistExpired, now)
+			if err != nil {
+				return err
+			}
+			teamIDs[entry.Edges.Position.TeamID] = true
+		}
+		for teamID := range teamIDs {
+			if err := refreshTeamStatus(ctx, tx, teamID); err != nil {
+				return err
+			}
+		}
+		expired = len(entries)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// findMemberEntry は memberID のメンバーの待機エントリーをポジションと一緒に取得する
+func findMemberEntry(ctx context.Context, tx *ent.Tx, entryID int, memberID string) (*ent.WaitlistEntry, error) {
+	return tx.WaitlistEntry.Query().
+		Where(
+			waitlistentry.ID(entryID),
+			waitlistentry.HasMemberWith(member.MemberID(memberID)),
+		).
+		WithPosition().
+		Only(ctx)
+}
+
+// waitlistPlace は entry より前に並んでいる WAITING のエントリー数から待ち順を求める
+func waitlistPlace(ctx context.Context, client *ent.Client, entry *ent.WaitlistEntry) (int, error) {
+	ahead, err := client.WaitlistEntry.Query().
+		Where(
+			waitlistentry.PositionID(entry.PositionID),
+			waitlistentry.StatusEQ(models.WaitlistWaiting),
+			waitlistentry.IDLT(entry.ID),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return ahead + 1, nil
+}
+
+// releaseWaitlistEntry は待機エントリーを status で終了させる
+// 空きを確保していた場合は空きを戻して次の人に提示する
+func releaseWaitlistEntry(ctx context.Context, tx *ent.Tx, entry *ent.WaitlistEntry, status models.WaitlistStatus, now time.Time) error {
+	err := tx.WaitlistEntry.UpdateOneID(entry.ID).SetStatus(status).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if entry.Status != models.WaitlistOffered {
+		return nil
+	}
+
+	err = tx.Position.UpdateOneID(entry.PositionID).AddVacancy(1).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return offerSeats(ctx, tx, entry.PositionID, now)
+}
+
+// cancelWaitlist はチームに参加したメンバーの残りの待機を取り消す
+func cancelWaitlist(ctx context.Context, tx *ent.Tx, memberID string, now time.Time) error {
+	entries, err := tx.WaitlistEntry.Query().
+		Where(
+			waitlistentry.HasMemberWith(member.MemberID(memberID)),
+			waitlistentry.StatusIn(models.WaitlistWaiting, models.WaitlistOffered),
+		).
+		WithPosition().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := releaseWaitlistEntry(ctx, tx, entry, models.WaitlistCancelled, now); err != nil {
+			return err
+		}
+		if err := refreshTeamStatus(ctx, tx, entry.Edges.Position.TeamID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// offerTeamSeats はチームの全ポジションについて空きを待機者に提示する
+func offerTeamSeats(ctx context.Context, tx *ent.Tx, teamID int, now time.Time) error {
+	positionIDs, err := tx.Position.Query().
+		Where(position.TeamID(teamID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, positionID := range positionIDs {
+		if err := offerSeats(ctx, tx, positionID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// offerSeats はポジションの空きを待ち順に提示し、提示した分の空きを確保する
+// 募集を締め切ったチームでは提示しない
+func offerSeats(ctx context.Context, tx *ent.Tx, positionID int, now time.Time) error {
+	posEnt, err := tx.Position.Query().
+		Where(position.ID(positionID)).
+		WithTeam().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if posEnt.Edges.Team == nil || posEnt.Edges.Team.Status.IsManual() {
+		return nil
+	}
+
+	for vacancy := posEnt.Vacancy; vacancy > 0; {
+		entry, err := tx.WaitlistEntry.Query().
+			Where(
+				waitlistentry.PositionID(positionID),
+				waitlistentry.StatusEQ(models.WaitlistWaiting),
+			).
+			WithMember().
+			Order(ent.Asc(waitlistentry.FieldID)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		// 他のチームに参加済みのメンバーには提示しない
+		joined, err := entry.Edges.Member.QueryTeams().Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if joined {
+			err = entry.Update().SetStatus(models.WaitlistCancelled).Exec(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = tx.Position.UpdateOneID(positionID).AddVacancy(-1).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		err = entry.Update().
+			SetStatus(models.WaitlistOffered).
+			SetOfferExpiresAt(now.Add(models.WaitlistOfferTTL)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		err = recordEvent(ctx, tx, domain.DomainEvent{
+			Type:        models.DomainEventWaitlistOffered,
+			AggregateID: entry.ID,
+			TeamID:      posEnt.TeamID,
+			MemberID:    entry.Edges.Member.MemberID,
+		})
+		if err != nil {
+			return err
+		}
+		vacancy--
+	}
+	return nil
+}
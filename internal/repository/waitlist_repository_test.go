@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitlistRepository_OfferAndAccept(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	waitlist := NewWaitlistRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	first := newTestMember(t, client, "first")
+	second := newTestMember(t, client, "second")
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, first.MemberID, models.Backend))
+
+	newTestMember(t, client, "waiter-1")
+	newTestMember(t, client, "waiter-2")
+
+	// 空きがある間は待機できない
+	_, err = waitlist.Enqueue(ctx, created.ID, "waiter-1", models.Backend)
+	assert.ErrorIs(t, err, models.ErrVacancyAvailable)
+
+	require.NoError(t, teams.JoinTeam(ctx, created.ID, second.MemberID, models.Backend))
+
+	entry1, err := waitlist.Enqueue(ctx, created.ID, "waiter-1", models.Backend)
+	require.NoError(t, err)
+	assert.Equal(t, 1, entry1.Place)
+	entry2, err := waitlist.Enqueue(ctx, created.ID, "waiter-2", models.Backend)
+	require.NoError(t, err)
+	assert.Equal(t, 2, entry2.Place)
+
+	_, err = waitlist.Enqueue(ctx, created.ID, "waiter-1", models.Backend)
+	assert.ErrorIs(t, err, models.ErrAlreadyWaitlisted)
+
+	// 脱退すると先頭の待機者に席が提示され、席は確保される
+	require.NoError(t, teams.LeaveTeam(ctx, created.ID, second.MemberID))
+	entries, err := waitlist.FindByMember(ctx, "waiter-1")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.WaitlistOffered, entries[0].Status)
+	require.NotNil(t, entries[0].OfferExpiresAt)
+
+	entries, err = waitlist.FindByMember(ctx, "waiter-2")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, 1, entries[0].Place)
+
+	team, err := teams.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TeamStatusFull, team.Status)
+	assert.Equal(t, int8(0), team.Positions[0].Vacancy)
+	assert.Equal(t, 1, team.Positions[0].Reserved)
+
+	err = waitlist.Accept(ctx, entry2.ID, "waiter-2", time.Now())
+	assert.ErrorIs(t, err, models.ErrNoOffer)
+
+	require.NoError(t, waitlist.Accept(ctx, entry1.ID, "waiter-1", time.Now()))
+	team, err = teams.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, team.Positions[0].Filled)
+	assert.Equal(t, 0, team.Positions[0].Reserved)
+	assert.Equal(t, int8(0), team.Positions[0].Vacancy)
+	_, ok := team.Member("waiter-1")
+	assert.True(t, ok)
+
+	entries, err = waitlist.FindByMember(ctx, "waiter-1")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestWaitlistRepository_ExpiredOfferMovesToNext(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	waitlist := NewWaitlistRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	require.NoError(t, teams.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Backend, Vacancy: 0},
+	}))
+
+	newTestMember(t, client, "waiter-1")
+	newTestMember(t, client, "waiter-2")
+	entry1, err := waitlist.Enqueue(ctx, created.ID, "waiter-1", models.Backend)
+	require.NoError(t, err)
+	_, err = waitlist.Enqueue(ctx, created.ID, "waiter-2", models.Backend)
+	require.NoError(t, err)
+
+	// リーダーが席を増やすと先頭の待機者に提示される
+	require.NoError(t, teams.UpdatePositions(ctx, created.ID, []domain.Position{
+		{Role: models.Backend, Vacancy: 1},
+	}))
+
+	// 期限前は失効しない
+	expired, err := waitlist.ExpireOffers(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, expired)
+
+	later := time.Now().Add(models.WaitlistOfferTTL + time.Minute)
+	err = waitlist.Accept(ctx, entry1.ID, "waiter-1", later)
+	assert.ErrorIs(t, err, models.ErrOfferExpired)
+
+	expired, err = waitlist.ExpireOffers(ctx, later)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	entries, err := waitlist.FindByMember(ctx, "waiter-1")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	entries, err = waitlist.FindByMember(ctx, "waiter-2")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.WaitlistOffered, entries[0].Status)
+
+	// 提示中の席を取り消すと、待機者がいなければ空きに戻る
+	require.NoError(t, waitlist.Cancel(ctx, entries[0].ID, "waiter-2", later))
+	team, err := teams.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int8(1), team.Positions[0].Vacancy)
+	assert.Equal(t, models.TeamStatusRecruiting, team.Status)
+}
@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"backend_golang/ent"
+	"backend_golang/ent/webhook"
+	"backend_golang/ent/webhookdelivery"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"time"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *domain.Webhook) (*domain.Webhook, error)
+	FindByID(ctx context.Context, teamID int, webhookID int) (*domain.Webhook, error)
+	FindByTeam(ctx context.Context, teamID int) ([]domain.Webhook, error)
+	// Update は送信先・形式・イベントの種類と有効かどうかを変更する。有効な Webhook は連続した失敗の回数を0に戻す
+	Update(ctx context.Context, webhook *domain.Webhook) error
+	Delete(ctx context.Context, webhookID int) error
+	// EnqueueDelivery は送信を登録する。同じ Webhook に同じイベントが既に登録されている場合は作成せずに false を返す
+	EnqueueDelivery(ctx context.Context, delivery *domain.WebhookDelivery) (bool, error)
+	// FindDueDeliveries は送る日時を過ぎた送信待ちを送信先と一緒に古い順に返す
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error)
+	// RecordAttempt は送信の結果を記録する。失敗が続いて Webhook を無効にした場合は true を返す
+	RecordAttempt(ctx context.Context, deliveryID int, attempt domain.WebhookAttempt) (bool, error)
+	FindDeliveries(ctx context.Context, webhookID int, page int, size int) ([]domain.WebhookDelivery, error)
+}
+
+type webhookRepository struct {
+	client *ent.Client
+	tx     *TransactionManager
+}
+
+func NewWebhookRepository(client *ent.Client) WebhookRepository {
+	return &webhookRepository{
+		client: client,
+		tx:     NewTransactionManager(client),
+	}
+}
+
+func (w *webhookRepository) Create(ctx context.Context, input *domain.Webhook) (*domain.Webhook, error) {
+	created, err := w.client.Webhook.Create().
+		SetTeamID(input.TeamID).
+		SetURL(input.URL).
+		SetFormat(input.Format).
+		SetSecret(input.Secret).
+		SetEvents(input.Events).
+		SetCreatedBy(input.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainWebhook(created)
+	return &result, nil
+}
+
+func (w *webhookRepository) FindByID(ctx context.Context, teamID int, webhookID int) (*domain.Webhook, error) {
+	found, err := w.client.Webhook.Query().
+		Where(
+			webhook.ID(webhookID),
+			webhook.TeamID(teamID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := toDomainWebhook(found)
+	return &result, nil
+}
+
+func (w *webhookRepository) FindByTeam(ctx context.Context, teamID int) ([]domain.Webhook, error) {
+	found, err := w.client.Webhook.Query().
+		Where(webhook.TeamID(teamID)).
+		Order(ent.Asc(webhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Webhook, len(found))
+	for i, f := range found {
+		result[i] = toDomainWebhook(f)
+	}
+	return result, nil
+}
+
+func (w *webhookRepository) Update(ctx context.Context, input *domain.Webhook) error {
+	update := w.client.Webhook.UpdateOneID(input.ID).
+		SetURL(input.URL).
+		SetFormat(input.Format).
+		SetEvents(input.Events).
+		SetActive(input.Active)
+	if input.Active {
+		update.
+			SetConsecutiveFailures(0).
+			ClearDisabledAt()
+	}
+	return update.Exec(ctx)
+}
+
+func (w *webhookRepository) Delete(ctx context.Context, webhookID int) error {
+	return w.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		_, err := tx.WebhookDelivery.Delete().
+			Where(webhookdelivery.WebhookID(webhookID)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Webhook.DeleteOneID(webhookID).Exec(ctx)
+	})
+}
+
+func (w *webhookRepository) EnqueueDelivery(ctx context.Context, input *domain.WebhookDelivery) (bool, error) {
+	exists, err := w.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.WebhookID(input.WebhookID),
+			webhookdelivery.EventID(input.EventID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	err = w.client.WebhookDelivery.Create().
+		SetWebhookID(input.WebhookID).
+		SetEventID(input.EventID).
+		SetEventType(input.EventType).
+		SetBody(input.Body).
+		Exec(ctx)
+	if err != nil {
+		// 同時に登録された場合は一意制約で弾かれる
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (w *webhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	found, err := w.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(models.WebhookDeliveryPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.Active(true)),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.WebhookDelivery, len(found))
+	for i, f := range found {
+		result[i] = toDomainWebhookDelivery(f)
+		if f.Edges.Webhook != nil {
+			hook := toDomainWebhook(f.Edges.Webhook)
+			result[i].Webhook = &hook
+		}
+	}
+	return result, nil
+}
+
+func (w *webhookRepository) RecordAttempt(ctx context.Context, deliveryID int, attempt domain.WebhookAttempt) (bool, error) {
+	var disabled bool
+	err := w.tx.WithTx(ctx, func(tx *ent.Tx) error {
+		delivery, err := tx.WebhookDelivery.UpdateOneID(deliveryID).
+			AddAttempts(1).
+			SetStatus(attempt.Status).
+			SetResponseStatus(attempt.ResponseStatus).
+			SetLastError(attempt.Error).
+			SetDurationMs(attempt.Duration.Milliseconds()).
+			SetNextAttemptAt(attempt.NextAttemptAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if attempt.Succeeded() {
+			err = delivery.Update().SetDeliveredAt(attempt.At).Exec(ctx)
+			if err != nil {
+				return err
+			}
+			return tx.Webhook.UpdateOneID(delivery.WebhookID).
+				SetConsecutiveFailures(0).
+				Exec(ctx)
+		}
+
+		// 加算で更新し、同時に失敗を記録しても回数を取りこぼさないようにする
+		hook, err := tx.Webhook.UpdateOneID(delivery.WebhookID).
+			AddConsecutiveFailures(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if !hook.Active || hook.ConsecutiveFailures < models.WebhookDisableAfter {
+			return nil
+		}
+
+		err = hook.Update().
+			SetActive(false).
+			SetDisabledAt(attempt.At).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		// 無効にした Webhook に残っている送信待ちは諦める
+		_, err = tx.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.WebhookID(hook.ID),
+				webhookdelivery.StatusEQ(models.WebhookDeliveryPending),
+			).
+			SetStatus(models.WebhookDeliveryFailed).
+			SetLastError("webhook disabled after repeated failures").
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		disabled = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return disabled, nil
+}
+
+// FindDeliveries は Webhook への送信を新しい順に返す
+func (w *webhookRepository) FindDeliveries(ctx context.Context, webhookID int, page int, size int) ([]domain.WebhookDelivery, error) {
+	found, err := w.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(webhookID)).
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Offset((page - 1) * size).
+		Limit(size).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.WebhookDelivery, len(found))
+	for i, f := range found {
+		result[i] = toDomainWebhookDelivery(f)
+	}
+	return result, nil
+}
+
+func toDomainWebhook(found *ent.Webhook) domain.Webhook {
+	return domain.Webhook{
+		ID:                  found.ID,
+		TeamID:              found.TeamID,
+		URL:                 found.URL,
+		Format:              found.Format,
+		Secret:              found.Secret,
+		Events:              found.Events,
+		Active:              found.Active,
+		ConsecutiveFailures: found.ConsecutiveFailures,
+		DisabledAt:          found.DisabledAt,
+		CreatedBy:           found.CreatedBy,
+		CreatedAt:           found.CreatedAt,
+		UpdatedAt:           found.UpdatedAt,
+	}
+}
+
+func toDomainWebhookDelivery(found *ent.WebhookDelivery) domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:             found.ID,
+		WebhookID:      found.WebhookID,
+		EventID:        found.EventID,
+		EventType:      found.EventType,
+		Body:           found.Body,
+		Status:         found.Status,
+		Attempts:       found.Attempts,
+		NextAttemptAt:  found.NextAttemptAt,
+		ResponseStatus: found.ResponseStatus,
+		LastError:      found.LastError,
+		Duration:       time.Duration(found.DurationMs) * time.Millisecond,
+		DeliveredAt:    found.DeliveredAt,
+		CreatedAt:      found.CreatedAt,
+	}
+}
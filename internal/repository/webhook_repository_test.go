@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository_EnqueueAndRecordAttempts(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	webhooks := NewWebhookRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+
+	hook, err := webhooks.Create(ctx, &domain.Webhook{
+		TeamID:    created.ID,
+		URL:       "https://example.com/hook",
+		Format:    models.WebhookSlack,
+		Secret:    "secret",
+		Events:    []models.DomainEventType{models.DomainEventAnnouncementCreated},
+		CreatedBy: leader.MemberID,
+	})
+	require.NoError(t, err)
+	assert.True(t, hook.Active)
+
+	_, err = webhooks.FindByID(ctx, created.ID+1, hook.ID)
+	assert.Error(t, err)
+
+	// 同じイベントは二重に登録しない
+	delivery := &domain.WebhookDelivery{WebhookID: hook.ID, EventID: 1, EventType: models.DomainEventAnnouncementCreated, Body: `{"text":"hello"}`}
+	ok, err := webhooks.EnqueueDelivery(ctx, delivery)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = webhooks.EnqueueDelivery(ctx, delivery)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	now := time.Now()
+	due, err := webhooks.FindDueDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.NotNil(t, due[0].Webhook)
+	assert.Equal(t, "secret", due[0].Webhook.Secret)
+
+	disabled, err := webhooks.RecordAttempt(ctx, due[0].ID, domain.WebhookAttempt{
+		Status:         models.WebhookDeliveryPending,
+		ResponseStatus: 500,
+		Error:          "unexpected status 500",
+		Duration:       120 * time.Millisecond,
+		NextAttemptAt:  now.Add(time.Minute),
+		At:             now,
+	})
+	require.NoError(t, err)
+	assert.False(t, disabled)
+
+	// 再送は指定した日時まで待つ
+	due, err = webhooks.FindDueDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	due, err = webhooks.FindDueDeliveries(ctx, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	_, err = webhooks.RecordAttempt(ctx, due[0].ID, domain.WebhookAttempt{
+		Status:         models.WebhookDeliverySucceeded,
+		ResponseStatus: 200,
+		NextAttemptAt:  now.Add(time.Minute),
+		At:             now.Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	logs, err := webhooks.FindDeliveries(ctx, hook.ID, 1, 20)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, models.WebhookDeliverySucceeded, logs[0].Status)
+	assert.Equal(t, 2, logs[0].Attempts)
+	assert.Equal(t, 200, logs[0].ResponseStatus)
+	require.NotNil(t, logs[0].DeliveredAt)
+
+	found, err := webhooks.FindByID(ctx, created.ID, hook.ID)
+	require.NoError(t, err)
+	assert.Zero(t, found.ConsecutiveFailures)
+}
+
+func TestWebhookRepository_DisablesAfterRepeatedFailures(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCountingClient(t)
+	teams := NewTeamRepository(client)
+	webhooks := NewWebhookRepository(client)
+
+	leader := newTestMember(t, client, "leader")
+	created, err := teams.CreateTeam(ctx, domainTeam("gophers", leader.MemberID))
+	require.NoError(t, err)
+	hook, err := webhooks.Create(ctx, &domain.Webhook{
+		TeamID:    created.ID,
+		URL:       "https://example.com/hook",
+		Format:    models.WebhookGeneric,
+		Secret:    "secret",
+		CreatedBy: leader.MemberID,
+	})
+	require.NoError(t, err)
+
+	for eventID := 1; eventID <= 2; eventID++ {
+		_, err := webhooks.EnqueueDelivery(ctx, &domain.WebhookDelivery{WebhookID: hook.ID, EventID: eventID, EventType: models.DomainEventMemberJoined, Body: "{}"})
+		require.NoError(t, err)
+	}
+	now := time.Now()
+	due, err := webhooks.FindDueDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+
+	failure := domain.WebhookAttempt{Status: models.WebhookDeliveryPending, Error: "connection refused", NextAttemptAt: now, At: now}
+	for i := 1; i < models.WebhookDisableAfter; i++ {
+		disabled, err := webhooks.RecordAttempt(ctx, due[0].ID, failure)
+		require.NoError(t, err)
+		assert.False(t, disabled)
+	}
+	disabled, err := webhooks.RecordAttempt(ctx, due[0].ID, failure)
+	require.NoError(t, err)
+	assert.True(t, disabled)
+
+	found, err := webhooks.FindByID(ctx, created.ID, hook.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Active)
+	assert.NotNil(t, found.DisabledAt)
+
+	// 無効にした Webhook の送信待ちは諦める
+	due, err = webhooks.FindDueDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+	logs, err := webhooks.FindDeliveries(ctx, hook.ID, 1, 20)
+	require.NoError(t, err)
+	for _, log := range logs {
+		assert.Equal(t, models.WebhookDeliveryFailed, log.Status)
+	}
+
+	// 有効に戻すと失敗の回数を0に戻す
+	found.Active = true
+	require.NoError(t, webhooks.Update(ctx, found))
+	found, err = webhooks.FindByID(ctx, created.ID, hook.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Active)
+	assert.Zero(t, found.ConsecutiveFailures)
+	assert.Nil(t, found.DisabledAt)
+
+	// チームを削除すると Webhook と送信の記録も削除する
+	require.NoError(t, teams.DeleteTeam(ctx, created.ID))
+	assert.Zero(t, client.Webhook.Query().CountX(ctx))
+	assert.Zero(t, client.WebhookDelivery.Query().CountX(ctx))
+}
@@ -0,0 +1,187 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+)
+
+// BM25 のパラメータ
+const (
+	bm25K1      = 1.2
+	bm25B       = 0.75
+	titleWeight = 2
+)
+
+type docKey struct {
+	kind Kind
+	id   int
+}
+
+// MemoryIndex はプロセス内に転置インデックスを持つ Searcher の実装
+// MySQL の FULLTEXT インデックスが使えない SQLite 環境（開発・テスト）向け
+type MemoryIndex struct {
+	mu       sync.RWMutex
+	docs     map[docKey]Document
+	lengths  map[docKey]int
+	postings map[string]map[docKey]int
+	total    int
+}
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[docKey]Document),
+		lengths:  make(map[docKey]int),
+		postings: make(map[string]map[docKey]int),
+	}
+}
+
+func (m *MemoryIndex) Index(ctx context.Context, docs ...Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, doc := range docs {
+		key := docKey{doc.Kind, doc.ID}
+		m.remove(key)
+
+		frequencies := make(map[string]int)
+		length := 0
+		for _, token := range tokenize(doc.Title) {
+			frequencies[token] += titleWeight
+			length += titleWeight
+		}
+		for _, token := range tokenize(doc.Body) {
+			frequencies[token]++
+			length++
+		}
+
+		for token, frequency := range frequencies {
+			posting, ok := m.postings[token]
+			if !ok {
+				posting = make(map[docKey]int)
+				m.postings[token] = posting
+			}
+			posting[key] = frequency
+		}
+		m.docs[key] = doc
+		m.lengths[key] = length
+		m.total += length
+	}
+	return nil
+}
+
+func (m *MemoryIndex) Remove(ctx context.Context, kind Kind, id int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(docKey{kind, id})
+	return nil
+}
+
+func (m *MemoryIndex) remove(key docKey) {
+	doc, ok := m.docs[key]
+	if !ok {
+		return
+	}
+	for _, token := range append(tokenize(doc.Title), tokenize(doc.Body)...) {
+		if posting, ok := m.postings[token]; ok {
+			delete(posting, key)
+			if len(posting) == 0 {
+				delete(m.postings, token)
+			}
+		}
+	}
+	m.total -= m.lengths[key]
+	delete(m.lengths, key)
+	delete(m.docs, key)
+}
+
+// Search は全てのトークンを含む文書を BM25 のスコア順に返す
+func (m *MemoryIndex) Search(ctx context.Context, query Query) ([]Result, error) {
+	tokens := uniqueTokens(tokenize(query.Text))
+	if len(tokens) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	// 出現文書数の少ないトークンから候補を絞り込む
+	sort.Slice(tokens, func(i, j int) bool {
+		return len(m.postings[tokens[i]]) < len(m.postings[tokens[j]])
+	})
+	candidates := make(map[docKey]struct{})
+	for key := range m.postings[tokens[0]] {
+		if query.includes(key.kind) {
+			candidates[key] = struct{}{}
+		}
+	}
+	for _, token := range tokens[1:] {
+		posting := m.postings[token]
+		for key := range candidates {
+			if _, ok := posting[key]; !ok {
+				delete(candidates, key)
+			}
+		}
+	}
+
+	n := float64(len(m.docs))
+	averageLength := float64(m.total) / math.Max(n, 1)
+	results := make([]Result, 0, len(candidates))
+	for key := range candidates {
+		var score float64
+		length := float64(m.lengths[key])
+		for _, token := range tokens {
+			posting := m.postings[token]
+			df := float64(len(posting))
+			tf := float64(posting[key])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*length/averageLength))
+		}
+
+		doc := m.docs[key]
+		results = append(results, Result{
+			Kind:    doc.Kind,
+			ID:      doc.ID,
+			Title:   doc.Title,
+			Score:   score,
+			Snippet: Highlight(doc.Body, query.Text),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].Kind != results[j].Kind {
+			return results[i].Kind < results[j].Kind
+		}
+		return results[i].ID > results[j].ID
+	})
+	return paginate(results, query.Offset, query.Limit), nil
+}
+
+func uniqueTokens(tokens []string) []string {
+	seen := make(map[string]struct{}, len(tokens))
+	unique := tokens[:0]
+	for _, token := range tokens {
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		unique = append(unique, token)
+	}
+	return unique
+}
+
+func paginate(results []Result, offset int, limit int) []Result {
+	if offset >= len(results) {
+		return []Result{}
+	}
+	results = results[offset:]
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return results
+}
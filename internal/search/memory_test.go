@@ -0,0 +1,92 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "latin words are lowercased", text: "Go Backend", want: []string{"go", "backend"}},
+		{name: "japanese is split into bigrams", text: "勉強会", want: []string{"勉強", "強会"}},
+		{name: "korean is split into bigrams", text: "스터디", want: []string{"스터", "터디"}},
+		{name: "single cjk rune", text: "会", want: []string{"会"}},
+		{name: "mixed text", text: "Go勉強会!", want: []string{"go", "勉強", "強会"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenize(tt.text))
+		})
+	}
+}
+
+func TestMemoryIndex_Search(t *testing.T) {
+	ctx := context.Background()
+	index := NewMemoryIndex()
+	require.NoError(t, index.Index(ctx,
+		Document{Kind: KindAnnouncement, ID: 1, Title: "Go 勉強会のメンバー募集", Body: "毎週Goの勉強会を開催しています"},
+		Document{Kind: KindAnnouncement, ID: 2, Title: "フロントエンド募集", Body: "React を使ったサービス開発。勉強会もあります"},
+		Document{Kind: KindTeam, ID: 1, Title: "Gophers", Body: "Go でバックエンドを作るチーム"},
+	))
+
+	t.Run("ranks documents by relevance", func(t *testing.T) {
+		results, err := index.Search(ctx, Query{Text: "勉強会"})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, 1, results[0].ID)
+		assert.Equal(t, 2, results[1].ID)
+		assert.Greater(t, results[0].Score, results[1].Score)
+	})
+
+	t.Run("requires all terms", func(t *testing.T) {
+		results, err := index.Search(ctx, Query{Text: "React 勉強会"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, 2, results[0].ID)
+	})
+
+	t.Run("filters by kind", func(t *testing.T) {
+		results, err := index.Search(ctx, Query{Text: "go", Kinds: []Kind{KindTeam}})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, KindTeam, results[0].Kind)
+	})
+
+	t.Run("highlights matches in snippet", func(t *testing.T) {
+		results, err := index.Search(ctx, Query{Text: "react"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Contains(t, results[0].Snippet, "<mark>React</mark>")
+	})
+
+	t.Run("removed documents are not returned", func(t *testing.T) {
+		require.NoError(t, index.Remove(ctx, KindAnnouncement, 2))
+		results, err := index.Search(ctx, Query{Text: "勉強会"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, 1, results[0].ID)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		_, err := index.Search(ctx, Query{Text: "  !  "})
+		assert.ErrorIs(t, err, ErrEmptyQuery)
+	})
+}
+
+func TestHighlight(t *testing.T) {
+	assert.Equal(t, "&lt;b&gt;<mark>Go</mark>&lt;/b&gt; と <mark>go</mark>", Highlight("<b>Go</b> と go", "GO"))
+	assert.Equal(t, "no match", Highlight("no match", "xyz"))
+}
+
+func TestBooleanQuery(t *testing.T) {
+	assert.Equal(t, `+"Go" +"勉強会"`, booleanQuery(`Go "勉強会"`))
+	assert.Equal(t, "", booleanQuery(` "" `))
+}
@@ -0,0 +1,119 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type fulltextIndex struct {
+	table   string
+	name    string
+	columns string
+}
+
+var fulltextIndexes = []fulltextIndex{
+	{table: "announcements", name: "ft_announcements_title_content", columns: "title, content"},
+	{table: "teams", name: "ft_teams_name_description", columns: "name, description"},
+}
+
+// MySQLSearcher は ngram パーサーを使った MySQL の FULLTEXT インデックスで検索する Searcher の実装
+// インデックスは MySQL が行の更新に合わせて維持するため Index と Remove は何もしない
+type MySQLSearcher struct {
+	db *sql.DB
+}
+
+func NewMySQLSearcher(db *sql.DB) *MySQLSearcher {
+	return &MySQLSearcher{db: db}
+}
+
+// EnsureIndexes は FULLTEXT インデックスが無ければ作成する
+// ent のマイグレーションでは WITH PARSER を指定できないため、スキーマ作成後に呼び出す
+func (m *MySQLSearcher) EnsureIndexes(ctx context.Context) error {
+	for _, index := range fulltextIndexes {
+		var count int
+		err := m.db.QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+			index.table, index.name,
+		).Scan(&count)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		stmt := fmt.Sprintf("ALTER TABLE `%s` ADD FULLTEXT INDEX `%s` (%s) WITH PARSER ngram", index.table, index.name, index.columns)
+		if _, err := m.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MySQLSearcher) Index(ctx context.Context, docs ...Document) error {
+	return nil
+}
+
+func (m *MySQLSearcher) Remove(ctx context.Context, kind Kind, id int) error {
+	return nil
+}
+
+func (m *MySQLSearcher) Search(ctx context.Context, query Query) ([]Result, error) {
+	against := booleanQuery(query.Text)
+	if against == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	var selects []string
+	var args []any
+	if query.includes(KindAnnouncement) {
+		selects = append(selects, "SELECT 'announcement' AS kind, id, title, content AS body, MATCH (title, content) AGAINST (? IN BOOLEAN MODE) AS score FROM announcements WHERE MATCH (title, content) AGAINST (? IN BOOLEAN MODE)")
+		args = append(args, against, against)
+	}
+	if query.includes(KindTeam) {
+		selects = append(selects, "SELECT 'team' AS kind, id, name AS title, description AS body, MATCH (name, description) AGAINST (? IN BOOLEAN MODE) AS score FROM teams WHERE MATCH (name, description) AGAINST (? IN BOOLEAN MODE)")
+		args = append(args, against, against)
+	}
+	if len(selects) == 0 {
+		return []Result{}, nil
+	}
+
+	stmt := strings.Join(selects, " UNION ALL ") + " ORDER BY score DESC, id DESC"
+	if query.Limit > 0 {
+		stmt += " LIMIT ? OFFSET ?"
+		args = append(args, query.Limit, query.Offset)
+	}
+
+	rows, err := m.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []Result{}
+	for rows.Next() {
+		var result Result
+		var body string
+		if err := rows.Scan(&result.Kind, &result.ID, &result.Title, &body, &result.Score); err != nil {
+			return nil, err
+		}
+		result.Snippet = Highlight(body, query.Text)
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+// booleanQuery は各検索語を必須のフレーズとして BOOLEAN MODE の検索式に変換する
+// ngram パーサーはフレーズ内を bi-gram の並びとして扱うので、日本語・韓国語でも語の区切りに依存しない
+func booleanQuery(text string) string {
+	var parts []string
+	for _, term := range terms(text) {
+		term = strings.ReplaceAll(term, `"`, "")
+		if term == "" {
+			continue
+		}
+		parts = append(parts, `+"`+term+`"`)
+	}
+	return strings.Join(parts, " ")
+}
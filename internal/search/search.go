@@ -0,0 +1,60 @@
+package search
+
+import (
+	"context"
+	"errors"
+)
+
+type Kind string
+
+const (
+	KindAnnouncement Kind = "announcement"
+	KindTeam         Kind = "team"
+)
+
+var ErrEmptyQuery = errors.New("search query is empty")
+
+// Document は検索インデックスに登録する文書
+type Document struct {
+	Kind  Kind
+	ID    int
+	Title string
+	Body  string
+}
+
+type Query struct {
+	Text   string
+	Kinds  []Kind
+	Offset int
+	Limit  int
+}
+
+// Result は関連度順に並んだ検索結果の1件
+// Snippet は HTML エスケープ済みで、一致箇所が <mark> で囲まれている
+type Result struct {
+	Kind    Kind
+	ID      int
+	Title   string
+	Score   float64
+	Snippet string
+}
+
+// Searcher はお知らせとチームの全文検索を提供する
+// DB 側でインデックスを管理する実装では Index と Remove は何もしない
+type Searcher interface {
+	Index(ctx context.Context, docs ...Document) error
+	Remove(ctx context.Context, kind Kind, id int) error
+	Search(ctx context.Context, query Query) ([]Result, error)
+}
+
+func (q Query) includes(kind Kind) bool {
+	if len(q.Kinds) == 0 {
+		return true
+	}
+	for _, k := range q.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
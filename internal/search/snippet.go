@@ -0,0 +1,103 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const snippetRadius = 40
+
+type span struct {
+	start, end int
+}
+
+// Highlight は本文から検索語の周辺を切り出し、一致箇所を <mark> で囲んだ HTML 断片を返す
+func Highlight(text string, query string) string {
+	lower := strings.ToLower(text)
+	var spans []span
+	for _, term := range terms(query) {
+		term = strings.ToLower(term)
+		for offset := 0; ; {
+			i := strings.Index(lower[offset:], term)
+			if i < 0 {
+				break
+			}
+			spans = append(spans, span{offset + i, offset + i + len(term)})
+			offset += i + len(term)
+		}
+	}
+	// 大文字小文字変換でバイト長が変わる文字を含む場合は位置がずれるので強調しない
+	if len(lower) != len(text) {
+		spans = nil
+	}
+	spans = mergeSpans(spans)
+
+	start, end := 0, len(text)
+	if len(spans) > 0 {
+		start = backRunes(text, spans[0].start, snippetRadius)
+	}
+	end = forwardRunes(text, start, snippetRadius*3)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	cursor := start
+	for _, s := range spans {
+		if s.start >= end {
+			break
+		}
+		if s.end > end {
+			s.end = end
+		}
+		b.WriteString(html.EscapeString(text[cursor:s.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[s.start:s.end]))
+		b.WriteString("</mark>")
+		cursor = s.end
+	}
+	b.WriteString(html.EscapeString(text[cursor:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+func mergeSpans(spans []span) []span {
+	if len(spans) == 0 {
+		return spans
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	merged := []span{spans[0]}
+	for _, s := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if s.start <= last.end {
+			if s.end > last.end {
+				last.end = s.end
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// backRunes は位置 i から n 文字前のバイト位置を返す
+func backRunes(text string, i int, n int) int {
+	for ; n > 0 && i > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(text[:i])
+		i -= size
+	}
+	return i
+}
+
+// forwardRunes は位置 i から n 文字後のバイト位置を返す
+func forwardRunes(text string, i int, n int) int {
+	for ; n > 0 && i < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return i
+}
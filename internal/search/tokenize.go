@@ -0,0 +1,62 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ngramSize は MySQL の ngram_token_size のデフォルト値に合わせている
+const ngramSize = 2
+
+// tokenize はテキストを検索用のトークンに分割する
+// 英数字は単語単位で小文字化し、日本語・韓国語などの CJK 文字列は MySQL の ngram パーサーと同様に bi-gram に分割する
+func tokenize(text string) []string {
+	var tokens []string
+	var word, cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 0:
+			return
+		case len(cjk) < ngramSize:
+			tokens = append(tokens, string(cjk))
+		default:
+			for i := 0; i+ngramSize <= len(cjk); i++ {
+				tokens = append(tokens, string(cjk[i:i+ngramSize]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
+
+// terms はクエリを空白区切りの検索語に分割する
+func terms(text string) []string {
+	return strings.Fields(text)
+}
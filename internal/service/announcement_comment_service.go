@@ -0,0 +1,164 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"backend_golang/internal/ratelimit"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+// commentRatePolicy は1人がコメントを投稿できる頻度。10分に5件まで続けて投稿でき、2分ごとに1件分補充する
+var commentRatePolicy = ratelimit.Policy{Burst: 5, Period: 10 * time.Minute}
+
+type AnnouncementCommentService interface {
+	PostComment(ctx context.Context, announcementID int, userID string, input smodels.AnnouncementCommentInput) (*smodels.AnnouncementCommentResponse, error)
+	GetComments(ctx context.Context, announcementID int, page int, size int) ([]smodels.AnnouncementCommentResponse, error)
+	DeleteComment(ctx context.Context, announcementID int, commentID int, userID string) error
+}
+
+type announcementCommentService struct {
+	commentRepository      repository.AnnouncementCommentRepository
+	announcementRepository repository.AnnouncementRepository
+	notifier               Notifier
+	// limiter はメンバーごとの投稿数を制限する。数えてから投稿するのと違い、同時に投稿しても上限を超えない
+	limiter ratelimit.Store
+}
+
+func NewAnnouncementCommentService(commentRepository repository.AnnouncementCommentRepository, announcementRepository repository.AnnouncementRepository, notifier Notifier, limiter ratelimit.Store) AnnouncementCommentService {
+	return &announcementCommentService{
+		commentRepository:      commentRepository,
+		announcementRepository: announcementRepository,
+		notifier:               notifier,
+		limiter:                limiter,
+	}
+}
+
+// PostComment は質問または返信を投稿する。チームリーダーの投稿は回答として扱う
+func (a *announcementCommentService) PostComment(ctx context.Context, announcementID int, userID string, input smodels.AnnouncementCommentInput) (*smodels.AnnouncementCommentResponse, error) {
+	announcement, err := a.announcementRepository.GetAnnouncement(ctx, announcementID)
+	if err != nil {
+		return nil, err
+	}
+	// チームが削除されている場合はアーカイブ済みとして扱う
+	team := announcement.Team
+	if team == nil || team.Status == models.TeamStatusArchived {
+		return nil, models.ErrTeamArchived
+	}
+
+	// 質問はチームリーダーに、返信は質問の投稿者に通知する
+	recipientID := team.CreatedBy
+	if input.ParentID != nil {
+		parent, err := a.commentRepository.FindByID(ctx, announcementID, *input.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ParentID != nil {
+			return nil, models.ErrReplyTooDeep
+		}
+		if parent.IsDeleted() {
+			return nil, models.ErrCommentDeleted
+		}
+		recipientID = parent.AuthorID
+	}
+
+	// 投稿できないリクエストでは数えないよう、検証の後にトークンを取り出す
+	limited, err := a.limiter.Take(ctx, "announcement-comment "+userID, commentRatePolicy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !limited.Allowed {
+		return nil, fmt.Errorf("%w: at most %d comments per %s, retry after %s", models.ErrRateLimited, commentRatePolicy.Burst, commentRatePolicy.Period, limited.RetryAfter.Round(time.Second))
+	}
+
+	created, err := a.commentRepository.Create(ctx, &domain.AnnouncementComment{
+		AnnouncementID: announcementID,
+		ParentID:       input.ParentID,
+		Body:           input.Body,
+		AuthorID:       userID,
+		LeaderAnswer:   team.CreatedBy == userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	teamID := team.ID
+	a.notifier.Notify(ctx, domain.Notification{
+		RecipientID: recipientID,
+		Kind:        models.NotificationNewComment,
+		TeamID:      &teamID,
+		ActorID:     userID,
+		Subject:     announcement.Title,
+		Link:        fmt.Sprintf("/v1/announcements/%d/comments", announcementID),
+	})
+	response := toAnnouncementCommentResponse(*created)
+	return &response, nil
+}
+
+// GetComments は質問を投稿順に返信と一緒に返す。誰でも閲覧できる
+func (a *announcementCommentService) GetComments(ctx context.Context, announcementID int, page int, size int) ([]smodels.AnnouncementCommentResponse, error) {
+	if _, err := a.announcementRepository.GetAnnouncement(ctx, announcementID); err != nil {
+		return nil, err
+	}
+
+	questions, err := a.commentRepository.FindQuestions(ctx, announcementID, page, size)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]smodels.AnnouncementCommentResponse, len(questions))
+	for i, question := range questions {
+		result[i] = toAnnouncementCommentResponse(question)
+	}
+	return result, nil
+}
+
+// DeleteComment は投稿者とチームリーダーが削除できる
+func (a *announcementCommentService) DeleteComment(ctx context.Context, announcementID int, commentID int, userID string) error {
+	announcement, err := a.announcementRepository.GetAnnouncement(ctx, announcementID)
+	if err != nil {
+		return err
+	}
+	comment, err := a.commentRepository.FindByID(ctx, announcementID, commentID)
+	if err != nil {
+		return err
+	}
+	// チームが削除されている場合は投稿者のみ削除できる
+	allowed := comment.AuthorID == userID
+	if announcement.Team != nil {
+		allowed = announcement.Team.CanModerate(userID, comment.AuthorID)
+	}
+	if !allowed {
+		return models.ErrNotAuthor
+	}
+	return a.commentRepository.SoftDelete(ctx, announcementID, commentID, time.Now())
+}
+
+// toAnnouncementCommentResponse は削除したコメントの本文と投稿者を隠して返す
+func toAnnouncementCommentResponse(comment domain.AnnouncementComment) smodels.AnnouncementCommentResponse {
+	result := smodels.AnnouncementCommentResponse{
+		ID:           comment.ID,
+		ParentID:     comment.ParentID,
+		LeaderAnswer: comment.LeaderAnswer,
+		Deleted:      comment.IsDeleted(),
+		CreatedAt:    comment.CreatedAt,
+	}
+	if !comment.IsDeleted() {
+		result.Body = comment.Body
+		result.Author = &smodels.AuthorResponse{
+			ID:       comment.AuthorID,
+			Nickname: comment.AuthorNickname,
+		}
+	}
+	if comment.ParentID == nil {
+		answered := comment.IsAnswered()
+		result.Answered = &answered
+		result.Replies = make([]smodels.AnnouncementCommentResponse, len(comment.Replies))
+		for i, reply := range comment.Replies {
+			result.Replies[i] = toAnnouncementCommentResponse(reply)
+		}
+	}
+	return result
+}
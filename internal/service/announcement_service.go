@@ -2,29 +2,41 @@ package service
 
 import (
 	"backend_golang/ent"
+	"backend_golang/internal/domain"
 	"backend_golang/internal/models"
 	"backend_golang/internal/repository"
+	"backend_golang/internal/search"
 	imodels "backend_golang/internal/service/models"
 	"context"
 	"errors"
+	"math"
 	"time"
 )
 
 type AnnouncementService interface {
 	Announce(ctx context.Context, model imodels.RegisterAnnouncement) (int, error)
-	GetAnnouncement(ctx context.Context, announcementID int) (*imodels.AnnouncementResponse, error)
-	GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []string, keyword string) ([]imodels.AnnouncementResponse, error)
+	GetAnnouncement(ctx context.Context, announcementID int, viewerID string) (*imodels.AnnouncementResponse, error)
+	GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []models.Role, keyword string) ([]imodels.AnnouncementSummaryResponse, error)
 }
 
 type announcementService struct {
 	announcementRepository repository.AnnouncementRepository
 	teamRepository         repository.TeamRepository
+	skillRepository        repository.SkillRepository
+	visibility             MemberVisibility
+	searcher               search.Searcher
 }
 
-func NewAnnouncementService(announcementRepository repository.AnnouncementRepository, teamRepository repository.TeamRepository) AnnouncementService {
+// keywordBatchSize はキーワード検索で一度に検索インデックスから取り出すお知らせの数
+const keywordBatchSize = 100
+
+func NewAnnouncementService(announcementRepository repository.AnnouncementRepository, teamRepository repository.TeamRepository, skillRepository repository.SkillRepository, visibility MemberVisibility, searcher search.Searcher) AnnouncementService {
 	return &announcementService{
 		announcementRepository: announcementRepository,
 		teamRepository:         teamRepository,
+		skillRepository:        skillRepository,
+		visibility:             visibility,
+		searcher:               searcher,
 	}
 }
 
@@ -35,7 +47,7 @@ func (a *announcementService) Announce(ctx context.Context, model imodels.Regist
 		return 0, err
 	}
 	if team.CreatedBy != model.MemberID {
-		return 0, errors.New("you are not the team leader")
+		return 0, models.ErrNotTeamLeader
 	}
 
 	// 最新のアナウンスを取得
@@ -57,16 +69,8 @@ func (a *announcementService) Announce(ctx context.Context, model imodels.Regist
 	return announcement.ID, nil
 }
 
-func (a *announcementService) GetAnnouncement(ctx context.Context, announcementID int) (*imodels.AnnouncementResponse, error) {
+func (a *announcementService) GetAnnouncement(ctx context.Context, announcementID int, viewerID string) (*imodels.AnnouncementResponse, error) {
 	announcement, err := a.announcementRepository.GetAnnouncement(ctx, announcementID)
-
-	var vacancies []models.Vacancy
-	for _, position := range announcement.Team.Positions {
-		vacancies = append(vacancies, models.Vacancy{
-			Role:    position.Role,
-			Vacancy: position.Vacancy,
-		})
-	}
 	if err != nil {
 		return nil, err
 	}
@@ -77,52 +81,103 @@ func (a *announcementService) GetAnnouncement(ctx context.Context, announcementI
 		Content:   announcement.Content,
 		CreatedAt: announcement.CreatedAt,
 		UpdatedAt: announcement.UpdatedAt,
-		Team: &imodels.TeamResponse{
-			ID:          announcement.Team.ID,
-			Name:        announcement.Team.Name,
-			Description: announcement.Team.Description,
-			Headcount:   announcement.Team.Headcount,
-			CreatedBy:   announcement.Team.CreatedBy,
-			Members:     announcement.Team.Members,
-			Vacancies:   vacancies,
-			Skills:      announcement.Team.Skills,
-		},
+		Team:      a.visibility.Team(viewerID, announcement.Team),
 	}, nil
 }
 
-func (a *announcementService) GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []string, keyword string) ([]imodels.AnnouncementResponse, error) {
-	announcements, err := a.announcementRepository.GetAnnouncements(ctx, page, size, skills, positions, keyword)
+func (a *announcementService) GetAnnouncements(ctx context.Context, page int, size int, skills []string, positions []models.Role, keyword string) ([]imodels.AnnouncementSummaryResponse, error) {
+	// 表記ゆれを吸収するためスキル名を正規名に変換してから絞り込む
+	if len(skills) > 0 {
+		resolved, err := a.skillRepository.Resolve(ctx, skills)
+		if err != nil {
+			return nil, err
+		}
+		skills = resolved
+	}
+
+	var announcements []domain.AnnouncementSummary
+	var err error
+	if keyword != "" {
+		announcements, err = a.searchAnnouncements(ctx, page, size, skills, positions, keyword)
+	} else {
+		announcements, err = a.announcementRepository.GetAnnouncements(ctx, page, size, skills, positions, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO 변환
-	var result []imodels.AnnouncementResponse
+	result := make([]imodels.AnnouncementSummaryResponse, 0, len(announcements))
 	for _, announcement := range announcements {
-		var vacancies []models.Vacancy
-		for _, position := range announcement.Team.Positions {
-			vacancies = append(vacancies, models.Vacancy{
-				Role:    position.Role,
-				Vacancy: position.Vacancy,
-			})
-		}
-		result = append(result, imodels.AnnouncementResponse{
+		response := imodels.AnnouncementSummaryResponse{
 			ID:        announcement.ID,
 			Title:     announcement.Title,
 			Content:   announcement.Content,
 			CreatedAt: announcement.CreatedAt,
 			UpdatedAt: announcement.UpdatedAt,
-			Team: &imodels.TeamResponse{
+		}
+		if announcement.Team != nil {
+			openRoles := make([]models.Vacancy, len(announcement.Team.OpenPositions))
+			for i, position := range announcement.Team.OpenPositions {
+				openRoles[i] = models.NewVacancy(position.Role, position.Vacancy)
+			}
+			response.Team = &imodels.TeamSummaryResponse{
 				ID:          announcement.Team.ID,
 				Name:        announcement.Team.Name,
-				Description: announcement.Team.Description,
-				Headcount:   announcement.Team.Headcount,
-				CreatedBy:   announcement.Team.CreatedBy,
-				Members:     announcement.Team.Members,
-				Vacancies:   vacancies,
-				Skills:      announcement.Team.Skills,
-			},
-		})
+				Status:      announcement.Team.Status,
+				OpenRoles:   openRoles,
+				Skills:      announcement.Team.SkillNames,
+				MemberCount: announcement.Team.MemberCount,
+			}
+		}
+		result = append(result, response)
 	}
 	return result, nil
 }
+
+// searchAnnouncements はキーワードに一致するお知らせを関連度順に返す。
+// スキル・ポジションの絞り込みで除かれる分があるため、ページが埋まるまで検索結果を keywordBatchSize ずつ読む
+func (a *announcementService) searchAnnouncements(ctx context.Context, page int, size int, skills []string, positions []models.Role, keyword string) ([]domain.AnnouncementSummary, error) {
+	offset := int(math.Max(float64(page-1), 0)) * size
+	var matched []domain.AnnouncementSummary
+	for start := 0; len(matched) < offset+size; start += keywordBatchSize {
+		results, err := a.searcher.Search(ctx, search.Query{
+			Text:   keyword,
+			Kinds:  []search.Kind{search.KindAnnouncement},
+			Offset: start,
+			Limit:  keywordBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 {
+			break
+		}
+
+		ids := make([]int, len(results))
+		for i, result := range results {
+			ids[i] = result.ID
+		}
+		found, err := a.announcementRepository.GetAnnouncements(ctx, 1, len(ids), skills, positions, ids)
+		if err != nil {
+			return nil, err
+		}
+		// リポジトリは作成日時の順に返すので、検索結果の順に並べ直す
+		byID := make(map[int]domain.AnnouncementSummary, len(found))
+		for _, announcement := range found {
+			byID[announcement.ID] = announcement
+		}
+		for _, id := range ids {
+			if announcement, ok := byID[id]; ok {
+				matched = append(matched, announcement)
+			}
+		}
+		if len(results) < keywordBatchSize {
+			break
+		}
+	}
+
+	if offset >= len(matched) {
+		return nil, nil
+	}
+	return matched[offset:min(offset+size, len(matched))], nil
+}
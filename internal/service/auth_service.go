@@ -4,10 +4,11 @@ import (
 	config "backend_golang/configs"
 	"backend_golang/ent"
 	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	imodels "backend_golang/internal/models"
 	"backend_golang/internal/repository"
 	"backend_golang/internal/service/models"
 	"context"
-	"log"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -18,6 +19,7 @@ type AuthService interface {
 	GoogleCallback(c context.Context, code string) (string, error)
 	Signup(c context.Context, userID string, signup models.SignupMember) (string, error)
 	GetMember(c context.Context, userID string) (*models.UserResponse, error)
+	UpdateRoles(c context.Context, userID string, roles []imodels.Role) (*models.UserResponse, error)
 }
 
 type authService struct {
@@ -49,7 +51,7 @@ func (a *authService) GoogleCallback(c context.Context, code string) (string, er
 	}
 
 	if foundMember, err := a.authRepository.GetMemberByID(c, member.ID); foundMember != nil && err == nil {
-		return a.generateAccessToken(foundMember.ID)
+		return a.generateAccessToken(c, foundMember.ID)
 	}
 
 	if _, err := a.authRepository.GetTransientMemberByID(c, member.ID); err != nil {
@@ -66,17 +68,17 @@ func (a *authService) GoogleCallback(c context.Context, code string) (string, er
 		}
 	}
 
-	return a.generateAccessToken(member.ID)
+	return a.generateAccessToken(c, member.ID)
 }
 
-func (*authService) generateAccessToken(id string) (string, error) {
+func (*authService) generateAccessToken(c context.Context, id string) (string, error) {
 	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"iss": "team-recruitment",
 		"sub": id,
 		"exp": time.Now().Add(time.Minute * 30).Unix(),
 	}).SignedString(config.JWTConfig.GetSecretKey())
 	if err != nil {
-		log.Printf("error creating access token: %v", err)
+		logging.FromContext(c).Error("error creating access token", "error", err)
 		return "", err
 	}
 
@@ -92,7 +94,8 @@ func (a *authService) Signup(c context.Context, userID string, signup models.Sig
 	member, err := a.authRepository.CreateMember(c, &domain.Member{
 		ID:            userID,
 		Bio:           signup.Bio,
-		PreferredRole: string(signup.PreferredRole),
+		PreferredRole: signup.PreferredRole,
+		Roles:         signup.Roles,
 	})
 	if err != nil {
 		return "", err
@@ -126,6 +129,18 @@ func (a *authService) GetMember(c context.Context, userID string) (*models.UserR
 		return nil, err
 	}
 
+	return toUserResponse(member), nil
+}
+
+func (a *authService) UpdateRoles(c context.Context, userID string, roles []imodels.Role) (*models.UserResponse, error) {
+	member, err := a.authRepository.UpdateMemberRoles(c, userID, roles)
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(member), nil
+}
+
+func toUserResponse(member *domain.Member) *models.UserResponse {
 	return &models.UserResponse{
 		ID:            member.ID,
 		Email:         member.Email,
@@ -133,6 +148,7 @@ func (a *authService) GetMember(c context.Context, userID string) (*models.UserR
 		Picture:       member.Picture,
 		Bio:           member.Bio,
 		PreferredRole: member.PreferredRole,
+		Roles:         member.Roles,
 		Transient:     false,
-	}, nil
+	}
 }
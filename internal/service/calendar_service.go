@@ -0,0 +1,197 @@
+package service
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/ical"
+	imodels "backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	calendarProdID = "-//team-recruitment//events//JA"
+	// calendarUIDDomain は UID の @ 以降。イベントIDと組み合わせて変わらない UID にする
+	calendarUIDDomain = "team-recruitment"
+	// calendarFeedHistory は終了したイベントをフィードに残す期間
+	calendarFeedHistory = 30 * 24 * time.Hour
+)
+
+type CalendarService interface {
+	GetFeeds(ctx context.Context, userID string) (*smodels.CalendarFeedResponse, error)
+	RotateToken(ctx context.Context, userID string) (*smodels.CalendarFeedResponse, error)
+	GetMemberFeed(ctx context.Context, token string) (*ical.Calendar, error)
+	GetTeamFeed(ctx context.Context, teamID int, token string) (*ical.Calendar, error)
+}
+
+type calendarService struct {
+	eventRepository repository.EventRepository
+	teamRepository  repository.TeamRepository
+	authRepository  repository.AuthRepository
+	// baseURL はフィードの URL の先頭部分。リクエストの Host ヘッダーは信用せず、設定した公開 URL を使う
+	baseURL string
+}
+
+func NewCalendarService(eventRepository repository.EventRepository, teamRepository repository.TeamRepository, authRepository repository.AuthRepository, baseURL string) CalendarService {
+	return &calendarService{
+		eventRepository: eventRepository,
+		teamRepository:  teamRepository,
+		authRepository:  authRepository,
+		baseURL:         baseURL,
+	}
+}
+
+// GetFeeds はフィードの URL を返す。トークンが未発行の場合は発行する
+func (c *calendarService) GetFeeds(ctx context.Context, userID string) (*smodels.CalendarFeedResponse, error) {
+	member, err := c.authRepository.GetMemberByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.CalendarToken != "" {
+		return c.toCalendarFeedResponse(member, member.CalendarToken), nil
+	}
+	return c.RotateToken(ctx, userID)
+}
+
+// RotateToken はトークンを再発行する。以前のトークンを使ったフィードは読めなくなる
+func (c *calendarService) RotateToken(ctx context.Context, userID string) (*smodels.CalendarFeedResponse, error) {
+	member, err := c.authRepository.GetMemberByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authRepository.SetCalendarToken(ctx, userID, token); err != nil {
+		return nil, err
+	}
+	return c.toCalendarFeedResponse(member, token), nil
+}
+
+// GetMemberFeed は所属チームのイベントのうち、不参加と回答していないものを返す
+func (c *calendarService) GetMemberFeed(ctx context.Context, token string) (*ical.Calendar, error) {
+	member, err := c.memberByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	events, err := c.eventRepository.FindFeedByMember(ctx, member.ID, now.Add(-calendarFeedHistory))
+	if err != nil {
+		return nil, err
+	}
+	return toCalendar(member.Nickname, events, now), nil
+}
+
+func (c *calendarService) GetTeamFeed(ctx context.Context, teamID int, token string) (*ical.Calendar, error) {
+	member, err := c.memberByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	team, err := findJoinedTeam(ctx, c.teamRepository, teamID, member.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	events, err := c.eventRepository.FindFeedByTeam(ctx, teamID, now.Add(-calendarFeedHistory))
+	if err != nil {
+		return nil, err
+	}
+	return toCalendar(team.Name, events, now), nil
+}
+
+// memberByToken はトークンのメンバーを返す。無効なトークンはトークンがない場合と同じく認証エラーにする
+func (c *calendarService) memberByToken(ctx context.Context, token string) (*domain.Member, error) {
+	member, err := c.authRepository.GetMemberByCalendarToken(ctx, token)
+	if ent.IsNotFound(err) {
+		return nil, imodels.ErrInvalidCalendarToken
+	}
+	return member, err
+}
+
+func (c *calendarService) toCalendarFeedResponse(member *domain.Member, token string) *smodels.CalendarFeedResponse {
+	query := "?token=" + url.QueryEscape(token)
+	result := &smodels.CalendarFeedResponse{
+		Token:     token,
+		FeedURL:   c.baseURL + "/v1/me/calendar.ics" + query,
+		TeamFeeds: []smodels.CalendarTeamFeed{},
+	}
+	if member.TeamID != 0 {
+		result.TeamFeeds = append(result.TeamFeeds, smodels.CalendarTeamFeed{
+			TeamID:  member.TeamID,
+			FeedURL: fmt.Sprintf("%s/v1/teams/%d/calendar.ics%s", c.baseURL, member.TeamID, query),
+		})
+	}
+	return result
+}
+
+func toCalendar(name string, events []domain.Event, now time.Time) *ical.Calendar {
+	cal := &ical.Calendar{
+		ProdID: calendarProdID,
+		Name:   name,
+	}
+	for _, event := range events {
+		cal.Events = append(cal.Events, toCalendarEvents(event, now)...)
+	}
+	return cal
+}
+
+// toCalendarEvents は繰り返しイベントを RRULE を持つ VEVENT と、個別に変更した回の VEVENT に変換する
+// 取り消した回は EXDATE で除く
+func toCalendarEvents(event domain.Event, now time.Time) []ical.Event {
+	master := toCalendarEvent(event, now)
+	if !event.IsRecurring() {
+		return []ical.Event{master}
+	}
+
+	master.RRule = event.Recurrence
+	result := []ical.Event{master}
+	if event.IsCancelled() {
+		return result
+	}
+	for _, override := range event.Overrides {
+		if override.Cancelled {
+			result[0].ExDates = append(result[0].ExDates, override.OriginalStartsAt)
+			continue
+		}
+		occurrence, ok := event.Occurrence(override.OriginalStartsAt)
+		if !ok {
+			continue
+		}
+		changed := toCalendarEvent(occurrence, now)
+		changed.RecurrenceID = &override.OriginalStartsAt
+		result = append(result, changed)
+	}
+	return result
+}
+
+// toCalendarEvent はイベントを VEVENT に変換する
+// 変更・取り消しは同じ UID で SEQUENCE を上げて配信し、カレンダーアプリに上書きさせる
+func toCalendarEvent(event domain.Event, now time.Time) ical.Event {
+	result := ical.Event{
+		UID:          fmt.Sprintf("event-%d@%s", event.ID, calendarUIDDomain),
+		Sequence:     event.Sequence,
+		Stamp:        now,
+		LastModified: event.UpdatedAt,
+		Start:        event.StartsAt,
+		End:          event.EndsAt,
+		Summary:      event.Title,
+		Description:  event.Agenda,
+		Location:     event.Location,
+		URL:          event.OnlineURL,
+		Status:       ical.StatusConfirmed,
+	}
+	if result.Location == "" {
+		result.Location = event.OnlineURL
+	}
+	if event.IsCancelled() {
+		result.Status = ical.StatusCancelled
+	}
+	return result
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"backend_golang/ent"
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"backend_golang/internal/outbox"
+	"backend_golang/internal/repository"
+	"backend_golang/internal/search"
+	"backend_golang/internal/webhook"
+	"context"
+	"fmt"
+)
+
+// NotificationEventTypes は NewNotificationSubscriber が通知を作るドメインイベントの種類
+var NotificationEventTypes = []models.DomainEventType{
+	models.DomainEventMemberJoined,
+	models.DomainEventMemberLeft,
+	models.DomainEventWaitlistJoined,
+	models.DomainEventWaitlistOffered,
+}
+
+// SearchEventTypes は NewSearchSubscriber が検索インデックスに反映するドメインイベントの種類
+var SearchEventTypes = []models.DomainEventType{
+	models.DomainEventTeamCreated,
+	models.DomainEventTeamDeleted,
+	models.DomainEventAnnouncementCreated,
+}
+
+// NewNotificationSubscriber はチームへの参加・脱退と待機リストの変化をメンバーに通知する
+// 通知はイベントの ID で重複を確認するため、同じイベントを再配信しても二重に通知しない
+func NewNotificationSubscriber(teamRepository repository.TeamRepository, notifications NotificationService) outbox.Handler {
+	return func(ctx context.Context, event domain.DomainEvent) error {
+		team, err := teamRepository.FindByID(ctx, event.TeamID)
+		if err != nil {
+			// 配信までにチームが削除された場合は通知しない
+			return ignoreNotFound(err)
+		}
+
+		var notification domain.Notification
+		switch event.Type {
+		case models.DomainEventMemberJoined:
+			notification = teamLeaderNotification(models.NotificationMemberJoined, *team, event.MemberID)
+		case models.DomainEventMemberLeft:
+			notification = teamLeaderNotification(models.NotificationMemberLeft, *team, event.MemberID)
+		case models.DomainEventWaitlistJoined:
+			notification = teamLeaderNotification(models.NotificationApplicationReceived, *team, event.MemberID)
+		case models.DomainEventWaitlistOffered:
+			notification = domain.Notification{
+				RecipientID: event.MemberID,
+				Kind:        models.NotificationApplicationAccepted,
+				TeamID:      &team.ID,
+				Subject:     team.Name,
+				Link:        "/v1/me/waitlist",
+			}
+		default:
+			return nil
+		}
+		notification.DedupeKey = fmt.Sprintf("outbox:%d", event.ID)
+		return notifications.Deliver(ctx, notification)
+	}
+}
+
+// NewSearchSubscriber はチームと募集記事の作成・削除を検索インデックスに反映する
+// インデックスへの登録は同じ文書の置き換えなので、再配信しても結果は変わらない
+func NewSearchSubscriber(teamRepository repository.TeamRepository, announcementRepository repository.AnnouncementRepository, searcher search.Searcher) outbox.Handler {
+	return func(ctx context.Context, event domain.DomainEvent) error {
+		switch event.Type {
+		case models.DomainEventTeamCreated:
+			team, err := teamRepository.FindByID(ctx, event.AggregateID)
+			if err != nil {
+				return ignoreNotFound(err)
+			}
+			return searcher.Index(ctx, search.Document{
+				Kind:  search.KindTeam,
+				ID:    team.ID,
+				Title: team.Name,
+				Body:  team.Description,
+			})
+		case models.DomainEventTeamDeleted:
+			return searcher.Remove(ctx, search.KindTeam, event.AggregateID)
+		case models.DomainEventAnnouncementCreated:
+			announcement, err := announcementRepository.GetAnnouncement(ctx, event.AggregateID)
+			if err != nil {
+				return ignoreNotFound(err)
+			}
+			return searcher.Index(ctx, search.Document{
+				Kind:  search.KindAnnouncement,
+				ID:    announcement.ID,
+				Title: announcement.Title,
+				Body:  announcement.Content,
+			})
+		}
+		return nil
+	}
+}
+
+// NewWebhookSubscriber はチームのイベントを Webhook の送信待ちに登録する。送信は WebhookService.DeliverPending が行う
+// 送信は Webhook とイベントの組で一意なので、再配信しても二重に送らない
+func NewWebhookSubscriber(webhookRepository repository.WebhookRepository, teamRepository repository.TeamRepository, announcementRepository repository.AnnouncementRepository, authRepository repository.AuthRepository, baseURL string) outbox.Handler {
+	return func(ctx context.Context, event domain.DomainEvent) error {
+		webhooks, err := webhookRepository.FindByTeam(ctx, event.TeamID)
+		if err != nil {
+			return err
+		}
+		var targets []domain.Webhook
+		for _, hook := range webhooks {
+			if hook.Accepts(event.Type) {
+				targets = append(targets, hook)
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+
+		team, err := teamRepository.FindByID(ctx, event.TeamID)
+		if err != nil {
+			return ignoreNotFound(err)
+		}
+		message := webhook.Message{
+			ID:         event.ID,
+			Type:       event.Type,
+			OccurredAt: event.OccurredAt,
+			Team:       webhook.Team{ID: team.ID, Name: team.Name},
+		}
+		if event.MemberID != "" {
+			message.Member = &webhook.Member{ID: event.MemberID, Nickname: event.MemberID}
+			member, err := authRepository.GetMemberByID(ctx, event.MemberID)
+			if err != nil && !ent.IsNotFound(err) {
+				return err
+			}
+			if member != nil {
+				message.Member.Nickname = member.Nickname
+			}
+		}
+
+		switch event.Type {
+		case models.DomainEventAnnouncementCreated:
+			announcement, err := announcementRepository.GetAnnouncement(ctx, event.AggregateID)
+			if err != nil {
+				return ignoreNotFound(err)
+			}
+			message.Announcement = &webhook.Announcement{
+				ID:    announcement.ID,
+				Title: announcement.Title,
+				URL:   fmt.Sprintf("%s/v1/announcements/%d", baseURL, announcement.ID),
+			}
+			message.Text = fmt.Sprintf("[%s] 募集記事「%s」を公開しました %s", team.Name, announcement.Title, message.Announcement.URL)
+		case models.DomainEventMemberJoined:
+			message.Text = fmt.Sprintf("[%s] %s さんがチームに参加しました", team.Name, message.Member.Nickname)
+		case models.DomainEventMemberLeft:
+			message.Text = fmt.Sprintf("[%s] %s さんがチームから脱退しました", team.Name, message.Member.Nickname)
+		case models.DomainEventWaitlistJoined:
+			message.Text = fmt.Sprintf("[%s] %s さんが待機リストに並びました", team.Name, message.Member.Nickname)
+		default:
+			return nil
+		}
+
+		for _, hook := range targets {
+			body, err := webhook.Render(hook.Format, message)
+			if err != nil {
+				return err
+			}
+			_, err = webhookRepository.EnqueueDelivery(ctx, &domain.WebhookDelivery{
+				WebhookID: hook.ID,
+				EventID:   event.ID,
+				EventType: event.Type,
+				Body:      string(body),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// ignoreNotFound は配信までに対象が削除された場合のエラーを無視する
+func ignoreNotFound(err error) error {
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
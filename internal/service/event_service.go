@@ -0,0 +1,344 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// maxUpcomingEvents は自分の予定一覧で返すイベントの最大数
+	maxUpcomingEvents = 50
+	// upcomingEventRange は自分の予定一覧で繰り返しイベントを展開する期間
+	upcomingEventRange = 90 * 24 * time.Hour
+	// eventReminderLead は開始のどれだけ前にリマインダーを送るか
+	eventReminderLead = time.Hour
+)
+
+type EventService interface {
+	Create(ctx context.Context, teamID int, userID string, input smodels.EventInput) (*smodels.EventResponse, error)
+	Update(ctx context.Context, eventID int, userID string, input smodels.EventInput) (*smodels.EventResponse, error)
+	Cancel(ctx context.Context, eventID int, userID string) error
+	GetEvent(ctx context.Context, eventID int, userID string) (*smodels.EventResponse, error)
+	GetTeamEvents(ctx context.Context, teamID int, userID string, from time.Time, to time.Time) ([]smodels.EventResponse, error)
+	GetUpcomingEvents(ctx context.Context, userID string) ([]smodels.EventResponse, error)
+	RSVP(ctx context.Context, eventID int, userID string, status models.RSVPStatus) (*smodels.EventResponse, error)
+	UpdateOccurrence(ctx context.Context, eventID int, userID string, original time.Time, input smodels.OccurrenceInput) (*smodels.EventResponse, error)
+	CancelOccurrence(ctx context.Context, eventID int, userID string, original time.Time) error
+	SendReminders(ctx context.Context) error
+}
+
+type eventService struct {
+	eventRepository repository.EventRepository
+	teamRepository  repository.TeamRepository
+	notifier        Notifier
+}
+
+func NewEventService(eventRepository repository.EventRepository, teamRepository repository.TeamRepository, notifier Notifier) EventService {
+	return &eventService{
+		eventRepository: eventRepository,
+		teamRepository:  teamRepository,
+		notifier:        notifier,
+	}
+}
+
+func (e *eventService) Create(ctx context.Context, teamID int, userID string, input smodels.EventInput) (*smodels.EventResponse, error) {
+	team, err := findLedTeam(ctx, e.teamRepository, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Status == models.TeamStatusArchived {
+		return nil, models.ErrTeamArchived
+	}
+
+	event := &domain.Event{
+		TeamID:     teamID,
+		Title:      input.Title,
+		Agenda:     input.Agenda,
+		StartsAt:   input.StartsAt,
+		EndsAt:     input.EndsAt,
+		Location:   input.Location,
+		OnlineURL:  input.OnlineURL,
+		Capacity:   input.Capacity,
+		Recurrence: input.Recurrence,
+		CreatedBy:  userID,
+	}
+	event.RecurrenceEndsAt, err = event.SeriesEnd()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := e.eventRepository.Create(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	response := toEventResponse(*created)
+	return &response, nil
+}
+
+func (e *eventService) Update(ctx context.Context, eventID int, userID string, input smodels.EventInput) (*smodels.EventResponse, error) {
+	event, err := e.eventRepository.FindByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findLedTeam(ctx, e.teamRepository, event.TeamID, userID); err != nil {
+		return nil, err
+	}
+	if event.IsCancelled() {
+		return nil, models.ErrEventCancelled
+	}
+
+	// 既に参加と回答したメンバーより少ない定員にはできない
+	if input.Capacity > 0 && input.Capacity < event.Going {
+		return nil, fmt.Errorf("%w: %d going", models.ErrCapacityBelowGoing, event.Going)
+	}
+
+	updated := &domain.Event{
+		ID:         eventID,
+		Title:      input.Title,
+		Agenda:     input.Agenda,
+		StartsAt:   input.StartsAt,
+		EndsAt:     input.EndsAt,
+		Location:   input.Location,
+		OnlineURL:  input.OnlineURL,
+		Capacity:   input.Capacity,
+		Recurrence: input.Recurrence,
+	}
+	updated.RecurrenceEndsAt, err = updated.SeriesEnd()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.eventRepository.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+	return e.getEvent(ctx, eventID, userID)
+}
+
+// Cancel はイベントを取り消す。取り消したイベントは一覧から外れ、カレンダーフィードには取り消しとして残る
+func (e *eventService) Cancel(ctx context.Context, eventID int, userID string) error {
+	event, err := e.eventRepository.FindByID(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if _, err := findLedTeam(ctx, e.teamRepository, event.TeamID, userID); err != nil {
+		return err
+	}
+	return e.eventRepository.Cancel(ctx, eventID, time.Now())
+}
+
+// GetEvent はチームのメンバーにのみイベントを公開する
+func (e *eventService) GetEvent(ctx context.Context, eventID int, userID string) (*smodels.EventResponse, error) {
+	event, err := e.eventRepository.FindByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findJoinedTeam(ctx, e.teamRepository, event.TeamID, userID); err != nil {
+		return nil, err
+	}
+	response := toEventResponse(*event)
+	return &response, nil
+}
+
+func (e *eventService) GetTeamEvents(ctx context.Context, teamID int, userID string, from time.Time, to time.Time) ([]smodels.EventResponse, error) {
+	if _, err := findJoinedTeam(ctx, e.teamRepository, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	events, err := e.eventRepository.FindByTeam(ctx, teamID, from, to, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventResponses(expandEvents(events, from, to)), nil
+}
+
+func (e *eventService) GetUpcomingEvents(ctx context.Context, userID string) ([]smodels.EventResponse, error) {
+	now := time.Now()
+	events, err := e.eventRepository.FindUpcomingByMember(ctx, userID, now, now.Add(upcomingEventRange))
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := expandEvents(events, now, now.Add(upcomingEventRange))
+	if len(occurrences) > maxUpcomingEvents {
+		occurrences = occurrences[:maxUpcomingEvents]
+	}
+	return toEventResponses(occurrences), nil
+}
+
+func (e *eventService) RSVP(ctx context.Context, eventID int, userID string, status models.RSVPStatus) (*smodels.EventResponse, error) {
+	event, err := e.eventRepository.FindByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findJoinedTeam(ctx, e.teamRepository, event.TeamID, userID); err != nil {
+		return nil, err
+	}
+	if event.IsCancelled() {
+		return nil, models.ErrEventCancelled
+	}
+	if event.HasEnded(time.Now()) {
+		return nil, models.ErrEventEnded
+	}
+
+	if err := e.eventRepository.RSVP(ctx, eventID, userID, status); err != nil {
+		return nil, err
+	}
+	return e.getEvent(ctx, eventID, userID)
+}
+
+// UpdateOccurrence は繰り返しイベントの original に始まる回だけを変更する
+func (e *eventService) UpdateOccurrence(ctx context.Context, eventID int, userID string, original time.Time, input smodels.OccurrenceInput) (*smodels.EventResponse, error) {
+	event, err := e.findLedOccurrence(ctx, eventID, userID, original)
+	if err != nil {
+		return nil, err
+	}
+
+	override := domain.EventOverride{
+		OriginalStartsAt: original,
+		Title:            input.Title,
+		Agenda:           input.Agenda,
+		StartsAt:         input.StartsAt,
+		EndsAt:           input.EndsAt,
+		Location:         input.Location,
+	}
+	// 変更後の開始・終了日時の前後関係を確認する
+	changed := *event
+	changed.Overrides = []domain.EventOverride{override}
+	occurrence, _ := changed.Occurrence(original)
+	if !occurrence.EndsAt.After(occurrence.StartsAt) {
+		return nil, fmt.Errorf("%w: endsAt must be after startsAt", models.ErrInvalidOccurrence)
+	}
+
+	if err := e.eventRepository.SaveOverride(ctx, eventID, override); err != nil {
+		return nil, err
+	}
+	response := toEventResponse(occurrence)
+	return &response, nil
+}
+
+// CancelOccurrence は繰り返しイベントの original に始まる回だけを取り消す
+func (e *eventService) CancelOccurrence(ctx context.Context, eventID int, userID string, original time.Time) error {
+	if _, err := e.findLedOccurrence(ctx, eventID, userID, original); err != nil {
+		return err
+	}
+	return e.eventRepository.SaveOverride(ctx, eventID, domain.EventOverride{
+		OriginalStartsAt: original,
+		Cancelled:        true,
+	})
+}
+
+// findLedOccurrence はチームリーダーが変更できる繰り返しイベントで、original に始まる回があることを確認する
+func (e *eventService) findLedOccurrence(ctx context.Context, eventID int, userID string, original time.Time) (*domain.Event, error) {
+	event, err := e.eventRepository.FindByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findLedTeam(ctx, e.teamRepository, event.TeamID, userID); err != nil {
+		return nil, err
+	}
+	if event.IsCancelled() {
+		return nil, models.ErrEventCancelled
+	}
+	if !event.IsRecurring() {
+		return nil, models.ErrNotRecurring
+	}
+	if _, ok := event.Occurrence(original); !ok {
+		return nil, fmt.Errorf("%w: %s", models.ErrNoSuchOccurrence, original.Format(time.RFC3339))
+	}
+	return event, nil
+}
+
+func (e *eventService) getEvent(ctx context.Context, eventID int, userID string) (*smodels.EventResponse, error) {
+	event, err := e.eventRepository.FindByID(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := toEventResponse(*event)
+	return &response, nil
+}
+
+// expandEvents は繰り返しイベントを from から to までの回に展開し、開始日時順に並べる
+// SendReminders は eventReminderLead 以内に始まる回について、参加または未定と回答したメンバーに通知する
+// 定期的に実行し、同じ回のリマインダーは一度だけ送る
+func (e *eventService) SendReminders(ctx context.Context) error {
+	now := time.Now()
+	events, err := e.eventRepository.FindBetween(ctx, now, now.Add(eventReminderLead))
+	if err != nil {
+		return err
+	}
+
+	for _, event := range expandEvents(events, now, now.Add(eventReminderLead)) {
+		// 既に始まっている回には送らない
+		if event.StartsAt.Before(now) {
+			continue
+		}
+		attendees, err := e.eventRepository.FindAttendees(ctx, event.ID)
+		if err != nil {
+			return err
+		}
+
+		original := event.StartsAt
+		if event.OccurrenceStart != nil {
+			original = *event.OccurrenceStart
+		}
+		notifications := make([]domain.Notification, len(attendees))
+		for i, memberID := range attendees {
+			teamID := event.TeamID
+			notifications[i] = domain.Notification{
+				RecipientID: memberID,
+				Kind:        models.NotificationEventReminder,
+				TeamID:      &teamID,
+				Subject:     event.Title,
+				Link:        fmt.Sprintf("/v1/events/%d", event.ID),
+				DedupeKey:   fmt.Sprintf("event-reminder:%d:%d:%s", event.ID, original.Unix(), memberID),
+			}
+		}
+		e.notifier.Notify(ctx, notifications...)
+	}
+	return nil
+}
+
+func expandEvents(events []domain.Event, from time.Time, to time.Time) []domain.Event {
+	var result []domain.Event
+	for _, event := range events {
+		result = append(result, event.Occurrences(from, to)...)
+	}
+	domain.SortEvents(result)
+	return result
+}
+
+func toEventResponses(events []domain.Event) []smodels.EventResponse {
+	result := make([]smodels.EventResponse, len(events))
+	for i, event := range events {
+		result[i] = toEventResponse(event)
+	}
+	return result
+}
+
+func toEventResponse(event domain.Event) smodels.EventResponse {
+	return smodels.EventResponse{
+		ID:              event.ID,
+		TeamID:          event.TeamID,
+		TeamName:        event.TeamName,
+		Title:           event.Title,
+		Agenda:          event.Agenda,
+		StartsAt:        event.StartsAt,
+		EndsAt:          event.EndsAt,
+		Location:        event.Location,
+		OnlineURL:       event.OnlineURL,
+		Capacity:        event.Capacity,
+		Going:           event.Going,
+		Recurrence:      event.Recurrence,
+		OccurrenceStart: event.OccurrenceStart,
+		MyRSVP:          event.MyRSVP,
+		CancelledAt:     event.CancelledAt,
+		CreatedBy:       event.CreatedBy,
+		CreatedAt:       event.CreatedAt,
+		UpdatedAt:       event.UpdatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"backend_golang/internal/logging"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"sync/atomic"
+)
+
+// 準備状況の確認結果
+const (
+	healthOK          = "ok"
+	healthUnreachable = "unreachable"
+	healthPending     = "pending"
+)
+
+type HealthService interface {
+	// Ready はデータベースに接続でき、マイグレーションが最新かどうかを確認する
+	Ready(ctx context.Context) smodels.ReadinessResponse
+}
+
+type healthService struct {
+	healthRepository repository.HealthRepository
+	// migrated はマイグレーションが最新だと確認できたかどうか
+	// 実行中にスキーマが古くなることはないので、一度確認できた後はスキーマを比べない
+	migrated atomic.Bool
+}
+
+func NewHealthService(healthRepository repository.HealthRepository) HealthService {
+	return &healthService{
+		healthRepository: healthRepository,
+	}
+}
+
+func (h *healthService) Ready(ctx context.Context) smodels.ReadinessResponse {
+	result := smodels.ReadinessResponse{
+		Ready: true,
+		Checks: map[string]string{
+			"database":   healthOK,
+			"migrations": healthOK,
+		},
+	}
+
+	if err := h.healthRepository.Ping(ctx); err != nil {
+		logging.FromContext(ctx).Warn("database is not reachable", "error", err)
+		result.Ready = false
+		result.Checks["database"] = healthUnreachable
+		result.Checks["migrations"] = healthUnreachable
+		return result
+	}
+
+	if !h.migrated.Load() {
+		current, err := h.healthRepository.MigrationsCurrent(ctx)
+		switch {
+		case err != nil:
+			logging.FromContext(ctx).Warn("failed checking migrations", "error", err)
+			result.Ready = false
+			result.Checks["migrations"] = healthUnreachable
+		case !current:
+			result.Ready = false
+			result.Checks["migrations"] = healthPending
+		default:
+			h.migrated.Store(true)
+		}
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+type InvitationService interface {
+	Create(ctx context.Context, teamID int, create smodels.CreateInvitation) (*smodels.InvitationResponse, error)
+	GetTeamInvitations(ctx context.Context, teamID int, userID string) ([]smodels.InvitationResponse, error)
+	Revoke(ctx context.Context, teamID int, invitationID int, userID string) error
+	GetMyInvitations(ctx context.Context, userID string) ([]smodels.InvitationResponse, error)
+	Accept(ctx context.Context, invitationID int, userID string) (int, error)
+	Decline(ctx context.Context, invitationID int, userID string) error
+	Redeem(ctx context.Context, token string, userID string) (int, error)
+}
+
+type invitationService struct {
+	invitationRepository repository.InvitationRepository
+	teamRepository       repository.TeamRepository
+}
+
+func NewInvitationService(invitationRepository repository.InvitationRepository, teamRepository repository.TeamRepository) InvitationService {
+	return &invitationService{
+		invitationRepository: invitationRepository,
+		teamRepository:       teamRepository,
+	}
+}
+
+func (i *invitationService) Create(ctx context.Context, teamID int, create smodels.CreateInvitation) (*smodels.InvitationResponse, error) {
+	team, err := findLedTeam(ctx, i.teamRepository, teamID, create.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Status == models.TeamStatusArchived {
+		return nil, models.ErrTeamArchived
+	}
+
+	// 招待する役割のポジションがチームにあるか確認する
+	var exists bool
+	for _, position := range team.Positions {
+		if position.Role == create.Role {
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w %s", models.ErrNoVacancy, create.Role)
+	}
+
+	invitation := &domain.Invitation{
+		TeamID:    teamID,
+		Role:      create.Role,
+		CreatedBy: create.MemberID,
+	}
+	if create.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(create.ExpiresIn)
+		invitation.ExpiresAt = &expiresAt
+	}
+
+	if create.InviteeID != "" {
+		if _, ok := team.Member(create.InviteeID); ok {
+			return nil, models.ErrAlreadyInTeam
+		}
+		invitation.Kind = models.InvitationDirect
+		invitation.InviteeID = create.InviteeID
+		invitation.MaxUses = 1
+	} else {
+		token, err := generateToken()
+		if err != nil {
+			return nil, err
+		}
+		invitation.Kind = models.InvitationLink
+		invitation.Token = token
+		invitation.MaxUses = create.MaxUses
+	}
+
+	created, err := i.invitationRepository.Create(ctx, invitation)
+	if err != nil {
+		return nil, err
+	}
+	response := toInvitationResponse(*created)
+	return &response, nil
+}
+
+func (i *invitationService) GetTeamInvitations(ctx context.Context, teamID int, userID string) ([]smodels.InvitationResponse, error) {
+	if _, err := findLedTeam(ctx, i.teamRepository, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := i.invitationRepository.FindByTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return toInvitationResponses(invitations), nil
+}
+
+func (i *invitationService) Revoke(ctx context.Context, teamID int, invitationID int, userID string) error {
+	if _, err := findLedTeam(ctx, i.teamRepository, teamID, userID); err != nil {
+		return err
+	}
+	return i.invitationRepository.Revoke(ctx, teamID, invitationID)
+}
+
+func (i *invitationService) GetMyInvitations(ctx context.Context, userID string) ([]smodels.InvitationResponse, error) {
+	invitations, err := i.invitationRepository.FindPendingByInvitee(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return toInvitationResponses(invitations), nil
+}
+
+// Accept は直接招待を承諾する。招待された役割で参加するため申告済みの役割かどうかは問わない
+func (i *invitationService) Accept(ctx context.Context, invitationID int, userID string) (int, error) {
+	return i.invitationRepository.Accept(ctx, invitationID, userID, time.Now())
+}
+
+func (i *invitationService) Decline(ctx context.Context, invitationID int, userID string) error {
+	return i.invitationRepository.Decline(ctx, invitationID, userID)
+}
+
+// Redeem は招待リンクを使ってチームに参加する
+func (i *invitationService) Redeem(ctx context.Context, token string, userID string) (int, error) {
+	return i.invitationRepository.Redeem(ctx, token, userID, time.Now())
+}
+
+func toInvitationResponses(invitations []domain.Invitation) []smodels.InvitationResponse {
+	result := make([]smodels.InvitationResponse, len(invitations))
+	for i, invitation := range invitations {
+		result[i] = toInvitationResponse(invitation)
+	}
+	return result
+}
+
+func toInvitationResponse(invitation domain.Invitation) smodels.InvitationResponse {
+	return smodels.InvitationResponse{
+		ID:        invitation.ID,
+		TeamID:    invitation.TeamID,
+		TeamName:  invitation.TeamName,
+		Kind:      invitation.Kind,
+		Role:      invitation.Role,
+		Status:    invitation.Status,
+		Token:     invitation.Token,
+		MaxUses:   invitation.MaxUses,
+		Uses:      invitation.Uses,
+		ExpiresAt: invitation.ExpiresAt,
+		InviteeID: invitation.InviteeID,
+		CreatedAt: invitation.CreatedAt,
+	}
+}
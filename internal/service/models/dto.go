@@ -1,7 +1,6 @@
 package models
 
 import (
-	"backend_golang/internal/domain"
 	"backend_golang/internal/models"
 	"time"
 )
@@ -25,6 +24,7 @@ type RegisterAnnouncement struct {
 type SignupMember struct {
 	Bio           string
 	PreferredRole models.Role
+	Roles         []models.Role
 }
 
 type LoginResponse struct {
@@ -32,24 +32,58 @@ type LoginResponse struct {
 }
 
 type UserResponse struct {
-	ID            string `json:"id"`
-	Email         string `json:"email"`
-	Nickname      string `json:"nickname"`
-	Picture       string `json:"picture"`
-	Bio           string `json:"bio"`
-	PreferredRole string `json:"preferred_role"`
-	Transient     bool   `json:"transient"`
+	ID            string        `json:"id"`
+	Email         string        `json:"email"`
+	Nickname      string        `json:"nickname"`
+	Picture       string        `json:"picture"`
+	Bio           string        `json:"bio"`
+	PreferredRole models.Role   `json:"preferred_role"`
+	Roles         []models.Role `json:"roles"`
+	Transient     bool          `json:"transient"`
+}
+
+// PublicMemberResponse は誰にでも公開してよいメンバー情報
+type PublicMemberResponse struct {
+	ID            string      `json:"id"`
+	Nickname      string      `json:"nickname"`
+	Picture       string      `json:"picture"`
+	Bio           string      `json:"bio"`
+	PreferredRole models.Role `json:"preferred_role"`
+	// TeamRole はチーム内で担当しているポジション。チームリーダーの場合は含まれない
+	TeamRole models.Role `json:"team_role,omitempty"`
+}
+
+// MemberResponse は閲覧者に応じて非公開フィールドを含むメンバー情報
+// Email は公開ポリシーで許可された場合のみ設定される
+type MemberResponse struct {
+	PublicMemberResponse
+	Email string `json:"email,omitempty"`
+}
+
+type SkillResponse struct {
+	Name string `json:"name"`
 }
 
 type TeamResponse struct {
-	ID          int              `json:"id"`
-	Name        string           `json:"name"`
-	Description string           `json:"description"`
-	Headcount   int8             `json:"headcount"`
-	CreatedBy   string           `json:"created_by"`
-	Members     []domain.Member  `json:"members"`
-	Vacancies   []models.Vacancy `json:"vacancies"`
-	Skills      []domain.Skill   `json:"skills"`
+	ID          int                `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Headcount   int8               `json:"headcount"`
+	CreatedBy   string             `json:"created_by"`
+	Status      models.TeamStatus  `json:"status"`
+	Members     []MemberResponse   `json:"members"`
+	Vacancies   []models.Vacancy   `json:"vacancies"`
+	Positions   []PositionResponse `json:"positions"`
+	Skills      []SkillResponse    `json:"skills"`
+}
+
+// PositionResponse はポジションの充足状況（例: Backend 2/3）
+type PositionResponse struct {
+	Role     models.Role `json:"role"`
+	Capacity int         `json:"capacity"`
+	Filled   int         `json:"filled"`
+	Reserved int         `json:"reserved"`
+	Vacancy  int8        `json:"vacancy"`
 }
 
 type AnnouncementResponse struct {
@@ -60,3 +94,266 @@ type AnnouncementResponse struct {
 	UpdatedAt time.Time     `json:"updated_at"`
 	Team      *TeamResponse `json:"team"`
 }
+
+type TeamSummaryResponse struct {
+	ID          int               `json:"id"`
+	Name        string            `json:"name"`
+	Status      models.TeamStatus `json:"status"`
+	OpenRoles   []models.Vacancy  `json:"open_roles"`
+	Skills      []string          `json:"skills"`
+	MemberCount int               `json:"member_count"`
+}
+
+type AnnouncementSummaryResponse struct {
+	ID        int                  `json:"id"`
+	Title     string               `json:"title"`
+	Content   string               `json:"content"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+	Team      *TeamSummaryResponse `json:"team"`
+}
+
+type SearchResultResponse struct {
+	Type    string  `json:"type"`
+	ID      int     `json:"id"`
+	Title   string  `json:"title"`
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"`
+}
+
+type SkillSuggestionResponse struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
+	UsageCount int    `json:"usage_count"`
+}
+
+type WaitlistEntryResponse struct {
+	ID       int                   `json:"id"`
+	TeamID   int                   `json:"team_id"`
+	TeamName string                `json:"team_name"`
+	Role     models.Role           `json:"role"`
+	Status   models.WaitlistStatus `json:"status"`
+	// Place は待ち順。空きが提示されている場合は含まれない
+	Place          int        `json:"place,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type CreateInvitation struct {
+	MemberID string
+	// InviteeID を指定した場合はメンバーへの直接招待、空の場合は招待リンクを作成する
+	InviteeID string
+	Role      models.Role
+	ExpiresIn time.Duration
+	MaxUses   int
+}
+
+type InvitationResponse struct {
+	ID        int                     `json:"id"`
+	TeamID    int                     `json:"team_id"`
+	TeamName  string                  `json:"team_name"`
+	Kind      models.InvitationKind   `json:"kind"`
+	Role      models.Role             `json:"role"`
+	Status    models.InvitationStatus `json:"status"`
+	Token     string                  `json:"token,omitempty"`
+	MaxUses   int                     `json:"max_uses"`
+	Uses      int                     `json:"uses"`
+	ExpiresAt *time.Time              `json:"expires_at,omitempty"`
+	InviteeID string                  `json:"invitee_id,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+// EventInput はイベントの作成・更新に使う入力
+type EventInput struct {
+	Title     string
+	Agenda    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Location  string
+	OnlineURL string
+	Capacity  int
+	// Recurrence は RRULE。空の場合は繰り返さない
+	Recurrence string
+}
+
+// OccurrenceInput は繰り返しイベントの1回分の変更。nil の項目は繰り返しイベントの値を使う
+type OccurrenceInput struct {
+	Title    *string
+	Agenda   *string
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	Location *string
+}
+
+type EventResponse struct {
+	ID        int       `json:"id"`
+	TeamID    int       `json:"team_id"`
+	TeamName  string    `json:"team_name"`
+	Title     string    `json:"title"`
+	Agenda    string    `json:"agenda"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Location  string    `json:"location"`
+	OnlineURL string    `json:"online_url"`
+	Capacity  int       `json:"capacity"`
+	Going     int       `json:"going"`
+	// Recurrence は RRULE。繰り返さないイベントの場合は含まれない
+	Recurrence string `json:"recurrence,omitempty"`
+	// OccurrenceStart は繰り返しを展開した回の元の開始日時。回ごとの変更・取り消しに使う
+	OccurrenceStart *time.Time `json:"occurrence_start,omitempty"`
+	// MyRSVP は閲覧者の出欠回答。未回答の場合は含まれない
+	MyRSVP models.RSVPStatus `json:"my_rsvp,omitempty"`
+	// CancelledAt は取り消した日時。取り消していない場合は含まれない
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	CreatedBy   string     `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// CalendarFeedResponse は .ics フィードの購読に使う URL。チームのフィードも同じトークンで認証する
+type CalendarFeedResponse struct {
+	Token     string             `json:"token"`
+	FeedURL   string             `json:"feed_url"`
+	TeamFeeds []CalendarTeamFeed `json:"team_feeds"`
+}
+
+// CalendarTeamFeed は所属チームのフィードの URL
+type CalendarTeamFeed struct {
+	TeamID  int    `json:"team_id"`
+	FeedURL string `json:"feed_url"`
+}
+
+// ThreadInput はスレッドの作成・変更に使う入力。Body は Markdown
+type ThreadInput struct {
+	Title string
+	Body  string
+}
+
+type AuthorResponse struct {
+	ID       string `json:"id"`
+	Nickname string `json:"nickname"`
+}
+
+type ThreadResponse struct {
+	ID     int            `json:"id"`
+	TeamID int            `json:"team_id"`
+	Title  string         `json:"title"`
+	Body   string         `json:"body"`
+	Author AuthorResponse `json:"author"`
+	// Mentions は本文でメンションされたメンバーの ID
+	Mentions       []string   `json:"mentions"`
+	CommentCount   int        `json:"comment_count"`
+	LastActivityAt time.Time  `json:"last_activity_at"`
+	EditedAt       *time.Time `json:"edited_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type ThreadCommentResponse struct {
+	ID       int            `json:"id"`
+	ThreadID int            `json:"thread_id"`
+	Body     string         `json:"body"`
+	Author   AuthorResponse `json:"author"`
+	// Mentions は本文でメンションされたメンバーの ID
+	Mentions  []string   `json:"mentions"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// AnnouncementCommentInput は募集記事へのコメントの入力。ParentID を指定した場合は返信になる
+type AnnouncementCommentInput struct {
+	Body     string
+	ParentID *int
+}
+
+type AnnouncementCommentResponse struct {
+	ID       int  `json:"id"`
+	ParentID *int `json:"parent_id,omitempty"`
+	// Body と Author は削除したコメントでは含まれない
+	Body   string          `json:"body,omitempty"`
+	Author *AuthorResponse `json:"author,omitempty"`
+	// LeaderAnswer はチームリーダーの投稿かどうか
+	LeaderAnswer bool      `json:"leader_answer"`
+	Deleted      bool      `json:"deleted"`
+	CreatedAt    time.Time `json:"created_at"`
+	// Answered と Replies は質問（最上位のコメント）にのみ含まれる
+	Answered *bool                         `json:"answered,omitempty"`
+	Replies  []AnnouncementCommentResponse `json:"replies,omitempty"`
+}
+
+type NotificationResponse struct {
+	ID     int                     `json:"id"`
+	Kind   models.NotificationKind `json:"kind"`
+	TeamID *int                    `json:"team_id,omitempty"`
+	// ActorID は通知のきっかけになったメンバーの ID
+	ActorID string `json:"actor_id,omitempty"`
+	// Subject は通知時点のチーム名・スレッド名・イベント名など
+	Subject   string    `json:"subject"`
+	Link      string    `json:"link"`
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	Unread        int                    `json:"unread"`
+	Notifications []NotificationResponse `json:"notifications"`
+}
+
+type UnreadCountResponse struct {
+	Unread int `json:"unread"`
+}
+
+type NotificationPreferencesInput struct {
+	Locale models.Locale
+	// Email は通知の種類ごとにメールを受け取るかどうか。含まれない種類は変更しない
+	Email map[models.NotificationKind]bool
+}
+
+type NotificationPreferencesResponse struct {
+	Locale models.Locale                    `json:"locale"`
+	Email  map[models.NotificationKind]bool `json:"email"`
+}
+
+type WebhookInput struct {
+	URL    string
+	Format models.WebhookFormat
+	Events []models.DomainEventType
+	// Active が nil の場合は変更しない
+	Active *bool
+}
+
+type WebhookResponse struct {
+	ID     int                      `json:"id"`
+	TeamID int                      `json:"team_id"`
+	URL    string                   `json:"url"`
+	Format models.WebhookFormat     `json:"format"`
+	Events []models.DomainEventType `json:"events"`
+	// Secret は署名の鍵。登録時のみ含まれる
+	Secret              string     `json:"secret,omitempty"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int                          `json:"id"`
+	EventID        int                          `json:"event_id"`
+	EventType      models.DomainEventType       `json:"event_type"`
+	Status         models.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                          `json:"attempts"`
+	ResponseStatus int                          `json:"response_status,omitempty"`
+	Error          string                       `json:"error,omitempty"`
+	DurationMs     int64                        `json:"duration_ms"`
+	// NextAttemptAt は送信待ちの場合のみ含まれる
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type ReadinessResponse struct {
+	Ready bool `json:"ready"`
+	// Checks は確認項目ごとの結果。ok, unreachable, pending のいずれか
+	Checks map[string]string `json:"checks"`
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/mail"
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	"context"
+	"strings"
+)
+
+// NotificationMailer は通知をメールでも送る。メールは送信待ちに追加するだけで、送信は待たない
+type NotificationMailer interface {
+	Send(ctx context.Context, notification domain.Notification)
+}
+
+type notificationMailer struct {
+	authRepository repository.AuthRepository
+	queue          *mail.Queue
+	templates      *mail.Templates
+	baseURL        string
+}
+
+func NewNotificationMailer(authRepository repository.AuthRepository, queue *mail.Queue, templates *mail.Templates, baseURL string) NotificationMailer {
+	return &notificationMailer{
+		authRepository: authRepository,
+		queue:          queue,
+		templates:      templates,
+		baseURL:        baseURL,
+	}
+}
+
+// notificationMail はメールのテンプレートに渡す値
+type notificationMail struct {
+	Nickname      string
+	ActorNickname string
+	Subject       string
+	URL           string
+}
+
+// Send は受信者がメールを止めていなければ、受信者の言語でメールを作って送信待ちに追加する
+func (n *notificationMailer) Send(ctx context.Context, notification domain.Notification) {
+	if !notification.Kind.SendsEmail() {
+		return
+	}
+	recipient, err := n.authRepository.GetMemberByID(ctx, notification.RecipientID)
+	if err != nil {
+		logging.FromContext(ctx).Error("error finding recipient of notification", "kind", notification.Kind, "notification_id", notification.ID, "error", err)
+		return
+	}
+	if !recipient.WantsEmail(notification.Kind) {
+		return
+	}
+
+	data := notificationMail{
+		Nickname: recipient.Nickname,
+		Subject:  notification.Subject,
+		URL:      n.baseURL + notification.Link,
+	}
+	if notification.ActorID != "" {
+		if actor, err := n.authRepository.GetMemberByID(ctx, notification.ActorID); err == nil {
+			data.ActorNickname = actor.Nickname
+		}
+	}
+
+	locale := recipient.Locale
+	if locale == "" {
+		locale = models.DefaultLocale
+	}
+	subject, body, err := n.templates.Render(strings.ToLower(string(notification.Kind)), string(locale), data)
+	if err != nil {
+		logging.FromContext(ctx).Error("error rendering mail", "kind", notification.Kind, "error", err)
+		return
+	}
+	n.queue.Enqueue(mail.Message{
+		To:      recipient.Email,
+		Subject: subject,
+		Body:    body,
+	})
+}
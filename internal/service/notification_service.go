@@ -0,0 +1,188 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/models"
+	"backend_golang/internal/pubsub"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Notifier はメンバーに通知を送る。通知の失敗で元の操作を失敗させないため、エラーはログに出力するだけにする
+type Notifier interface {
+	Notify(ctx context.Context, notifications ...domain.Notification)
+}
+
+type NotificationService interface {
+	Notifier
+	// Deliver は Notify と同じく通知を送り、失敗した場合はエラーを返す。再試行する呼び出し元で使う
+	Deliver(ctx context.Context, notifications ...domain.Notification) error
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, page int, size int) (*smodels.NotificationListResponse, error)
+	MarkRead(ctx context.Context, userID string, ids []int) (*smodels.UnreadCountResponse, error)
+	CountUnread(ctx context.Context, userID string) (*smodels.UnreadCountResponse, error)
+	GetPreferences(ctx context.Context, userID string) (*smodels.NotificationPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, userID string, input smodels.NotificationPreferencesInput) (*smodels.NotificationPreferencesResponse, error)
+	// Subscribe は userID への新しい通知を受け取るチャネルと、購読をやめる関数を返す
+	Subscribe(userID string) (<-chan smodels.NotificationResponse, func())
+}
+
+type notificationService struct {
+	notificationRepository repository.NotificationRepository
+	authRepository         repository.AuthRepository
+	hub                    *pubsub.Hub[smodels.NotificationResponse]
+	mailer                 NotificationMailer
+}
+
+func NewNotificationService(notificationRepository repository.NotificationRepository, authRepository repository.AuthRepository, hub *pubsub.Hub[smodels.NotificationResponse], mailer NotificationMailer) NotificationService {
+	return &notificationService{
+		notificationRepository: notificationRepository,
+		authRepository:         authRepository,
+		hub:                    hub,
+		mailer:                 mailer,
+	}
+}
+
+// Notify は通知を保存し、接続中のメンバーへの配信とメールの送信を行う。本人の操作による本人への通知は送らない
+func (n *notificationService) Notify(ctx context.Context, notifications ...domain.Notification) {
+	if err := n.Deliver(ctx, notifications...); err != nil {
+		logging.FromContext(ctx).Error("error creating notifications", "error", err)
+	}
+}
+
+func (n *notificationService) Deliver(ctx context.Context, notifications ...domain.Notification) error {
+	var errs []error
+	for _, notification := range notifications {
+		if notification.IsSelfNotification() {
+			continue
+		}
+		created, ok, err := n.notificationRepository.Create(ctx, &notification)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s notification for %s: %w", notification.Kind, notification.RecipientID, err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		n.hub.Publish(created.RecipientID, toNotificationResponse(*created))
+		n.mailer.Send(ctx, *created)
+	}
+	return errors.Join(errs...)
+}
+
+func (n *notificationService) GetNotifications(ctx context.Context, userID string, unreadOnly bool, page int, size int) (*smodels.NotificationListResponse, error) {
+	notifications, err := n.notificationRepository.FindByRecipient(ctx, userID, unreadOnly, page, size)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := n.notificationRepository.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &smodels.NotificationListResponse{
+		Unread:        unread,
+		Notifications: make([]smodels.NotificationResponse, len(notifications)),
+	}
+	for i, notification := range notifications {
+		result.Notifications[i] = toNotificationResponse(notification)
+	}
+	return result, nil
+}
+
+// MarkRead は通知を既読にする。ids が空の場合は全て既読にする
+func (n *notificationService) MarkRead(ctx context.Context, userID string, ids []int) (*smodels.UnreadCountResponse, error) {
+	if _, err := n.notificationRepository.MarkRead(ctx, userID, ids, time.Now()); err != nil {
+		return nil, err
+	}
+	return n.CountUnread(ctx, userID)
+}
+
+func (n *notificationService) CountUnread(ctx context.Context, userID string) (*smodels.UnreadCountResponse, error) {
+	unread, err := n.notificationRepository.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &smodels.UnreadCountResponse{Unread: unread}, nil
+}
+
+func (n *notificationService) GetPreferences(ctx context.Context, userID string) (*smodels.NotificationPreferencesResponse, error) {
+	member, err := n.authRepository.GetMemberByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toNotificationPreferencesResponse(*member), nil
+}
+
+// UpdatePreferences はメールの言語と、種類ごとにメールを受け取るかどうかを変更する。指定しなかった種類は変更しない
+func (n *notificationService) UpdatePreferences(ctx context.Context, userID string, input smodels.NotificationPreferencesInput) (*smodels.NotificationPreferencesResponse, error) {
+	member, err := n.authRepository.GetMemberByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	muted := make([]models.NotificationKind, 0)
+	for _, kind := range models.EmailNotificationKinds() {
+		wants, ok := input.Email[kind]
+		if !ok {
+			wants = member.WantsEmail(kind)
+		}
+		if !wants {
+			muted = append(muted, kind)
+		}
+	}
+
+	updated, err := n.authRepository.UpdateNotificationPreferences(ctx, userID, input.Locale, muted)
+	if err != nil {
+		return nil, err
+	}
+	return toNotificationPreferencesResponse(*updated), nil
+}
+
+func (n *notificationService) Subscribe(userID string) (<-chan smodels.NotificationResponse, func()) {
+	return n.hub.Subscribe(userID)
+}
+
+// teamLeaderNotification はチームリーダーへのチームに関する通知を作る
+func teamLeaderNotification(kind models.NotificationKind, team domain.Team, actorID string) domain.Notification {
+	teamID := team.ID
+	return domain.Notification{
+		RecipientID: team.CreatedBy,
+		Kind:        kind,
+		TeamID:      &teamID,
+		ActorID:     actorID,
+		Subject:     team.Name,
+		Link:        fmt.Sprintf("/v1/teams/%d", team.ID),
+	}
+}
+
+func toNotificationPreferencesResponse(member domain.Member) *smodels.NotificationPreferencesResponse {
+	result := &smodels.NotificationPreferencesResponse{
+		Locale: member.Locale,
+		Email:  make(map[models.NotificationKind]bool),
+	}
+	if result.Locale == "" {
+		result.Locale = models.DefaultLocale
+	}
+	for _, kind := range models.EmailNotificationKinds() {
+		result.Email[kind] = member.WantsEmail(kind)
+	}
+	return result
+}
+
+func toNotificationResponse(notification domain.Notification) smodels.NotificationResponse {
+	return smodels.NotificationResponse{
+		ID:        notification.ID,
+		Kind:      notification.Kind,
+		TeamID:    notification.TeamID,
+		ActorID:   notification.ActorID,
+		Subject:   notification.Subject,
+		Link:      notification.Link,
+		Read:      notification.IsRead(),
+		CreatedAt: notification.CreatedAt,
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"backend_golang/internal/repository"
+	"backend_golang/internal/search"
+	"backend_golang/internal/service/models"
+	"context"
+	"math"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, keyword string, kinds []search.Kind, page int, size int) ([]models.SearchResultResponse, error)
+	Rebuild(ctx context.Context) error
+}
+
+type searchService struct {
+	searcher         search.Searcher
+	searchRepository repository.SearchRepository
+}
+
+func NewSearchService(searcher search.Searcher, searchRepository repository.SearchRepository) SearchService {
+	return &searchService{
+		searcher:         searcher,
+		searchRepository: searchRepository,
+	}
+}
+
+func (s *searchService) Search(ctx context.Context, keyword string, kinds []search.Kind, page int, size int) ([]models.SearchResultResponse, error) {
+	results, err := s.searcher.Search(ctx, search.Query{
+		Text:   keyword,
+		Kinds:  kinds,
+		Offset: int(math.Max(float64(page-1), 0)) * size,
+		Limit:  size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]models.SearchResultResponse, len(results))
+	for i, result := range results {
+		response[i] = models.SearchResultResponse{
+			Type:    string(result.Kind),
+			ID:      result.ID,
+			Title:   result.Title,
+			Score:   result.Score,
+			Snippet: result.Snippet,
+		}
+	}
+	return response, nil
+}
+
+// Rebuild は DB の内容から検索インデックスを作り直す
+func (s *searchService) Rebuild(ctx context.Context) error {
+	docs, err := s.searchRepository.Documents(ctx)
+	if err != nil {
+		return err
+	}
+	return s.searcher.Index(ctx, docs...)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+)
+
+type SkillService interface {
+	Seed(ctx context.Context) error
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]smodels.SkillSuggestionResponse, error)
+	Merge(ctx context.Context, sourceID int, targetID int) (*smodels.SkillSuggestionResponse, error)
+}
+
+type skillService struct {
+	skillRepository repository.SkillRepository
+}
+
+func NewSkillService(skillRepository repository.SkillRepository) SkillService {
+	return &skillService{
+		skillRepository: skillRepository,
+	}
+}
+
+func (s *skillService) Seed(ctx context.Context) error {
+	return s.skillRepository.Seed(ctx, models.DefaultSkillTaxonomy)
+}
+
+func (s *skillService) Autocomplete(ctx context.Context, prefix string, limit int) ([]smodels.SkillSuggestionResponse, error) {
+	skills, err := s.skillRepository.Autocomplete(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]smodels.SkillSuggestionResponse, len(skills))
+	for i, skill := range skills {
+		result[i] = smodels.SkillSuggestionResponse{
+			ID:         skill.ID,
+			Name:       skill.Name,
+			Category:   skill.Category,
+			UsageCount: skill.UsageCount,
+		}
+	}
+	return result, nil
+}
+
+func (s *skillService) Merge(ctx context.Context, sourceID int, targetID int) (*smodels.SkillSuggestionResponse, error) {
+	skill, err := s.skillRepository.Merge(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return &smodels.SkillSuggestionResponse{
+		ID:       skill.ID,
+		Name:     skill.Name,
+		Category: skill.Category,
+	}, nil
+}
@@ -12,17 +12,23 @@ import (
 type TeamService interface {
 	Create(ctx context.Context, createTeam models.CreateTeam) (int, error)
 	Delete(ctx context.Context, teamID int) error
-	GetTeam(ctx context.Context, teamID int) (*models.TeamResponse, error)
-	JoinTeam(ctx context.Context, teamID int, userID string) error
+	GetTeam(ctx context.Context, teamID int, viewerID string) (*models.TeamResponse, error)
+	JoinTeam(ctx context.Context, teamID int, userID string, role imodels.Role) error
+	LeaveTeam(ctx context.Context, teamID int, userID string) error
+	UpdatePositions(ctx context.Context, teamID int, userID string, vacancies []imodels.Vacancy) error
+	CloseRecruitment(ctx context.Context, teamID int, userID string) error
+	ReopenRecruitment(ctx context.Context, teamID int, userID string) error
+	Archive(ctx context.Context, teamID int, userID string) error
 }
 
 type teamService struct {
 	teamRepository repository.TeamRepository
 	authRepository repository.AuthRepository
+	visibility     MemberVisibility
 }
 
-func NewTeamService(teamRepository repository.TeamRepository, authRepository repository.AuthRepository) TeamService {
-	return &teamService{teamRepository: teamRepository, authRepository: authRepository}
+func NewTeamService(teamRepository repository.TeamRepository, authRepository repository.AuthRepository, visibility MemberVisibility) TeamService {
+	return &teamService{teamRepository: teamRepository, authRepository: authRepository, visibility: visibility}
 }
 
 func (t *teamService) Create(ctx context.Context, createTeam models.CreateTeam) (int, error) {
@@ -35,6 +41,11 @@ func (t *teamService) Create(ctx context.Context, createTeam models.CreateTeam)
 		}
 	}
 
+	// リーダー自身も人数に含める
+	if err := checkHeadcount(createTeam.Headcount, 1, positions); err != nil {
+		return 0, err
+	}
+
 	skills := make([]domain.Skill, len(createTeam.Skills))
 	for i, skill := range createTeam.Skills {
 		skills[i] = domain.Skill{
@@ -62,79 +73,159 @@ func (t *teamService) Delete(ctx context.Context, teamID int) error {
 		return err
 	}
 
-	err = t.teamRepository.DeleteTeam(ctx, teamID)
-	if err != nil {
-		return err
-	}
-	return nil
+	return t.teamRepository.DeleteTeam(ctx, teamID)
 }
 
-func (t *teamService) GetTeam(ctx context.Context, teamID int) (*models.TeamResponse, error) {
+func (t *teamService) GetTeam(ctx context.Context, teamID int, viewerID string) (*models.TeamResponse, error) {
 	team, err := t.teamRepository.FindByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
-	vacancies := make([]imodels.Vacancy, len(team.Positions))
-	for i, position := range team.Positions {
-		vacancies[i] = imodels.Vacancy{
-			Role:    position.Role,
-			Vacancy: position.Vacancy,
-		}
-	}
-
-	members := make([]domain.Member, len(team.Members))
-	for i, member := range team.Members {
-		members[i] = domain.Member{
-			ID:            member.ID,
-			Email:         member.Email,
-			Nickname:      member.Nickname,
-			Picture:       member.Picture,
-			Bio:           member.Bio,
-			PreferredRole: member.PreferredRole,
-		}
-	}
-	return &models.TeamResponse{
-		ID:          team.ID,
-		Name:        team.Name,
-		Description: team.Description,
-		Headcount:   team.Headcount,
-		CreatedBy:   team.CreatedBy,
-		Members:     members,
-		Vacancies:   vacancies,
-		Skills:      team.Skills,
-	}, nil
+	return t.visibility.Team(viewerID, team), nil
 }
 
-func (t *teamService) JoinTeam(ctx context.Context, teamID int, userID string) error {
+func (t *teamService) JoinTeam(ctx context.Context, teamID int, userID string, role imodels.Role) error {
 	team, err := t.teamRepository.FindByID(ctx, teamID)
 	if err != nil {
 		return err
 	}
 
+	if team.Status.IsManual() {
+		return imodels.ErrRecruitmentClosed
+	}
+
 	// 멤버 정보 조회
 	member, err := t.authRepository.GetMemberByID(ctx, userID)
 	if err != nil {
 		return err
 	}
 
-	// 멤버의　역할이 존재하고 TO 가 있는지 확인
+	// 역할을 지정하지 않은 경우 희망 역할로 참여한다
+	if role == "" {
+		role = member.PreferredRole
+	}
+	if !member.CanFill(role) {
+		return fmt.Errorf("%w: %s", imodels.ErrRoleNotDeclared, role)
+	}
+
+	// 역할의 포지션이 존재하고 TO 가 있는지 확인
 	var exists bool
 	for _, position := range team.Positions {
-		if string(position.Role) == member.PreferredRole && position.Vacancy > 0 {
+		if position.Role == role && position.Vacancy > 0 {
 			exists = true
 			break
 		}
 	}
 
 	if !exists {
-		return fmt.Errorf("no available position for role %s", member.PreferredRole)
+		return fmt.Errorf("%w %s", imodels.ErrNoVacancy, role)
 	}
 
 	// 트랜잭션 내에서 팀 참여 처리
-	err = t.teamRepository.JoinTeam(ctx, teamID, userID)
+	err = t.teamRepository.JoinTeam(ctx, teamID, userID, role)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (t *teamService) LeaveTeam(ctx context.Context, teamID int, userID string) error {
+	team, err := t.teamRepository.FindByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if team.CreatedBy == userID {
+		return imodels.ErrLeaderCannotLeave
+	}
+	if _, ok := team.Member(userID); !ok {
+		return imodels.ErrNotTeamMember
+	}
+	return t.teamRepository.LeaveTeam(ctx, teamID, userID)
+}
+
+func (t *teamService) UpdatePositions(ctx context.Context, teamID int, userID string, vacancies []imodels.Vacancy) error {
+	team, err := findLedTeam(ctx, t.teamRepository, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if team.Status == imodels.TeamStatusArchived {
+		return imodels.ErrTeamArchived
+	}
+
+	positions := make([]domain.Position, len(vacancies))
+	for i, vacancy := range vacancies {
+		positions[i] = domain.Position{
+			Role:    vacancy.Role,
+			Vacancy: vacancy.Vacancy,
+		}
+	}
+	// 待機者に提示中の席も埋まっているものとして数える
+	occupied := len(team.Members)
+	for _, position := range team.Positions {
+		occupied += position.Reserved
+	}
+	if err := checkHeadcount(team.Headcount, occupied, positions); err != nil {
+		return err
+	}
+
+	return t.teamRepository.UpdatePositions(ctx, teamID, positions)
+}
+
+func (t *teamService) CloseRecruitment(ctx context.Context, teamID int, userID string) error {
+	return t.changeStatus(ctx, teamID, userID, imodels.TeamStatusClosed)
+}
+
+// ReopenRecruitment は締め切った募集を再開する。空きポジションがなければ FULL になる
+func (t *teamService) ReopenRecruitment(ctx context.Context, teamID int, userID string) error {
+	return t.changeStatus(ctx, teamID, userID, imodels.TeamStatusRecruiting)
+}
+
+func (t *teamService) Archive(ctx context.Context, teamID int, userID string) error {
+	return t.changeStatus(ctx, teamID, userID, imodels.TeamStatusArchived)
+}
+
+func (t *teamService) changeStatus(ctx context.Context, teamID int, userID string, status imodels.TeamStatus) error {
+	team, err := findLedTeam(ctx, t.teamRepository, teamID, userID)
+	if err != nil {
+		return err
+	}
+	// アーカイブしたチームは再開できない
+	if team.Status == imodels.TeamStatusArchived {
+		return imodels.ErrTeamArchived
+	}
+	return t.teamRepository.UpdateStatus(ctx, teamID, status)
+}
+
+// findLedTeam は userID がリーダーを務めるチームを返す
+func findLedTeam(ctx context.Context, teamRepository repository.TeamRepository, teamID int, userID string) (*domain.Team, error) {
+	team, err := teamRepository.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if team.CreatedBy != userID {
+		return nil, imodels.ErrNotTeamLeader
+	}
+	return team, nil
+}
+
+// findJoinedTeam は userID が所属するチームを返す。所属していない場合は ErrNotTeamMember を返す
+func findJoinedTeam(ctx context.Context, teamRepository repository.TeamRepository, teamID int, userID string) (*domain.Team, error) {
+	team, err := teamRepository.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := team.Member(userID); !ok {
+		return nil, imodels.ErrNotTeamMember
+	}
+	return team, nil
+}
+
+// checkHeadcount は所属メンバー数と募集人数の合計が定員を超えないことを確認する
+func checkHeadcount(headcount int8, members int, positions []domain.Position) error {
+	seats := domain.Team{Positions: positions}.OpenSeats()
+	if members+seats > int(headcount) {
+		return fmt.Errorf("%w: %d members + %d open positions > headcount %d", imodels.ErrHeadcountExceeded, members, seats, headcount)
+	}
+	return nil
+}
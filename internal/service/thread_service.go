@@ -0,0 +1,282 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/mention"
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+type ThreadService interface {
+	CreateThread(ctx context.Context, teamID int, userID string, input smodels.ThreadInput) (*smodels.ThreadResponse, error)
+	GetThreads(ctx context.Context, teamID int, userID string, page int, size int) ([]smodels.ThreadResponse, error)
+	GetThread(ctx context.Context, threadID int, userID string) (*smodels.ThreadResponse, error)
+	UpdateThread(ctx context.Context, threadID int, userID string, input smodels.ThreadInput) (*smodels.ThreadResponse, error)
+	DeleteThread(ctx context.Context, threadID int, userID string) error
+	CreateComment(ctx context.Context, threadID int, userID string, body string) (*smodels.ThreadCommentResponse, error)
+	GetComments(ctx context.Context, threadID int, userID string, page int, size int) ([]smodels.ThreadCommentResponse, error)
+	UpdateComment(ctx context.Context, threadID int, commentID int, userID string, body string) (*smodels.ThreadCommentResponse, error)
+	DeleteComment(ctx context.Context, threadID int, commentID int, userID string) error
+}
+
+type threadService struct {
+	threadRepository repository.ThreadRepository
+	teamRepository   repository.TeamRepository
+	notifier         Notifier
+}
+
+func NewThreadService(threadRepository repository.ThreadRepository, teamRepository repository.TeamRepository, notifier Notifier) ThreadService {
+	return &threadService{
+		threadRepository: threadRepository,
+		teamRepository:   teamRepository,
+		notifier:         notifier,
+	}
+}
+
+func (t *threadService) CreateThread(ctx context.Context, teamID int, userID string, input smodels.ThreadInput) (*smodels.ThreadResponse, error) {
+	team, err := findJoinedTeam(ctx, t.teamRepository, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Status == models.TeamStatusArchived {
+		return nil, models.ErrTeamArchived
+	}
+
+	created, err := t.threadRepository.CreateThread(ctx, &domain.Thread{
+		TeamID:   teamID,
+		Title:    input.Title,
+		Body:     input.Body,
+		AuthorID: userID,
+		Mentions: team.ResolveMentions(mention.Parse(input.Body)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.notifier.Notify(ctx, threadNotifications(*created, "", created.Mentions, userID)...)
+	response := toThreadResponse(*created)
+	return &response, nil
+}
+
+func (t *threadService) GetThreads(ctx context.Context, teamID int, userID string, page int, size int) ([]smodels.ThreadResponse, error) {
+	if _, err := findJoinedTeam(ctx, t.teamRepository, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	threads, err := t.threadRepository.FindThreads(ctx, teamID, page, size)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]smodels.ThreadResponse, len(threads))
+	for i, thread := range threads {
+		result[i] = toThreadResponse(thread)
+	}
+	return result, nil
+}
+
+func (t *threadService) GetThread(ctx context.Context, threadID int, userID string) (*smodels.ThreadResponse, error) {
+	thread, _, err := t.findThread(ctx, threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := toThreadResponse(*thread)
+	return &response, nil
+}
+
+// UpdateThread は作成者だけが変更できる
+func (t *threadService) UpdateThread(ctx context.Context, threadID int, userID string, input smodels.ThreadInput) (*smodels.ThreadResponse, error) {
+	thread, team, err := t.findThread(ctx, threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if thread.AuthorID != userID {
+		return nil, models.ErrNotAuthor
+	}
+
+	thread.Title = input.Title
+	thread.Body = input.Body
+	thread.Mentions = team.ResolveMentions(mention.Parse(input.Body))
+	if err := t.threadRepository.UpdateThread(ctx, thread, time.Now()); err != nil {
+		return nil, err
+	}
+	return t.GetThread(ctx, threadID, userID)
+}
+
+// DeleteThread は作成者とチームリーダーが削除できる
+func (t *threadService) DeleteThread(ctx context.Context, threadID int, userID string) error {
+	thread, team, err := t.findThread(ctx, threadID, userID)
+	if err != nil {
+		return err
+	}
+	if !team.CanModerate(userID, thread.AuthorID) {
+		return models.ErrNotAuthor
+	}
+	return t.threadRepository.DeleteThread(ctx, threadID)
+}
+
+func (t *threadService) CreateComment(ctx context.Context, threadID int, userID string, body string) (*smodels.ThreadCommentResponse, error) {
+	thread, team, err := t.findThread(ctx, threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Status == models.TeamStatusArchived {
+		return nil, models.ErrTeamArchived
+	}
+
+	created, err := t.threadRepository.CreateComment(ctx, &domain.ThreadComment{
+		ThreadID: threadID,
+		Body:     body,
+		AuthorID: userID,
+		Mentions: team.ResolveMentions(mention.Parse(body)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.notifier.Notify(ctx, threadNotifications(*thread, thread.AuthorID, created.Mentions, userID)...)
+	response := toThreadCommentResponse(*created)
+	return &response, nil
+}
+
+func (t *threadService) GetComments(ctx context.Context, threadID int, userID string, page int, size int) ([]smodels.ThreadCommentResponse, error) {
+	if _, _, err := t.findThread(ctx, threadID, userID); err != nil {
+		return nil, err
+	}
+
+	comments, err := t.threadRepository.FindComments(ctx, threadID, page, size)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]smodels.ThreadCommentResponse, len(comments))
+	for i, comment := range comments {
+		result[i] = toThreadCommentResponse(comment)
+	}
+	return result, nil
+}
+
+// UpdateComment は作成者だけが変更できる
+func (t *threadService) UpdateComment(ctx context.Context, threadID int, commentID int, userID string, body string) (*smodels.ThreadCommentResponse, error) {
+	_, team, err := t.findThread(ctx, threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := t.threadRepository.FindComment(ctx, threadID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != userID {
+		return nil, models.ErrNotAuthor
+	}
+
+	comment.Body = body
+	comment.Mentions = team.ResolveMentions(mention.Parse(body))
+	if err := t.threadRepository.UpdateComment(ctx, comment, time.Now()); err != nil {
+		return nil, err
+	}
+
+	updated, err := t.threadRepository.FindComment(ctx, threadID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	response := toThreadCommentResponse(*updated)
+	return &response, nil
+}
+
+// DeleteComment は作成者とチームリーダーが削除できる
+func (t *threadService) DeleteComment(ctx context.Context, threadID int, commentID int, userID string) error {
+	_, team, err := t.findThread(ctx, threadID, userID)
+	if err != nil {
+		return err
+	}
+	comment, err := t.threadRepository.FindComment(ctx, threadID, commentID)
+	if err != nil {
+		return err
+	}
+	if !team.CanModerate(userID, comment.AuthorID) {
+		return models.ErrNotAuthor
+	}
+	return t.threadRepository.DeleteComment(ctx, threadID, commentID)
+}
+
+// findThread はスレッドと、userID が所属していることを確認したチームを返す
+func (t *threadService) findThread(ctx context.Context, threadID int, userID string) (*domain.Thread, *domain.Team, error) {
+	thread, err := t.threadRepository.FindThread(ctx, threadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	team, err := findJoinedTeam(ctx, t.teamRepository, thread.TeamID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return thread, team, nil
+}
+
+func toThreadResponse(thread domain.Thread) smodels.ThreadResponse {
+	return smodels.ThreadResponse{
+		ID:     thread.ID,
+		TeamID: thread.TeamID,
+		Title:  thread.Title,
+		Body:   thread.Body,
+		Author: smodels.AuthorResponse{
+			ID:       thread.AuthorID,
+			Nickname: thread.AuthorNickname,
+		},
+		Mentions:       mentionsOrEmpty(thread.Mentions),
+		CommentCount:   thread.CommentCount,
+		LastActivityAt: thread.LastActivityAt,
+		EditedAt:       thread.EditedAt,
+		CreatedAt:      thread.CreatedAt,
+	}
+}
+
+func toThreadCommentResponse(comment domain.ThreadComment) smodels.ThreadCommentResponse {
+	return smodels.ThreadCommentResponse{
+		ID:       comment.ID,
+		ThreadID: comment.ThreadID,
+		Body:     comment.Body,
+		Author: smodels.AuthorResponse{
+			ID:       comment.AuthorID,
+			Nickname: comment.AuthorNickname,
+		},
+		Mentions:  mentionsOrEmpty(comment.Mentions),
+		EditedAt:  comment.EditedAt,
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
+// mentionsOrEmpty はメンションがない場合も JSON で空配列を返すようにする
+func mentionsOrEmpty(mentions []string) []string {
+	if mentions == nil {
+		return []string{}
+	}
+	return mentions
+}
+
+// threadNotifications はスレッドへの投稿の通知を作る。mentions のメンバーにはメンションを、
+// メンションされていなければ commentedTo（コメントの場合はスレッドの作成者）に新しいコメントを通知する
+func threadNotifications(thread domain.Thread, commentedTo string, mentions []string, actorID string) []domain.Notification {
+	notification := func(recipientID string, kind models.NotificationKind) domain.Notification {
+		teamID := thread.TeamID
+		return domain.Notification{
+			RecipientID: recipientID,
+			Kind:        kind,
+			TeamID:      &teamID,
+			ActorID:     actorID,
+			Subject:     thread.Title,
+			Link:        fmt.Sprintf("/v1/threads/%d", thread.ID),
+		}
+	}
+
+	var result []domain.Notification
+	mentioned := false
+	for _, memberID := range mentions {
+		result = append(result, notification(memberID, models.NotificationMentioned))
+		mentioned = mentioned || memberID == commentedTo
+	}
+	if commentedTo != "" && !mentioned {
+		result = append(result, notification(commentedTo, models.NotificationNewComment))
+	}
+	return result
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// generateToken は URL に含められる推測困難なトークンを生成する
+func generateToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
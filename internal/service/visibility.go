@@ -0,0 +1,89 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	imodels "backend_golang/internal/models"
+	"backend_golang/internal/service/models"
+)
+
+// MemberVisibility は閲覧者ごとにメンバー情報のどのフィールドを公開するかを決めるポリシー
+// メールアドレスは本人と、LeaderCanSeeEmail が有効な場合は所属チームのリーダーにのみ公開する
+type MemberVisibility struct {
+	LeaderCanSeeEmail bool
+}
+
+func NewMemberVisibility(leaderCanSeeEmail bool) MemberVisibility {
+	return MemberVisibility{LeaderCanSeeEmail: leaderCanSeeEmail}
+}
+
+// CanSeeEmail は viewerID の閲覧者が team に所属する member のメールアドレスを見られるかを返す
+// viewerID が空の場合は未ログインの閲覧者として扱う
+func (v MemberVisibility) CanSeeEmail(viewerID string, member domain.Member, team *domain.Team) bool {
+	if viewerID == "" {
+		return false
+	}
+	if viewerID == member.ID {
+		return true
+	}
+	return v.LeaderCanSeeEmail && team != nil && team.CreatedBy == viewerID
+}
+
+func (v MemberVisibility) Member(viewerID string, member domain.Member, team *domain.Team) models.MemberResponse {
+	response := models.MemberResponse{
+		PublicMemberResponse: models.PublicMemberResponse{
+			ID:            member.ID,
+			Nickname:      member.Nickname,
+			Picture:       member.Picture,
+			Bio:           member.Bio,
+			PreferredRole: member.PreferredRole,
+			TeamRole:      member.TeamRole,
+		},
+	}
+	if v.CanSeeEmail(viewerID, member, team) {
+		response.Email = member.Email
+	}
+	return response
+}
+
+// Team はチームのドメインモデルを閲覧者向けのレスポンスに変換する
+func (v MemberVisibility) Team(viewerID string, team *domain.Team) *models.TeamResponse {
+	if team == nil {
+		return nil
+	}
+
+	members := make([]models.MemberResponse, len(team.Members))
+	for i, member := range team.Members {
+		members[i] = v.Member(viewerID, member, team)
+	}
+
+	vacancies := make([]imodels.Vacancy, len(team.Positions))
+	positions := make([]models.PositionResponse, len(team.Positions))
+	for i, position := range team.Positions {
+		vacancies[i] = imodels.NewVacancy(position.Role, position.Vacancy)
+		positions[i] = models.PositionResponse{
+			Role:     position.Role,
+			Capacity: position.Capacity(),
+			Filled:   position.Filled,
+			Reserved: position.Reserved,
+			Vacancy:  position.Vacancy,
+		}
+	}
+
+	skills := make([]models.SkillResponse, len(team.Skills))
+	for i, skill := range team.Skills {
+		skills[i] = models.SkillResponse{Name: skill.Name}
+	}
+
+	return &models.TeamResponse{
+		ID:          team.ID,
+		Name:        team.Name,
+		Description: team.Description,
+		Headcount:   team.Headcount,
+		CreatedBy:   team.CreatedBy,
+		Status:      team.Status,
+		Members:     members,
+		Vacancies:   vacancies,
+		Positions:   positions,
+		Skills:      skills,
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberVisibility_CanSeeEmail(t *testing.T) {
+	member := domain.Member{ID: "member", Email: "member@example.com"}
+	team := &domain.Team{ID: 1, CreatedBy: "leader", Members: []domain.Member{{ID: "leader"}, member, {ID: "teammate"}}}
+
+	tests := []struct {
+		name              string
+		leaderCanSeeEmail bool
+		viewerID          string
+		team              *domain.Team
+		want              bool
+	}{
+		{name: "self", viewerID: "member", team: team, want: true},
+		{name: "self without team", viewerID: "member", want: true},
+		{name: "leader with flag on", leaderCanSeeEmail: true, viewerID: "leader", team: team, want: true},
+		{name: "leader with flag off", viewerID: "leader", team: team, want: false},
+		{name: "leader of unknown team", leaderCanSeeEmail: true, viewerID: "leader", want: false},
+		{name: "teammate", leaderCanSeeEmail: true, viewerID: "teammate", team: team, want: false},
+		{name: "other member", leaderCanSeeEmail: true, viewerID: "stranger", team: team, want: false},
+		{name: "anonymous", leaderCanSeeEmail: true, viewerID: "", team: team, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visibility := NewMemberVisibility(tt.leaderCanSeeEmail)
+			assert.Equal(t, tt.want, visibility.CanSeeEmail(tt.viewerID, member, tt.team))
+
+			response := visibility.Member(tt.viewerID, member, tt.team)
+			assert.Equal(t, "member", response.ID)
+			if tt.want {
+				assert.Equal(t, "member@example.com", response.Email)
+			} else {
+				assert.Empty(t, response.Email)
+			}
+		})
+	}
+}
+
+func TestMemberVisibility_Team(t *testing.T) {
+	team := &domain.Team{
+		ID:        1,
+		CreatedBy: "leader",
+		Members: []domain.Member{
+			{ID: "leader", Email: "leader@example.com"},
+			{ID: "member", Email: "member@example.com"},
+			{ID: "teammate", Email: "teammate@example.com"},
+		},
+	}
+	emails := func(visibility MemberVisibility, viewerID string) map[string]string {
+		response := visibility.Team(viewerID, team)
+		require.NotNil(t, response)
+		emails := make(map[string]string)
+		for _, member := range response.Members {
+			emails[member.ID] = member.Email
+		}
+		return emails
+	}
+
+	assert.Equal(t, map[string]string{"leader": "", "member": "member@example.com", "teammate": ""},
+		emails(NewMemberVisibility(true), "member"))
+	assert.Equal(t, map[string]string{"leader": "leader@example.com", "member": "member@example.com", "teammate": "teammate@example.com"},
+		emails(NewMemberVisibility(true), "leader"))
+	assert.Equal(t, map[string]string{"leader": "leader@example.com", "member": "", "teammate": ""},
+		emails(NewMemberVisibility(false), "leader"))
+	assert.Equal(t, map[string]string{"leader": "", "member": "", "teammate": ""},
+		emails(NewMemberVisibility(true), ""))
+
+	assert.Nil(t, NewMemberVisibility(true).Team("leader", nil))
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+type WaitlistService interface {
+	Join(ctx context.Context, teamID int, userID string, role models.Role) (*smodels.WaitlistEntryResponse, error)
+	GetWaitlist(ctx context.Context, userID string) ([]smodels.WaitlistEntryResponse, error)
+	Accept(ctx context.Context, entryID int, userID string) error
+	Cancel(ctx context.Context, entryID int, userID string) error
+	ExpireOffers(ctx context.Context) (int, error)
+}
+
+type waitlistService struct {
+	waitlistRepository repository.WaitlistRepository
+	teamRepository     repository.TeamRepository
+	authRepository     repository.AuthRepository
+}
+
+func NewWaitlistService(waitlistRepository repository.WaitlistRepository, teamRepository repository.TeamRepository, authRepository repository.AuthRepository) WaitlistService {
+	return &waitlistService{
+		waitlistRepository: waitlistRepository,
+		teamRepository:     teamRepository,
+		authRepository:     authRepository,
+	}
+}
+
+func (w *waitlistService) Join(ctx context.Context, teamID int, userID string, role models.Role) (*smodels.WaitlistEntryResponse, error) {
+	team, err := w.teamRepository.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Status.IsManual() {
+		return nil, models.ErrRecruitmentClosed
+	}
+
+	member, err := w.authRepository.GetMemberByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// チーム参加と同じく、役割を指定しない場合は希望する役割で待機する
+	if role == "" {
+		role = member.PreferredRole
+	}
+	if !member.CanFill(role) {
+		return nil, fmt.Errorf("%w: %s", models.ErrRoleNotDeclared, role)
+	}
+
+	entry, err := w.waitlistRepository.Enqueue(ctx, teamID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	response := toWaitlistEntryResponse(*entry)
+	return &response, nil
+}
+
+func (w *waitlistService) GetWaitlist(ctx context.Context, userID string) ([]smodels.WaitlistEntryResponse, error) {
+	entries, err := w.waitlistRepository.FindByMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]smodels.WaitlistEntryResponse, len(entries))
+	for i, entry := range entries {
+		result[i] = toWaitlistEntryResponse(entry)
+	}
+	return result, nil
+}
+
+func (w *waitlistService) Accept(ctx context.Context, entryID int, userID string) error {
+	return w.waitlistRepository.Accept(ctx, entryID, userID, time.Now())
+}
+
+func (w *waitlistService) Cancel(ctx context.Context, entryID int, userID string) error {
+	return w.waitlistRepository.Cancel(ctx, entryID, userID, time.Now())
+}
+
+// ExpireOffers は承諾期限を過ぎた提示を失効させる。定期的に実行する
+func (w *waitlistService) ExpireOffers(ctx context.Context) (int, error) {
+	return w.waitlistRepository.ExpireOffers(ctx, time.Now())
+}
+
+func toWaitlistEntryResponse(entry domain.WaitlistEntry) smodels.WaitlistEntryResponse {
+	return smodels.WaitlistEntryResponse{
+		ID:             entry.ID,
+		TeamID:         entry.TeamID,
+		TeamName:       entry.TeamName,
+		Role:           entry.Role,
+		Status:         entry.Status,
+		Place:          entry.Place,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
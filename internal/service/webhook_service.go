@@ -0,0 +1,241 @@
+package service
+
+import (
+	"backend_golang/internal/domain"
+	"backend_golang/internal/logging"
+	"backend_golang/internal/models"
+	"backend_golang/internal/repository"
+	smodels "backend_golang/internal/service/models"
+	"backend_golang/internal/webhook"
+	"context"
+	"time"
+)
+
+const (
+	webhookBatchSize = 50
+	// webhookRetryBackoff は最初の再送までの間隔。失敗するたびに倍にする
+	webhookRetryBackoff = 30 * time.Second
+)
+
+type WebhookService interface {
+	Create(ctx context.Context, teamID int, userID string, input smodels.WebhookInput) (*smodels.WebhookResponse, error)
+	GetWebhooks(ctx context.Context, teamID int, userID string) ([]smodels.WebhookResponse, error)
+	Update(ctx context.Context, teamID int, webhookID int, userID string, input smodels.WebhookInput) (*smodels.WebhookResponse, error)
+	Delete(ctx context.Context, teamID int, webhookID int, userID string) error
+	GetDeliveries(ctx context.Context, teamID int, webhookID int, userID string, page int, size int) ([]smodels.WebhookDeliveryResponse, error)
+	// DeliverPending は送る日時を過ぎた送信待ちを送る。定期的に実行する
+	DeliverPending(ctx context.Context) error
+}
+
+type webhookService struct {
+	webhookRepository repository.WebhookRepository
+	teamRepository    repository.TeamRepository
+	client            *webhook.Client
+}
+
+func NewWebhookService(webhookRepository repository.WebhookRepository, teamRepository repository.TeamRepository, client *webhook.Client) WebhookService {
+	return &webhookService{
+		webhookRepository: webhookRepository,
+		teamRepository:    teamRepository,
+		client:            client,
+	}
+}
+
+func (w *webhookService) Create(ctx context.Context, teamID int, userID string, input smodels.WebhookInput) (*smodels.WebhookResponse, error) {
+	team, err := findLedTeam(ctx, w.teamRepository, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Status == models.TeamStatusArchived {
+		return nil, models.ErrTeamArchived
+	}
+
+	secret, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	if input.Format == "" {
+		input.Format = models.WebhookGeneric
+	}
+	created, err := w.webhookRepository.Create(ctx, &domain.Webhook{
+		TeamID:    teamID,
+		URL:       input.URL,
+		Format:    input.Format,
+		Secret:    secret,
+		Events:    input.Events,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 署名の鍵は登録時にだけ返す
+	response := toWebhookResponse(*created)
+	response.Secret = created.Secret
+	return &response, nil
+}
+
+func (w *webhookService) GetWebhooks(ctx context.Context, teamID int, userID string) ([]smodels.WebhookResponse, error) {
+	if _, err := findLedTeam(ctx, w.teamRepository, teamID, userID); err != nil {
+		return nil, err
+	}
+	webhooks, err := w.webhookRepository.FindByTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]smodels.WebhookResponse, len(webhooks))
+	for i, hook := range webhooks {
+		result[i] = toWebhookResponse(hook)
+	}
+	return result, nil
+}
+
+// Update は Webhook を変更する。自動で無効になった Webhook は active を true にすると再び送るようになる
+func (w *webhookService) Update(ctx context.Context, teamID int, webhookID int, userID string, input smodels.WebhookInput) (*smodels.WebhookResponse, error) {
+	if _, err := findLedTeam(ctx, w.teamRepository, teamID, userID); err != nil {
+		return nil, err
+	}
+	hook, err := w.webhookRepository.FindByID(ctx, teamID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	hook.URL = input.URL
+	if input.Format != "" {
+		hook.Format = input.Format
+	}
+	hook.Events = input.Events
+	if input.Active != nil {
+		hook.Active = *input.Active
+	}
+	if err := w.webhookRepository.Update(ctx, hook); err != nil {
+		return nil, err
+	}
+
+	updated, err := w.webhookRepository.FindByID(ctx, teamID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	response := toWebhookResponse(*updated)
+	return &response, nil
+}
+
+func (w *webhookService) Delete(ctx context.Context, teamID int, webhookID int, userID string) error {
+	if _, err := findLedTeam(ctx, w.teamRepository, teamID, userID); err != nil {
+		return err
+	}
+	if _, err := w.webhookRepository.FindByID(ctx, teamID, webhookID); err != nil {
+		return err
+	}
+	return w.webhookRepository.Delete(ctx, webhookID)
+}
+
+func (w *webhookService) GetDeliveries(ctx context.Context, teamID int, webhookID int, userID string, page int, size int) ([]smodels.WebhookDeliveryResponse, error) {
+	if _, err := findLedTeam(ctx, w.teamRepository, teamID, userID); err != nil {
+		return nil, err
+	}
+	if _, err := w.webhookRepository.FindByID(ctx, teamID, webhookID); err != nil {
+		return nil, err
+	}
+	deliveries, err := w.webhookRepository.FindDeliveries(ctx, webhookID, page, size)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]smodels.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = toWebhookDeliveryResponse(delivery)
+	}
+	return result, nil
+}
+
+func (w *webhookService) DeliverPending(ctx context.Context) error {
+	deliveries, err := w.webhookRepository.FindDueDeliveries(ctx, time.Now(), webhookBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		resp, sendErr := w.client.Send(ctx, webhook.Request{
+			URL:        delivery.Webhook.URL,
+			Secret:     delivery.Webhook.Secret,
+			DeliveryID: delivery.ID,
+			Event:      string(delivery.EventType),
+			Body:       []byte(delivery.Body),
+		})
+
+		now := time.Now()
+		attempt := domain.WebhookAttempt{
+			Status:         models.WebhookDeliverySucceeded,
+			ResponseStatus: resp.StatusCode,
+			Duration:       resp.Duration,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			At:             now,
+		}
+		if sendErr != nil {
+			attempt.Error = sendErr.Error()
+			attempt.Status = models.WebhookDeliveryFailed
+			if attempts := delivery.Attempts + 1; attempts < models.WebhookMaxAttempts {
+				attempt.Status = models.WebhookDeliveryPending
+				attempt.NextAttemptAt = now.Add(webhookRetryDelay(attempts))
+			}
+		}
+
+		disabled, err := w.webhookRepository.RecordAttempt(ctx, delivery.ID, attempt)
+		if err != nil {
+			return err
+		}
+		if disabled {
+			logging.FromContext(ctx).Warn("webhook disabled after consecutive failures", "webhook_id", delivery.Webhook.ID, "team_id", delivery.Webhook.TeamID, "failures", models.WebhookDisableAfter)
+		}
+	}
+	return nil
+}
+
+// webhookRetryDelay は attempts 回目の失敗の後に再送まで待つ時間
+func webhookRetryDelay(attempts int) time.Duration {
+	return webhookRetryBackoff << (attempts - 1)
+}
+
+func toWebhookResponse(hook domain.Webhook) smodels.WebhookResponse {
+	events := hook.Events
+	if events == nil {
+		events = []models.DomainEventType{}
+	}
+	return smodels.WebhookResponse{
+		ID:                  hook.ID,
+		TeamID:              hook.TeamID,
+		URL:                 hook.URL,
+		Format:              hook.Format,
+		Events:              events,
+		Active:              hook.Active,
+		ConsecutiveFailures: hook.ConsecutiveFailures,
+		DisabledAt:          hook.DisabledAt,
+		CreatedAt:           hook.CreatedAt,
+		UpdatedAt:           hook.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery domain.WebhookDelivery) smodels.WebhookDeliveryResponse {
+	result := smodels.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.LastError,
+		DurationMs:     delivery.Duration.Milliseconds(),
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+	if delivery.Status == models.WebhookDeliveryPending {
+		next := delivery.NextAttemptAt
+		result.NextAttemptAt = &next
+	}
+	return result
+}
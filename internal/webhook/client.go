@@ -0,0 +1,198 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader は本文の署名を送るヘッダー。値は "sha256=<16進数>"
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader は署名に含めた送信時刻（UNIX 秒）を送るヘッダー
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	userAgent      = "TeamRecruitment-Webhook/1.0"
+	defaultTimeout = 10 * time.Second
+	// 接続を再利用するために読み捨てる応答の本文の上限
+	maxDiscardBody = 64 << 10
+)
+
+// ErrForbiddenAddress はループバックやプライベートネットワークなど、内部のアドレスへの送信
+var ErrForbiddenAddress = errors.New("webhook destination address is not allowed")
+
+// Sign は secret で "<timestamp>.<body>" の HMAC-SHA256 を計算する
+// 受信側は同じ計算をして SignatureHeader と比べ、古い時刻の要求を拒否することで再送攻撃を防げる
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify は署名が正しいかどうかを確認する
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Request は Webhook への1回の送信
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID int
+	Event      string
+	Body       []byte
+}
+
+// Response は送信の結果。応答がなかった場合 StatusCode は0
+type Response struct {
+	StatusCode int
+	Duration   time.Duration
+}
+
+// StatusError は 2xx 以外の応答。応答の本文は配信の記録からリーダーが読めるため含めない
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.StatusCode)
+}
+
+type Client struct {
+	http *http.Client
+	now  func() time.Time
+}
+
+// NewClient は内部のアドレスに送らないクライアントを作る
+// 宛先は接続するときに解決したアドレスで確かめるので、登録後に DNS の向き先を変えられても内部には届かない
+func NewClient() *Client {
+	dialer := &net.Dialer{
+		Timeout: defaultTimeout,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシを経由すると接続先がプロキシになり、宛先を確かめられない
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return newClient(transport)
+}
+
+// NewUnguardedClient は宛先を制限しないクライアントを作る。httptest のサーバーに送るテストで使う
+func NewUnguardedClient() *Client {
+	return newClient(http.DefaultTransport)
+}
+
+func newClient(transport http.RoundTripper) *Client {
+	return &Client{
+		http: &http.Client{
+			Transport: transport,
+			Timeout:   defaultTimeout,
+			// 登録された URL 以外には送らない
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// nonPublicPrefixes は net.IP のメソッドで判定できない、インターネット上で到達できない特殊用途のアドレス（IANA の Special-Purpose Address Registry）
+// 内部のアドレスを埋め込める IPv6 の変換用アドレス（NAT64、Teredo、6to4）も送信先にしない
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // このネットワーク
+	netip.MustParsePrefix("100.64.0.0/10"),   // キャリアグレード NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF プロトコル割り当て
+	netip.MustParsePrefix("192.0.2.0/24"),    // ドキュメント用（TEST-NET-1）
+	netip.MustParsePrefix("192.88.99.0/24"),  // 6to4 リレー
+	netip.MustParsePrefix("198.18.0.0/15"),   // ベンチマーク用
+	netip.MustParsePrefix("198.51.100.0/24"), // ドキュメント用（TEST-NET-2）
+	netip.MustParsePrefix("203.0.113.0/24"),  // ドキュメント用（TEST-NET-3）
+	netip.MustParsePrefix("240.0.0.0/4"),     // 予約済み・リミテッドブロードキャスト
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // ローカルの NAT64
+	netip.MustParsePrefix("100::/64"),        // 破棄用
+	netip.MustParsePrefix("2001::/23"),       // IETF プロトコル割り当て（Teredo を含む）
+	netip.MustParsePrefix("2001:db8::/32"),   // ドキュメント用
+	netip.MustParsePrefix("2002::/16"),       // 6to4
+	netip.MustParsePrefix("3fff::/20"),       // ドキュメント用
+	netip.MustParsePrefix("fec0::/10"),       // サイトローカル（廃止）
+}
+
+// IsPublicIP はインターネット上のアドレスかどうか。ループバック、プライベート、リンクローカル、未指定、マルチキャスト、
+// nonPublicPrefixes のアドレスは含まない。IPv4 射影アドレスは IPv4 のアドレスとして判定する
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() {
+		return false
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Send は署名した本文を POST する。2xx 以外の応答は StatusError を返す
+func (c *Client) Send(ctx context.Context, request Request) (Response, error) {
+	timestamp := c.now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, request.Event)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(request.DeliveryID))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(request.Secret, timestamp, request.Body))
+
+	started := c.now()
+	resp, err := c.http.Do(req)
+	result := Response{Duration: c.now().Sub(started)}
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+	result.StatusCode = resp.StatusCode
+
+	// 接続を再利用できるよう本文を読み捨てる
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDiscardBody))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, &StatusError{StatusCode: resp.StatusCode}
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"backend_golang/internal/models"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Message は Webhook に送るイベントの内容
+type Message struct {
+	// ID は outbox のイベントの ID。受信側で重複を除くのに使える
+	ID           int                    `json:"id"`
+	Type         models.DomainEventType `json:"type"`
+	OccurredAt   time.Time              `json:"occurred_at"`
+	Team         Team                   `json:"team"`
+	Member       *Member                `json:"member,omitempty"`
+	Announcement *Announcement          `json:"announcement,omitempty"`
+	// Text は人が読むための要約。Slack・Discord 形式ではこれだけを送る
+	Text string `json:"text"`
+}
+
+type Team struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Member struct {
+	ID       string `json:"id"`
+	Nickname string `json:"nickname"`
+}
+
+type Announcement struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// slackBody は Slack の Incoming Webhook の本文
+type slackBody struct {
+	Text string `json:"text"`
+}
+
+// discordBody は Discord の Webhook の本文
+type discordBody struct {
+	Content         string                 `json:"content"`
+	AllowedMentions discordAllowedMentions `json:"allowed_mentions"`
+}
+
+type discordAllowedMentions struct {
+	Parse []string `json:"parse"`
+}
+
+// Render は format の形式で Webhook の本文を作る
+func Render(format models.WebhookFormat, message Message) ([]byte, error) {
+	switch format {
+	case models.WebhookGeneric:
+		return json.Marshal(message)
+	case models.WebhookSlack:
+		return json.Marshal(slackBody{Text: message.Text})
+	case models.WebhookDiscord:
+		// @everyone などのメンションを解釈させない
+		return json.Marshal(discordBody{
+			Content:         message.Text,
+			AllowedMentions: discordAllowedMentions{Parse: []string{}},
+		})
+	}
+	return nil, fmt.Errorf("unknown webhook format: %s", format)
+}
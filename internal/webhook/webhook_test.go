@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"backend_golang/internal/models"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SendSignsBody(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{r.Header.Clone(), body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	body := []byte(`{"text":"hello"}`)
+	resp, err := NewUnguardedClient().Send(context.Background(), Request{
+		URL:        server.URL,
+		Secret:     "s3cret",
+		DeliveryID: 7,
+		Event:      string(models.DomainEventMemberJoined),
+		Body:       body,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	got := <-requests
+	assert.Equal(t, body, got.body)
+	assert.Equal(t, "application/json", got.header.Get("Content-Type"))
+	assert.Equal(t, "MEMBER_JOINED", got.header.Get(EventHeader))
+	assert.Equal(t, "7", got.header.Get(DeliveryHeader))
+
+	timestamp, err := strconv.ParseInt(got.header.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	assert.True(t, Verify("s3cret", timestamp, got.body, got.header.Get(SignatureHeader)))
+	assert.False(t, Verify("other", timestamp, got.body, got.header.Get(SignatureHeader)))
+	assert.False(t, Verify("s3cret", timestamp+1, got.body, got.header.Get(SignatureHeader)))
+}
+
+func TestClient_SendFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/error":
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+		case "/redirect":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := NewUnguardedClient()
+	resp, err := client.Send(context.Background(), Request{URL: server.URL + "/error", Body: []byte("{}")})
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	// 応答の本文は配信の記録に残さない
+	assert.Equal(t, "unexpected status 400", statusErr.Error())
+
+	// リダイレクトは追わずに失敗として扱う
+	resp, err = client.Send(context.Background(), Request{URL: server.URL + "/redirect", Body: []byte("{}")})
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+	// 応答がない場合はステータスが0になる
+	server.Close()
+	resp, err = client.Send(context.Background(), Request{URL: server.URL, Body: []byte("{}")})
+	assert.Error(t, err)
+	assert.Zero(t, resp.StatusCode)
+}
+
+func TestClient_SendRejectsInternalAddresses(t *testing.T) {
+	var called atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+	}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	client := NewClient()
+	for _, host := range []string{"127.0.0.1", "localhost", "[::1]", "0.0.0.0", "169.254.169.254", "10.0.0.1", "192.168.1.1"} {
+		resp, err := client.Send(context.Background(), Request{URL: "http://" + host + ":" + port, Body: []byte("{}")})
+		assert.ErrorIs(t, err, ErrForbiddenAddress, host)
+		assert.Zero(t, resp.StatusCode)
+	}
+	assert.False(t, called.Load())
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "::1", "10.1.2.3", "172.16.0.1", "192.168.0.1", "169.254.169.254", "fe80::1", "fd00::1", "0.0.0.0", "::", "224.0.0.1", "::ffff:127.0.0.1"} {
+		assert.False(t, IsPublicIP(net.ParseIP(ip)), ip)
+	}
+	// 特殊用途のアドレスと、内部のアドレスを埋め込める変換用のアドレス
+	for _, ip := range []string{
+		"0.1.2.3", "100.64.0.1", "100.127.255.254", "192.0.0.8", "192.0.2.1", "192.88.99.1", "198.18.0.1", "198.19.255.255",
+		"198.51.100.7", "203.0.113.9", "240.0.0.1", "255.255.255.255", "::ffff:100.64.0.1",
+		"64:ff9b::a9fe:a9fe", "64:ff9b:1::1", "100::1", "2001::1", "2001:db8::1", "2002:a00:1::1", "3fff::1", "fec0::1",
+	} {
+		assert.False(t, IsPublicIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"93.184.216.34", "2606:2800:220:1::1", "8.8.8.8", "100.63.255.255", "100.128.0.1", "198.20.0.1", "::ffff:8.8.8.8"} {
+		assert.True(t, IsPublicIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func TestRender(t *testing.T) {
+	message := Message{
+		ID:         3,
+		Type:       models.DomainEventAnnouncementCreated,
+		OccurredAt: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		Team:       Team{ID: 1004, Name: "gophers"},
+		Announcement: &Announcement{
+			ID:    21,
+			Title: "Go エンジニア募集",
+			URL:   "http://localhost:8080/v1/announcements/21",
+		},
+		Text: "[gophers] 募集記事「Go エンジニア募集」を公開しました @everyone",
+	}
+
+	generic, err := Render(models.WebhookGeneric, message)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": 3,
+		"type": "ANNOUNCEMENT_CREATED",
+		"occurred_at": "2025-04-01T10:00:00Z",
+		"team": {"id": 1004, "name": "gophers"},
+		"announcement": {"id": 21, "title": "Go エンジニア募集", "url": "http://localhost:8080/v1/announcements/21"},
+		"text": "[gophers] 募集記事「Go エンジニア募集」を公開しました @everyone"
+	}`, string(generic))
+
+	slack, err := Render(models.WebhookSlack, message)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text": "[gophers] 募集記事「Go エンジニア募集」を公開しました @everyone"}`, string(slack))
+
+	discord, err := Render(models.WebhookDiscord, message)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"content": "[gophers] 募集記事「Go エンジニア募集」を公開しました @everyone",
+		"allowed_mentions": {"parse": []}
+	}`, string(discord))
+
+	_, err = Render(models.WebhookFormat("TEAMS"), message)
+	assert.Error(t, err)
+}
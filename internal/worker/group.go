@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Group は複数のワーカーを起動し、停止をまとめて待つ
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewGroup は ctx がキャンセルされるか Stop するまで動くワーカーのグループを作る
+func NewGroup(ctx context.Context) *Group {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{ctx: ctx, cancel: cancel}
+}
+
+// Go は fn を goroutine で実行する。fn は渡した context がキャンセルされたら戻る
+func (g *Group) Go(fn func(ctx context.Context)) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		fn(g.ctx)
+	}()
+}
+
+// Every は Every(ctx, name, interval, fn) をグループで実行する
+func (g *Group) Every(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	g.Go(func(ctx context.Context) {
+		Every(ctx, name, interval, fn)
+	})
+}
+
+// Stop はワーカーに停止を伝え、実行中の処理が終わるのを待つ
+// ctx が先に終わった場合は待つのをやめて ctx のエラーを返す
+func (g *Group) Stop(ctx context.Context) error {
+	g.cancel()
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
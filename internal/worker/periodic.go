@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"backend_golang/internal/logging"
+	"context"
+	"time"
+)
+
+// Every は ctx がキャンセルされるまで interval ごとに fn を実行する
+// fn のエラーはログに出力して次の実行を続ける
+func Every(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	ctx = logging.With(ctx, "worker", name)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				logging.FromContext(ctx).Error("worker failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var runs atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		Every(ctx, "test", time.Millisecond, func(ctx context.Context) error {
+			// エラーが返っても実行は続く
+			if runs.Add(1) >= 3 {
+				cancel()
+			}
+			return errors.New("failed")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after cancel")
+	}
+	assert.GreaterOrEqual(t, runs.Load(), int32(3))
+}
+
+func TestGroup_StopWaitsForRunningWork(t *testing.T) {
+	group := NewGroup(context.Background())
+
+	var finished atomic.Bool
+	started := make(chan struct{})
+	group.Go(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		// 停止を伝えられた後の後片付けも待つ
+		time.Sleep(10 * time.Millisecond)
+		finished.Store(true)
+	})
+	group.Every("test", time.Millisecond, func(ctx context.Context) error { return nil })
+	<-started
+
+	assert.NoError(t, group.Stop(context.Background()))
+	assert.True(t, finished.Load())
+}
+
+func TestGroup_StopGivesUpAtDeadline(t *testing.T) {
+	group := NewGroup(context.Background())
+	release := make(chan struct{})
+	defer close(release)
+	group.Go(func(ctx context.Context) {
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, group.Stop(ctx), context.DeadlineExceeded)
+}